@@ -57,6 +57,7 @@ func main() {
 		config.CorsAllowedOrigins,
 		api.SwaggerJsonTemplate(),
 		api.RegisterSubmitHandler,
+		api.RegisterJobsHandler,
 		api.RegisterEventHandler,
 	)
 	defer shutdownGateway()
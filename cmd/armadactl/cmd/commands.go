@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"github.com/G-Research/armada/cmd/armadactl/cmd/job"
 	"github.com/G-Research/armada/cmd/armadactl/cmd/queue"
+	"github.com/G-Research/armada/cmd/armadactl/cmd/schedule"
 	"github.com/spf13/cobra"
 )
 
@@ -11,17 +13,21 @@ func init() {
 		Delete(),
 		Update(),
 		Info(),
+		Get(),
+		Pause(),
+		Resume(),
 	)
 }
 
 func Create() *cobra.Command {
 	command := cobra.Command{
 		Use:   "create",
-		Short: "Create Armada resource. Supported: queue",
+		Short: "Create Armada resource. Supported: queue, schedule",
 	}
 
 	command.AddCommand(
 		queue.Create(),
+		schedule.Create(),
 	)
 
 	return &command
@@ -30,11 +36,12 @@ func Create() *cobra.Command {
 func Delete() *cobra.Command {
 	command := cobra.Command{
 		Use:   "delete",
-		Short: "Delete Armada resource. Supported: queue",
+		Short: "Delete Armada resource. Supported: queue, schedule",
 	}
 
 	command.AddCommand(
 		queue.Delete(),
+		schedule.Delete(),
 	)
 
 	return &command
@@ -56,11 +63,53 @@ func Update() *cobra.Command {
 func Info() *cobra.Command {
 	command := cobra.Command{
 		Use:   "describe",
-		Short: "Retrieve information about armada resource. Supported: queue",
+		Short: "Retrieve information about armada resource. Supported: queue, job",
 	}
 
 	command.AddCommand(
 		queue.Describe(),
+		job.Describe(),
+	)
+
+	return &command
+}
+
+func Get() *cobra.Command {
+	command := cobra.Command{
+		Use:   "get",
+		Short: "List Armada resources. Supported: queues, cordons, schedules",
+	}
+
+	command.AddCommand(
+		queue.List(),
+		schedule.List(),
+		cordonsCmd,
+	)
+
+	return &command
+}
+
+func Pause() *cobra.Command {
+	command := cobra.Command{
+		Use:   "pause",
+		Short: "Pause scheduling of Armada resource. Supported: queue",
+	}
+
+	command.AddCommand(
+		queue.Pause(),
+	)
+
+	return &command
+}
+
+func Resume() *cobra.Command {
+	command := cobra.Command{
+		Use:   "resume",
+		Short: "Resume scheduling of Armada resource. Supported: queue",
+	}
+
+	command.AddCommand(
+		queue.Resume(),
 	)
 
 	return &command
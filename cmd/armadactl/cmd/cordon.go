@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(cordonCmd)
+	rootCmd.AddCommand(uncordonCmd)
+	for _, command := range []*cobra.Command{cordonCmd, uncordonCmd} {
+		command.Flags().String("cluster", "", "Cluster id to select")
+		command.Flags().String("pool", "", "Pool to select, all clusters of the pool are selected")
+	}
+	cordonCmd.Flags().String("reason", "", "Reason for the cordon")
+	cordonCmd.Flags().Bool("drain", false, "Return the leases of jobs leased to the selected clusters but not yet running")
+}
+
+var cordonCmd = &cobra.Command{
+	Use:   "cordon",
+	Short: "Stop leasing jobs to a cluster or pool",
+	Long: `Stop leasing new jobs to a cluster or all clusters of a pool, running jobs are not affected.
+With --drain, jobs leased to the clusters which have not started yet are returned to their queues, this requires
+the server to publish events to NATS or Kafka.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		clusterId, _ := cmd.Flags().GetString("cluster")
+		pool, _ := cmd.Flags().GetString("pool")
+		reason, _ := cmd.Flags().GetString("reason")
+		drain, _ := cmd.Flags().GetBool("drain")
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, err := client.CordonCluster(ctx, &api.ClusterCordonRequest{
+				ClusterId: clusterId,
+				Pool:      pool,
+				Reason:    reason,
+				Drain:     drain,
+			})
+			if err != nil {
+				exitWithError(err)
+			}
+
+			log.Infof("Cordoned %s", cordonTarget(clusterId, pool))
+			if len(result.DrainedJobIds) > 0 {
+				log.Infof("The following jobs were returned to their queues:")
+				for _, jobId := range result.DrainedJobIds {
+					log.Infof("%s", jobId)
+				}
+			}
+		})
+	},
+}
+
+var uncordonCmd = &cobra.Command{
+	Use:   "uncordon",
+	Short: "Resume leasing jobs to a cordoned cluster or pool",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		clusterId, _ := cmd.Flags().GetString("cluster")
+		pool, _ := cmd.Flags().GetString("pool")
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			_, err := client.UncordonCluster(ctx, &api.ClusterUncordonRequest{ClusterId: clusterId, Pool: pool})
+			if err != nil {
+				exitWithError(err)
+			}
+			log.Infof("Uncordoned %s", cordonTarget(clusterId, pool))
+		})
+	},
+}
+
+var cordonsCmd = &cobra.Command{
+	Use:   "cordons",
+	Short: "Lists cordoned clusters and pools",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, err := client.GetClusterCordons(ctx, &types.Empty{})
+			if err != nil {
+				exitWithError(err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CLUSTER\tPOOL\tREQUESTOR\tCREATED\tREASON")
+			for _, cordon := range result.Cordons {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", cordon.ClusterId, cordon.Pool, cordon.Requestor, cordon.Created.Format("2006-01-02 15:04:05"), cordon.Reason)
+			}
+			w.Flush()
+		})
+	},
+}
+
+func cordonTarget(clusterId string, pool string) string {
+	if clusterId != "" {
+		return "cluster " + clusterId
+	}
+	return "pool " + pool
+}
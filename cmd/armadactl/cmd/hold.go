@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(holdCmd)
+	rootCmd.AddCommand(releaseCmd)
+	for _, command := range []*cobra.Command{holdCmd, releaseCmd} {
+		command.Flags().StringSlice(
+			"jobId", []string{}, "Jobs to select, can be specified multiple times")
+		command.Flags().String(
+			"queue", "", "Queue including the jobs to select (requires job set or labels to be specified)")
+		command.Flags().String(
+			"jobSet", "", "Job set including the jobs to select (requires queue to be specified)")
+		command.Flags().StringToString(
+			"labels", map[string]string{}, "Only select jobs with all of these labels, e.g. --labels key1=value1,key2=value2")
+	}
+}
+
+var holdCmd = &cobra.Command{
+	Use:   "hold",
+	Short: "Hold queued jobs in Armada",
+	Long: `Take queued jobs out of their queue, so they are not scheduled until they are released.
+Jobs are selected either by job id, or by queue with job set and/or labels.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		request := &api.JobHoldRequest{}
+		request.JobIds, request.Queue, request.JobSetId, request.Labels = holdSelector(cmd)
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, err := client.HoldJobs(ctx, request)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = reportHoldResults(result.HoldResults, "held")
+			if err != nil {
+				exitWithError(err)
+			}
+		})
+	},
+}
+
+var releaseCmd = &cobra.Command{
+	Use:   "release",
+	Short: "Release held jobs in Armada",
+	Long: `Put held jobs back into their queue with their priority, so they are scheduled again.
+Jobs are selected either by job id, or by queue with job set and/or labels.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		request := &api.JobReleaseRequest{}
+		request.JobIds, request.Queue, request.JobSetId, request.Labels = holdSelector(cmd)
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, err := client.ReleaseJobs(ctx, request)
+			if err != nil {
+				exitWithError(err)
+			}
+
+			err = reportHoldResults(result.ReleaseResults, "released")
+			if err != nil {
+				exitWithError(err)
+			}
+		})
+	},
+}
+
+func holdSelector(cmd *cobra.Command) (jobIds []string, queue string, jobSet string, labels map[string]string) {
+	jobIds, _ = cmd.Flags().GetStringSlice("jobId")
+	queue, _ = cmd.Flags().GetString("queue")
+	jobSet, _ = cmd.Flags().GetString("jobSet")
+	labels, _ = cmd.Flags().GetStringToString("labels")
+	return jobIds, queue, jobSet, labels
+}
+
+func reportHoldResults(results map[string]string, action string) error {
+	if len(results) == 0 {
+		return fmt.Errorf("no jobs were %s", action)
+	}
+
+	var succeededIds []string
+	erroredIds := make(map[string]string)
+	for jobId, errorString := range results {
+		if errorString != "" {
+			erroredIds[jobId] = errorString
+		} else {
+			succeededIds = append(succeededIds, jobId)
+		}
+	}
+
+	if len(succeededIds) > 0 {
+		log.Infof("The following jobs were %s:", action)
+		for _, jobId := range succeededIds {
+			log.Infof("%s", jobId)
+		}
+	}
+
+	if len(erroredIds) > 0 {
+		log.Infof("\nThe following jobs could not be %s:", action)
+		for jobId, errorString := range erroredIds {
+			log.Infof("%s: %s", jobId, errorString)
+		}
+		return fmt.Errorf("Some jobs could not be %s", action)
+	}
+	return nil
+}
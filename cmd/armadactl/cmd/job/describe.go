@@ -0,0 +1,92 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func Describe() *cobra.Command {
+	command := &cobra.Command{
+		Use:          "job <jobId>",
+		Short:        "Prints out job info.",
+		Long:         "Prints out job info including its state, the cluster it is leased by and the reason it was last retried.",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		jobId := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+		conn, err := client.CreateApiConnection(apiConnectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		apiClient := api.NewJobsClient(conn)
+		ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+		defer cancel()
+
+		jobs, err := apiClient.GetJobs(ctx, &api.JobGetRequest{JobIds: []string{jobId}})
+		if err != nil {
+			return fmt.Errorf("failed to retrieve job: %s", err)
+		}
+		statuses, err := apiClient.GetJobStatus(ctx, &api.JobStatusRequest{JobIds: []string{jobId}})
+		if err != nil {
+			return fmt.Errorf("failed to retrieve job status: %s", err)
+		}
+		if len(jobs.Jobs) == 0 || len(statuses.Statuses) == 0 {
+			return fmt.Errorf("job %s not found", jobId)
+		}
+
+		return printJob(cmd.OutOrStdout(), jobs.Jobs[0], statuses.Statuses[0])
+	}
+
+	return command
+}
+
+func printJob(out io.Writer, job *api.Job, status *api.JobStatus) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Job:\t%s\n", job.Id)
+	fmt.Fprintf(w, "Queue:\t%s\n", job.Queue)
+	fmt.Fprintf(w, "Job set:\t%s\n", job.JobSetId)
+	fmt.Fprintf(w, "Owner:\t%s\n", job.Owner)
+	fmt.Fprintf(w, "Namespace:\t%s\n", job.Namespace)
+	fmt.Fprintf(w, "Priority:\t%g\n", job.Priority)
+	fmt.Fprintf(w, "Created:\t%s\n", job.Created.Format(time.RFC3339))
+	fmt.Fprintf(w, "State:\t%s\n", status.State)
+	if status.State == api.JobState_Queued {
+		fmt.Fprintf(w, "Effective priority:\t%g\n", status.EffectivePriority)
+	}
+	if status.ThrottledReason != "" {
+		fmt.Fprintf(w, "Throttled:\t%s\n", status.ThrottledReason)
+	}
+	if status.ClusterId != "" {
+		fmt.Fprintf(w, "Cluster:\t%s\n", status.ClusterId)
+	}
+	if status.Started != nil {
+		fmt.Fprintf(w, "Started:\t%s\n", status.Started.Format(time.RFC3339))
+	}
+	fmt.Fprintf(w, "Retry attempts:\t%d\n", status.RetryAttempts)
+	if status.LastFailureReason != "" {
+		fmt.Fprintf(w, "Last failure reason:\t%s\n", status.LastFailureReason)
+	}
+	for i, podSpec := range job.GetAllPodSpecs() {
+		images := make([]string, 0, len(podSpec.Containers))
+		for _, container := range podSpec.Containers {
+			images = append(images, container.Image)
+		}
+		fmt.Fprintf(w, "Pod %d images:\t%s\n", i, strings.Join(images, ", "))
+	}
+	return w.Flush()
+}
@@ -27,6 +27,10 @@ func Create() *cobra.Command {
 	command.Flags().StringToString("resourceLimits", map[string]string{},
 		"Command separated list of resource limits pairs, defaults to empty list.\nExample: --resourceLimits cpu=0.3,memory=0.2",
 	)
+	command.Flags().Float64("priorityAgingRate", 0, "Improvement of job priority per hour spent queued, defaults to no aging.")
+	command.Flags().Float64("priorityAgingCap", 0, "Maximum improvement of job priority through aging, defaults to no cap.")
+	command.Flags().Uint32("maxRunning", 0, "Maximum number of jobs of the queue leased or running at once, defaults to no limit.")
+	command.Flags().Float64("maxOvercommitRatio", 0, "Maximum ratio of resource limits to resource requests of job pods, defaults to no cap.")
 
 	command.RunE = func(cmd *cobra.Command, args []string) error {
 		queueName, err := cmd.Flags().GetString("queueName")
@@ -54,6 +58,26 @@ func Create() *cobra.Command {
 			return fmt.Errorf("failed to retrieve resourceLimits value: %s", err)
 		}
 
+		agingRate, err := cmd.Flags().GetFloat64("priorityAgingRate")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve priorityAgingRate value: %s", err)
+		}
+
+		agingCap, err := cmd.Flags().GetFloat64("priorityAgingCap")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve priorityAgingCap value: %s", err)
+		}
+
+		maxRunning, err := cmd.Flags().GetUint32("maxRunning")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve maxRunning value: %s", err)
+		}
+
+		maxOvercommitRatio, err := cmd.Flags().GetFloat64("maxOvercommitRatio")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve maxOvercommitRatio value: %s", err)
+		}
+
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 		conn, err := client.CreateApiConnection(apiConnectionDetails)
 		if err != nil {
@@ -64,11 +88,15 @@ func Create() *cobra.Command {
 		submissionClient := api.NewSubmitClient(conn)
 
 		queue := &api.Queue{
-			Name:           queueName,
-			PriorityFactor: priority,
-			UserOwners:     owners,
-			GroupOwners:    groups,
-			ResourceLimits: resourceLimits,
+			Name:               queueName,
+			PriorityFactor:     priority,
+			UserOwners:         owners,
+			GroupOwners:        groups,
+			ResourceLimits:     resourceLimits,
+			PriorityAgingRate:  agingRate,
+			PriorityAgingCap:   agingCap,
+			MaxRunning:         maxRunning,
+			MaxOvercommitRatio: maxOvercommitRatio,
 		}
 
 		if err = client.CreateQueue(submissionClient, queue); err != nil {
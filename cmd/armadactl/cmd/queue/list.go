@@ -0,0 +1,138 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+const listPageSize = 100
+
+func List() *cobra.Command {
+	command := &cobra.Command{
+		Use:          "queues",
+		Short:        "Lists queues",
+		Long:         "Lists queues ordered by name, optionally with the number of queued and leased jobs, priority and usage of each queue.",
+		SilenceUsage: true,
+	}
+
+	command.Flags().SortFlags = false
+	command.Flags().String("owner", "", "Only list queues owned by this user")
+	command.Flags().String("group", "", "Only list queues owned by this group")
+	command.Flags().String("namePrefix", "", "Only list queues with names starting with this prefix")
+	command.Flags().Bool("status", false, "Include queued and leased jobs, priority and usage of each queue")
+	command.Flags().StringP("output", "o", "table", "Output format, one of: table, json, yaml")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		owner, err := cmd.Flags().GetString("owner")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve owner value: %s", err)
+		}
+
+		group, err := cmd.Flags().GetString("group")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve group value: %s", err)
+		}
+
+		namePrefix, err := cmd.Flags().GetString("namePrefix")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve namePrefix value: %s", err)
+		}
+
+		includeStatus, err := cmd.Flags().GetBool("status")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve status value: %s", err)
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve output value: %s", err)
+		}
+		if output != "table" && output != "json" && output != "yaml" {
+			return fmt.Errorf("unsupported output format %q, must be one of: table, json, yaml", output)
+		}
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+		conn, err := client.CreateApiConnection(apiConnectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		queues, err := client.ListQueues(api.NewSubmitClient(conn), &api.QueueListRequest{
+			Owner:         owner,
+			Group:         group,
+			NamePrefix:    namePrefix,
+			Take:          listPageSize,
+			IncludeStatus: includeStatus,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list queues: %s", err)
+		}
+
+		return printQueues(cmd.OutOrStdout(), queues, output, includeStatus)
+	}
+
+	return command
+}
+
+func printQueues(out io.Writer, queues []*api.QueueListItem, output string, includeStatus bool) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(queues, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(queues)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	header := "NAME\tPRIORITY FACTOR\tOWNERS\tGROUPS"
+	if includeStatus {
+		header += "\tQUEUED\tLEASED\tPRIORITY\tUSAGE"
+	}
+	fmt.Fprintln(w, header)
+	for _, item := range queues {
+		queue := item.Queue
+		row := fmt.Sprintf("%s\t%g\t%s\t%s", queue.Name, queue.PriorityFactor,
+			strings.Join(queue.UserOwners, ","), strings.Join(queue.GroupOwners, ","))
+		if includeStatus && item.Status != nil {
+			row += fmt.Sprintf("\t%d\t%d\t%s\t%s", item.Status.QueuedJobs, item.Status.LeasedJobs,
+				formatPoolPriorities(item.Status.Priority), common.ComputeResources(item.Status.Usage).String())
+		}
+		fmt.Fprintln(w, row)
+	}
+	return w.Flush()
+}
+
+func formatPoolPriorities(priorities map[string]float64) string {
+	pools := make([]string, 0, len(priorities))
+	for pool := range priorities {
+		pools = append(pools, pool)
+	}
+	sort.Strings(pools)
+
+	formatted := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		formatted = append(formatted, fmt.Sprintf("%s=%.2f", pool, priorities[pool]))
+	}
+	return strings.Join(formatted, ",")
+}
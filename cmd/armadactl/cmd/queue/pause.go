@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func Pause() *cobra.Command {
+	return schedulingCommand(
+		"Pause scheduling of queue",
+		"Queued jobs of a paused queue are not leased until the queue is resumed, jobs already leased or running are not affected.",
+		"paused",
+		func(submitClient api.SubmitClient, request *api.QueueSchedulingRequest) error {
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			_, err := submitClient.PauseQueueScheduling(ctx, request)
+			return err
+		})
+}
+
+func Resume() *cobra.Command {
+	return schedulingCommand(
+		"Resume scheduling of queue",
+		"Queued jobs of a resumed queue are leased again.",
+		"resumed",
+		func(submitClient api.SubmitClient, request *api.QueueSchedulingRequest) error {
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			_, err := submitClient.ResumeQueueScheduling(ctx, request)
+			return err
+		})
+}
+
+func schedulingCommand(short string, long string, action string, call func(api.SubmitClient, *api.QueueSchedulingRequest) error) *cobra.Command {
+	command := &cobra.Command{
+		Use:          "queue",
+		Short:        short,
+		Long:         long,
+		SilenceUsage: true,
+	}
+
+	command.Flags().SortFlags = false
+	command.Flags().StringP("queueName", "n", "", "[required] Queue name")
+	command.MarkFlagRequired("queueName")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		queueName, err := cmd.Flags().GetString("queueName")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve name value: %s", err)
+		}
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+		conn, err := client.CreateApiConnection(apiConnectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		if err := call(api.NewSubmitClient(conn), &api.QueueSchedulingRequest{Name: queueName}); err != nil {
+			return fmt.Errorf("failed to update queue %s: %s", queueName, err)
+		}
+
+		cmd.Printf("Scheduling of queue %s %s", queueName, action)
+		return nil
+	}
+
+	return command
+}
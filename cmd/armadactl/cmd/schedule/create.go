@@ -0,0 +1,87 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+	"github.com/G-Research/armada/pkg/client/domain"
+	"github.com/G-Research/armada/pkg/client/util"
+	"github.com/G-Research/armada/pkg/client/validation"
+)
+
+func Create() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "schedule <name> ./path/to/jobs.yaml",
+		Short: "Create recurring job schedule",
+		Long: `Creates a schedule submitting the jobs from file each time the cron expression fires.
+The file has the same format as for armadactl submit, jobs must not have client ids.`,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+	}
+
+	command.Flags().SortFlags = false
+	command.Flags().String("cron", "", "[required] Cron expression in standard 5 field format, evaluated in UTC")
+	command.Flags().String("concurrencyPolicy", "allow",
+		"What to do when jobs of the previous firing are still active, one of: allow, forbid, replace")
+	command.MarkFlagRequired("cron")
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		filePath := args[1]
+
+		cron, err := cmd.Flags().GetString("cron")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve cron value: %s", err)
+		}
+
+		policyName, err := cmd.Flags().GetString("concurrencyPolicy")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve concurrencyPolicy value: %s", err)
+		}
+		policy, ok := api.ConcurrencyPolicy_value[strings.Title(strings.ToLower(policyName))]
+		if !ok {
+			return fmt.Errorf("unsupported concurrency policy %q, must be one of: allow, forbid, replace", policyName)
+		}
+
+		if ok, err := validation.ValidateSubmitFile(filePath); !ok {
+			return err
+		}
+		submitFile := &domain.JobSubmitFile{}
+		if err := util.BindJsonOrYaml(filePath, submitFile); err != nil {
+			return err
+		}
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+		conn, err := client.CreateApiConnection(apiConnectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		_, err = api.NewSubmitClient(conn).CreateSchedule(ctx, &api.JobSchedule{
+			Name: name,
+			Cron: cron,
+			Template: &api.JobSubmitRequest{
+				Queue:           submitFile.Queue,
+				JobSetId:        submitFile.JobSetId,
+				JobRequestItems: submitFile.Jobs,
+			},
+			ConcurrencyPolicy: api.ConcurrencyPolicy(policy),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create schedule %s: %s", name, err)
+		}
+
+		cmd.Printf("Schedule %s created", name)
+		return nil
+	}
+
+	return command
+}
@@ -0,0 +1,43 @@
+package schedule
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func Delete() *cobra.Command {
+	command := &cobra.Command{
+		Use:          "schedule <name>",
+		Short:        "Delete job schedule",
+		Long:         "Deletes the schedule, jobs already submitted by it are not cancelled.",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+		conn, err := client.CreateApiConnection(apiConnectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		if _, err := api.NewSubmitClient(conn).DeleteSchedule(ctx, &api.JobScheduleDeleteRequest{Name: name}); err != nil {
+			return fmt.Errorf("failed to delete schedule %s: %s", name, err)
+		}
+
+		cmd.Printf("Schedule %s deleted", name)
+		return nil
+	}
+
+	return command
+}
@@ -0,0 +1,52 @@
+package schedule
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func List() *cobra.Command {
+	command := &cobra.Command{
+		Use:          "schedules",
+		Short:        "Lists job schedules",
+		Long:         "Lists job schedules of queues you can submit jobs to, ordered by name.",
+		SilenceUsage: true,
+	}
+
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+		conn, err := client.CreateApiConnection(apiConnectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		schedules, err := api.NewSubmitClient(conn).GetSchedules(ctx, &types.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to list schedules: %s", err)
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "NAME\tCRON\tCONCURRENCY POLICY\tQUEUE\tJOB SET\tJOBS\tOWNER")
+		for _, schedule := range schedules.Schedules {
+			queue, jobSetId, jobs := "", "", 0
+			if schedule.Template != nil {
+				queue, jobSetId, jobs = schedule.Template.Queue, schedule.Template.JobSetId, len(schedule.Template.JobRequestItems)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n", schedule.Name, schedule.Cron, schedule.ConcurrencyPolicy,
+				queue, jobSetId, jobs, schedule.Owner)
+		}
+		return w.Flush()
+	}
+
+	return command
+}
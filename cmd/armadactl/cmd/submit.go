@@ -59,6 +59,9 @@ var submitCmd = &cobra.Command{
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 
 		requests := client.CreateChunkedSubmitRequests(submitFile.Queue, submitFile.JobSetId, submitFile.Jobs)
+		for _, request := range requests {
+			request.MaxRunning = submitFile.MaxRunning
+		}
 
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			submissionClient := api.NewSubmitClient(conn)
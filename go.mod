@@ -36,6 +36,7 @@ require (
 	github.com/pquerna/cachecontrol v0.0.0-20180517163645-1555304b9b35 // indirect
 	github.com/prometheus/client_golang v1.11.0
 	github.com/rakyll/statik v0.1.7
+	github.com/segmentio/kafka-go v0.4.17
 	github.com/sirupsen/logrus v1.8.1
 	github.com/spf13/cobra v1.2.1
 	github.com/spf13/pflag v1.0.5
@@ -53,4 +54,5 @@ require (
 	k8s.io/component-base v0.22.3
 	k8s.io/component-helpers v0.22.2
 	k8s.io/kubelet v0.22.3
+	sigs.k8s.io/yaml v1.2.0
 )
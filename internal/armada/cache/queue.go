@@ -10,6 +10,7 @@ import (
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/armada/scheduling"
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -25,6 +26,7 @@ type QueueCache struct {
 	queueDurations         map[string]map[string]*metrics.FloatMetrics
 	queuedResources        map[string]map[string]metrics.ResourceMetrics
 	queueNonMatchingJobIds map[string]map[string]stringSet
+	queueThrottledJobs     map[string]map[string]int
 }
 
 func NewQueueCache(
@@ -38,7 +40,8 @@ func NewQueueCache(
 		schedulingInfoRepository: schedulingInfoRepository,
 		queueDurations:           map[string]map[string]*metrics.FloatMetrics{},
 		queuedResources:          map[string]map[string]metrics.ResourceMetrics{},
-		queueNonMatchingJobIds:   map[string]map[string]stringSet{}}
+		queueNonMatchingJobIds:   map[string]map[string]stringSet{},
+		queueThrottledJobs:       map[string]map[string]int{}}
 
 	return collector
 }
@@ -63,16 +66,21 @@ func (c *QueueCache) Refresh() {
 		resourceUsageByPool := map[string]*metrics.ResourceMetricsRecorder{}
 		nonMatchingJobs := map[string]stringSet{}
 		queueDurationByPool := map[string]*metrics.FloatMetricsRecorder{}
+		limitedJobs := []*api.Job{}
 		currentTime := time.Now()
 		err := c.jobRepository.IterateQueueJobs(queue.Name, func(job *api.Job) {
+			if queue.MaxRunning > 0 || job.JobSetMaxRunning > 0 {
+				limitedJobs = append(limitedJobs, job)
+			}
 			jobResources := common.TotalJobResourceRequest(job)
 			nonMatchingClusters := stringSet{}
 			queuedTime := currentTime.Sub(job.Created)
 
+			// Jobs only count towards pools with a cluster they are allowed on and can run on
 			for pool, infos := range clusterInfoByPool {
 				matches := false
 				for _, schedulingInfo := range infos {
-					if scheduling.MatchSchedulingRequirements(job, schedulingInfo) {
+					if job.AllowsCluster(schedulingInfo.ClusterId, pool) && scheduling.MatchSchedulingRequirements(job, schedulingInfo) {
 						matches = true
 					} else {
 						nonMatchingClusters[schedulingInfo.ClusterId] = empty{}
@@ -102,11 +110,49 @@ func (c *QueueCache) Refresh() {
 			log.Errorf("Error while getting queue %s resources %s", queue.Name, err)
 		}
 
+		throttled, err := c.countThrottledJobs(queue, limitedJobs)
+		if err != nil {
+			log.Errorf("Error while counting queue %s throttled jobs %s", queue.Name, err)
+		}
+
 		c.updateQueuedNonMatchingJobs(queue.Name, nonMatchingJobs)
 		c.updateQueueMetrics(queue.Name, resourceUsageByPool, queueDurationByPool)
+		c.updateQueueThrottledJobs(queue.Name, throttled)
 	}
 }
 
+// Counts queued jobs held back by max running limits, by the limit holding them back
+func (c *QueueCache) countThrottledJobs(queue *api.Queue, limitedJobs []*api.Job) (map[string]int, error) {
+	throttled := map[string]int{}
+	if len(limitedJobs) == 0 {
+		return throttled, nil
+	}
+
+	jobSetIds := []string{}
+	for _, job := range limitedJobs {
+		if job.JobSetMaxRunning > 0 && !util.ContainsString(jobSetIds, job.JobSetId) {
+			jobSetIds = append(jobSetIds, job.JobSetId)
+		}
+	}
+	queueRunning, jobSetRunning, e := c.jobRepository.GetLeasedJobCounts(queue.Name, jobSetIds)
+	if e != nil {
+		return throttled, e
+	}
+
+	for _, job := range limitedJobs {
+		if limit := queue.ThrottledBy(job, queueRunning, jobSetRunning[job.JobSetId]); limit != "" {
+			throttled[limit]++
+		}
+	}
+	return throttled, nil
+}
+
+func (c *QueueCache) updateQueueThrottledJobs(queueName string, throttled map[string]int) {
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+	c.queueThrottledJobs[queueName] = throttled
+}
+
 func (c *QueueCache) updateQueueMetrics(queueName string, resourcesByPool map[string]*metrics.ResourceMetricsRecorder,
 	queueDurationsByPool map[string]*metrics.FloatMetricsRecorder) {
 	c.refreshMutex.Lock()
@@ -137,6 +183,7 @@ func (c *QueueCache) GetQueueMetrics(queueName string) *metrics.QueueMetrics {
 	return &metrics.QueueMetrics{
 		Resources: c.queuedResources[queueName],
 		Durations: c.queueDurations[queueName],
+		Throttled: c.queueThrottledJobs[queueName],
 	}
 }
 
@@ -177,3 +224,7 @@ func matches(nonMatchingJobs map[string]stringSet, clusterId, jobId string) bool
 func (c *QueueCache) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
 	return c.jobRepository.TryLeaseJobs(clusterId, queue, jobs)
 }
+
+func (c *QueueCache) GetLeasedJobCounts(queue string, jobSetIds []string) (int, map[string]int, error) {
+	return c.jobRepository.GetLeasedJobCounts(queue, jobSetIds)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/auth/configuration"
+	"github.com/G-Research/armada/internal/common/eventstream"
 )
 
 type ArmadaConfig struct {
@@ -21,6 +22,7 @@ type ArmadaConfig struct {
 	PriorityHalfTime time.Duration
 	Redis            redis.UniversalOptions
 	EventsNats       NatsConfig
+	EventsKafka      KafkaConfig
 	EventsRedis      redis.UniversalOptions
 
 	Scheduling        SchedulingConfig
@@ -28,9 +30,19 @@ type ArmadaConfig struct {
 	DatabaseRetention DatabaseRetentionPolicy
 	EventRetention    EventRetentionPolicy
 
-	Metrics MetricsConfig
+	Metrics        MetricsConfig
+	LeaderElection LeaderElectionConfig
 }
 
+type FairnessModel string
+
+const (
+	// Usage of each resource is weighted by its scarcity and summed, used when no model is set
+	ScarcityFairness FairnessModel = "scarcity"
+	// Usage is the largest fraction of the pool capacity used of any resource
+	DominantResourceFairness FairnessModel = "dominantResource"
+)
+
 type SchedulingConfig struct {
 	UseProbabilisticSchedulingForAllResources bool
 	QueueLeaseBatchSize                       uint
@@ -44,6 +56,18 @@ type SchedulingConfig struct {
 	MaxRetries                                uint // Maximum number of retries before a Job is failed
 	ResourceScarcity                          map[string]float64
 	PoolResourceScarcity                      map[string]map[string]float64
+	// How usage of different resources is compared between queues, resource scarcity is only used by ScarcityFairness
+	FairnessModel FairnessModel
+	// How often queued jobs are rescored with their aged priority, jobs are not aged when 0
+	PriorityAgingInterval time.Duration
+	// How often jobs submitted with a not before time are checked for being due and job schedules are fired
+	ScheduleCheckInterval time.Duration
+	// How long queues, usage and cordons read by lease requests are shared between requests before being reloaded,
+	// every request reloads them when 0
+	StateRefreshInterval time.Duration
+	// How many queues are read, evaluated and leased from concurrently by a lease request, queues are processed
+	// one at a time when 0 or 1
+	QueueLeaseParallelism int
 }
 
 type DatabaseRetentionPolicy struct {
@@ -61,11 +85,23 @@ type LeaseSettings struct {
 }
 
 type NatsConfig struct {
+	// Either stan (NATS Streaming, default) or jetstream
+	Transport      string
 	Servers        []string
 	ClusterID      string
 	Subject        string
 	QueueGroup     string
 	JobStatusGroup string
+	JetStream      eventstream.JetStreamConfig
+}
+
+// Events are routed through Kafka instead of NATS if brokers are set
+type KafkaConfig struct {
+	Brokers        []string
+	Topic          string
+	QueueGroup     string
+	JobStatusGroup string
+	Settings       eventstream.KafkaConfig
 }
 
 type QueueManagementConfig struct {
@@ -76,3 +112,12 @@ type QueueManagementConfig struct {
 type MetricsConfig struct {
 	RefreshInterval time.Duration
 }
+
+// Background tasks which must run on a single server replica, like lease expiry, only run on the elected leader.
+// Leader election is disabled when LeaseDuration is 0, all replicas then run all tasks.
+type LeaderElectionConfig struct {
+	// How long the leader lock is held without renewal, a crashed leader is replaced after at most this long
+	LeaseDuration time.Duration
+	// How often the leader renews the lock and other replicas try to acquire it, should be less than half of LeaseDuration
+	RetryInterval time.Duration
+}
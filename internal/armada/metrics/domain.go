@@ -3,4 +3,6 @@ package metrics
 type QueueMetrics struct {
 	Resources map[string]ResourceMetrics
 	Durations map[string]*FloatMetrics
+	// Number of queued jobs held back by each max running limit
+	Throttled map[string]int
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/armada/scheduling"
 	"github.com/G-Research/armada/internal/common"
@@ -23,14 +24,18 @@ func ExposeDataMetrics(
 	jobRepository repository.JobRepository,
 	usageRepository repository.UsageRepository,
 	schedulingInfoRepository repository.SchedulingInfoRepository,
+	cordonRepository repository.CordonRepository,
 	queueMetrics QueueMetricProvider,
+	schedulingConfig *configuration.SchedulingConfig,
 ) *QueueInfoCollector {
 	collector := &QueueInfoCollector{
 		queueRepository:          queueRepository,
 		jobRepository:            jobRepository,
 		usageRepository:          usageRepository,
 		schedulingInfoRepository: schedulingInfoRepository,
-		queueMetrics:             queueMetrics}
+		cordonRepository:         cordonRepository,
+		queueMetrics:             queueMetrics,
+		schedulingConfig:         schedulingConfig}
 	prometheus.MustRegister(collector)
 	return collector
 }
@@ -40,7 +45,9 @@ type QueueInfoCollector struct {
 	jobRepository            repository.JobRepository
 	usageRepository          repository.UsageRepository
 	schedulingInfoRepository repository.SchedulingInfoRepository
+	cordonRepository         repository.CordonRepository
 	queueMetrics             QueueMetricProvider
+	schedulingConfig         *configuration.SchedulingConfig
 }
 
 var queueSizeDesc = prometheus.NewDesc(
@@ -50,6 +57,34 @@ var queueSizeDesc = prometheus.NewDesc(
 	nil,
 )
 
+var queueSchedulingPausedDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_scheduling_paused",
+	"Whether scheduling of a queue is paused",
+	[]string{"queueName"},
+	nil,
+)
+
+var queueMaxRunningDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_max_running",
+	"Maximum number of running jobs of a queue",
+	[]string{"queueName"},
+	nil,
+)
+
+var queueThrottledJobsDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_throttled_jobs",
+	"Number of queued jobs held back by a max running limit",
+	[]string{"queueName", "limit"},
+	nil,
+)
+
+var clusterCordonedDesc = prometheus.NewDesc(
+	MetricPrefix+"cluster_cordoned",
+	"Cordoned clusters and pools, which don't lease jobs",
+	[]string{"cluster", "pool"},
+	nil,
+)
+
 var queuePriorityDesc = prometheus.NewDesc(
 	MetricPrefix+"queue_priority",
 	"Priority of a queue",
@@ -183,6 +218,13 @@ var queueUsedDesc = prometheus.NewDesc(
 	nil,
 )
 
+var queueLimitDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_resource_limit",
+	"Resource limits of running jobs of a queue",
+	[]string{"cluster", "pool", "queueName", "resourceType", "nodeType"},
+	nil,
+)
+
 var queueLeasedPodCountDesc = prometheus.NewDesc(
 	MetricPrefix+"queue_leased_pod_count",
 	"Number of leased pods",
@@ -206,6 +248,10 @@ var clusterAvailableCapacity = prometheus.NewDesc(
 
 func (c *QueueInfoCollector) Describe(desc chan<- *prometheus.Desc) {
 	desc <- queueSizeDesc
+	desc <- queueSchedulingPausedDesc
+	desc <- queueMaxRunningDesc
+	desc <- queueThrottledJobsDesc
+	desc <- clusterCordonedDesc
 	desc <- queuePriorityDesc
 	desc <- queueDurationDesc
 	desc <- minQueueDurationDesc
@@ -253,6 +299,13 @@ func (c *QueueInfoCollector) Collect(metrics chan<- prometheus.Metric) {
 		return
 	}
 
+	cordons, e := c.cordonRepository.GetCordons()
+	if e != nil {
+		log.Errorf("Error while getting cluster cordons %s", e)
+		recordInvalidMetrics(metrics, e)
+		return
+	}
+
 	activeClusterInfo := scheduling.FilterActiveClusterSchedulingInfoReports(clusterSchedulingInfo)
 	runDurationsByPool, runResourceByPool := c.calculateRunningJobStats(queues, activeClusterInfo)
 
@@ -270,15 +323,27 @@ func (c *QueueInfoCollector) Collect(metrics chan<- prometheus.Metric) {
 		for cluster := range poolReports {
 			poolPriorities[cluster] = clusterPriorities[cluster]
 		}
-		queuePriority := scheduling.CalculateQueuesPriorityInfo(poolPriorities, poolReports, queues)
+		fairness := scheduling.NewFairness(c.schedulingConfig, pool, poolReports)
+		queuePriority := scheduling.CalculateQueuesPriorityInfo(fairness, poolPriorities, poolReports, queues)
 		for queue, priority := range queuePriority {
 			metrics <- prometheus.MustNewConstMetric(queuePriorityDesc, prometheus.GaugeValue, priority.Priority, pool, queue.Name)
 		}
 	}
 
+	for _, cordon := range cordons {
+		metrics <- prometheus.MustNewConstMetric(clusterCordonedDesc, prometheus.GaugeValue, 1, cordon.ClusterId, cordon.Pool)
+	}
+
 	for i, q := range queues {
 		metrics <- prometheus.MustNewConstMetric(queueSizeDesc, prometheus.GaugeValue, float64(queueSizes[i]), q.Name)
+		metrics <- prometheus.MustNewConstMetric(queueSchedulingPausedDesc, prometheus.GaugeValue, boolToFloat(q.SchedulingPaused), q.Name)
+		if q.MaxRunning > 0 {
+			metrics <- prometheus.MustNewConstMetric(queueMaxRunningDesc, prometheus.GaugeValue, float64(q.MaxRunning), q.Name)
+		}
 		queueMetrics := c.queueMetrics.GetQueueMetrics(q.Name)
+		for limit, throttled := range queueMetrics.Throttled {
+			metrics <- prometheus.MustNewConstMetric(queueThrottledJobsDesc, prometheus.GaugeValue, float64(throttled), q.Name, limit)
+		}
 		for pool, queueDurations := range queueMetrics.Durations {
 			if queueDurations.GetCount() > 0 {
 				metrics <- prometheus.MustNewConstHistogram(queueDurationDesc, queueDurations.GetCount(),
@@ -353,6 +418,17 @@ func (c *QueueInfoCollector) recordQueueUsageMetrics(metrics chan<- prometheus.M
 							resourceType,
 							nodeTypeUsage.NodeType.Id)
 					}
+					for resourceType, value := range queueReport.ResourcesLimit {
+						metrics <- prometheus.MustNewConstMetric(
+							queueLimitDesc,
+							prometheus.GaugeValue,
+							common.QuantityAsFloat64(value),
+							cluster,
+							report.Pool,
+							queueReport.Name,
+							resourceType,
+							nodeTypeUsage.NodeType.Id)
+					}
 					for phase, count := range queueReport.CountOfPodsByPhase {
 						metrics <- prometheus.MustNewConstMetric(
 							queueLeasedPodCountDesc,
@@ -524,11 +600,21 @@ func (c *QueueInfoCollector) calculateRunningJobStats(
 	return runDurationMetrics, runResourceMetrics
 }
 
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
 func recordInvalidMetrics(metrics chan<- prometheus.Metric, e error) {
 	metrics <- prometheus.NewInvalidMetric(queueSizeDesc, e)
+	metrics <- prometheus.NewInvalidMetric(queueSchedulingPausedDesc, e)
+	metrics <- prometheus.NewInvalidMetric(clusterCordonedDesc, e)
 	metrics <- prometheus.NewInvalidMetric(queuePriorityDesc, e)
 	metrics <- prometheus.NewInvalidMetric(queueResourcesDesc, e)
 	metrics <- prometheus.NewInvalidMetric(queueAllocatedDesc, e)
+	metrics <- prometheus.NewInvalidMetric(queueLimitDesc, e)
 	metrics <- prometheus.NewInvalidMetric(queueDurationDesc, e)
 	metrics <- prometheus.NewInvalidMetric(minQueueDurationDesc, e)
 	metrics <- prometheus.NewInvalidMetric(maxQueueDurationDesc, e)
@@ -13,6 +13,9 @@ const (
 	CancelAnyJobs                             = "cancel_any_jobs"
 	ReprioritizeJobs                          = "reprioritize_jobs"
 	ReprioritizeAnyJobs                       = "reprioritize_any_jobs"
+	HoldJobs                                  = "hold_jobs"
+	HoldAnyJobs                               = "hold_any_jobs"
+	ManageScheduling                          = "manage_scheduling"
 	WatchAllEvents                            = "watch_all_events"
 
 	ExecuteJobs = "execute_jobs"
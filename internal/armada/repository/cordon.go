@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const clusterCordonKey = "Cluster:Cordon" // cluster:{clusterId} or pool:{pool} -> cordon
+
+var ErrCordonNotFound = errors.New("Cordon does not exist")
+
+type CordonRepository interface {
+	GetCordons() ([]*api.ClusterCordon, error)
+	Cordon(cordon *api.ClusterCordon) error
+	Uncordon(clusterId string, pool string) error
+}
+
+type RedisCordonRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisCordonRepository(db redis.UniversalClient) *RedisCordonRepository {
+	return &RedisCordonRepository{db: db}
+}
+
+func (r *RedisCordonRepository) GetCordons() ([]*api.ClusterCordon, error) {
+	result, err := r.db.HGetAll(clusterCordonKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	cordons := make([]*api.ClusterCordon, 0, len(result))
+	for _, v := range result {
+		cordon := &api.ClusterCordon{}
+		e := proto.Unmarshal([]byte(v), cordon)
+		if e != nil {
+			return nil, e
+		}
+		cordons = append(cordons, cordon)
+	}
+	return cordons, nil
+}
+
+// Cordoning a cluster or pool again replaces its cordon
+func (r *RedisCordonRepository) Cordon(cordon *api.ClusterCordon) error {
+	data, e := proto.Marshal(cordon)
+	if e != nil {
+		return e
+	}
+	return r.db.HSet(clusterCordonKey, cordonField(cordon.ClusterId, cordon.Pool), data).Err()
+}
+
+func (r *RedisCordonRepository) Uncordon(clusterId string, pool string) error {
+	removed, e := r.db.HDel(clusterCordonKey, cordonField(clusterId, pool)).Result()
+	if e != nil {
+		return e
+	}
+	if removed == 0 {
+		return ErrCordonNotFound
+	}
+	return nil
+}
+
+func cordonField(clusterId string, pool string) string {
+	if clusterId != "" {
+		return "cluster:" + clusterId
+	}
+	return "pool:" + pool
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestCordonAndUncordon(t *testing.T) {
+	withCordonRepository(func(r *RedisCordonRepository) {
+		clusterCordon := &api.ClusterCordon{ClusterId: "cluster-1", Reason: "maintenance", Created: time.Now().UTC()}
+		poolCordon := &api.ClusterCordon{Pool: "cluster-1", Reason: "incident", Created: time.Now().UTC()}
+
+		assert.Nil(t, r.Cordon(clusterCordon))
+		assert.Nil(t, r.Cordon(poolCordon))
+
+		cordons, e := r.GetCordons()
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []*api.ClusterCordon{clusterCordon, poolCordon}, cordons)
+
+		assert.Nil(t, r.Uncordon("cluster-1", ""))
+		assert.Equal(t, ErrCordonNotFound, r.Uncordon("cluster-1", ""))
+
+		cordons, e = r.GetCordons()
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.ClusterCordon{poolCordon}, cordons)
+	})
+}
+
+func withCordonRepository(action func(r *RedisCordonRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	repo := NewRedisCordonRepository(client)
+	action(repo)
+}
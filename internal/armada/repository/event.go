@@ -12,6 +12,8 @@ import (
 
 const eventStreamPrefix = "Events:"
 const dataKey = "message"
+const reportedEventPrefix = "Events:Reported:"
+const reportedEventExpiry = 24 * time.Hour
 
 type EventStore interface {
 	ReportEvents(message []*api.EventMessage) error
@@ -22,6 +24,12 @@ type EventRepository interface {
 	GetLastMessageId(queue, jobSetId string) (string, error)
 }
 
+// EventDeduplicator tracks the deduplication keys of reported events, so events replayed by executors are only stored once
+type EventDeduplicator interface {
+	FilterReported(messages []*api.EventMessage) ([]*api.EventMessage, error)
+	MarkReported(messages []*api.EventMessage) error
+}
+
 type RedisEventRepository struct {
 	db             redis.UniversalClient
 	eventRetention configuration.EventRetentionPolicy
@@ -127,6 +135,62 @@ func (repo *RedisEventRepository) GetLastMessageId(queue, jobSetId string) (stri
 	return "0", nil
 }
 
+// FilterReported returns the messages which have not been reported before, messages without deduplication key are never filtered
+func (repo *RedisEventRepository) FilterReported(messages []*api.EventMessage) ([]*api.EventMessage, error) {
+	keys := []string{}
+	for _, m := range messages {
+		if m.DeduplicationKey != "" {
+			keys = append(keys, reportedEventPrefix+m.DeduplicationKey)
+		}
+	}
+	if len(keys) == 0 {
+		return messages, nil
+	}
+
+	// GETs are pipelined rather than one MGET, as the keys can be in different slots on redis cluster
+	pipe := repo.db.Pipeline()
+	cmds := make([]*redis.StringCmd, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, pipe.Get(key))
+	}
+	_, e := pipe.Exec()
+	if e != nil && e != redis.Nil {
+		return nil, e
+	}
+	reported := map[string]bool{}
+	for i, cmd := range cmds {
+		if cmd.Err() == nil {
+			reported[keys[i]] = true
+		} else if cmd.Err() != redis.Nil {
+			return nil, cmd.Err()
+		}
+	}
+
+	result := make([]*api.EventMessage, 0, len(messages))
+	for _, m := range messages {
+		if m.DeduplicationKey == "" || !reported[reportedEventPrefix+m.DeduplicationKey] {
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
+
+func (repo *RedisEventRepository) MarkReported(messages []*api.EventMessage) error {
+	pipe := repo.db.Pipeline()
+	keys := 0
+	for _, m := range messages {
+		if m.DeduplicationKey != "" {
+			pipe.Set(reportedEventPrefix+m.DeduplicationKey, 1, reportedEventExpiry)
+			keys++
+		}
+	}
+	if keys == 0 {
+		return nil
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
 func getJobSetEventsKey(queue, jobSetId string) string {
 	return eventStreamPrefix + queue + ":" + jobSetId
 }
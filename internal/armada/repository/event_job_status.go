@@ -1,44 +1,36 @@
 package repository
 
 import (
-	"github.com/gogo/protobuf/proto"
-	"github.com/nats-io/stan.go"
-	stanPb "github.com/nats-io/stan.go/pb"
 	log "github.com/sirupsen/logrus"
 
-	stanUtil "github.com/G-Research/armada/internal/common/stan-util"
+	"github.com/G-Research/armada/internal/common/eventstream"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 type NatsEventJobStatusProcessor struct {
-	connection    *stanUtil.DurableConnection
+	stream        eventstream.EventStream
 	jobRepository JobRepository
-	subject       string
 	group         string
 }
 
-func NewNatsEventJobStatusProcessor(connection *stanUtil.DurableConnection, jobRepository JobRepository, subject string, group string) *NatsEventJobStatusProcessor {
-	return &NatsEventJobStatusProcessor{connection: connection, jobRepository: jobRepository, subject: subject, group: group}
+func NewNatsEventJobStatusProcessor(stream eventstream.EventStream, jobRepository JobRepository, group string) *NatsEventJobStatusProcessor {
+	return &NatsEventJobStatusProcessor{stream: stream, jobRepository: jobRepository, group: group}
 }
 
 func (p *NatsEventJobStatusProcessor) Start() {
-	err := p.connection.QueueSubscribe(p.subject, p.group,
-		p.handleMessage,
-		stan.SetManualAckMode(),
-		stan.StartAt(stanPb.StartPosition_LastReceived),
-		stan.DurableName(p.group))
+	err := p.stream.QueueSubscribe(p.group, p.handleMessage)
 
 	if err != nil {
 		panic(err)
 	}
 }
 
-func (p *NatsEventJobStatusProcessor) handleMessage(msg *stan.Msg) {
+func (p *NatsEventJobStatusProcessor) handleMessage(msg *eventstream.Message) {
 	// TODO: batching???
-	event, err := unmarshal(msg)
+	event, err := api.UnwrapEvent(msg.EventMessage)
 
 	if err != nil {
-		log.Errorf("Error while unmarshalling nats message: %v", err)
+		log.Errorf("Error while unwrapping event message: %v", err)
 	} else {
 		switch event := event.(type) {
 		case *api.JobRunningEvent:
@@ -49,6 +41,18 @@ func (p *NatsEventJobStatusProcessor) handleMessage(msg *stan.Msg) {
 					return
 				}
 			}
+		case *api.JobLeaseReturnedEvent:
+			err = p.jobRepository.UpdateLastFailureReason(event.JobId, event.Reason)
+			if err != nil {
+				log.Errorf("Error while updating job failure reason: %v", err)
+				return
+			}
+		case *api.JobFailedEvent:
+			err = p.jobRepository.UpdateLastFailureReason(event.JobId, event.Reason)
+			if err != nil {
+				log.Errorf("Error while updating job failure reason: %v", err)
+				return
+			}
 		}
 	}
 	err = msg.Ack()
@@ -56,12 +60,3 @@ func (p *NatsEventJobStatusProcessor) handleMessage(msg *stan.Msg) {
 		log.Errorf("Error while ack nats message: %v", err)
 	}
 }
-
-func unmarshal(msg *stan.Msg) (api.Event, error) {
-	eventMessage := &api.EventMessage{}
-	err := proto.Unmarshal(msg.Data, eventMessage)
-	if err != nil {
-		return nil, err
-	}
-	return api.UnwrapEvent(eventMessage)
-}
@@ -29,6 +29,12 @@ const jobRetriesPrefix = "Job:Retries:"    // {jobId}            - number of ret
 const jobClientIdPrefix = "job:ClientId:"  // {queue}:{clientId} - corresponding jobId
 const keySeparator = ":"
 
+const jobLastFailurePrefix = "Job:LastFailure:" // {jobId} - reason the lease of the job was last returned or the job failed
+const jobHeldPrefix = "Job:Held:"               // {queue} - sorted set of held jobIds by priority
+const jobDeferredPrefix = "Job:Deferred:"       // {queue} - sorted set of jobIds by not before time
+const jobQueuedTimeKey = "Job:QueuedTime"       //         - map jobId -> time the job was last queued
+const jobLeasedCountPrefix = "Job:LeasedCount:" // {queue} - map jobSetId -> number of leased jobs
+
 const queueResourcesBatchSize = 20000
 
 const JobNotFound = "no job found with provided Id"
@@ -50,17 +56,26 @@ type JobRepository interface {
 	IterateQueueJobs(queueName string, action func(*api.Job)) error
 	GetQueueJobIds(queueName string) ([]string, error)
 	RenewLease(clusterId string, jobIds []string) (renewed []string, e error)
-	ExpireLeases(queue string, deadline time.Time) (expired []*api.Job, e error)
+	ExpireLeases(queue string, deadline time.Time, leaderToken int64) (expired []*api.Job, e error)
 	ReturnLease(clusterId string, jobId string) (returnedJob *api.Job, err error)
 	DeleteJobs(jobs []*api.Job) map[*api.Job]error
 	GetActiveJobIds(queue string, jobSetId string) ([]string, error)
 	GetLeasedJobIds(queue string) ([]string, error)
+	GetLeasedJobCounts(queue string, jobSetIds []string) (int, map[string]int, error)
 	UpdateStartTime(jobId string, clusterId string, startTime time.Time) error
 	UpdateJobs(ids []string, mutator func([]*api.Job)) []UpdateJobResult
 	GetJobRunInfos(jobIds []string) (map[string]*RunInfo, error)
 	GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error)
 	AddRetryAttempt(jobId string) error
 	GetNumberOfRetryAttempts(jobId string) (int, error)
+	UpdateLastFailureReason(jobId string, reason string) error
+	GetJobStatuses(jobs []*api.Job) ([]*api.JobStatus, error)
+	HoldJobs(jobs []*api.Job) map[*api.Job]error
+	ReleaseJobs(jobs []*api.Job) map[*api.Job]error
+	GetHeldJobIds(queue string) ([]string, error)
+	QueueDueJobs(queue string, now time.Time) ([]*api.Job, error)
+	UpdateQueuedPriorities(queue string, jobIds []string, priority func(*api.Job) float64) error
+	GetQueuedTimes(jobIds []string) (map[string]time.Time, error)
 }
 
 type RedisJobRepository struct {
@@ -143,6 +158,11 @@ func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, owner
 
 			RequiredNodeLabels: item.RequiredNodeLabels,
 			Ingress:            item.Ingress,
+			PeerDiscovery:      item.PeerDiscovery,
+			NotBefore:          item.NotBefore,
+			JobSetMaxRunning:   request.MaxRunning,
+			AllowedPools:       item.AllowedPools,
+			AllowedClusters:    item.AllowedClusters,
 
 			Priority: item.Priority,
 
@@ -162,7 +182,9 @@ type SubmitJobResult struct {
 	JobId             string
 	SubmittedJob      *api.Job
 	DuplicateDetected bool
-	Error             error
+	// Deferred jobs are added to the deferred set of their queue until their not before time
+	Deferred bool
+	Error    error
 }
 
 func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
@@ -171,15 +193,19 @@ func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, er
 	addJobScript.Load(pipe)
 
 	saveResults := make([]*redis.Cmd, 0, len(jobs))
+	deferred := make([]bool, 0, len(jobs))
 
+	now := time.Now()
 	for _, job := range jobs {
 		jobData, e := proto.Marshal(job)
 		if e != nil {
 			return nil, e
 		}
 
-		result := addJob(pipe, job, &jobData)
+		isDeferred := job.NotBefore != nil && job.NotBefore.After(now)
+		result := addJob(pipe, job, &jobData, isDeferred, now)
 		saveResults = append(saveResults, result)
+		deferred = append(deferred, isDeferred)
 	}
 
 	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
@@ -192,6 +218,7 @@ func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, er
 			SubmittedJob:      jobs[i],
 			Error:             err,
 			DuplicateDetected: resultJobId != jobs[i].Id,
+			Deferred:          deferred[i],
 		}
 		result = append(result, submitJobResult)
 	}
@@ -216,7 +243,7 @@ func (repo *RedisJobRepository) ReturnLease(clusterId string, jobId string) (ret
 	}
 	job := jobs[0]
 
-	returned, e := returnLease(repo.db, clusterId, job.Queue, job.Id, job.Priority).Int()
+	returned, e := returnLease(repo.db, clusterId, job, time.Now()).Int()
 	if e != nil {
 		return nil, e
 	}
@@ -229,30 +256,40 @@ func (repo *RedisJobRepository) ReturnLease(clusterId string, jobId string) (ret
 type deleteJobRedisResponse struct {
 	job                            *api.Job
 	expiryAlreadySet               bool
-	removeFromLeasedResult         *redis.IntCmd
+	removeFromLeasedResult         *redis.Cmd
 	removeFromQueueResult          *redis.IntCmd
+	removeFromHeldResult           *redis.IntCmd
+	removeFromDeferredResult       *redis.IntCmd
 	removeClusterAssociationResult *redis.IntCmd
 	removeStartTimeResult          *redis.IntCmd
 	setJobExpiryResult             *redis.BoolCmd
 	deleteJobSetIndexResult        *redis.IntCmd
 	deleteJobRetriesResult         *redis.IntCmd
+	expireJobLastFailureResult     *redis.BoolCmd
+	removeQueuedTimeResult         *redis.IntCmd
 }
 
 func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
 	expiryStatus := repo.getExpiryStatus(jobs)
 	pipe := repo.db.Pipeline()
+	removeLeasedScript.Load(pipe)
 	deletionResults := make([]*deleteJobRedisResponse, 0, len(jobs))
 	for _, job := range jobs {
 		deletionResult := &deleteJobRedisResponse{job: job, expiryAlreadySet: expiryStatus[job]}
 		deletionResult.removeFromQueueResult = pipe.ZRem(jobQueuePrefix+job.Queue, job.Id)
-		deletionResult.removeFromLeasedResult = pipe.ZRem(jobLeasedPrefix+job.Queue, job.Id)
+		deletionResult.removeFromLeasedResult = removeLeased(pipe, job)
+		deletionResult.removeFromHeldResult = pipe.ZRem(jobHeldPrefix+job.Queue, job.Id)
+		deletionResult.removeFromDeferredResult = pipe.ZRem(jobDeferredPrefix+job.Queue, job.Id)
 		deletionResult.removeClusterAssociationResult = pipe.HDel(jobClusterMapKey, job.Id)
 		deletionResult.removeStartTimeResult = pipe.Del(jobStartTimePrefix + job.Id)
 		deletionResult.deleteJobSetIndexResult = pipe.SRem(jobSetPrefix+job.JobSetId, job.Id)
 		deletionResult.deleteJobRetriesResult = pipe.Del(jobRetriesPrefix + job.Id)
+		deletionResult.removeQueuedTimeResult = pipe.HDel(jobQueuedTimeKey, job.Id)
 
 		if !deletionResult.expiryAlreadySet {
 			deletionResult.setJobExpiryResult = pipe.Expire(jobObjectPrefix+job.Id, repo.retentionPolicy.JobRetentionDuration)
+			// The failure reason of a finished job is kept as long as the job
+			deletionResult.expireJobLastFailureResult = pipe.Expire(jobLastFailurePrefix+job.Id, repo.retentionPolicy.JobRetentionDuration)
 		}
 		deletionResults = append(deletionResults, deletionResult)
 	}
@@ -302,7 +339,7 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 	var totalUpdates int64 = 0
 	var errorMessage error = nil
 
-	modified, e := deletionResponse.removeFromLeasedResult.Result()
+	modified, e := deletionResponse.removeFromLeasedResult.Int64()
 	totalUpdates += modified
 	if e != nil {
 		errorMessage = e
@@ -314,6 +351,18 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 		errorMessage = e
 	}
 
+	modified, e = deletionResponse.removeFromHeldResult.Result()
+	totalUpdates += modified
+	if e != nil {
+		errorMessage = e
+	}
+
+	modified, e = deletionResponse.removeFromDeferredResult.Result()
+	totalUpdates += modified
+	if e != nil {
+		errorMessage = e
+	}
+
 	modified, e = deletionResponse.deleteJobSetIndexResult.Result()
 	totalUpdates += modified
 	if e != nil {
@@ -338,6 +387,20 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 		errorMessage = e
 	}
 
+	// Not counted as an update, the failure reason is kept as long as the finished job
+	if deletionResponse.expireJobLastFailureResult != nil {
+		_, e = deletionResponse.expireJobLastFailureResult.Result()
+		if e != nil {
+			errorMessage = e
+		}
+	}
+
+	// Not counted as an update, the queued time is kept after the job is leased
+	_, e = deletionResponse.removeQueuedTimeResult.Result()
+	if e != nil {
+		errorMessage = e
+	}
+
 	if !deletionResponse.expiryAlreadySet {
 		expirySet, e := deletionResponse.setJobExpiryResult.Result()
 		if expirySet {
@@ -488,6 +551,33 @@ func (repo *RedisJobRepository) GetLeasedJobIds(queue string) ([]string, error)
 	return repo.db.ZRange(jobLeasedPrefix+queue, 0, -1).Result()
 }
 
+// GetLeasedJobCounts returns the number of leased jobs of the queue, and of each of the job sets
+func (repo *RedisJobRepository) GetLeasedJobCounts(queue string, jobSetIds []string) (int, map[string]int, error) {
+	pipe := repo.db.Pipeline()
+	queueCount := pipe.ZCard(jobLeasedPrefix + queue)
+	var jobSetCounts *redis.SliceCmd
+	if len(jobSetIds) > 0 {
+		jobSetCounts = pipe.HMGet(jobLeasedCountPrefix+queue, jobSetIds...)
+	}
+	_, e := pipe.Exec()
+	if e != nil {
+		return 0, nil, e
+	}
+
+	counts := make(map[string]int, len(jobSetIds))
+	for i, jobSetId := range jobSetIds {
+		counts[jobSetId] = 0
+		if value := jobSetCounts.Val()[i]; value != nil {
+			count, e := strconv.Atoi(value.(string))
+			if e != nil {
+				return 0, nil, e
+			}
+			counts[jobSetId] = count
+		}
+	}
+	return int(queueCount.Val()), counts, nil
+}
+
 func (repo *RedisJobRepository) getAssociatedCluster(jobIds []string) (map[string]string, error) {
 	associatedCluster := make(map[string]string, len(jobIds))
 	pipe := repo.db.Pipeline()
@@ -746,6 +836,8 @@ func (repo *RedisJobRepository) GetActiveJobIds(queue string, jobSetId string) (
 	tx := repo.db.TxPipeline()
 	queuedIdsCommand := tx.ZRange(jobQueuePrefix+queue, 0, -1)
 	leasedIdsCommand := tx.ZRange(jobLeasedPrefix+queue, 0, -1)
+	heldIdsCommand := tx.ZRange(jobHeldPrefix+queue, 0, -1)
+	deferredIdsCommand := tx.ZRange(jobDeferredPrefix+queue, 0, -1)
 	jobSetIdsCommand := tx.SMembers(jobSetPrefix + jobSetId)
 	_, _ = tx.Exec()
 
@@ -757,12 +849,20 @@ func (repo *RedisJobRepository) GetActiveJobIds(queue string, jobSetId string) (
 	if e != nil {
 		return nil, e
 	}
+	heldIds, e := heldIdsCommand.Result()
+	if e != nil {
+		return nil, e
+	}
+	deferredIds, e := deferredIdsCommand.Result()
+	if e != nil {
+		return nil, e
+	}
 	jobSetIds, e := jobSetIdsCommand.Result()
 	if e != nil {
 		return nil, e
 	}
 
-	activeIds := util.StringListToSet(append(queuedIds, leasedIds...))
+	activeIds := util.StringListToSet(append(append(append(queuedIds, leasedIds...), heldIds...), deferredIds...))
 	activeSetIds := []string{}
 	for _, id := range jobSetIds {
 		if activeIds[id] {
@@ -777,6 +877,8 @@ func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobS
 	tx := repo.db.TxPipeline()
 	queuedIdsCommand := tx.ZRange(jobQueuePrefix+queue, 0, -1)
 	leasedIdsCommand := tx.ZRange(jobLeasedPrefix+queue, 0, -1)
+	heldIdsCommand := tx.ZRange(jobHeldPrefix+queue, 0, -1)
+	deferredIdsCommand := tx.ZRange(jobDeferredPrefix+queue, 0, -1)
 	_, _ = tx.Exec()
 
 	queuedIds, e := queuedIdsCommand.Result()
@@ -787,6 +889,14 @@ func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobS
 	if e != nil {
 		return nil, e
 	}
+	heldIds, e := heldIdsCommand.Result()
+	if e != nil {
+		return nil, e
+	}
+	deferredIds, e := deferredIdsCommand.Result()
+	if e != nil {
+		return nil, e
+	}
 
 	jobSets := map[string]*api.JobSetInfo{}
 
@@ -803,7 +913,8 @@ func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobS
 		info.LeasedJobs++
 	}
 
-	queuedJobs, e := repo.GetExistingJobsByIds(queuedIds)
+	// Deferred jobs are counted as queued
+	queuedJobs, e := repo.GetExistingJobsByIds(append(queuedIds, deferredIds...))
 	if e != nil {
 		return nil, e
 	}
@@ -816,6 +927,19 @@ func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobS
 		info.QueuedJobs++
 	}
 
+	heldJobs, e := repo.GetExistingJobsByIds(heldIds)
+	if e != nil {
+		return nil, e
+	}
+	for _, job := range heldJobs {
+		info, ok := jobSets[job.JobSetId]
+		if !ok {
+			info = &api.JobSetInfo{Name: job.JobSetId}
+			jobSets[job.JobSetId] = info
+		}
+		info.HeldJobs++
+	}
+
 	result := []*api.JobSetInfo{}
 	for _, i := range jobSets {
 		result = append(result, i)
@@ -823,7 +947,171 @@ func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobS
 	return result, nil
 }
 
-func (repo *RedisJobRepository) ExpireLeases(queue string, deadline time.Time) ([]*api.Job, error) {
+// Moves queued jobs to the held set of their queue, returns an error for jobs which are not queued
+func (repo *RedisJobRepository) HoldJobs(jobs []*api.Job) map[*api.Job]error {
+	return repo.moveJobs(jobs, jobQueuePrefix, jobHeldPrefix, "job %s is not queued", nil)
+}
+
+// Moves held jobs back to their queue with their current priority, returns an error for jobs which are not held
+func (repo *RedisJobRepository) ReleaseJobs(jobs []*api.Job) map[*api.Job]error {
+	now := time.Now()
+	return repo.moveJobs(jobs, jobHeldPrefix, jobQueuePrefix, "job %s is not held", &now)
+}
+
+// Jobs moved to their queue are recorded as queued at queuedTime, when it is not nil
+func (repo *RedisJobRepository) moveJobs(jobs []*api.Job, fromPrefix string, toPrefix string, notFoundMessage string, queuedTime *time.Time) map[*api.Job]error {
+	queued := ""
+	if queuedTime != nil {
+		queued = strconv.FormatInt(queuedTime.UnixNano(), 10)
+	}
+	pipe := repo.db.Pipeline()
+	moveJobScript.Load(pipe)
+	cmds := make([]*redis.Cmd, 0, len(jobs))
+	for _, job := range jobs {
+		cmds = append(cmds, moveJobScript.Run(pipe, []string{fromPrefix + job.Queue, toPrefix + job.Queue, jobQueuedTimeKey}, job.Id, job.Priority, queued))
+	}
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	result := make(map[*api.Job]error, len(jobs))
+	for i, job := range jobs {
+		moved, e := cmds[i].Int()
+		if e != nil {
+			result[job] = e
+		} else if moved == 0 {
+			result[job] = fmt.Errorf(notFoundMessage, job.Id)
+		} else {
+			result[job] = nil
+		}
+	}
+	return result
+}
+
+var moveJobScript = redis.NewScript(`
+local from = KEYS[1]
+local to = KEYS[2]
+local queuedTimes = KEYS[3]
+
+local jobId = ARGV[1]
+local priority = ARGV[2]
+local queuedTime = ARGV[3]
+
+local exists = redis.call('ZSCORE', from, jobId)
+
+if exists then
+	redis.call('ZREM', from, jobId)
+	if queuedTime ~= '' then
+		redis.call('HSET', queuedTimes, jobId, queuedTime)
+	end
+	return redis.call('ZADD', to, priority, jobId)
+end
+
+return 0
+`)
+
+func (repo *RedisJobRepository) GetHeldJobIds(queue string) ([]string, error) {
+	return repo.db.ZRange(jobHeldPrefix+queue, 0, -1).Result()
+}
+
+// Moves deferred jobs which are due by now to their queue, returns the jobs moved by this call.
+// Moving is atomic per job, so concurrent calls from several servers queue each job once.
+func (repo *RedisJobRepository) QueueDueJobs(queue string, now time.Time) ([]*api.Job, error) {
+	maxScore := strconv.FormatInt(now.UnixNano(), 10)
+	ids, e := repo.db.ZRangeByScore(jobDeferredPrefix+queue, redis.ZRangeBy{Min: "-inf", Max: maxScore}).Result()
+	if e != nil {
+		return nil, e
+	}
+	jobs, e := repo.GetExistingJobsByIds(ids)
+	if e != nil {
+		return nil, e
+	}
+
+	queued := []*api.Job{}
+	for job, e := range repo.moveJobs(jobs, jobDeferredPrefix, jobQueuePrefix, "job %s is not deferred", &now) {
+		if e == nil {
+			queued = append(queued, job)
+		}
+	}
+	return queued, nil
+}
+
+// Changes the score jobs are ordered by in the queue to the priority computed from the stored job, jobs no longer
+// queued are left untouched. Jobs are read and rescored in a transaction, so a score computed from a job which was
+// updated in between, e.g. reprioritized, is never written.
+func (repo *RedisJobRepository) UpdateQueuedPriorities(queue string, jobIds []string, priority func(*api.Job) float64) error {
+	return repo.updateQueuedPriorities(queue, jobIds, priority, 250, 3, 100*time.Millisecond)
+}
+
+func (repo *RedisJobRepository) updateQueuedPriorities(queue string, jobIds []string, priority func(*api.Job) float64, batchSize int, retries int, retryDelay time.Duration) error {
+	for _, batch := range util.Batch(jobIds, batchSize) {
+		for retry := 0; ; retry++ {
+			e := repo.updateQueuedPriorityBatch(queue, batch, priority)
+			if e != redis.TxFailedErr {
+				if e != nil {
+					return e
+				}
+				break
+			}
+			if retry >= retries {
+				log.Warnf("UpdateQueuedPriorities: Redis Transaction failed after retrying, giving up (queue %s)", queue)
+				return e
+			}
+			time.Sleep(retryDelay)
+		}
+	}
+	return nil
+}
+
+func (repo *RedisJobRepository) updateQueuedPriorityBatch(queue string, ids []string, priority func(*api.Job) float64) error {
+	var keysToWatch []string
+	for _, id := range ids {
+		keysToWatch = append(keysToWatch, jobObjectPrefix+id)
+	}
+
+	return repo.db.Watch(func(tx *redis.Tx) error {
+		// Read over a separate connection, see updateJobBatch
+		jobs, e := repo.GetExistingJobsByIds(ids)
+		if e != nil {
+			return e
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		pipe := tx.Pipeline()
+		for _, job := range jobs {
+			pipe.ZAddXX(jobQueuePrefix+queue, redis.Z{Score: priority(job), Member: job.Id})
+		}
+		_, e = pipe.Exec()
+		return e
+	}, keysToWatch...)
+}
+
+// GetQueuedTimes returns when the jobs were last queued, jobs queued before the time was recorded are missing
+func (repo *RedisJobRepository) GetQueuedTimes(jobIds []string) (map[string]time.Time, error) {
+	queuedTimes := make(map[string]time.Time, len(jobIds))
+	if len(jobIds) == 0 {
+		return queuedTimes, nil
+	}
+	values, e := repo.db.HMGet(jobQueuedTimeKey, jobIds...).Result()
+	if e != nil {
+		return nil, e
+	}
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		nanos, e := strconv.ParseInt(value.(string), 10, 64)
+		if e != nil {
+			return nil, e
+		}
+		queuedTimes[jobIds[i]] = time.Unix(0, nanos)
+	}
+	return queuedTimes, nil
+}
+
+// ExpireLeases returns jobs leased before the deadline to their queue, ErrStaleLeaderToken is returned and no lease
+// is expired when the leader token is stale
+func (repo *RedisJobRepository) ExpireLeases(queue string, deadline time.Time, leaderToken int64) ([]*api.Job, error) {
 	maxScore := strconv.FormatInt(deadline.UnixNano(), 10)
 
 	// TODO: expire just limited number here ???
@@ -845,13 +1133,14 @@ func (repo *RedisJobRepository) ExpireLeases(queue string, deadline time.Time) (
 
 	pipe := repo.db.Pipeline()
 	expireScript.Load(pipe)
+	now := time.Now()
 	for _, job := range expiringJobs {
-		cmds[job] = expire(pipe, job.Queue, job.Id, job.Priority, deadline)
+		cmds[job] = expire(pipe, job, deadline, now, leaderToken)
 	}
 	_, e = pipe.Exec()
 
 	if e != nil {
-		return nil, e
+		return nil, staleLeaderTokenError(e)
 	}
 
 	for job, cmd := range cmds {
@@ -870,6 +1159,106 @@ func (repo *RedisJobRepository) AddRetryAttempt(jobId string) error {
 	return err
 }
 
+// The reason of a finished job expires together with the job, so it is not kept for longer than the job
+func (repo *RedisJobRepository) UpdateLastFailureReason(jobId string, reason string) error {
+	return updateLastFailureReasonScript.Run(repo.db, []string{jobObjectPrefix + jobId, jobLastFailurePrefix + jobId}, reason).Err()
+}
+
+var updateLastFailureReasonScript = redis.NewScript(`
+local job = KEYS[1]
+local lastFailure = KEYS[2]
+
+local reason = ARGV[1]
+
+if redis.call('EXISTS', job) == 0 then
+	return 0
+end
+
+local ttl = redis.call('PTTL', job)
+if ttl < 0 then
+	return redis.call('SET', lastFailure, reason)
+end
+return redis.call('SET', lastFailure, reason, 'PX', tostring(ttl))
+`)
+
+type jobStatusRedisResponse struct {
+	queued      *redis.FloatCmd
+	leased      *redis.FloatCmd
+	held        *redis.FloatCmd
+	deferred    *redis.FloatCmd
+	clusterId   *redis.StringCmd
+	startTimes  *redis.StringStringMapCmd
+	retries     *redis.StringCmd
+	lastFailure *redis.StringCmd
+}
+
+// Returns the status of each job, jobs which are neither queued, held, deferred nor leased are finished
+func (repo *RedisJobRepository) GetJobStatuses(jobs []*api.Job) ([]*api.JobStatus, error) {
+	pipe := repo.db.Pipeline()
+	responses := make([]*jobStatusRedisResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, &jobStatusRedisResponse{
+			queued:      pipe.ZScore(jobQueuePrefix+job.Queue, job.Id),
+			leased:      pipe.ZScore(jobLeasedPrefix+job.Queue, job.Id),
+			held:        pipe.ZScore(jobHeldPrefix+job.Queue, job.Id),
+			deferred:    pipe.ZScore(jobDeferredPrefix+job.Queue, job.Id),
+			clusterId:   pipe.HGet(jobClusterMapKey, job.Id),
+			startTimes:  pipe.HGetAll(jobStartTimePrefix + job.Id),
+			retries:     pipe.Get(jobRetriesPrefix + job.Id),
+			lastFailure: pipe.Get(jobLastFailurePrefix + job.Id),
+		})
+	}
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	statuses := make([]*api.JobStatus, 0, len(jobs))
+	for i, job := range jobs {
+		response := responses[i]
+		for _, cmd := range []redis.Cmder{response.queued, response.leased, response.held, response.deferred, response.clusterId, response.startTimes, response.retries, response.lastFailure} {
+			if cmd.Err() != nil && cmd.Err() != redis.Nil {
+				return nil, cmd.Err()
+			}
+		}
+
+		status := &api.JobStatus{
+			JobId:             job.Id,
+			Queue:             job.Queue,
+			JobSetId:          job.JobSetId,
+			State:             api.JobState_Finished,
+			LastFailureReason: response.lastFailure.Val(),
+		}
+		if response.retries.Val() != "" {
+			retries, err := strconv.Atoi(response.retries.Val())
+			if err != nil {
+				return nil, err
+			}
+			status.RetryAttempts = int32(retries)
+		}
+
+		if response.queued.Err() == nil {
+			status.State = api.JobState_Queued
+			status.EffectivePriority = response.queued.Val()
+		} else if response.held.Err() == nil {
+			status.State = api.JobState_Held
+		} else if response.deferred.Err() == nil {
+			status.State = api.JobState_Deferred
+		} else if response.leased.Err() == nil {
+			status.State = api.JobState_Leased
+			status.ClusterId = response.clusterId.Val()
+			if startTime, present := response.startTimes.Val()[status.ClusterId]; present {
+				i, err := strconv.ParseInt(startTime, 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				started := time.Unix(0, i)
+				status.State = api.JobState_Running
+				status.Started = &started
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
 func (repo *RedisJobRepository) GetNumberOfRetryAttempts(jobId string) (int, error) {
 	retriesStr, err := repo.db.Get(jobRetriesPrefix + jobId).Result()
 	if err == redis.Nil {
@@ -896,7 +1285,7 @@ func (repo *RedisJobRepository) leaseJobs(clusterId string, jobs []*api.Job) ([]
 
 	cmds := make(map[string]*redis.Cmd)
 	for _, job := range jobs {
-		cmds[job.Id] = leaseJob(pipe, job.Queue, clusterId, job.Id, now)
+		cmds[job.Id] = leaseJob(pipe, job, clusterId, now)
 	}
 	_, e := pipe.Exec()
 	if e != nil {
@@ -931,7 +1320,7 @@ func (repo *RedisJobRepository) applyDefaults(spec *v1.PodSpec) {
 			}
 			for k, v := range repo.defaultJobLimits {
 				_, limitExists := c.Resources.Limits[v1.ResourceName(k)]
-				_, requestExists := c.Resources.Limits[v1.ResourceName(k)]
+				_, requestExists := c.Resources.Requests[v1.ResourceName(k)]
 				if !limitExists && !requestExists {
 					c.Resources.Requests[v1.ResourceName(k)] = v
 					c.Resources.Limits[v1.ResourceName(k)] = v
@@ -955,10 +1344,15 @@ func (repo *RedisJobRepository) applyDefaults(spec *v1.PodSpec) {
 	}
 }
 
-func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte) *redis.Cmd {
+// Deferred jobs are added to the deferred set of their queue instead of the queue
+func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte, deferred bool, now time.Time) *redis.Cmd {
+	queueKey, score, queuedTime := jobQueuePrefix+job.Queue, job.Priority, strconv.FormatInt(now.UnixNano(), 10)
+	if deferred {
+		queueKey, score, queuedTime = jobDeferredPrefix+job.Queue, float64(job.NotBefore.UnixNano()), ""
+	}
 	return addJobScript.Run(db,
-		[]string{jobQueuePrefix + job.Queue, jobObjectPrefix + job.Id, jobSetPrefix + job.JobSetId, jobClientIdPrefix + job.Queue + keySeparator + job.ClientId},
-		job.Id, job.Priority, *jobData, job.ClientId)
+		[]string{queueKey, jobObjectPrefix + job.Id, jobSetPrefix + job.JobSetId, jobClientIdPrefix + job.Queue + keySeparator + job.ClientId, jobQueuedTimeKey},
+		job.Id, score, *jobData, job.ClientId, queuedTime)
 }
 
 var addJobScript = redis.NewScript(`
@@ -966,11 +1360,13 @@ local queueKey = KEYS[1]
 local jobKey = KEYS[2]
 local jobSetKey = KEYS[3]
 local jobClientIdKey = KEYS[4]
+local queuedTimes = KEYS[5]
 
 local jobId = ARGV[1]
-local jobPriority = ARGV[2]
+local jobScore = ARGV[2]
 local jobData = ARGV[3]
 local clientId = ARGV[4]
+local queuedTime = ARGV[5]
 
 if clientId ~= '' then
 	local existingJobId = redis.call('GET', jobClientIdKey)
@@ -982,14 +1378,17 @@ end
 
 redis.call('SET', jobKey, jobData)
 redis.call('SADD', jobSetKey, jobId)
-redis.call('ZADD', queueKey, jobPriority, jobId)
+redis.call('ZADD', queueKey, jobScore, jobId)
+if queuedTime ~= '' then
+	redis.call('HSET', queuedTimes, jobId, queuedTime)
+end
 
 return jobId
 `)
 
-func leaseJob(db redis.Cmdable, queueName string, clusterId string, jobId string, now time.Time) *redis.Cmd {
-	return leaseJobScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey},
-		clusterId, jobId, float64(now.UnixNano()))
+func leaseJob(db redis.Cmdable, job *api.Job, clusterId string, now time.Time) *redis.Cmd {
+	return leaseJobScript.Run(db, []string{jobQueuePrefix + job.Queue, jobLeasedPrefix + job.Queue, jobClusterMapKey, jobLeasedCountPrefix + job.Queue},
+		clusterId, job.Id, float64(now.UnixNano()), job.JobSetId)
 }
 
 const alreadyAllocatedByDifferentCluster = -42
@@ -999,15 +1398,18 @@ var leaseJobScript = redis.NewScript(`
 local queue = KEYS[1]
 local leasedJobsSet = KEYS[2]
 local clusterAssociation = KEYS[3]
+local leasedCounts = KEYS[4]
 
 local clusterId = ARGV[1]
 local jobId = ARGV[2]
 local currentTime = ARGV[3]
+local jobSetId = ARGV[4]
 
 local exists = redis.call('ZREM', queue, jobId)
 
 if exists == 1 then 
 	redis.call('HSET', clusterAssociation, jobId, clusterId)
+	redis.call('HINCRBY', leasedCounts, jobSetId, 1)
 	return redis.call('ZADD', leasedJobsSet, currentTime, jobId)
 else
 	local currentClusterId = redis.call('HGET', clusterAssociation, jobId)
@@ -1025,19 +1427,30 @@ else
 end
 `)
 
-func expire(db redis.Cmdable, queueName string, jobId string, priority float64, deadline time.Time) *redis.Cmd {
-	return expireScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey},
-		jobId, priority, float64(deadline.UnixNano()))
+func expire(db redis.Cmdable, job *api.Job, deadline time.Time, now time.Time, leaderToken int64) *redis.Cmd {
+	return expireScript.Run(db, []string{jobQueuePrefix + job.Queue, jobLeasedPrefix + job.Queue, jobClusterMapKey, jobQueuedTimeKey,
+		serverLeaderTokenKey, jobLeasedCountPrefix + job.Queue},
+		job.Id, job.Priority, float64(deadline.UnixNano()), strconv.FormatInt(now.UnixNano(), 10), leaderToken, job.JobSetId)
 }
 
 var expireScript = redis.NewScript(`
 local queue = KEYS[1]
 local leasedJobsSet = KEYS[2]
 local clusterAssociation = KEYS[3]
+local queuedTimes = KEYS[4]
+local leaderTokenKey = KEYS[5]
+local leasedCounts = KEYS[6]
 
 local jobId = ARGV[1]
 local priority = tonumber(ARGV[2])
 local deadline = tonumber(ARGV[3])
+local queuedTime = ARGV[4]
+local leaderToken = ARGV[5]
+local jobSetId = ARGV[6]
+
+if leaderToken ~= '0' and redis.call('GET', leaderTokenKey) ~= leaderToken then
+	return redis.error_reply('stale leader token')
+end
 
 local leasedTime = tonumber(redis.call('ZSCORE', leasedJobsSet, jobId))
 
@@ -1045,6 +1458,10 @@ if leasedTime ~= nil and leasedTime < deadline then
 	redis.call('HDEL', clusterAssociation, jobId)
 	local exists = redis.call('ZREM', leasedJobsSet, jobId)
 	if exists ~= 0 then
+		if redis.call('HINCRBY', leasedCounts, jobSetId, -1) <= 0 then
+			redis.call('HDEL', leasedCounts, jobSetId)
+		end
+		redis.call('HSET', queuedTimes, jobId, queuedTime)
 		return redis.call('ZADD', queue, priority, jobId)
 	else
 		return 0
@@ -1052,19 +1469,24 @@ if leasedTime ~= nil and leasedTime < deadline then
 end
 `)
 
-func returnLease(db redis.Cmdable, clusterId string, queueName string, jobId string, priority float64) *redis.Cmd {
-	return returnLeaseScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey},
-		clusterId, jobId, priority)
+func returnLease(db redis.Cmdable, clusterId string, job *api.Job, now time.Time) *redis.Cmd {
+	return returnLeaseScript.Run(db, []string{jobQueuePrefix + job.Queue, jobLeasedPrefix + job.Queue, jobClusterMapKey, jobQueuedTimeKey,
+		jobLeasedCountPrefix + job.Queue},
+		clusterId, job.Id, job.Priority, strconv.FormatInt(now.UnixNano(), 10), job.JobSetId)
 }
 
 var returnLeaseScript = redis.NewScript(`
 local queue = KEYS[1]
 local leasedJobsSet = KEYS[2]
 local clusterAssociation = KEYS[3]
+local queuedTimes = KEYS[4]
+local leasedCounts = KEYS[5]
 
 local clusterId = ARGV[1]
 local jobId = ARGV[2]
 local priority = tonumber(ARGV[3])
+local queuedTime = ARGV[4]
+local jobSetId = ARGV[5]
 
 local currentClusterId = redis.call('HGET', clusterAssociation, jobId)
 
@@ -1072,6 +1494,10 @@ if currentClusterId == clusterId then
 	redis.call('HDEL', clusterAssociation, jobId)
 	local exists = redis.call('ZREM', leasedJobsSet, jobId)
 	if exists ~= 0 then
+		if redis.call('HINCRBY', leasedCounts, jobSetId, -1) <= 0 then
+			redis.call('HDEL', leasedCounts, jobSetId)
+		end
+		redis.call('HSET', queuedTimes, jobId, queuedTime)
 		return redis.call('ZADD', queue, priority, jobId)
 	else
 		return 0
@@ -1079,3 +1505,21 @@ if currentClusterId == clusterId then
 end
 return 0
 `)
+
+func removeLeased(db redis.Cmdable, job *api.Job) *redis.Cmd {
+	return removeLeasedScript.Run(db, []string{jobLeasedPrefix + job.Queue, jobLeasedCountPrefix + job.Queue}, job.Id, job.JobSetId)
+}
+
+var removeLeasedScript = redis.NewScript(`
+local leasedJobsSet = KEYS[1]
+local leasedCounts = KEYS[2]
+
+local jobId = ARGV[1]
+local jobSetId = ARGV[2]
+
+local exists = redis.call('ZREM', leasedJobsSet, jobId)
+if exists ~= 0 and redis.call('HINCRBY', leasedCounts, jobSetId, -1) <= 0 then
+	redis.call('HDEL', leasedCounts, jobSetId)
+end
+return exists
+`)
@@ -60,7 +60,7 @@ func TestJobLeaseExpiry(t *testing.T) {
 		deadline := time.Now()
 		addLeasedJob(t, r, "queue1", "cluster1")
 
-		_, e := r.ExpireLeases("queue1", deadline)
+		_, e := r.ExpireLeases("queue1", deadline, 0)
 		assert.Nil(t, e)
 
 		queued, e := r.PeekQueue("queue1", 10)
@@ -70,12 +70,31 @@ func TestJobLeaseExpiry(t *testing.T) {
 	})
 }
 
+func TestJobLeaseExpiry_WithStaleLeaderToken_ExpiresNothing(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+		staleToken, token := acquireServerLeaderLockTwice(t, r.db)
+
+		_, e := r.ExpireLeases("queue1", time.Now(), staleToken)
+		assert.Equal(t, ErrStaleLeaderToken, e)
+
+		queued, e := r.PeekQueue("queue1", 10)
+		assert.Nil(t, e)
+		assert.Empty(t, queued)
+
+		expired, e := r.ExpireLeases("queue1", time.Now(), token)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(expired))
+		assert.Equal(t, job.Id, expired[0].Id)
+	})
+}
+
 func TestEvenExpiredLeaseCanBeRenewed(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 		deadline := time.Now()
 
-		_, e := r.ExpireLeases("queue1", deadline)
+		_, e := r.ExpireLeases("queue1", deadline, 0)
 		assert.Nil(t, e)
 
 		renewed, e := r.RenewLease("cluster1", []string{job.Id})
@@ -108,7 +127,7 @@ func TestDeletingExpiredJobShouldDeleteJobFromQueue(t *testing.T) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 		deadline := time.Now()
 
-		_, e := r.ExpireLeases("queue1", deadline)
+		_, e := r.ExpireLeases("queue1", deadline, 0)
 		assert.Nil(t, e)
 
 		deletionResult := r.DeleteJobs([]*api.Job{job})
@@ -296,6 +315,47 @@ func TestGetLeasedJobIds(t *testing.T) {
 	})
 }
 
+func TestGetLeasedJobCounts(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		addTestJob(t, r, "queue1")
+		addLeasedJob(t, r, "queue1", "cluster1")
+		addLeasedJob(t, r, "queue1", "cluster2")
+		addLeasedJob(t, r, "queue2", "cluster1")
+
+		queueCount, jobSetCounts, e := r.GetLeasedJobCounts("queue1", []string{"set1", "set2"})
+		assert.Nil(t, e)
+		assert.Equal(t, 2, queueCount)
+		assert.Equal(t, map[string]int{"set1": 2, "set2": 0}, jobSetCounts)
+	})
+}
+
+func TestGetLeasedJobCounts_DecreasedOnReturnExpiryAndDelete(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		returned := addLeasedJob(t, r, "queue1", "cluster1")
+		expired := addLeasedJob(t, r, "queue1", "cluster1")
+		deadline := time.Now()
+		deleted := addLeasedJob(t, r, "queue1", "cluster1")
+		addLeasedJob(t, r, "queue1", "cluster1")
+
+		_, e := r.RenewLease("cluster1", []string{deleted.Id})
+		assert.Nil(t, e)
+		_, jobSetCounts, e := r.GetLeasedJobCounts("queue1", []string{"set1"})
+		assert.Nil(t, e)
+		assert.Equal(t, map[string]int{"set1": 4}, jobSetCounts)
+
+		_, e = r.ReturnLease("cluster1", returned.Id)
+		assert.Nil(t, e)
+		_, e = r.ExpireLeases("queue1", deadline, 0)
+		assert.Nil(t, e)
+		r.DeleteJobs([]*api.Job{deleted, expired})
+
+		queueCount, jobSetCounts, e := r.GetLeasedJobCounts("queue1", []string{"set1"})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, queueCount)
+		assert.Equal(t, map[string]int{"set1": 1}, jobSetCounts)
+	})
+}
+
 func TestUpdateStartTime(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
@@ -572,6 +632,159 @@ func TestRetriesOfDeletedJobShouldBeZero(t *testing.T) {
 	})
 }
 
+func TestGetJobStatuses(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		runningJob := addLeasedJob(t, r, "queue1", "cluster2")
+		finishedJob := addLeasedJob(t, r, "queue1", "cluster1")
+
+		startTime := time.Now()
+		err := r.UpdateStartTime(runningJob.Id, "cluster2", startTime)
+		assert.Nil(t, err)
+		err = r.AddRetryAttempt(queuedJob.Id)
+		assert.Nil(t, err)
+		err = r.UpdateLastFailureReason(queuedJob.Id, "node failure")
+		assert.Nil(t, err)
+		r.DeleteJobs([]*api.Job{finishedJob})
+
+		statuses, err := r.GetJobStatuses([]*api.Job{queuedJob, leasedJob, runningJob, finishedJob})
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(statuses))
+
+		assert.Equal(t, api.JobState_Queued, statuses[0].State)
+		assert.Equal(t, queuedJob.Priority, statuses[0].EffectivePriority)
+		assert.Equal(t, int32(1), statuses[0].RetryAttempts)
+		assert.Equal(t, "node failure", statuses[0].LastFailureReason)
+		assert.Empty(t, statuses[0].ClusterId)
+
+		assert.Equal(t, api.JobState_Leased, statuses[1].State)
+		assert.Equal(t, "cluster1", statuses[1].ClusterId)
+		assert.Nil(t, statuses[1].Started)
+
+		assert.Equal(t, api.JobState_Running, statuses[2].State)
+		assert.Equal(t, "cluster2", statuses[2].ClusterId)
+		assert.Equal(t, startTime.UTC(), statuses[2].Started.UTC())
+
+		assert.Equal(t, api.JobState_Finished, statuses[3].State)
+		assert.Equal(t, finishedJob.Queue, statuses[3].Queue)
+	})
+}
+
+func TestUpdateLastFailureReason_KeptForFinishedJob(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		failedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		returnedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		err := r.UpdateLastFailureReason(returnedJob.Id, "node failure")
+		assert.Nil(t, err)
+		r.DeleteJobs([]*api.Job{failedJob, returnedJob})
+
+		err = r.UpdateLastFailureReason(failedJob.Id, "out of memory")
+		assert.Nil(t, err)
+
+		statuses, err := r.GetJobStatuses([]*api.Job{failedJob, returnedJob})
+		assert.Nil(t, err)
+		assert.Equal(t, "out of memory", statuses[0].LastFailureReason)
+		assert.Equal(t, "node failure", statuses[1].LastFailureReason)
+
+		for _, job := range []*api.Job{failedJob, returnedJob} {
+			ttl, err := r.db.TTL(jobLastFailurePrefix + job.Id).Result()
+			assert.Nil(t, err)
+			assert.True(t, ttl > 0)
+		}
+	})
+}
+
+func TestUpdateLastFailureReason_IgnoresExpiredJob(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		err := r.UpdateLastFailureReason("missing", "node failure")
+		assert.Nil(t, err)
+
+		exists, err := r.db.Exists(jobLastFailurePrefix + "missing").Result()
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), exists)
+	})
+}
+
+func TestHoldJobs_MovesQueuedJobToHeldSet(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+
+		results := r.HoldJobs([]*api.Job{queuedJob, leasedJob})
+		assert.Nil(t, results[queuedJob])
+		assert.Error(t, results[leasedJob])
+
+		queued, e := r.GetQueueJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Empty(t, queued)
+
+		held, e := r.GetHeldJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{queuedJob.Id}, held)
+
+		statuses, e := r.GetJobStatuses([]*api.Job{queuedJob})
+		assert.Nil(t, e)
+		assert.Equal(t, api.JobState_Held, statuses[0].State)
+
+		ids, e := r.GetActiveJobIds("queue1", "set1")
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(ids))
+
+		infos, e := r.GetQueueActiveJobSets("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.JobSetInfo{{
+			Name:       "set1",
+			LeasedJobs: 1,
+			HeldJobs:   1,
+		}}, infos)
+	})
+}
+
+func TestReleaseJobs_ReturnsJobToQueueWithItsPriority(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+		notHeldJob := addTestJob(t, r, "queue1")
+
+		results := r.HoldJobs([]*api.Job{job})
+		assert.Nil(t, results[job])
+
+		// Jobs can be reprioritized while held
+		r.UpdateJobs([]string{job.Id}, func(jobs []*api.Job) {
+			jobs[0].Priority = 3
+		})
+		jobs, e := r.GetExistingJobsByIds([]string{job.Id})
+		assert.Nil(t, e)
+		job = jobs[0]
+
+		results = r.ReleaseJobs([]*api.Job{job, notHeldJob})
+		assert.Nil(t, results[job])
+		assert.Error(t, results[notHeldJob])
+
+		held, e := r.GetHeldJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Empty(t, held)
+
+		priority, e := r.db.ZScore(jobQueuePrefix+"queue1", job.Id).Result()
+		assert.Nil(t, e)
+		assert.Equal(t, 3.0, priority)
+	})
+}
+
+func TestDeleteHeldJob(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+		r.HoldJobs([]*api.Job{job})
+
+		result := r.DeleteJobs([]*api.Job{job})
+		assert.Nil(t, result[job])
+
+		held, e := r.GetHeldJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Empty(t, held)
+	})
+}
+
 func TestIterateQueueJobs(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		addedJobs := []*api.Job{}
@@ -591,6 +804,179 @@ func TestIterateQueueJobs(t *testing.T) {
 	})
 }
 
+func TestAddJobs_WithNotBefore_DefersJobUntilDue(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		notBefore := time.Now().Add(time.Hour)
+		jobs, e := r.CreateJobs(&api.JobSubmitRequest{
+			Queue:           "queue1",
+			JobSetId:        "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{{NotBefore: &notBefore, PodSpec: testPodSpec()}},
+		}, "user", []string{})
+		assert.Nil(t, e)
+		_, e = r.AddJobs(jobs)
+		assert.Nil(t, e)
+		job := jobs[0]
+
+		queued, e := r.GetQueueJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Empty(t, queued)
+
+		active, e := r.GetActiveJobIds("queue1", "set1")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job.Id}, active)
+
+		statuses, e := r.GetJobStatuses([]*api.Job{job})
+		assert.Nil(t, e)
+		assert.Equal(t, api.JobState_Deferred, statuses[0].State)
+
+		due, e := r.QueueDueJobs("queue1", time.Now())
+		assert.Nil(t, e)
+		assert.Empty(t, due)
+
+		due, e = r.QueueDueJobs("queue1", notBefore.Add(time.Second))
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(due))
+		assert.Equal(t, job.Id, due[0].Id)
+
+		due, e = r.QueueDueJobs("queue1", notBefore.Add(time.Second))
+		assert.Nil(t, e)
+		assert.Empty(t, due)
+
+		queued, e = r.GetQueueJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job.Id}, queued)
+	})
+}
+
+func TestDeleteDeferredJob(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		notBefore := time.Now().Add(time.Hour)
+		jobs, e := r.CreateJobs(&api.JobSubmitRequest{
+			Queue:           "queue1",
+			JobSetId:        "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{{NotBefore: &notBefore, PodSpec: testPodSpec()}},
+		}, "user", []string{})
+		assert.Nil(t, e)
+		_, e = r.AddJobs(jobs)
+		assert.Nil(t, e)
+
+		result := r.DeleteJobs(jobs)
+		assert.Nil(t, result[jobs[0]])
+
+		due, e := r.QueueDueJobs("queue1", notBefore.Add(time.Second))
+		assert.Nil(t, e)
+		assert.Empty(t, due)
+	})
+}
+
+func TestUpdateQueuedPriorities_ReordersQueue_IgnoresJobsNotQueued(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		first := addTestJob(t, r, "queue1")
+		second := addTestJob(t, r, "queue1")
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+
+		priorities := map[string]float64{second.Id: -5, leased.Id: -10}
+		e := r.UpdateQueuedPriorities("queue1", []string{second.Id, leased.Id}, func(job *api.Job) float64 {
+			return priorities[job.Id]
+		})
+		assert.Nil(t, e)
+
+		queued, e := r.GetQueueJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{second.Id, first.Id}, queued)
+
+		statuses, e := r.GetJobStatuses([]*api.Job{second, leased})
+		assert.Nil(t, e)
+		assert.Equal(t, -5.0, statuses[0].EffectivePriority)
+		assert.Equal(t, api.JobState_Leased, statuses[1].State)
+	})
+}
+
+func TestUpdateQueuedPriorities_DoesNotOverwriteConcurrentReprioritization(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+
+		reprioritized := false
+		e := r.updateQueuedPriorities("queue1", []string{job.Id}, func(storedJob *api.Job) float64 {
+			if !reprioritized {
+				reprioritized = true
+				results := r.UpdateJobs([]string{job.Id}, func(jobs []*api.Job) {
+					for _, job := range jobs {
+						job.Priority = 7
+					}
+				})
+				assert.Nil(t, results[0].Error)
+			}
+			return storedJob.Priority - 1
+		}, 100, 3, time.Microsecond)
+		assert.Nil(t, e)
+
+		statuses, e := r.GetJobStatuses([]*api.Job{job})
+		assert.Nil(t, e)
+		assert.Equal(t, 6.0, statuses[0].EffectivePriority)
+	})
+}
+
+func TestGetQueuedTimes_RecordedOnSubmitReturnAndRelease(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		submitted := time.Now()
+		job := addTestJob(t, r, "queue1")
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+		held := addTestJob(t, r, "queue1")
+		results := r.HoldJobs([]*api.Job{held})
+		assert.Nil(t, results[held])
+
+		queuedTimes, e := r.GetQueuedTimes([]string{job.Id, leased.Id, held.Id, "missing"})
+		assert.Nil(t, e)
+		assert.Equal(t, 3, len(queuedTimes))
+		assert.False(t, queuedTimes[job.Id].Before(submitted))
+
+		requeued := time.Now()
+		_, e = r.ReturnLease("cluster1", leased.Id)
+		assert.Nil(t, e)
+		results = r.ReleaseJobs([]*api.Job{held})
+		assert.Nil(t, results[held])
+
+		queuedTimes, e = r.GetQueuedTimes([]string{leased.Id, held.Id})
+		assert.Nil(t, e)
+		assert.False(t, queuedTimes[leased.Id].Before(requeued))
+		assert.False(t, queuedTimes[held.Id].Before(requeued))
+	})
+}
+
+func TestGetQueuedTimes_RecordedWhenDeferredJobIsDue_RemovedOnDelete(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		notBefore := time.Now().Add(time.Hour)
+		jobs, e := r.CreateJobs(&api.JobSubmitRequest{
+			Queue:           "queue1",
+			JobSetId:        "set1",
+			JobRequestItems: []*api.JobSubmitRequestItem{{NotBefore: &notBefore, PodSpec: testPodSpec()}},
+		}, "user", []string{})
+		assert.Nil(t, e)
+		_, e = r.AddJobs(jobs)
+		assert.Nil(t, e)
+		job := jobs[0]
+
+		queuedTimes, e := r.GetQueuedTimes([]string{job.Id})
+		assert.Nil(t, e)
+		assert.Empty(t, queuedTimes)
+
+		due := notBefore.Add(time.Second)
+		_, e = r.QueueDueJobs("queue1", due)
+		assert.Nil(t, e)
+
+		queuedTimes, e = r.GetQueuedTimes([]string{job.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, due.UnixNano(), queuedTimes[job.Id].UnixNano())
+
+		r.DeleteJobs([]*api.Job{job})
+
+		queuedTimes, e = r.GetQueuedTimes([]string{job.Id})
+		assert.Nil(t, e)
+		assert.Empty(t, queuedTimes)
+	})
+}
+
 func TestUpdateJobs_SingleJobThatExists_ChangesJob(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		job1 := addTestJobWithClientId(t, r, "queue1", "my-job-1")
@@ -825,6 +1211,17 @@ func addTestJobInner(t *testing.T, r *RedisJobRepository, queue string, clientId
 	return jobs[0]
 }
 
+func testPodSpec() *v1.PodSpec {
+	cpu := resource.MustParse("1")
+	memory := resource.MustParse("512Mi")
+	return &v1.PodSpec{Containers: []v1.Container{{
+		Resources: v1.ResourceRequirements{
+			Limits:   v1.ResourceList{"cpu": cpu, "memory": memory},
+			Requests: v1.ResourceList{"cpu": cpu, "memory": memory},
+		},
+	}}}
+}
+
 func withRepository(action func(r *RedisJobRepository)) {
 	withRepositoryUsingJobDefaults(nil, []v1.Toleration{}, configuration.DatabaseRetentionPolicy{JobRetentionDuration: time.Hour}, action)
 }
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const leaderLockPrefix = "LeaderLock:"            // {name} - hash with the holder id and fencing token of the current holding
+const leaderLockTokenPrefix = "LeaderLock:Token:" // {name} - last fencing token issued
+
+// ServerLeaderLockName is the leader lock of the servers, writes of their singleton tasks carry its fencing token
+const ServerLeaderLockName = "armada-server"
+
+const serverLeaderTokenKey = leaderLockTokenPrefix + ServerLeaderLockName
+
+// ErrStaleLeaderToken is returned for writes carrying the fencing token of a holding replaced by a newer one.
+// Writes carrying token 0 are not fenced, they come from servers running without leader election.
+var ErrStaleLeaderToken = errors.New("stale leader token")
+
+func staleLeaderTokenError(e error) error {
+	if e != nil && e.Error() == ErrStaleLeaderToken.Error() {
+		return ErrStaleLeaderToken
+	}
+	return e
+}
+
+// RedisLeaderLock is a task.LeaderLock kept in redis, the lock expires unless its holder renews it.
+type RedisLeaderLock struct {
+	db   redis.UniversalClient
+	name string
+}
+
+func NewRedisLeaderLock(db redis.UniversalClient, name string) *RedisLeaderLock {
+	return &RedisLeaderLock{db: db, name: name}
+}
+
+func (r *RedisLeaderLock) TryAcquire(holderId string, ttl time.Duration) (bool, int64, error) {
+	token, e := acquireLeaderLockScript.Run(r.db, []string{leaderLockPrefix + r.name, leaderLockTokenPrefix + r.name},
+		holderId, ttl.Milliseconds()).Int64()
+	if e != nil {
+		return false, 0, e
+	}
+	if token < 0 {
+		return false, 0, nil
+	}
+	return true, token, nil
+}
+
+func (r *RedisLeaderLock) Release(holderId string) error {
+	return releaseLeaderLockScript.Run(r.db, []string{leaderLockPrefix + r.name}, holderId).Err()
+}
+
+var acquireLeaderLockScript = redis.NewScript(`
+local lockKey = KEYS[1]
+local tokenKey = KEYS[2]
+
+local holderId = ARGV[1]
+local ttl = ARGV[2]
+
+local holder = redis.call('HGET', lockKey, 'holder')
+if holder == holderId then
+	redis.call('PEXPIRE', lockKey, ttl)
+	return tonumber(redis.call('HGET', lockKey, 'token'))
+end
+if holder then
+	return -1
+end
+
+local token = redis.call('INCR', tokenKey)
+redis.call('HMSET', lockKey, 'holder', holderId, 'token', token)
+redis.call('PEXPIRE', lockKey, ttl)
+return token
+`)
+
+var releaseLeaderLockScript = redis.NewScript(`
+local lockKey = KEYS[1]
+local holderId = ARGV[1]
+
+if redis.call('HGET', lockKey, 'holder') == holderId then
+	redis.call('DEL', lockKey)
+end
+return 0
+`)
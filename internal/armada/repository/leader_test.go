@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisLeaderLock_HeldByOneHolderUntilReleased(t *testing.T) {
+	withLeaderLock(func(lock *RedisLeaderLock) {
+		acquired, token, e := lock.TryAcquire("first", time.Minute)
+		assert.Nil(t, e)
+		assert.True(t, acquired)
+		assert.Equal(t, int64(1), token)
+
+		acquired, _, e = lock.TryAcquire("second", time.Minute)
+		assert.Nil(t, e)
+		assert.False(t, acquired)
+
+		acquired, token, e = lock.TryAcquire("first", time.Minute)
+		assert.Nil(t, e)
+		assert.True(t, acquired)
+		assert.Equal(t, int64(1), token)
+
+		assert.Nil(t, lock.Release("second"))
+		acquired, _, e = lock.TryAcquire("second", time.Minute)
+		assert.Nil(t, e)
+		assert.False(t, acquired)
+
+		assert.Nil(t, lock.Release("first"))
+		acquired, token, e = lock.TryAcquire("second", time.Minute)
+		assert.Nil(t, e)
+		assert.True(t, acquired)
+		assert.Equal(t, int64(2), token)
+	})
+}
+
+// Returns the token of the first holding of the server leader lock, which is stale, and of the second
+func acquireServerLeaderLockTwice(t *testing.T, db redis.UniversalClient) (int64, int64) {
+	lock := NewRedisLeaderLock(db, ServerLeaderLockName)
+	_, staleToken, e := lock.TryAcquire("first", time.Minute)
+	assert.Nil(t, e)
+	assert.Nil(t, lock.Release("first"))
+	_, token, e := lock.TryAcquire("second", time.Minute)
+	assert.Nil(t, e)
+	return staleToken, token
+}
+
+func withLeaderLock(action func(lock *RedisLeaderLock)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	action(NewRedisLeaderLock(client, "test"))
+}
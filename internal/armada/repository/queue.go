@@ -2,9 +2,11 @@ package repository
 
 import (
 	"errors"
+	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Research/armada/pkg/api"
 )
@@ -19,6 +21,7 @@ type QueueRepository interface {
 	GetQueue(name string) (*api.Queue, error)
 	CreateQueue(queue *api.Queue) error
 	UpdateQueue(queue *api.Queue) error
+	ModifyQueue(name string, mutator func(*api.Queue)) error
 	DeleteQueue(name string) error
 }
 
@@ -99,6 +102,43 @@ func (r *RedisQueueRepository) UpdateQueue(queue *api.Queue) error {
 	return result.Err()
 }
 
+// Applies the mutator to the stored queue in a transaction, so changes made to the queue in between, e.g. pausing its
+// scheduling, are not overwritten
+func (r *RedisQueueRepository) ModifyQueue(name string, mutator func(*api.Queue)) error {
+	return r.modifyQueue(name, mutator, 3, 100*time.Millisecond)
+}
+
+func (r *RedisQueueRepository) modifyQueue(name string, mutator func(*api.Queue), retries int, retryDelay time.Duration) error {
+	for retry := 0; ; retry++ {
+		e := r.db.Watch(func(tx *redis.Tx) error {
+			// Watch() sends MULTI together with WATCH, the queue is read using a separate connection
+			queue, e := r.GetQueue(name)
+			if e != nil {
+				return e
+			}
+			mutator(queue)
+			data, e := proto.Marshal(queue)
+			if e != nil {
+				return e
+			}
+
+			pipe := tx.Pipeline()
+			pipe.HSet(queueHashKey, name, data)
+			_, e = pipe.Exec()
+			return e
+		}, queueHashKey)
+
+		if e != redis.TxFailedErr {
+			return e
+		}
+		if retry >= retries {
+			log.Warnf("ModifyQueue: Redis Transaction failed after retrying, giving up (queue %s)", name)
+			return e
+		}
+		time.Sleep(retryDelay)
+	}
+}
+
 func (r *RedisQueueRepository) DeleteQueue(name string) error {
 	result := r.db.HDel(queueHashKey, name)
 	return result.Err()
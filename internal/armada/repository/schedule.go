@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const jobScheduleKey = "Schedule"              // {name} -> schedule
+const jobScheduleFiredKey = "Schedule:Fired"   // {name} -> time of the last tick the schedule fired for
+const jobScheduleJobsPrefix = "Schedule:Jobs:" // {name} - set of jobIds submitted by the last firing
+
+var ErrScheduleNotFound = errors.New("Schedule does not exist")
+var ErrScheduleAlreadyExists = errors.New("Schedule already exists")
+
+type ScheduleRepository interface {
+	GetSchedules() ([]*api.JobSchedule, error)
+	GetSchedule(name string) (*api.JobSchedule, error)
+	CreateSchedule(schedule *api.JobSchedule) error
+	DeleteSchedule(name string) error
+	GetLastFiredTimes() (map[string]time.Time, error)
+	ClaimTick(name string, tick time.Time, leaderToken int64) (bool, error)
+	ReleaseTick(name string, tick time.Time, lastFired time.Time) error
+	GetScheduledJobIds(name string) ([]string, error)
+	SetScheduledJobIds(name string, jobIds []string) error
+}
+
+type RedisScheduleRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisScheduleRepository(db redis.UniversalClient) *RedisScheduleRepository {
+	return &RedisScheduleRepository{db: db}
+}
+
+func (r *RedisScheduleRepository) GetSchedules() ([]*api.JobSchedule, error) {
+	result, err := r.db.HGetAll(jobScheduleKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*api.JobSchedule, 0, len(result))
+	for _, v := range result {
+		schedule := &api.JobSchedule{}
+		e := proto.Unmarshal([]byte(v), schedule)
+		if e != nil {
+			return nil, e
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+func (r *RedisScheduleRepository) GetSchedule(name string) (*api.JobSchedule, error) {
+	result, err := r.db.HGet(jobScheduleKey, name).Result()
+	if err == redis.Nil {
+		return nil, ErrScheduleNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	schedule := &api.JobSchedule{}
+	e := proto.Unmarshal([]byte(result), schedule)
+	if e != nil {
+		return nil, e
+	}
+	return schedule, nil
+}
+
+// The schedule first fires for the first tick after its creation time
+func (r *RedisScheduleRepository) CreateSchedule(schedule *api.JobSchedule) error {
+	data, e := proto.Marshal(schedule)
+	if e != nil {
+		return e
+	}
+	created, e := createScheduleScript.Run(r.db, []string{jobScheduleKey, jobScheduleFiredKey},
+		schedule.Name, data, schedule.Created.UnixNano()).Int()
+	if e != nil {
+		return e
+	}
+	if created == 0 {
+		return ErrScheduleAlreadyExists
+	}
+	return nil
+}
+
+var createScheduleScript = redis.NewScript(`
+local scheduleKey = KEYS[1]
+local firedKey = KEYS[2]
+
+local name = ARGV[1]
+local schedule = ARGV[2]
+local created = ARGV[3]
+
+if redis.call('HSETNX', scheduleKey, name, schedule) == 0 then
+	return 0
+end
+redis.call('HSET', firedKey, name, created)
+return 1
+`)
+
+func (r *RedisScheduleRepository) DeleteSchedule(name string) error {
+	pipe := r.db.TxPipeline()
+	deleteResult := pipe.HDel(jobScheduleKey, name)
+	pipe.HDel(jobScheduleFiredKey, name)
+	pipe.Del(jobScheduleJobsPrefix + name)
+	_, e := pipe.Exec()
+	if e != nil {
+		return e
+	}
+	if deleteResult.Val() == 0 {
+		return ErrScheduleNotFound
+	}
+	return nil
+}
+
+func (r *RedisScheduleRepository) GetLastFiredTimes() (map[string]time.Time, error) {
+	result, err := r.db.HGetAll(jobScheduleFiredKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	times := make(map[string]time.Time, len(result))
+	for name, v := range result {
+		nanos, e := strconv.ParseInt(v, 10, 64)
+		if e != nil {
+			return nil, e
+		}
+		times[name] = time.Unix(0, nanos)
+	}
+	return times, nil
+}
+
+// Records the schedule as fired for the tick, returns false if it already fired for this or a later tick.
+// Only one of several servers firing schedules concurrently claims each tick, and no tick is claimed with a
+// stale leader token.
+func (r *RedisScheduleRepository) ClaimTick(name string, tick time.Time, leaderToken int64) (bool, error) {
+	claimed, e := claimTickScript.Run(r.db, []string{jobScheduleKey, jobScheduleFiredKey, serverLeaderTokenKey},
+		name, tick.UnixNano(), leaderToken).Int()
+	if e != nil {
+		return false, staleLeaderTokenError(e)
+	}
+	return claimed == 1, nil
+}
+
+var claimTickScript = redis.NewScript(`
+local scheduleKey = KEYS[1]
+local firedKey = KEYS[2]
+local leaderTokenKey = KEYS[3]
+
+local name = ARGV[1]
+local tick = ARGV[2]
+local leaderToken = ARGV[3]
+
+if leaderToken ~= '0' and redis.call('GET', leaderTokenKey) ~= leaderToken then
+	return redis.error_reply('stale leader token')
+end
+
+if redis.call('HEXISTS', scheduleKey, name) == 0 then
+	return 0
+end
+
+local lastFired = redis.call('HGET', firedKey, name)
+if lastFired and tonumber(lastFired) >= tonumber(tick) then
+	return 0
+end
+
+redis.call('HSET', firedKey, name, tick)
+return 1
+`)
+
+// Records the schedule as last fired at lastFired again, unless it fired for a later tick than the released one
+// in the meantime, so the tick is claimed and fired again
+func (r *RedisScheduleRepository) ReleaseTick(name string, tick time.Time, lastFired time.Time) error {
+	return releaseTickScript.Run(r.db, []string{jobScheduleFiredKey}, name, tick.UnixNano(), lastFired.UnixNano()).Err()
+}
+
+var releaseTickScript = redis.NewScript(`
+local firedKey = KEYS[1]
+
+local name = ARGV[1]
+local tick = ARGV[2]
+local lastFired = ARGV[3]
+
+if redis.call('HGET', firedKey, name) == tick then
+	redis.call('HSET', firedKey, name, lastFired)
+	return 1
+end
+return 0
+`)
+
+func (r *RedisScheduleRepository) GetScheduledJobIds(name string) ([]string, error) {
+	return r.db.SMembers(jobScheduleJobsPrefix + name).Result()
+}
+
+func (r *RedisScheduleRepository) SetScheduledJobIds(name string, jobIds []string) error {
+	pipe := r.db.TxPipeline()
+	pipe.Del(jobScheduleJobsPrefix + name)
+	if len(jobIds) > 0 {
+		members := make([]interface{}, 0, len(jobIds))
+		for _, id := range jobIds {
+			members = append(members, id)
+		}
+		pipe.SAdd(jobScheduleJobsPrefix+name, members...)
+	}
+	_, e := pipe.Exec()
+	return e
+}
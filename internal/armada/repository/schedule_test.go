@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestCreateAndDeleteSchedule(t *testing.T) {
+	withScheduleRepository(func(r *RedisScheduleRepository) {
+		schedule := &api.JobSchedule{Name: "nightly", Cron: "0 2 * * *", Template: &api.JobSubmitRequest{Queue: "queue1"}, Created: time.Now().UTC()}
+
+		assert.Nil(t, r.CreateSchedule(schedule))
+		assert.Equal(t, ErrScheduleAlreadyExists, r.CreateSchedule(schedule))
+
+		schedules, e := r.GetSchedules()
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.JobSchedule{schedule}, schedules)
+
+		lastFired, e := r.GetLastFiredTimes()
+		assert.Nil(t, e)
+		assert.Equal(t, schedule.Created.UnixNano(), lastFired["nightly"].UnixNano())
+
+		assert.Nil(t, r.DeleteSchedule("nightly"))
+		assert.Equal(t, ErrScheduleNotFound, r.DeleteSchedule("nightly"))
+
+		_, e = r.GetSchedule("nightly")
+		assert.Equal(t, ErrScheduleNotFound, e)
+	})
+}
+
+func TestClaimTick_ClaimsEachTickOnce(t *testing.T) {
+	withScheduleRepository(func(r *RedisScheduleRepository) {
+		created := time.Date(2021, 3, 10, 14, 37, 0, 0, time.UTC)
+		assert.Nil(t, r.CreateSchedule(&api.JobSchedule{Name: "hourly", Cron: "0 * * * *", Created: created}))
+
+		tick := time.Date(2021, 3, 10, 15, 0, 0, 0, time.UTC)
+		claimed, e := r.ClaimTick("hourly", tick, 0)
+		assert.Nil(t, e)
+		assert.True(t, claimed)
+
+		claimed, e = r.ClaimTick("hourly", tick, 0)
+		assert.Nil(t, e)
+		assert.False(t, claimed)
+
+		claimed, e = r.ClaimTick("hourly", tick.Add(-time.Hour), 0)
+		assert.Nil(t, e)
+		assert.False(t, claimed)
+
+		claimed, e = r.ClaimTick("missing", tick, 0)
+		assert.Nil(t, e)
+		assert.False(t, claimed)
+	})
+}
+
+func TestClaimTick_WithStaleLeaderToken_ReturnsError(t *testing.T) {
+	withScheduleRepository(func(r *RedisScheduleRepository) {
+		assert.Nil(t, r.CreateSchedule(&api.JobSchedule{Name: "hourly", Cron: "0 * * * *"}))
+		staleToken, token := acquireServerLeaderLockTwice(t, r.db)
+
+		tick := time.Date(2021, 3, 10, 15, 0, 0, 0, time.UTC)
+		_, e := r.ClaimTick("hourly", tick, staleToken)
+		assert.Equal(t, ErrStaleLeaderToken, e)
+
+		claimed, e := r.ClaimTick("hourly", tick, token)
+		assert.Nil(t, e)
+		assert.True(t, claimed)
+	})
+}
+
+func TestReleaseTick_TickCanBeClaimedAgain(t *testing.T) {
+	withScheduleRepository(func(r *RedisScheduleRepository) {
+		created := time.Date(2021, 3, 10, 14, 37, 0, 0, time.UTC)
+		assert.Nil(t, r.CreateSchedule(&api.JobSchedule{Name: "hourly", Cron: "0 * * * *", Created: created}))
+
+		tick := time.Date(2021, 3, 10, 15, 0, 0, 0, time.UTC)
+		claimed, e := r.ClaimTick("hourly", tick, 0)
+		assert.Nil(t, e)
+		assert.True(t, claimed)
+
+		assert.Nil(t, r.ReleaseTick("hourly", tick, created))
+		lastFired, e := r.GetLastFiredTimes()
+		assert.Nil(t, e)
+		assert.Equal(t, created.UnixNano(), lastFired["hourly"].UnixNano())
+
+		claimed, e = r.ClaimTick("hourly", tick, 0)
+		assert.Nil(t, e)
+		assert.True(t, claimed)
+	})
+}
+
+func TestReleaseTick_KeepsLaterTick(t *testing.T) {
+	withScheduleRepository(func(r *RedisScheduleRepository) {
+		created := time.Date(2021, 3, 10, 14, 37, 0, 0, time.UTC)
+		assert.Nil(t, r.CreateSchedule(&api.JobSchedule{Name: "hourly", Cron: "0 * * * *", Created: created}))
+
+		tick := time.Date(2021, 3, 10, 15, 0, 0, 0, time.UTC)
+		laterTick := tick.Add(time.Hour)
+		claimed, e := r.ClaimTick("hourly", laterTick, 0)
+		assert.Nil(t, e)
+		assert.True(t, claimed)
+
+		assert.Nil(t, r.ReleaseTick("hourly", tick, created))
+		lastFired, e := r.GetLastFiredTimes()
+		assert.Nil(t, e)
+		assert.Equal(t, laterTick.UnixNano(), lastFired["hourly"].UnixNano())
+	})
+}
+
+func TestSetScheduledJobIds_ReplacesJobIds(t *testing.T) {
+	withScheduleRepository(func(r *RedisScheduleRepository) {
+		assert.Nil(t, r.SetScheduledJobIds("nightly", []string{"a", "b"}))
+		assert.Nil(t, r.SetScheduledJobIds("nightly", []string{"c"}))
+
+		ids, e := r.GetScheduledJobIds("nightly")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{"c"}, ids)
+	})
+}
+
+func withScheduleRepository(action func(r *RedisScheduleRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	repo := NewRedisScheduleRepository(client)
+	action(repo)
+}
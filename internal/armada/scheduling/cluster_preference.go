@@ -0,0 +1,104 @@
+package scheduling
+
+import (
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// ClusterPreference decides which jobs the cluster requesting a lease can take, following the allowed pools and
+// clusters of jobs. Jobs allowing several of them are only leased to a less preferred cluster while none of the
+// clusters they prefer has the capacity and node types to run them. Among equally preferred clusters, jobs with
+// preferred node affinity are left for clusters with node types scoring higher, while those have the capacity.
+type ClusterPreference struct {
+	clusterId       string
+	pool            string
+	clusterReports  map[string]*api.ClusterUsageReport
+	schedulingInfos map[string]*api.ClusterSchedulingInfoReport
+}
+
+// NewClusterPreference creates the preference for the requesting cluster, clusterReports are the clusters jobs can
+// be left for and schedulingInfos their node types
+func NewClusterPreference(
+	clusterId string,
+	pool string,
+	clusterReports map[string]*api.ClusterUsageReport,
+	schedulingInfos map[string]*api.ClusterSchedulingInfoReport) *ClusterPreference {
+	return &ClusterPreference{
+		clusterId:       clusterId,
+		pool:            pool,
+		clusterReports:  clusterReports,
+		schedulingInfos: schedulingInfos,
+	}
+}
+
+func (p *ClusterPreference) Allows(job *api.Job) bool {
+	if p == nil {
+		return true
+	}
+
+	poolRank, clusterRank := job.PoolRank(p.pool), job.ClusterRank(p.clusterId)
+	if poolRank < 0 || clusterRank < 0 {
+		return false
+	}
+	affinityScore, scoresAffinity := p.preferredNodeAffinityScore(job, p.clusterId)
+	if poolRank == 0 && clusterRank == 0 && !scoresAffinity {
+		return true
+	}
+
+	request := common.TotalJobResourceRequest(job).AsFloat()
+	for id, report := range p.clusterReports {
+		otherPoolRank, otherClusterRank := job.PoolRank(report.Pool), job.ClusterRank(id)
+		if otherPoolRank < 0 || otherClusterRank < 0 {
+			continue
+		}
+		preferred := otherPoolRank < poolRank || (otherPoolRank == poolRank && otherClusterRank < clusterRank)
+		if !preferred && scoresAffinity && otherPoolRank == poolRank && otherClusterRank == clusterRank {
+			otherScore, _ := p.preferredNodeAffinityScore(job, id)
+			preferred = otherScore > affinityScore
+		}
+		if !preferred {
+			continue
+		}
+		schedulingInfo, ok := p.schedulingInfos[id]
+		if !ok || !MatchSchedulingRequirements(job, schedulingInfo) {
+			continue
+		}
+		available := util.GetClusterAvailableCapacity(report).AsFloat()
+		available.Sub(request)
+		if available.IsValid() {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the sum over pods of the job of the best preferred node affinity score among node types of the cluster
+// the pod fits, false when the job has no preferred node affinity or node types of the cluster are not known
+func (p *ClusterPreference) preferredNodeAffinityScore(job *api.Job, clusterId string) (int64, bool) {
+	schedulingInfo, ok := p.schedulingInfos[clusterId]
+	if !ok {
+		return 0, false
+	}
+
+	total := int64(0)
+	scored := false
+	for _, podSpec := range job.GetAllPodSpecs() {
+		podMatchingContext := NewPodMatchingContext(podSpec)
+		if podMatchingContext.preferredNodeAffinityTerms == nil {
+			continue
+		}
+		scored = true
+		best := int64(0)
+		for _, nodeType := range schedulingInfo.NodeTypes {
+			if !podMatchingContext.Matches(nodeType, common.ComputeResources(nodeType.AllocatableResources).AsFloat()) {
+				continue
+			}
+			if score := podMatchingContext.Score(nodeType); score > best {
+				best = score
+			}
+		}
+		total += best
+	}
+	return total, scored
+}
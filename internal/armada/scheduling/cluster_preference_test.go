@@ -0,0 +1,93 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestClusterPreference_Allows_WhenJobHasNoAllowedPoolsOrClusters_ReturnsTrue(t *testing.T) {
+	preference := NewClusterPreference("cluster1", "pool1", preferenceClusterReports("1"), preferenceSchedulingInfos())
+
+	assert.True(t, preference.Allows(&api.Job{PodSpec: classicPodSpec}))
+}
+
+func TestClusterPreference_Allows_WhenClusterNotAllowed_ReturnsFalse(t *testing.T) {
+	preference := NewClusterPreference("cluster1", "pool1", preferenceClusterReports("1"), preferenceSchedulingInfos())
+
+	assert.False(t, preference.Allows(&api.Job{PodSpec: classicPodSpec, AllowedPools: []string{"pool2"}}))
+	assert.False(t, preference.Allows(&api.Job{PodSpec: classicPodSpec, AllowedClusters: []string{"cluster2"}}))
+	assert.True(t, preference.Allows(&api.Job{PodSpec: classicPodSpec, AllowedPools: []string{"pool1"}, AllowedClusters: []string{"cluster1"}}))
+}
+
+func TestClusterPreference_Allows_WhenPreferredPoolCanRunJob_ReturnsFalse(t *testing.T) {
+	preference := NewClusterPreference("cluster1", "pool1", preferenceClusterReports("1"), preferenceSchedulingInfos())
+
+	assert.False(t, preference.Allows(&api.Job{PodSpec: classicPodSpec, AllowedPools: []string{"pool2", "pool1"}}))
+	assert.False(t, preference.Allows(&api.Job{PodSpec: classicPodSpec, AllowedClusters: []string{"cluster2", "cluster1"}}))
+}
+
+func TestClusterPreference_Allows_WhenPreferredPoolIsFull_ReturnsTrue(t *testing.T) {
+	preference := NewClusterPreference("cluster1", "pool1", preferenceClusterReports("0"), preferenceSchedulingInfos())
+
+	assert.True(t, preference.Allows(&api.Job{PodSpec: classicPodSpec, AllowedPools: []string{"pool2", "pool1"}}))
+}
+
+func TestClusterPreference_Allows_WhenPreferredPoolHasNoMatchingNodes_ReturnsTrue(t *testing.T) {
+	preference := NewClusterPreference("cluster1", "pool1", preferenceClusterReports("1"), preferenceSchedulingInfos())
+	podSpec := classicPodSpec.DeepCopy()
+	podSpec.NodeSelector = map[string]string{"gpu": "true"}
+
+	assert.True(t, preference.Allows(&api.Job{PodSpec: podSpec, AllowedPools: []string{"pool2", "pool1"}}))
+}
+
+func TestClusterPreference_Allows_WhenEquallyPreferredClusterHasHigherScoringNodeTypes_ReturnsFalse(t *testing.T) {
+	schedulingInfos := preferenceSchedulingInfos()
+	ssdNodeType := *schedulingInfos["cluster2"].NodeTypes[0]
+	ssdNodeType.Labels = map[string]string{"disk": "ssd"}
+	schedulingInfos["cluster2"].NodeTypes = []*api.NodeType{&ssdNodeType}
+	podSpec := classicPodSpec.DeepCopy()
+	podSpec.Affinity = &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{{
+			Weight: 10,
+			Preference: v1.NodeSelectorTerm{MatchExpressions: []v1.NodeSelectorRequirement{{
+				Key: "disk", Operator: v1.NodeSelectorOpIn, Values: []string{"ssd"},
+			}}},
+		}},
+	}}
+	job := &api.Job{PodSpec: podSpec}
+
+	assert.False(t, NewClusterPreference("cluster1", "pool1", preferenceClusterReports("1"), schedulingInfos).Allows(job))
+	assert.True(t, NewClusterPreference("cluster2", "pool2", preferenceClusterReports("1"), schedulingInfos).Allows(job))
+	assert.True(t, NewClusterPreference("cluster1", "pool1", preferenceClusterReports("0"), schedulingInfos).Allows(job))
+}
+
+// Reports of cluster1 in pool1 and cluster2 in pool2, cluster2 has the given cpu available
+func preferenceClusterReports(cluster2AvailableCpu string) map[string]*api.ClusterUsageReport {
+	return map[string]*api.ClusterUsageReport{
+		"cluster1": {
+			ClusterId:                "cluster1",
+			Pool:                     "pool1",
+			ClusterAvailableCapacity: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")},
+		},
+		"cluster2": {
+			ClusterId:                "cluster2",
+			Pool:                     "pool2",
+			ClusterAvailableCapacity: common.ComputeResources{"cpu": resource.MustParse(cluster2AvailableCpu), "memory": resource.MustParse("10Gi")},
+		},
+	}
+}
+
+func preferenceSchedulingInfos() map[string]*api.ClusterSchedulingInfoReport {
+	nodeTypes := []*api.NodeType{{AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}}}
+	return map[string]*api.ClusterSchedulingInfoReport{
+		"cluster1": {ClusterId: "cluster1", Pool: "pool1", ReportTime: time.Now(), NodeTypes: nodeTypes},
+		"cluster2": {ClusterId: "cluster2", Pool: "pool2", ReportTime: time.Now(), NodeTypes: nodeTypes},
+	}
+}
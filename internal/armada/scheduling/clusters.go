@@ -53,6 +53,16 @@ func FilterClusterLeasedReports(ids []string, reports map[string]*api.ClusterLea
 	return result
 }
 
+func FilterClusterPriorities(ids []string, priorities map[string]map[string]float64) map[string]map[string]float64 {
+	result := map[string]map[string]float64{}
+	for _, id := range ids {
+		if priority, ok := priorities[id]; ok {
+			result[id] = priority
+		}
+	}
+	return result
+}
+
 func FilterActiveClusterSchedulingInfoReports(reports map[string]*api.ClusterSchedulingInfoReport) map[string]*api.ClusterSchedulingInfoReport {
 	result := map[string]*api.ClusterSchedulingInfoReport{}
 	now := time.Now()
@@ -64,6 +74,16 @@ func FilterActiveClusterSchedulingInfoReports(reports map[string]*api.ClusterSch
 	return result
 }
 
+func FilterAllowedClusterSchedulingInfoReports(job *api.Job, reports map[string]*api.ClusterSchedulingInfoReport) map[string]*api.ClusterSchedulingInfoReport {
+	result := map[string]*api.ClusterSchedulingInfoReport{}
+	for id, report := range reports {
+		if job.AllowsCluster(id, report.Pool) {
+			result[id] = report
+		}
+	}
+	return result
+}
+
 func GroupSchedulingInfoByPool(reports map[string]*api.ClusterSchedulingInfoReport) map[string]map[string]*api.ClusterSchedulingInfoReport {
 	result := map[string]map[string]*api.ClusterSchedulingInfoReport{}
 	for id, report := range reports {
@@ -0,0 +1,65 @@
+package scheduling
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// DeferredJobQueuer queues jobs submitted with a not before time once that time has passed
+type DeferredJobQueuer struct {
+	jobRepository   repository.JobRepository
+	queueRepository repository.QueueRepository
+	eventStore      repository.EventStore
+}
+
+func NewDeferredJobQueuer(
+	jobRepository repository.JobRepository,
+	queueRepository repository.QueueRepository,
+	eventStore repository.EventStore) *DeferredJobQueuer {
+	return &DeferredJobQueuer{
+		jobRepository:   jobRepository,
+		queueRepository: queueRepository,
+		eventStore:      eventStore,
+	}
+}
+
+func (q *DeferredJobQueuer) QueueDueJobs() {
+	queues, e := q.queueRepository.GetAllQueues()
+	if e != nil {
+		log.Error(e)
+		return
+	}
+
+	for _, queue := range queues {
+		jobs, e := q.jobRepository.QueueDueJobs(queue.Name, time.Now())
+		if e != nil {
+			log.Errorf("Error while queueing deferred jobs of queue %s: %s", queue.Name, e)
+			continue
+		}
+
+		now := time.Now()
+		events := make([]*api.EventMessage, 0, len(jobs))
+		for _, job := range jobs {
+			event, e := api.Wrap(&api.JobQueuedEvent{
+				JobId:    job.Id,
+				Queue:    job.Queue,
+				JobSetId: job.JobSetId,
+				Created:  now,
+			})
+			if e != nil {
+				log.Error(e)
+				continue
+			}
+			events = append(events, event)
+		}
+		if len(events) > 0 {
+			if e := q.eventStore.ReportEvents(events); e != nil {
+				log.Error(e)
+			}
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package scheduling
+
+import (
+	"math"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Fairness reduces resources to a single usage figure, so usage and priority of queues can be compared
+type Fairness interface {
+	ResourcesAsUsage(resources common.ComputeResources) float64
+	ResourcesFloatAsUsage(resources common.ComputeResourcesFloat) float64
+	// Priority of queues without usage, in the same units as usage
+	MinimumPriority() float64
+}
+
+// Creates the fairness model configured for the pool, with the capacity of the pool taken from its active cluster reports
+func NewFairness(config *configuration.SchedulingConfig, pool string, activePoolClusterReports map[string]*api.ClusterUsageReport) Fairness {
+	if config.FairnessModel == configuration.DominantResourceFairness {
+		return NewDominantResourceFairness(util.SumReportClusterCapacity(activePoolClusterReports).AsFloat())
+	}
+	scarcity := config.GetResourceScarcity(pool)
+	if scarcity == nil {
+		scarcity = ResourceScarcityFromReports(activePoolClusterReports)
+	}
+	return NewScarcityFairness(scarcity)
+}
+
+// Usage is the sum of all resources weighted by their scarcity
+type ScarcityFairness struct {
+	resourceScarcity map[string]float64
+}
+
+func NewScarcityFairness(resourceScarcity map[string]float64) *ScarcityFairness {
+	return &ScarcityFairness{resourceScarcity: resourceScarcity}
+}
+
+func (f *ScarcityFairness) ResourcesAsUsage(resources common.ComputeResources) float64 {
+	return ResourcesAsUsage(f.resourceScarcity, resources)
+}
+
+func (f *ScarcityFairness) ResourcesFloatAsUsage(resources common.ComputeResourcesFloat) float64 {
+	return ResourcesFloatAsUsage(f.resourceScarcity, resources)
+}
+
+func (f *ScarcityFairness) MinimumPriority() float64 {
+	return minPriority
+}
+
+// Usage is the dominant share, the largest fraction of capacity used of any resource,
+// so queues using mostly memory and queues using mostly cpu are treated alike whatever the mix of the cluster
+type DominantResourceFairness struct {
+	capacity common.ComputeResourcesFloat
+}
+
+func NewDominantResourceFairness(capacity common.ComputeResourcesFloat) *DominantResourceFairness {
+	return &DominantResourceFairness{capacity: capacity}
+}
+
+func (f *DominantResourceFairness) ResourcesAsUsage(resources common.ComputeResources) float64 {
+	return f.ResourcesFloatAsUsage(resources.AsFloat())
+}
+
+// Resources without capacity are ignored
+func (f *DominantResourceFairness) ResourcesFloatAsUsage(resources common.ComputeResourcesFloat) float64 {
+	dominantShare := 0.0
+	for resourceName, quantity := range resources {
+		capacity := f.capacity[resourceName]
+		if capacity > 0 {
+			dominantShare = math.Max(dominantShare, quantity/capacity)
+		}
+	}
+	return dominantShare
+}
+
+// The share of minPriority cpu, matching the minimum priority of the scarcity model where cpu has scarcity 1
+func (f *DominantResourceFairness) MinimumPriority() float64 {
+	share := f.ResourcesFloatAsUsage(common.ComputeResourcesFloat{"cpu": minPriority})
+	if share <= 0 {
+		return minDominantShare
+	}
+	return share
+}
+
+// Slices resources by progressive filling: the dominant shares of queues, weighted by the inverse of their priority,
+// are raised together and each queue receives resources in the mix it currently uses, until a resource runs out.
+// Resources left over are then filled in their own mix the same way, so everything is sliced.
+// Queues receiving resources end with equal weighted dominant shares, queues already above that level receive none.
+func (f *DominantResourceFairness) sliceResource(queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+	slices := make(map[*api.Queue]common.ComputeResourcesFloat, len(queuePriorities))
+	usages := make(map[*api.Queue]common.ComputeResourcesFloat, len(queuePriorities))
+	directions := make(map[*api.Queue]common.ComputeResourcesFloat, len(queuePriorities))
+	available := f.sliceable(quantityToSlice)
+	for queue, info := range queuePriorities {
+		slices[queue] = common.ComputeResourcesFloat{}
+		usages[queue] = info.CurrentUsage.AsFloat()
+		directions[queue] = common.ComputeResourcesFloat{}
+		for resourceName := range available {
+			if usages[queue][resourceName] > 0 {
+				directions[queue][resourceName] = usages[queue][resourceName]
+			}
+		}
+		if len(directions[queue]) == 0 {
+			directions[queue] = available
+		}
+	}
+	if len(queuePriorities) == 0 {
+		return slices
+	}
+
+	// Resources without capacity don't change dominant shares, they are sliced by priority alone
+	withoutCapacity := quantityToSlice.DeepCopy()
+	withoutCapacity.Sub(available)
+	for queue, slice := range sliceByPriority(queuePriorities, withoutCapacity) {
+		slices[queue].Add(slice)
+	}
+	if len(available) == 0 {
+		return slices
+	}
+
+	f.fill(queuePriorities, usages, directions, slices, available)
+
+	leftover := quantityToSlice.DeepCopy()
+	for _, slice := range slices {
+		leftover.Sub(slice)
+	}
+	leftover = f.sliceable(leftover)
+	if len(leftover) == 0 {
+		return slices
+	}
+	for queue, slice := range slices {
+		usages[queue].Add(slice)
+		directions[queue] = leftover
+	}
+	// All queues take the leftover in the same mix, so it is sliced exactly once the amounts sum to one. Raising the
+	// dominant share of a queue in a mix other than its own can take a large amount at once, e.g. memory for a queue
+	// using mostly cpu, so what is left at the highest level is given to the queues which can take more at that level.
+	amounts, above := f.fill(queuePriorities, usages, directions, nil, leftover)
+	amountSum, extraSum := 0.0, 0.0
+	for queue, amount := range amounts {
+		amountSum += amount
+		extraSum += above[queue] - amount
+	}
+	for queue, amount := range amounts {
+		if extraSum > 0 && amountSum < 1 {
+			amount += (1 - amountSum) * (above[queue] - amount) / extraSum
+		}
+		slices[queue].Add(leftover.Mul(amount))
+	}
+	return slices
+}
+
+// Finds the highest level the weighted dominant shares can be raised to without exceeding quantity, adds the resources
+// queues receive to slices when it is not nil, and returns the amount of its direction each queue receives, along with
+// the amounts needed to go just above that level
+func (f *DominantResourceFairness) fill(
+	queuePriorities map[*api.Queue]QueuePriorityInfo,
+	usages map[*api.Queue]common.ComputeResourcesFloat,
+	directions map[*api.Queue]common.ComputeResourcesFloat,
+	slices map[*api.Queue]common.ComputeResourcesFloat,
+	quantity common.ComputeResourcesFloat) (map[*api.Queue]float64, map[*api.Queue]float64) {
+
+	amountsAt := func(level float64) (map[*api.Queue]float64, bool) {
+		amounts := make(map[*api.Queue]float64, len(queuePriorities))
+		total := common.ComputeResourcesFloat{}
+		for queue, info := range queuePriorities {
+			amount := f.amountToReach(usages[queue], directions[queue], level/info.Priority)
+			amounts[queue] = amount
+			total.Add(directions[queue].Mul(amount))
+		}
+		for resourceName, q := range total {
+			if q > quantity[resourceName]*(1+1e-12) {
+				return amounts, false
+			}
+		}
+		return amounts, true
+	}
+
+	low, high := 0.0, 1.0
+	for i := 0; i < 64; i++ {
+		if _, feasible := amountsAt(high); !feasible {
+			break
+		}
+		low, high = high, high*2
+	}
+	for i := 0; i < 100; i++ {
+		middle := (low + high) / 2
+		if _, feasible := amountsAt(middle); feasible {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	amounts, _ := amountsAt(low)
+	above, _ := amountsAt(high)
+	if slices != nil {
+		for queue, amount := range amounts {
+			slices[queue].Add(directions[queue].Mul(amount))
+		}
+	}
+	return amounts, above
+}
+
+// The smallest amount of direction which raises the dominant share of usage to share
+func (f *DominantResourceFairness) amountToReach(usage common.ComputeResourcesFloat, direction common.ComputeResourcesFloat, share float64) float64 {
+	if f.ResourcesFloatAsUsage(usage) >= share {
+		return 0
+	}
+	amount := math.Inf(1)
+	for resourceName, quantity := range direction {
+		if quantity > 0 {
+			amount = math.Min(amount, (share*f.capacity[resourceName]-usage[resourceName])/quantity)
+		}
+	}
+	return math.Max(0, amount)
+}
+
+// Resources which can be sliced and count towards dominant shares
+func (f *DominantResourceFairness) sliceable(resources common.ComputeResourcesFloat) common.ComputeResourcesFloat {
+	result := common.ComputeResourcesFloat{}
+	for resourceName, quantity := range resources {
+		if quantity > 0 && f.capacity[resourceName] > 0 {
+			result[resourceName] = quantity
+		}
+	}
+	return result
+}
+
+func sliceByPriority(queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+	inverseSum := 0.0
+	for _, info := range queuePriorities {
+		inverseSum += 1 / info.Priority
+	}
+	slices := make(map[*api.Queue]common.ComputeResourcesFloat, len(queuePriorities))
+	for queue, info := range queuePriorities {
+		slices[queue] = quantityToSlice.Mul(1 / info.Priority / inverseSum)
+	}
+	return slices
+}
@@ -0,0 +1,265 @@
+package scheduling
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const gi = 1024 * 1024 * 1024
+
+var fairnessModels = map[string]Fairness{
+	"scarcity":         NewScarcityFairness(scarcity),
+	"dominantResource": NewDominantResourceFairness(common.ComputeResourcesFloat{"cpu": 100, "memory": 200 * gi}),
+}
+
+func Test_NewFairness_UsesConfiguredModel(t *testing.T) {
+	reports := map[string]*api.ClusterUsageReport{
+		"cluster1": {ClusterCapacity: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("20Gi")}},
+	}
+
+	scarcityFairness := NewFairness(&configuration.SchedulingConfig{}, "pool", reports)
+	assert.Equal(t, NewScarcityFairness(map[string]float64{"cpu": 1, "memory": 10.0 / (20 * gi)}), scarcityFairness)
+
+	configuredFairness := NewFairness(&configuration.SchedulingConfig{ResourceScarcity: scarcity}, "pool", reports)
+	assert.Equal(t, NewScarcityFairness(scarcity), configuredFairness)
+
+	drf := NewFairness(&configuration.SchedulingConfig{FairnessModel: configuration.DominantResourceFairness}, "pool", reports)
+	assert.Equal(t, NewDominantResourceFairness(common.ComputeResourcesFloat{"cpu": 10, "memory": 20 * gi}), drf)
+}
+
+func Test_DominantResourceFairness_Usage(t *testing.T) {
+	drf := NewDominantResourceFairness(common.ComputeResourcesFloat{"cpu": 100, "memory": 200 * gi})
+
+	cpuHeavy := common.ComputeResources{"cpu": resource.MustParse("50"), "memory": resource.MustParse("10Gi")}
+	memoryHeavy := common.ComputeResources{"cpu": resource.MustParse("5"), "memory": resource.MustParse("100Gi")}
+
+	assert.Equal(t, 0.5, drf.ResourcesAsUsage(cpuHeavy))
+	assert.Equal(t, 0.5, drf.ResourcesAsUsage(memoryHeavy))
+	assert.Equal(t, 0.0, drf.ResourcesAsUsage(common.ComputeResources{"gpu": resource.MustParse("1")}))
+	assert.Equal(t, 0.005, drf.MinimumPriority())
+	assert.Equal(t, minDominantShare, NewDominantResourceFairness(common.ComputeResourcesFloat{}).MinimumPriority())
+}
+
+func Test_sliceResources_DominantResourceFairness(t *testing.T) {
+	q1 := &api.Queue{Name: "q1"}
+	q2 := &api.Queue{Name: "q2"}
+	q3 := &api.Queue{Name: "q3"}
+
+	drf := NewDominantResourceFairness(common.ComputeResourcesFloat{"cpu": 10, "memory": 10 * gi})
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		q1: {Priority: 1, CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}}, // dominant share 0.1
+		q2: {Priority: 1, CurrentUsage: common.ComputeResources{"memory": resource.MustParse("1Gi")}},                                 // dominant share 0.1
+		q3: {Priority: 1, CurrentUsage: common.ComputeResources{}},
+	}
+
+	slices := sliceResource(drf, queuePriorities, common.ComputeResourcesFloat{"cpu": 8})
+
+	// dominant shares are raised together to 0.3, q2 uses memory but only cpu is sliced
+	assert.InDelta(t, 2, slices[q1]["cpu"], 0.0001)
+	assert.InDelta(t, 3, slices[q2]["cpu"], 0.0001)
+	assert.InDelta(t, 3, slices[q3]["cpu"], 0.0001)
+	for queue, info := range queuePriorities {
+		assert.InDelta(t, 0.3, dominantShareWithSlice(drf, info, slices[queue]), 0.0001)
+	}
+}
+
+func Test_sliceResources_DominantResourceFairness_SlicesInMixOfQueueUsage(t *testing.T) {
+	cpuQueue := &api.Queue{Name: "cpu"}
+	memoryQueue := &api.Queue{Name: "memory"}
+
+	drf := NewDominantResourceFairness(common.ComputeResourcesFloat{"cpu": 10, "memory": 10 * gi})
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		cpuQueue:    {Priority: 1, CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("1Gi")}},
+		memoryQueue: {Priority: 1, CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("2Gi")}},
+	}
+
+	slices := sliceResource(drf, queuePriorities, common.ComputeResourcesFloat{"cpu": 6, "memory": 6 * gi})
+
+	// both queues grow in the mix they use to a dominant share of 0.6, without running out of either resource
+	assert.InDelta(t, 4, slices[cpuQueue]["cpu"], 0.0001)
+	assert.InDelta(t, 2, slices[cpuQueue]["memory"]/gi, 0.0001)
+	assert.InDelta(t, 2, slices[memoryQueue]["cpu"], 0.0001)
+	assert.InDelta(t, 4, slices[memoryQueue]["memory"]/gi, 0.0001)
+	for queue, info := range queuePriorities {
+		assert.InDelta(t, 0.6, dominantShareWithSlice(drf, info, slices[queue]), 0.0001)
+	}
+}
+
+func Test_sliceResources_DominantResourceFairness_Properties(t *testing.T) {
+	drf := fairnessModels["dominantResource"].(*DominantResourceFairness)
+
+	// queues receiving resources end with the lowest weighted dominant share
+	property := func(seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		queuePriorities := randomQueuePriorities(r)
+		quantityToSlice := randomResources(r).AsFloat()
+
+		slices := sliceResource(drf, queuePriorities, quantityToSlice)
+
+		for q1, info1 := range queuePriorities {
+			if drf.ResourcesFloatAsUsage(slices[q1]) < 1e-9 {
+				continue
+			}
+			weightedShare1 := dominantShareWithSlice(drf, info1, slices[q1]) * info1.Priority
+			for q2, info2 := range queuePriorities {
+				weightedShare2 := dominantShareWithSlice(drf, info2, slices[q2]) * info2.Priority
+				if weightedShare1 > weightedShare2*(1+1e-6)+1e-9 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	assert.NoError(t, quick.Check(property, &quick.Config{MaxCount: 500}))
+}
+
+func dominantShareWithSlice(drf Fairness, info QueuePriorityInfo, slice common.ComputeResourcesFloat) float64 {
+	resources := info.CurrentUsage.AsFloat()
+	resources.Add(slice)
+	return drf.ResourcesFloatAsUsage(resources)
+}
+
+func Test_sliceResource_Properties(t *testing.T) {
+	for name, fairness := range fairnessModels {
+		t.Run(name, func(t *testing.T) {
+			property := func(seed int64) bool {
+				r := rand.New(rand.NewSource(seed))
+				queuePriorities := randomQueuePriorities(r)
+				quantityToSlice := randomResources(r).AsFloat()
+
+				slices := sliceResource(fairness, queuePriorities, quantityToSlice)
+
+				total := common.ComputeResourcesFloat{}
+				for _, slice := range slices {
+					for _, quantity := range slice {
+						if quantity < 0 {
+							return false
+						}
+					}
+					total.Add(slice)
+				}
+				// everything is sliced
+				for resourceName, quantity := range quantityToSlice {
+					if math.Abs(total[resourceName]-quantity) > quantity*1e-9+1e-9 {
+						return false
+					}
+				}
+				// with the same priority a queue using less never gets a smaller slice, dominant shares don't add up
+				// so dominant resource fairness is checked on the resulting shares instead
+				if _, ok := fairness.(*DominantResourceFairness); ok {
+					return true
+				}
+				for q1, info1 := range queuePriorities {
+					for q2, info2 := range queuePriorities {
+						if info1.Priority == info2.Priority &&
+							fairness.ResourcesAsUsage(info1.CurrentUsage) < fairness.ResourcesAsUsage(info2.CurrentUsage) &&
+							fairness.ResourcesFloatAsUsage(slices[q1]) < fairness.ResourcesFloatAsUsage(slices[q2])-1e-9 {
+							return false
+						}
+					}
+				}
+				return true
+			}
+			assert.NoError(t, quick.Check(property, &quick.Config{MaxCount: 500}))
+		})
+	}
+}
+
+func Test_CalculateQueuesPriorityInfo_Properties(t *testing.T) {
+	for name, fairness := range fairnessModels {
+		t.Run(name, func(t *testing.T) {
+			property := func(usage float64, factor uint8) bool {
+				queue := &api.Queue{Name: "queue", PriorityFactor: float64(factor%10) + 1}
+				unusedQueue := &api.Queue{Name: "unused", PriorityFactor: 1}
+				clusterPriorities := map[string]map[string]float64{"cluster": {"queue": math.Abs(usage)}}
+
+				priorities := CalculateQueuesPriorityInfo(fairness, clusterPriorities, map[string]*api.ClusterUsageReport{}, []*api.Queue{queue, unusedQueue})
+
+				return priorities[unusedQueue].Priority == fairness.MinimumPriority() &&
+					priorities[queue].Priority >= fairness.MinimumPriority()*queue.PriorityFactor &&
+					priorities[queue].Priority == math.Max(math.Abs(usage), fairness.MinimumPriority())*queue.PriorityFactor
+			}
+			assert.NoError(t, quick.Check(property, nil))
+		})
+	}
+}
+
+func Test_DominantResourceFairness_Properties(t *testing.T) {
+	drf := fairnessModels["dominantResource"]
+
+	// usage is proportional to resources
+	scaling := func(seed int64, factor uint8) bool {
+		r := rand.New(rand.NewSource(seed))
+		resources := randomResources(r).AsFloat()
+		scaled := resources.Mul(float64(factor))
+		return math.Abs(drf.ResourcesFloatAsUsage(scaled)-float64(factor)*drf.ResourcesFloatAsUsage(resources)) < 1e-9
+	}
+	assert.NoError(t, quick.Check(scaling, nil))
+
+	// usage of a resource only counts once it dominates
+	dominance := func(seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		resources := randomResources(r).AsFloat()
+		usage := drf.ResourcesFloatAsUsage(resources)
+		withMoreMemory := resources.DeepCopy()
+		withMoreMemory["memory"] = usage * 200 * gi
+		return math.Abs(drf.ResourcesFloatAsUsage(withMoreMemory)-usage) < 1e-9
+	}
+	assert.NoError(t, quick.Check(dominance, nil))
+
+	// unlike summed usage, dominant share never exceeds the share of the whole capacity
+	bounded := func(seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		a := randomResources(r).AsFloat()
+		b := randomResources(r).AsFloat()
+		sum := a.DeepCopy()
+		sum.Add(b)
+		return drf.ResourcesFloatAsUsage(sum) <= drf.ResourcesFloatAsUsage(a)+drf.ResourcesFloatAsUsage(b)+1e-9
+	}
+	assert.NoError(t, quick.Check(bounded, nil))
+}
+
+func Test_ScarcityFairness_Properties(t *testing.T) {
+	scarcityFairness := fairnessModels["scarcity"]
+
+	// usage of resources adds up
+	additive := func(seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		a := randomResources(r).AsFloat()
+		b := randomResources(r).AsFloat()
+		sum := a.DeepCopy()
+		sum.Add(b)
+		return math.Abs(scarcityFairness.ResourcesFloatAsUsage(sum)-scarcityFairness.ResourcesFloatAsUsage(a)-scarcityFairness.ResourcesFloatAsUsage(b)) < 1e-6
+	}
+	assert.NoError(t, quick.Check(additive, nil))
+}
+
+func randomQueuePriorities(r *rand.Rand) map[*api.Queue]QueuePriorityInfo {
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{}
+	queueCount := 1 + r.Intn(5)
+	for i := 0; i < queueCount; i++ {
+		queuePriorities[&api.Queue{Name: string(rune('a' + i))}] = QueuePriorityInfo{
+			Priority:     float64(1 + r.Intn(3)),
+			CurrentUsage: randomResources(r),
+		}
+	}
+	return queuePriorities
+}
+
+func randomResources(r *rand.Rand) common.ComputeResources {
+	return common.ComputeResources{
+		"cpu":    *resource.NewMilliQuantity(int64(1+r.Intn(50000)), resource.DecimalSI),
+		"memory": *resource.NewQuantity(int64(1+r.Intn(100))*gi, resource.BinarySI),
+	}
+}
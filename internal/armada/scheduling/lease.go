@@ -4,6 +4,7 @@ import (
 	"context"
 	"math"
 	"math/rand"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -17,9 +18,13 @@ import (
 
 const maxJobsPerLease = 10000
 
+// Top jobs of a queue are read in batches doubling up to this many batches while jobs are skipped
+const maxTopJobsBatches = 4
+
 type JobQueue interface {
 	PeekClusterQueue(clusterId, queue string, limit int64) ([]*api.Job, error)
 	TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error)
+	GetLeasedJobCounts(queue string, jobSetIds []string) (int, map[string]int, error)
 }
 
 type leaseContext struct {
@@ -31,13 +36,18 @@ type leaseContext struct {
 	clusterId string
 
 	queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo
-	resourceScarcity    map[string]float64
+	fairness            Fairness
 	priorities          map[*api.Queue]QueuePriorityInfo
 
-	nodeResources  []*nodeTypeAllocation
-	minimumJobSize map[string]resource.Quantity
+	nodeResources     []*nodeTypeAllocation
+	minimumJobSize    map[string]resource.Quantity
+	clusterPreference *ClusterPreference
+
+	queueCache      map[string][]*api.Job
+	queueCacheMutex sync.Mutex
 
-	queueCache map[string][]*api.Job
+	runningLimits      map[string]*runningJobLimits
+	runningLimitsMutex sync.Mutex
 }
 
 func LeaseJobs(ctx context.Context,
@@ -49,7 +59,8 @@ func LeaseJobs(ctx context.Context,
 	activeClusterReports map[string]*api.ClusterUsageReport,
 	activeClusterLeaseJobReports map[string]*api.ClusterLeasedReport,
 	clusterPriorities map[string]map[string]float64,
-	activeQueues []*api.Queue) ([]*api.Job, error) {
+	activeQueues []*api.Queue,
+	clusterPreference *ClusterPreference) ([]*api.Job, error) {
 
 	resourcesToSchedule := common.ComputeResources(request.Resources).AsFloat()
 	currentClusterReport, ok := activeClusterReports[request.ClusterId]
@@ -69,12 +80,9 @@ func LeaseJobs(ctx context.Context,
 		resourcesToSchedule = resourcesToSchedule.LimitWith(capacity.MulByResource(config.MaximalClusterFractionToSchedule))
 	}
 
-	activeQueuePriority := CalculateQueuesPriorityInfo(clusterPriorities, activeClusterReports, activeQueues)
-	scarcity := config.GetResourceScarcity(request.Pool)
-	if scarcity == nil {
-		scarcity = ResourceScarcityFromReports(activeClusterReports)
-	}
-	activeQueueSchedulingInfo := SliceResourceWithLimits(scarcity, queueSchedulingInfo, activeQueuePriority, resourcesToSchedule)
+	fairness := NewFairness(config, request.Pool, activeClusterReports)
+	activeQueuePriority := CalculateQueuesPriorityInfo(fairness, clusterPriorities, activeClusterReports, activeQueues)
+	activeQueueSchedulingInfo := SliceResourceWithLimits(fairness, queueSchedulingInfo, activeQueuePriority, resourcesToSchedule)
 
 	lc := &leaseContext{
 		schedulingConfig: config,
@@ -83,13 +91,15 @@ func LeaseJobs(ctx context.Context,
 		ctx:       ctx,
 		clusterId: request.ClusterId,
 
-		resourceScarcity:    scarcity,
+		fairness:            fairness,
 		queueSchedulingInfo: activeQueueSchedulingInfo,
 		priorities:          activeQueuePriority,
 		nodeResources:       nodeResources,
 		minimumJobSize:      request.MinimumJobSize,
+		clusterPreference:   clusterPreference,
 
-		queueCache: map[string][]*api.Job{},
+		queueCache:    map[string][]*api.Job{},
+		runningLimits: map[string]*runningJobLimits{},
 
 		onJobsLeased: onJobLease,
 	}
@@ -152,28 +162,169 @@ func (c *leaseContext) scheduleJobs(limit int) ([]*api.Job, error) {
 	return jobs, nil
 }
 
+// Assigns each queue jobs up to its share of the resources in rounds. In each round the queues are processed concurrently
+// in three steps: their top jobs are read and the candidates fitting their share are selected, candidates are checked
+// against the node resources consumed by other queues one queue at a time, and finally the candidates are leased.
+// Queues which leased a full batch take part in the next round.
 func (c *leaseContext) assignJobs(limit int) ([]*api.Job, error) {
 	jobs := make([]*api.Job, 0)
-	// TODO: parallelize
+	if len(c.queueSchedulingInfo) == 0 {
+		return jobs, nil
+	}
+
+	// TODO: partition limit by priority instead
+	queueLimit := limit / len(c.queueSchedulingInfo)
+	rounds := make([]*queueRound, 0, len(c.queueSchedulingInfo))
 	for queue, info := range c.queueSchedulingInfo {
-		// TODO: partition limit by priority instead
-		leased, remainder, e := c.leaseJobs(queue, info.adjustedShare, limit/len(c.queueSchedulingInfo))
-		if e != nil {
-			log.Error(e)
-			continue
+		rounds = append(rounds, &queueRound{queue: queue, slice: info.adjustedShare.DeepCopy(), limit: queueLimit})
+	}
+	remaining := rounds
+
+	for len(remaining) > 0 {
+		c.forEachQueue(remaining, c.selectQueueCandidates)
+		c.confirmCandidateNodes(remaining)
+		c.forEachQueue(remaining, c.leaseQueueCandidates)
+
+		next := []*queueRound{}
+		for _, round := range remaining {
+			if round.err != nil {
+				log.Error(round.err)
+				continue
+			}
+			c.decreaseNodeResources(round.leased, round.candidateNodes)
+			jobs = append(jobs, round.leased...)
+			round.limit -= len(round.leased)
+			go c.onJobsLeased(round.leased)
+
+			// stop leasing from the queue if it leased less then batch (either the slice is too small or queue is empty),
+			// jobs skipped because of max running limits count towards the batch
+			if round.limit > 0 && round.slice.IsValid() && len(round.candidates)+round.throttled >= int(c.schedulingConfig.QueueLeaseBatchSize) {
+				next = append(next, round)
+			}
 		}
-		scheduled := info.adjustedShare.DeepCopy()
-		scheduled.Sub(remainder)
-		c.queueSchedulingInfo[queue].UpdateLimits(scheduled)
-		jobs = append(jobs, leased...)
+		remaining = next
 
 		if c.closeToDeadline() {
 			break
 		}
 	}
+
+	for _, round := range rounds {
+		if round.err != nil {
+			continue
+		}
+		info := c.queueSchedulingInfo[round.queue]
+		scheduled := info.adjustedShare.DeepCopy()
+		scheduled.Sub(round.slice)
+		info.UpdateLimits(scheduled)
+	}
 	return jobs, nil
 }
 
+// State of one queue in a round of assignJobs
+type queueRound struct {
+	queue *api.Queue
+	slice common.ComputeResourcesFloat
+	limit int
+
+	candidates     []*api.Job
+	candidateNodes map[*api.Job]nodeTypeUsedResources
+	throttled      int
+	leased         []*api.Job
+	err            error
+}
+
+// Runs action for each queue round, concurrently up to the configured parallelism
+func (c *leaseContext) forEachQueue(rounds []*queueRound, action func(*queueRound)) {
+	parallelism := c.schedulingConfig.QueueLeaseParallelism
+	if parallelism <= 1 {
+		for _, round := range rounds {
+			action(round)
+		}
+		return
+	}
+
+	work := make(chan *queueRound)
+	wg := sync.WaitGroup{}
+	for i := 0; i < parallelism && i < len(rounds); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for round := range work {
+				action(round)
+			}
+		}()
+	}
+	for _, round := range rounds {
+		work <- round
+	}
+	close(work)
+	wg.Wait()
+}
+
+// Selects candidates of the queue against the node resources available at the start of the round, without
+// considering candidates of other queues
+func (c *leaseContext) selectQueueCandidates(round *queueRound) {
+	round.candidates, round.candidateNodes, round.throttled, round.leased = nil, nil, 0, nil
+	if round.err != nil {
+		return
+	}
+	topJobs, e := c.getTopJobs(round.queue)
+	if e != nil {
+		round.err = e
+		return
+	}
+	round.candidates, round.candidateNodes, round.slice, round.throttled, round.err = c.selectCandidates(round.queue, topJobs, round.slice, round.limit)
+}
+
+// Checks candidates of all queues still fit the node resources once candidates of other queues are taken into account,
+// candidates which no longer fit are dropped and their resources returned to the slice of their queue
+func (c *leaseContext) confirmCandidateNodes(rounds []*queueRound) {
+	consumedNodeResources := nodeTypeUsedResources{}
+	for _, round := range rounds {
+		if round.err != nil {
+			continue
+		}
+		confirmed := make([]*api.Job, 0, len(round.candidates))
+		for _, job := range round.candidates {
+			newlyConsumed := round.candidateNodes[job]
+			if !fitsNodeTypeAllocations(newlyConsumed, consumedNodeResources) {
+				var ok bool
+				newlyConsumed, ok = matchAnyNodeTypeAllocation(job, c.nodeResources, consumedNodeResources)
+				if !ok {
+					round.slice.Add(common.TotalJobResourceRequest(job).AsFloat())
+					delete(round.candidateNodes, job)
+					continue
+				}
+				round.candidateNodes[job] = newlyConsumed
+			}
+			consumedNodeResources.Add(newlyConsumed)
+			confirmed = append(confirmed, job)
+		}
+		c.removeFromTopJobs(round.queue, round.candidates)
+		round.candidates = confirmed
+	}
+}
+
+func (c *leaseContext) leaseQueueCandidates(round *queueRound) {
+	if round.err != nil {
+		return
+	}
+	round.leased, round.err = c.queue.TryLeaseJobs(c.clusterId, round.queue.Name, round.candidates)
+}
+
+func fitsNodeTypeAllocations(resources nodeTypeUsedResources, alreadyConsumed nodeTypeUsedResources) bool {
+	for nodeType, required := range resources {
+		available := nodeType.availableResources.DeepCopy()
+		available.Sub(alreadyConsumed[nodeType])
+		available.Sub(required)
+		if !available.IsValid() {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
 
 	jobs := []*api.Job{}
@@ -182,7 +333,7 @@ func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
 	}
 
 	remainder := SumRemainingResource(c.queueSchedulingInfo)
-	shares := QueueSlicesToShares(c.resourceScarcity, c.queueSchedulingInfo)
+	shares := QueueSlicesToShares(c.fairness, c.queueSchedulingInfo)
 
 	queueCount := len(c.queueSchedulingInfo)
 	emptySteps := 0
@@ -211,13 +362,13 @@ func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
 
 			c.queueSchedulingInfo[queue].UpdateLimits(scheduled)
 			remainder.Sub(scheduled)
-			shares[queue] = math.Max(0, ResourcesFloatAsUsage(c.resourceScarcity, c.queueSchedulingInfo[queue].schedulingShare))
+			shares[queue] = math.Max(0, c.fairness.ResourcesFloatAsUsage(c.queueSchedulingInfo[queue].schedulingShare))
 		} else {
 			// if there are no suitable jobs to lease eliminate queue from the scheduling
 			delete(c.queueSchedulingInfo, queue)
 			delete(c.priorities, queue)
-			c.queueSchedulingInfo = SliceResourceWithLimits(c.resourceScarcity, c.queueSchedulingInfo, c.priorities, remainder)
-			shares = QueueSlicesToShares(c.resourceScarcity, c.queueSchedulingInfo)
+			c.queueSchedulingInfo = SliceResourceWithLimits(c.fairness, c.queueSchedulingInfo, c.priorities, remainder)
+			shares = QueueSlicesToShares(c.fairness, c.queueSchedulingInfo)
 		}
 
 		limit -= len(leased)
@@ -231,44 +382,22 @@ func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
 
 func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResourcesFloat, limit int) ([]*api.Job, common.ComputeResourcesFloat, error) {
 	jobs := make([]*api.Job, 0)
-	remainder := slice
 	for slice.IsValid() {
 		if limit <= 0 {
 			break
 		}
 
-		topJobs, ok := c.queueCache[queue.Name]
-		if !ok || len(topJobs) < int(c.schedulingConfig.QueueLeaseBatchSize/2) {
-			newTop, e := c.queue.PeekClusterQueue(c.clusterId, queue.Name, int64(c.schedulingConfig.QueueLeaseBatchSize))
-			if e != nil {
-				return nil, slice, e
-			}
-			c.queueCache[queue.Name] = newTop
-			topJobs = c.queueCache[queue.Name]
+		topJobs, e := c.getTopJobs(queue)
+		if e != nil {
+			return nil, slice, e
 		}
 
-		candidates := make([]*api.Job, 0)
-		candidateNodes := map[*api.Job]nodeTypeUsedResources{}
-		consumedNodeResources := nodeTypeUsedResources{}
-
-		for _, job := range topJobs {
-			requirement := common.TotalJobResourceRequest(job).AsFloat()
-			remainder = slice.DeepCopy()
-			remainder.Sub(requirement)
-			if isLargeEnough(job, c.minimumJobSize) && remainder.IsValid() {
-				newlyConsumed, ok := matchAnyNodeTypeAllocation(job, c.nodeResources, consumedNodeResources)
-				if ok {
-					slice = remainder
-					candidates = append(candidates, job)
-					candidateNodes[job] = newlyConsumed
-					consumedNodeResources.Add(newlyConsumed)
-				}
-			}
-			if len(candidates) >= limit {
-				break
-			}
+		candidates, candidateNodes, remainder, throttled, e := c.selectCandidates(queue, topJobs, slice, limit)
+		if e != nil {
+			return nil, slice, e
 		}
-		c.queueCache[queue.Name] = removeJobs(c.queueCache[queue.Name], candidates)
+		slice = remainder
+		c.removeFromTopJobs(queue, candidates)
 
 		leased, e := c.queue.TryLeaseJobs(c.clusterId, queue.Name, candidates)
 		if e != nil {
@@ -282,7 +411,7 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 
 		// stop scheduling round if we leased less then batch (either the slice is too small or queue is empty)
 		// TODO: should we look at next batch?
-		if len(candidates) < int(c.schedulingConfig.QueueLeaseBatchSize) {
+		if len(candidates)+throttled < int(c.schedulingConfig.QueueLeaseBatchSize) {
 			break
 		}
 		if c.closeToDeadline() {
@@ -295,6 +424,182 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 	return jobs, slice, nil
 }
 
+// Returns the cached top jobs of the queue which the cluster can take and which are not held back by max running limits,
+// reading more from the queue when less than half a batch is cached. The queue is read further while skipped jobs fill
+// the batch, up to maxTopJobsBatches batches. Queues at their max running limit are not read.
+// It is safe to call concurrently for different queues.
+func (c *leaseContext) getTopJobs(queue *api.Queue) ([]*api.Job, error) {
+	c.queueCacheMutex.Lock()
+	topJobs, ok := c.queueCache[queue.Name]
+	c.queueCacheMutex.Unlock()
+	batchSize := int(c.schedulingConfig.QueueLeaseBatchSize)
+	if ok && len(topJobs) >= batchSize/2 {
+		return topJobs, nil
+	}
+
+	runningLimits, e := c.getRunningLimits(queue, nil)
+	if e != nil {
+		return nil, e
+	}
+	if runningLimits.queueThrottled() {
+		c.queueCacheMutex.Lock()
+		c.queueCache[queue.Name] = []*api.Job{}
+		c.queueCacheMutex.Unlock()
+		return []*api.Job{}, nil
+	}
+
+	var newTop []*api.Job
+	for limit := batchSize; ; limit *= 2 {
+		peeked, e := c.queue.PeekClusterQueue(c.clusterId, queue.Name, int64(limit))
+		if e != nil {
+			return nil, e
+		}
+		newTop, e = c.filterThrottled(queue, c.filterPreferred(peeked))
+		if e != nil {
+			return nil, e
+		}
+		if len(newTop) >= batchSize || len(peeked) < limit || limit >= maxTopJobsBatches*batchSize {
+			break
+		}
+	}
+	if len(newTop) > batchSize {
+		newTop = newTop[:batchSize]
+	}
+
+	c.queueCacheMutex.Lock()
+	c.queueCache[queue.Name] = newTop
+	c.queueCacheMutex.Unlock()
+	return newTop, nil
+}
+
+// Drops jobs which are not allowed on the cluster, or left for clusters they prefer
+func (c *leaseContext) filterPreferred(jobs []*api.Job) []*api.Job {
+	filtered := make([]*api.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if c.clusterPreference.Allows(job) {
+			filtered = append(filtered, job)
+		}
+	}
+	return filtered
+}
+
+func (c *leaseContext) filterThrottled(queue *api.Queue, jobs []*api.Job) ([]*api.Job, error) {
+	runningLimits, e := c.getRunningLimits(queue, jobs)
+	if e != nil {
+		return nil, e
+	}
+	filtered := make([]*api.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if runningLimits.throttledBy(job) == "" {
+			filtered = append(filtered, job)
+		}
+	}
+	return filtered, nil
+}
+
+func (c *leaseContext) removeFromTopJobs(queue *api.Queue, jobs []*api.Job) {
+	c.queueCacheMutex.Lock()
+	defer c.queueCacheMutex.Unlock()
+	c.queueCache[queue.Name] = removeJobs(c.queueCache[queue.Name], jobs)
+}
+
+// Selects jobs fitting into the slice and the available node resources, up to limit jobs.
+// Returns the candidates, node resources each of them consumes and the remaining slice.
+// Jobs reaching a max running limit are skipped and dropped from the top jobs, the number of them is returned as well.
+// It only reads node resources, so it is safe to call concurrently for different queues.
+func (c *leaseContext) selectCandidates(queue *api.Queue, topJobs []*api.Job, slice common.ComputeResourcesFloat, limit int) (
+	[]*api.Job, map[*api.Job]nodeTypeUsedResources, common.ComputeResourcesFloat, int, error) {
+	candidates := make([]*api.Job, 0)
+	candidateNodes := map[*api.Job]nodeTypeUsedResources{}
+	consumedNodeResources := nodeTypeUsedResources{}
+	throttled := []*api.Job{}
+
+	runningLimits, e := c.getRunningLimits(queue, topJobs)
+	if e != nil {
+		return nil, nil, slice, 0, e
+	}
+
+	for _, job := range topJobs {
+		if runningLimits.throttledBy(job) != "" {
+			throttled = append(throttled, job)
+			continue
+		}
+		requirement := common.TotalJobResourceRequest(job).AsFloat()
+		remainder := slice.DeepCopy()
+		remainder.Sub(requirement)
+		if isLargeEnough(job, c.minimumJobSize) && remainder.IsValid() {
+			newlyConsumed, ok := matchAnyNodeTypeAllocation(job, c.nodeResources, consumedNodeResources)
+			if ok {
+				slice = remainder
+				candidates = append(candidates, job)
+				candidateNodes[job] = newlyConsumed
+				consumedNodeResources.Add(newlyConsumed)
+				runningLimits.add(job)
+			}
+		}
+		if len(candidates) >= limit {
+			break
+		}
+	}
+	c.removeFromTopJobs(queue, throttled)
+	return candidates, candidateNodes, slice, len(throttled), nil
+}
+
+// Leased jobs of a queue and its job sets, counted at the first use in a lease request and then by jobs selected
+// in the request. Limits can be exceeded when several lease requests select jobs of the same queue concurrently.
+type runningJobLimits struct {
+	queue         *api.Queue
+	queueRunning  int
+	jobSetRunning map[string]int
+}
+
+func (l *runningJobLimits) throttledBy(job *api.Job) string {
+	return l.queue.ThrottledBy(job, l.queueRunning, l.jobSetRunning[job.JobSetId])
+}
+
+// Whether the queue reached its max running limit, so none of its jobs can be leased
+func (l *runningJobLimits) queueThrottled() bool {
+	return l.throttledBy(&api.Job{}) != ""
+}
+
+func (l *runningJobLimits) add(job *api.Job) {
+	l.queueRunning++
+	l.jobSetRunning[job.JobSetId]++
+}
+
+// Returns the running job limits of the queue, with counts loaded for job sets of the jobs which have a limit
+func (c *leaseContext) getRunningLimits(queue *api.Queue, jobs []*api.Job) (*runningJobLimits, error) {
+	c.runningLimitsMutex.Lock()
+	limits, ok := c.runningLimits[queue.Name]
+	if !ok {
+		limits = &runningJobLimits{queue: queue, jobSetRunning: map[string]int{}}
+		c.runningLimits[queue.Name] = limits
+	}
+	c.runningLimitsMutex.Unlock()
+
+	jobSetIds := []string{}
+	for _, job := range jobs {
+		if _, counted := limits.jobSetRunning[job.JobSetId]; job.JobSetMaxRunning > 0 && !counted && !util.ContainsString(jobSetIds, job.JobSetId) {
+			jobSetIds = append(jobSetIds, job.JobSetId)
+		}
+	}
+	if (ok || queue.MaxRunning == 0) && len(jobSetIds) == 0 {
+		return limits, nil
+	}
+
+	queueRunning, jobSetRunning, e := c.queue.GetLeasedJobCounts(queue.Name, jobSetIds)
+	if e != nil {
+		return nil, e
+	}
+	if !ok {
+		limits.queueRunning = queueRunning
+	}
+	for jobSetId, running := range jobSetRunning {
+		limits.jobSetRunning[jobSetId] = running
+	}
+	return limits, nil
+}
+
 func (c *leaseContext) decreaseNodeResources(leased []*api.Job, nodeTypeUsage map[*api.Job]nodeTypeUsedResources) {
 	for _, j := range leased {
 		for nodeType, resources := range nodeTypeUsage[j] {
@@ -0,0 +1,149 @@
+package scheduling
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Redis round trip simulated by the fakes
+const benchmarkLatency = 200 * time.Microsecond
+
+func Benchmark_assignJobs(b *testing.B) {
+	for _, queueCount := range []int{10, 100} {
+		for _, parallelism := range []int{1, 8, 32} {
+			b.Run(fmt.Sprintf("queues %d parallelism %d", queueCount, parallelism), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					c := createAssignJobsContext(queueCount, 50, 10, queueCount*10, parallelism)
+					c.queue.(*fakeJobQueue).latency = benchmarkLatency
+					b.StartTimer()
+
+					_, _ = c.assignJobs(maxJobsPerLease)
+				}
+			})
+		}
+	}
+}
+
+func Benchmark_distributeRemainder(b *testing.B) {
+	log.SetLevel(log.WarnLevel)
+	defer log.SetLevel(log.InfoLevel)
+	for _, queueCount := range []int{10, 100} {
+		for _, parallelism := range []int{1, 8, 32} {
+			b.Run(fmt.Sprintf("queues %d parallelism %d", queueCount, parallelism), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					c := createAssignJobsContext(queueCount, 50, 10, queueCount, parallelism)
+					c.queue.(*fakeJobQueue).latency = benchmarkLatency
+					c.fairness = fairnessModels["scarcity"]
+					c.priorities = map[*api.Queue]QueuePriorityInfo{}
+					for queue := range c.queueSchedulingInfo {
+						c.priorities[queue] = QueuePriorityInfo{Priority: 1}
+					}
+					c.schedulingConfig.UseProbabilisticSchedulingForAllResources = true
+					b.StartTimer()
+
+					_, _ = c.scheduleJobs(maxJobsPerLease)
+				}
+			})
+		}
+	}
+}
+
+func Benchmark_SchedulingStateCache_Get(b *testing.B) {
+	for _, refreshInterval := range []time.Duration{0, time.Second} {
+		b.Run(fmt.Sprintf("refresh interval %s", refreshInterval), func(b *testing.B) {
+			queues := make([]*api.Queue, 0, 100)
+			for i := 0; i < 100; i++ {
+				queues = append(queues, &api.Queue{Name: fmt.Sprintf("queue%d", i)})
+			}
+			cache := NewSchedulingStateCache(
+				&slowJobRepository{},
+				&slowQueueRepository{queues: queues},
+				&slowUsageRepository{},
+				&slowSchedulingInfoRepository{},
+				&slowCordonRepository{},
+				refreshInterval)
+
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, e := cache.Get(); e != nil {
+						b.Fatal(e)
+					}
+				}
+			})
+		})
+	}
+}
+
+type slowJobRepository struct {
+	repository.JobRepository
+}
+
+func (r *slowJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
+	time.Sleep(benchmarkLatency)
+	return queues, nil
+}
+
+type slowQueueRepository struct {
+	repository.QueueRepository
+	queues []*api.Queue
+}
+
+func (r *slowQueueRepository) GetAllQueues() ([]*api.Queue, error) {
+	time.Sleep(benchmarkLatency)
+	return r.queues, nil
+}
+
+type slowUsageRepository struct {
+	repository.UsageRepository
+	leased map[string]*api.ClusterLeasedReport
+}
+
+func (r *slowUsageRepository) UpdateClusterLeased(report *api.ClusterLeasedReport) error {
+	time.Sleep(benchmarkLatency)
+	if r.leased == nil {
+		r.leased = map[string]*api.ClusterLeasedReport{}
+	}
+	r.leased[report.ClusterId] = report
+	return nil
+}
+
+func (r *slowUsageRepository) GetClusterUsageReports() (map[string]*api.ClusterUsageReport, error) {
+	time.Sleep(benchmarkLatency)
+	return map[string]*api.ClusterUsageReport{}, nil
+}
+
+func (r *slowUsageRepository) GetClusterPriorities(clusterIds []string) (map[string]map[string]float64, error) {
+	time.Sleep(benchmarkLatency)
+	return map[string]map[string]float64{}, nil
+}
+
+func (r *slowUsageRepository) GetClusterLeasedReports() (map[string]*api.ClusterLeasedReport, error) {
+	time.Sleep(benchmarkLatency)
+	return map[string]*api.ClusterLeasedReport{}, nil
+}
+
+type slowSchedulingInfoRepository struct {
+	repository.SchedulingInfoRepository
+}
+
+func (r *slowSchedulingInfoRepository) GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error) {
+	time.Sleep(benchmarkLatency)
+	return map[string]*api.ClusterSchedulingInfoReport{}, nil
+}
+
+type slowCordonRepository struct {
+	repository.CordonRepository
+}
+
+func (r *slowCordonRepository) GetCordons() ([]*api.ClusterCordon, error) {
+	time.Sleep(benchmarkLatency)
+	return []*api.ClusterCordon{}, nil
+}
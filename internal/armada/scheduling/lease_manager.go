@@ -6,6 +6,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/task"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -14,18 +15,21 @@ type LeaseManager struct {
 	queueRepository     repository.QueueRepository
 	eventStore          repository.EventStore
 	leaseExpiryDuration time.Duration
+	leader              *task.LeaderElection
 }
 
 func NewLeaseManager(
 	jobRepository repository.JobRepository,
 	queueRepository repository.QueueRepository,
 	eventStore repository.EventStore,
-	leaseExpiryDuration time.Duration) *LeaseManager {
+	leaseExpiryDuration time.Duration,
+	leader *task.LeaderElection) *LeaseManager {
 	return &LeaseManager{
 		jobRepository:       jobRepository,
 		queueRepository:     queueRepository,
 		eventStore:          eventStore,
-		leaseExpiryDuration: leaseExpiryDuration}
+		leaseExpiryDuration: leaseExpiryDuration,
+		leader:              leader}
 }
 
 func (l *LeaseManager) ExpireLeases() {
@@ -37,9 +41,17 @@ func (l *LeaseManager) ExpireLeases() {
 
 	deadline := time.Now().Add(-l.leaseExpiryDuration)
 	for _, queue := range queues {
-		jobs, e := l.jobRepository.ExpireLeases(queue.Name, deadline)
+		leaderToken, isLeader := task.LeaderToken(l.leader)
+		if !isLeader {
+			log.Warn("Stopped expiring leases, no longer the leader")
+			return
+		}
+		jobs, e := l.jobRepository.ExpireLeases(queue.Name, deadline, leaderToken)
 		now := time.Now()
-		if e != nil {
+		if e == repository.ErrStaleLeaderToken {
+			log.Warn("Stopped expiring leases, another server became the leader")
+			return
+		} else if e != nil {
 			log.Error(e)
 		} else {
 			for _, job := range jobs {
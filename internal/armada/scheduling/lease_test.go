@@ -2,6 +2,8 @@ package scheduling
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -30,127 +32,301 @@ func Test_minimumJobSize(t *testing.T) {
 }
 
 func Test_distributeRemainder_highPriorityUserDoesNotBlockOthers(t *testing.T) {
+	for name, fairness := range fairnessModels {
+		t.Run(name, func(t *testing.T) {
+			queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+			queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+
+			priorities := map[*api.Queue]QueuePriorityInfo{
+				queue1: {
+					Priority:     1000,
+					CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("80Gi")}},
+				queue2: {
+					Priority:     0.5,
+					CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("0"), "memory": resource.MustParse("0")}},
+			}
+			requestSize := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}
 
-	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
-	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
-
-	scarcity := map[string]float64{"cpu": 1, "gpu": 1}
-
-	priorities := map[*api.Queue]QueuePriorityInfo{
-		queue1: {
-			Priority:     1000,
-			CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("80Gi")}},
-		queue2: {
-			Priority:     0.5,
-			CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("0"), "memory": resource.MustParse("0")}},
-	}
-	requestSize := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}
-
-	schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
-		queue1: {remainingSchedulingLimit: requestSize.AsFloat(), schedulingShare: requestSize.AsFloat(), adjustedShare: requestSize.AsFloat()},
-		queue2: {remainingSchedulingLimit: requestSize.AsFloat(), schedulingShare: requestSize.AsFloat(), adjustedShare: requestSize.AsFloat()},
-	}
-
-	impossiblePodSpec := classicPodSpec.DeepCopy()
-	impossiblePodSpec.NodeSelector = map[string]string{"impossible": "label"}
-
-	jobQueue := &fakeJobQueue{
-		jobsByQueue: map[string][]*api.Job{
-			"queue1": {
-				&api.Job{PodSpec: classicPodSpec},
-				&api.Job{PodSpec: classicPodSpec},
-				&api.Job{PodSpec: classicPodSpec},
-				&api.Job{PodSpec: classicPodSpec},
-				&api.Job{PodSpec: classicPodSpec},
-			},
-			"queue2": {
-				&api.Job{PodSpec: impossiblePodSpec},
-			},
-		},
+			schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
+				queue1: {remainingSchedulingLimit: requestSize.AsFloat(), schedulingShare: requestSize.AsFloat(), adjustedShare: requestSize.AsFloat()},
+				queue2: {remainingSchedulingLimit: requestSize.AsFloat(), schedulingShare: requestSize.AsFloat(), adjustedShare: requestSize.AsFloat()},
+			}
+
+			impossiblePodSpec := classicPodSpec.DeepCopy()
+			impossiblePodSpec.NodeSelector = map[string]string{"impossible": "label"}
+
+			jobQueue := &fakeJobQueue{
+				jobsByQueue: map[string][]*api.Job{
+					"queue1": {
+						&api.Job{PodSpec: classicPodSpec},
+						&api.Job{PodSpec: classicPodSpec},
+						&api.Job{PodSpec: classicPodSpec},
+						&api.Job{PodSpec: classicPodSpec},
+						&api.Job{PodSpec: classicPodSpec},
+					},
+					"queue2": {
+						&api.Job{PodSpec: impossiblePodSpec},
+					},
+				},
+			}
+
+			// the leasing logic stops scheduling 1s before the deadline
+			ctx, _ := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
+
+			nodeResources := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+			nodes := []api.NodeInfo{{Name: "testNode", AllocatableResources: nodeResources, AvailableResources: nodeResources}}
+			c := leaseContext{
+				ctx: ctx,
+				schedulingConfig: &configuration.SchedulingConfig{
+					QueueLeaseBatchSize: 10,
+				},
+				onJobsLeased:  func(a []*api.Job) {},
+				clusterId:     "c1",
+				nodeResources: AggregateNodeTypeAllocations(nodes),
+
+				fairness:            fairness,
+				priorities:          priorities,
+				queueSchedulingInfo: SliceResourceWithLimits(fairness, schedulingInfo, priorities, requestSize.AsFloat()),
+				queue:               jobQueue,
+				queueCache:          map[string][]*api.Job{},
+				runningLimits:       map[string]*runningJobLimits{},
+			}
+
+			jobs, e := c.distributeRemainder(1000)
+			assert.Nil(t, e)
+			assert.Equal(t, 5, len(jobs))
+		})
 	}
+}
 
-	// the leasing logic stops scheduling 1s before the deadline
-	ctx, _ := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
+func Test_distributeRemainder_DoesNotExceedSchedulingLimits(t *testing.T) {
+	for name, fairness := range fairnessModels {
+		t.Run(name, func(t *testing.T) {
+			queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+
+			priorities := map[*api.Queue]QueuePriorityInfo{
+				queue1: {
+					Priority:     1000,
+					CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("80Gi")}},
+			}
+			requestSize := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}
+			resourceLimit := common.ComputeResources{"cpu": resource.MustParse("2.5"), "memory": resource.MustParse("2.5Gi")}.AsFloat()
 
-	nodeResources := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
-	nodes := []api.NodeInfo{{Name: "testNode", AllocatableResources: nodeResources, AvailableResources: nodeResources}}
-	c := leaseContext{
-		ctx: ctx,
-		schedulingConfig: &configuration.SchedulingConfig{
-			QueueLeaseBatchSize: 10,
-		},
-		onJobsLeased:  func(a []*api.Job) {},
-		clusterId:     "c1",
-		nodeResources: AggregateNodeTypeAllocations(nodes),
+			schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
+				queue1: {remainingSchedulingLimit: resourceLimit, schedulingShare: resourceLimit, adjustedShare: resourceLimit},
+			}
 
-		resourceScarcity:    scarcity,
-		priorities:          priorities,
-		queueSchedulingInfo: SliceResourceWithLimits(scarcity, schedulingInfo, priorities, requestSize.AsFloat()),
-		queue:               jobQueue,
-		queueCache:          map[string][]*api.Job{},
+			repository := &fakeJobQueue{
+				jobsByQueue: map[string][]*api.Job{
+					"queue1": {
+						&api.Job{PodSpec: classicPodSpec},
+						&api.Job{PodSpec: classicPodSpec},
+						&api.Job{PodSpec: classicPodSpec},
+						&api.Job{PodSpec: classicPodSpec},
+						&api.Job{PodSpec: classicPodSpec},
+					},
+				},
+			}
+
+			// the leasing logic stops scheduling 1s before the deadline
+			ctx, _ := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
+
+			nodeResources := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+			nodes := []api.NodeInfo{{Name: "testNode", AllocatableResources: nodeResources, AvailableResources: nodeResources}}
+
+			c := leaseContext{
+				ctx: ctx,
+				schedulingConfig: &configuration.SchedulingConfig{
+					QueueLeaseBatchSize: 10,
+				},
+				onJobsLeased: func(a []*api.Job) {},
+				clusterId:    "c1",
+
+				nodeResources: AggregateNodeTypeAllocations(nodes),
+
+				fairness:            fairness,
+				priorities:          priorities,
+				queueSchedulingInfo: SliceResourceWithLimits(fairness, schedulingInfo, priorities, requestSize.AsFloat()),
+				queue:               repository,
+				queueCache:          map[string][]*api.Job{},
+				runningLimits:       map[string]*runningJobLimits{},
+			}
+
+			jobs, e := c.distributeRemainder(1000)
+			assert.Nil(t, e)
+			assert.Equal(t, 2, len(jobs))
+		})
 	}
+}
 
-	jobs, e := c.distributeRemainder(1000)
+func Test_assignJobs_LeasesShareOfEachQueue(t *testing.T) {
+	for _, parallelism := range []int{0, 4} {
+		t.Run(fmt.Sprintf("parallelism %d", parallelism), func(t *testing.T) {
+			c := createAssignJobsContext(4, 20, 10, 1000, parallelism)
+
+			jobs, e := c.assignJobs(1000)
+			assert.Nil(t, e)
+			assert.Equal(t, 40, len(jobs))
+			for queue, info := range c.queueSchedulingInfo {
+				assert.Equal(t, 10, countQueueJobs(jobs, queue.Name))
+				assert.InDelta(t, 0, info.remainingSchedulingLimit["cpu"], 0.001)
+			}
+		})
+	}
+}
+
+func Test_assignJobs_WithParallelism_DoesNotOvercommitNodes(t *testing.T) {
+	c := createAssignJobsContext(4, 20, 10, 25, 4)
+
+	jobs, e := c.assignJobs(1000)
 	assert.Nil(t, e)
-	assert.Equal(t, 5, len(jobs))
+	assert.Equal(t, 25, len(jobs))
 }
 
-func Test_distributeRemainder_DoesNotExceedSchedulingLimits(t *testing.T) {
+func Test_assignJobs_RespectsQueueMaxRunning(t *testing.T) {
+	for _, parallelism := range []int{0, 4} {
+		t.Run(fmt.Sprintf("parallelism %d", parallelism), func(t *testing.T) {
+			c := createAssignJobsContext(2, 20, 10, 1000, parallelism)
+			jobQueue := c.queue.(*fakeJobQueue)
+			for queue := range c.queueSchedulingInfo {
+				if queue.Name == "queue0" {
+					queue.MaxRunning = 5
+					jobQueue.leasedByQueue = map[string][]*api.Job{queue.Name: {{Id: "running", Queue: queue.Name}}}
+				}
+			}
 
-	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+			jobs, e := c.assignJobs(1000)
+			assert.Nil(t, e)
+			assert.Equal(t, 4, countQueueJobs(jobs, "queue0"))
+			assert.Equal(t, 10, countQueueJobs(jobs, "queue1"))
+		})
+	}
+}
+
+func Test_assignJobs_DoesNotReadQueue_AtMaxRunning(t *testing.T) {
+	c := createAssignJobsContext(2, 20, 10, 1000, 0)
+	jobQueue := c.queue.(*fakeJobQueue)
+	for queue := range c.queueSchedulingInfo {
+		if queue.Name == "queue0" {
+			queue.MaxRunning = 1
+			jobQueue.leasedByQueue = map[string][]*api.Job{queue.Name: {{Id: "running", Queue: queue.Name}}}
+		}
+	}
 
-	scarcity := map[string]float64{"cpu": 1, "gpu": 1}
+	jobs, e := c.assignJobs(1000)
+	assert.Nil(t, e)
+	assert.Equal(t, 0, countQueueJobs(jobs, "queue0"))
+	assert.Equal(t, 10, countQueueJobs(jobs, "queue1"))
+	assert.Empty(t, jobQueue.peekLimits["queue0"])
+}
 
-	priorities := map[*api.Queue]QueuePriorityInfo{
-		queue1: {
-			Priority:     1000,
-			CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("80Gi")}},
+func Test_assignJobs_ReadsLimitedNumberOfBatches_WhenJobsAreThrottled(t *testing.T) {
+	c := createAssignJobsContext(1, 1000, 10, 1000, 0)
+	jobQueue := c.queue.(*fakeJobQueue)
+	for _, job := range jobQueue.jobsByQueue["queue0"] {
+		job.JobSetId = "limited"
+		job.JobSetMaxRunning = 1
 	}
-	requestSize := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}
-	resourceLimit := common.ComputeResources{"cpu": resource.MustParse("2.5"), "memory": resource.MustParse("2.5Gi")}.AsFloat()
+	jobQueue.leasedByQueue = map[string][]*api.Job{"queue0": {{Id: "running", Queue: "queue0", JobSetId: "limited"}}}
 
-	schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
-		queue1: {remainingSchedulingLimit: resourceLimit, schedulingShare: resourceLimit, adjustedShare: resourceLimit},
+	jobs, e := c.assignJobs(1000)
+	assert.Nil(t, e)
+	assert.Empty(t, jobs)
+	for _, limit := range jobQueue.peekLimits["queue0"] {
+		assert.LessOrEqual(t, limit, int64(maxTopJobsBatches*10))
 	}
+}
 
-	repository := &fakeJobQueue{
-		jobsByQueue: map[string][]*api.Job{
-			"queue1": {
-				&api.Job{PodSpec: classicPodSpec},
-				&api.Job{PodSpec: classicPodSpec},
-				&api.Job{PodSpec: classicPodSpec},
-				&api.Job{PodSpec: classicPodSpec},
-				&api.Job{PodSpec: classicPodSpec},
-			},
-		},
+func Test_assignJobs_RespectsJobSetMaxRunning_WhenLimitedJobsAreFirstInQueue(t *testing.T) {
+	c := createAssignJobsContext(1, 30, 10, 1000, 0)
+	jobQueue := c.queue.(*fakeJobQueue)
+	for i, job := range jobQueue.jobsByQueue["queue0"] {
+		if i < 15 {
+			job.JobSetId = "limited"
+			job.JobSetMaxRunning = 3
+		} else {
+			job.JobSetId = "unlimited"
+		}
 	}
 
-	// the leasing logic stops scheduling 1s before the deadline
-	ctx, _ := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
+	jobs, e := c.assignJobs(1000)
+	assert.Nil(t, e)
+	assert.Equal(t, 10, len(jobs))
+	assert.Equal(t, 3, countJobSetJobs(jobs, "limited"))
+	assert.Equal(t, 7, countJobSetJobs(jobs, "unlimited"))
+}
+
+func Test_assignJobs_SkipsJobsNotAllowedOnCluster_WhenTheyAreFirstInQueue(t *testing.T) {
+	c := createAssignJobsContext(1, 30, 10, 1000, 0)
+	c.clusterPreference = NewClusterPreference("c1", "pool1", map[string]*api.ClusterUsageReport{}, map[string]*api.ClusterSchedulingInfoReport{})
+	jobQueue := c.queue.(*fakeJobQueue)
+	for i, job := range jobQueue.jobsByQueue["queue0"] {
+		if i < 15 {
+			job.AllowedPools = []string{"pool2"}
+		} else {
+			job.AllowedPools = []string{"pool2", "pool1"}
+		}
+	}
+
+	jobs, e := c.assignJobs(1000)
+	assert.Nil(t, e)
+	assert.Equal(t, 10, len(jobs))
+	for _, job := range jobs {
+		assert.Contains(t, job.AllowedPools, "pool1")
+	}
+}
+
+// Creates a lease context with queues of 1 cpu jobs, each queue has a share of 10 cpu
+func createAssignJobsContext(queueCount int, jobsPerQueue int, batchSize uint, nodeCpu int, parallelism int) *leaseContext {
+	share := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}.AsFloat()
+	jobQueue := &fakeJobQueue{jobsByQueue: map[string][]*api.Job{}}
+	schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{}
+	for i := 0; i < queueCount; i++ {
+		queue := &api.Queue{Name: fmt.Sprintf("queue%d", i), PriorityFactor: 1}
+		schedulingInfo[queue] = NewQueueSchedulingInfo(share.DeepCopy(), share.DeepCopy(), share.DeepCopy())
+		for j := 0; j < jobsPerQueue; j++ {
+			jobQueue.jobsByQueue[queue.Name] = append(jobQueue.jobsByQueue[queue.Name],
+				&api.Job{Id: fmt.Sprintf("%s-%d", queue.Name, j), Queue: queue.Name, PodSpec: classicPodSpec})
+		}
+	}
 
-	nodeResources := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+	nodeResources := common.ComputeResources{"cpu": resource.MustParse(fmt.Sprint(nodeCpu)), "memory": resource.MustParse("100Gi")}
 	nodes := []api.NodeInfo{{Name: "testNode", AllocatableResources: nodeResources, AvailableResources: nodeResources}}
 
-	c := leaseContext{
-		ctx: ctx,
+	return &leaseContext{
+		ctx: context.Background(),
 		schedulingConfig: &configuration.SchedulingConfig{
-			QueueLeaseBatchSize: 10,
+			QueueLeaseBatchSize:   batchSize,
+			QueueLeaseParallelism: parallelism,
 		},
-		onJobsLeased: func(a []*api.Job) {},
-		clusterId:    "c1",
-
-		nodeResources: AggregateNodeTypeAllocations(nodes),
-
-		resourceScarcity:    scarcity,
-		priorities:          priorities,
-		queueSchedulingInfo: SliceResourceWithLimits(scarcity, schedulingInfo, priorities, requestSize.AsFloat()),
-		queue:               repository,
+		onJobsLeased:        func(a []*api.Job) {},
+		clusterId:           "c1",
+		nodeResources:       AggregateNodeTypeAllocations(nodes),
+		queueSchedulingInfo: schedulingInfo,
+		queue:               jobQueue,
 		queueCache:          map[string][]*api.Job{},
+		runningLimits:       map[string]*runningJobLimits{},
 	}
+}
 
-	jobs, e := c.distributeRemainder(1000)
-	assert.Nil(t, e)
-	assert.Equal(t, 2, len(jobs))
+func countQueueJobs(jobs []*api.Job, queue string) int {
+	count := 0
+	for _, job := range jobs {
+		if job.Queue == queue {
+			count++
+		}
+	}
+	return count
+}
+
+func countJobSetJobs(jobs []*api.Job, jobSetId string) int {
+	count := 0
+	for _, job := range jobs {
+		if job.JobSetId == jobSetId {
+			count++
+		}
+	}
+	return count
 }
 
 func Test_calculateQueueSchedulingLimits(t *testing.T) {
@@ -220,10 +396,22 @@ var classicPodSpec = &v1.PodSpec{
 		}}}}
 
 type fakeJobQueue struct {
-	jobsByQueue map[string][]*api.Job
+	jobsByQueue   map[string][]*api.Job
+	leasedByQueue map[string][]*api.Job
+	peekLimits    map[string][]int64
+	// Simulated redis round trip of each call
+	latency time.Duration
+	mutex   sync.Mutex
 }
 
 func (r *fakeJobQueue) PeekClusterQueue(clusterId, queue string, limit int64) ([]*api.Job, error) {
+	time.Sleep(r.latency)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.peekLimits == nil {
+		r.peekLimits = map[string][]int64{}
+	}
+	r.peekLimits[queue] = append(r.peekLimits[queue], limit)
 	jobs, exists := r.jobsByQueue[queue]
 	if !exists {
 		return []*api.Job{}, nil
@@ -235,6 +423,9 @@ func (r *fakeJobQueue) PeekClusterQueue(clusterId, queue string, limit int64) ([
 }
 
 func (r *fakeJobQueue) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
+	time.Sleep(r.latency)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 	remainingJobs := []*api.Job{}
 outer:
 	for _, j := range r.jobsByQueue[queue] {
@@ -246,5 +437,24 @@ outer:
 		remainingJobs = append(remainingJobs, j)
 	}
 	r.jobsByQueue[queue] = remainingJobs
+	if r.leasedByQueue == nil {
+		r.leasedByQueue = map[string][]*api.Job{}
+	}
+	r.leasedByQueue[queue] = append(r.leasedByQueue[queue], jobs...)
 	return jobs, nil
 }
+
+func (r *fakeJobQueue) GetLeasedJobCounts(queue string, jobSetIds []string) (int, map[string]int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	jobSetCounts := map[string]int{}
+	for _, jobSetId := range jobSetIds {
+		jobSetCounts[jobSetId] = 0
+	}
+	for _, job := range r.leasedByQueue[queue] {
+		if _, ok := jobSetCounts[job.JobSetId]; ok {
+			jobSetCounts[job.JobSetId]++
+		}
+	}
+	return len(r.leasedByQueue[queue]), jobSetCounts, nil
+}
@@ -1,6 +1,7 @@
 package scheduling
 
 import (
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -25,7 +26,9 @@ func CreateClusterSchedulingInfoReport(leaseRequest *api.LeaseRequest, nodeAlloc
 func extractNodeTypes(allocations []*nodeTypeAllocation) []*api.NodeType {
 	result := []*api.NodeType{}
 	for _, n := range allocations {
-		result = append(result, &n.nodeType)
+		nodeType := n.nodeType
+		nodeType.NodeCount = int32(n.nodeCount)
+		result = append(result, &nodeType)
 	}
 	return result
 }
@@ -34,15 +37,35 @@ func MatchSchedulingRequirements(job *api.Job, schedulingInfo *api.ClusterSchedu
 	if !isLargeEnough(job, schedulingInfo.MinimumJobSize) {
 		return false
 	}
+	nodeAllocations := reportedNodeTypeAllocations(schedulingInfo.NodeTypes)
+	placement := newJobPodPlacement(job)
 	for _, podSpec := range job.GetAllPodSpecs() {
-		// TODO: make sure there are enough nodes available for all the job pods
-		if !matchAnyNodeType(podSpec, schedulingInfo.NodeTypes) {
+		// Pods only have to fit on an empty node, resources in use are checked when leasing
+		_, ok := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, nodeTypeUsedResources{}, nodeTypeUsedResources{}, placement)
+		if !ok {
 			return false
 		}
 	}
 	return true
 }
 
+// Node types reported before node counts were tracked are assumed to have enough nodes for any job
+func reportedNodeTypeAllocations(nodeTypes []*api.NodeType) []*nodeTypeAllocation {
+	result := []*nodeTypeAllocation{}
+	for _, nodeType := range nodeTypes {
+		nodeCount := int(nodeType.NodeCount)
+		if nodeCount == 0 {
+			nodeCount = math.MaxInt32
+		}
+		result = append(result, &nodeTypeAllocation{
+			nodeType:           *nodeType,
+			availableResources: common.ComputeResources(nodeType.AllocatableResources).AsFloat(),
+			nodeCount:          nodeCount,
+		})
+	}
+	return result
+}
+
 func MatchSchedulingRequirementsOnAnyCluster(job *api.Job, allClusterSchedulingInfos map[string]*api.ClusterSchedulingInfoReport) bool {
 	for _, schedulingInfo := range allClusterSchedulingInfos {
 		if MatchSchedulingRequirements(job, schedulingInfo) {
@@ -58,29 +81,16 @@ func isLargeEnough(job *api.Job, minimumJobSize common.ComputeResources) bool {
 	return resourceRequest.IsValid()
 }
 
-func matchAnyNodeType(podSpec *v1.PodSpec, nodeTypes []*api.NodeType) bool {
-
-	podMatchingContext := NewPodMatchingContext(podSpec)
-
-	for _, nodeType := range nodeTypes {
-		nodeResources := common.ComputeResources(nodeType.AllocatableResources).AsFloat()
-
-		if podMatchingContext.Matches(nodeType, nodeResources) {
-			return true
-		}
-	}
-	return false
-}
-
 func matchAnyNodeTypeAllocation(job *api.Job,
 	nodeAllocations []*nodeTypeAllocation,
 	alreadyConsumed nodeTypeUsedResources) (nodeTypeUsedResources, bool) {
 
 	newlyConsumed := nodeTypeUsedResources{}
+	placement := newJobPodPlacement(job)
 
 	for _, podSpec := range job.GetAllPodSpecs() {
 
-		nodeType, ok := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+		nodeType, ok := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed, placement)
 
 		if !ok {
 			return nodeTypeUsedResources{}, false
@@ -92,25 +102,39 @@ func matchAnyNodeTypeAllocation(job *api.Job,
 	return newlyConsumed, true
 }
 
+// Returns the matching node type with the highest preference score, node types earlier in the list win ties
 func matchAnyNodeTypePodAllocation(
 	podSpec *v1.PodSpec,
 	nodeAllocations []*nodeTypeAllocation,
 	alreadyConsumed nodeTypeUsedResources,
-	newlyConsumed nodeTypeUsedResources) (*nodeTypeAllocation, bool) {
+	newlyConsumed nodeTypeUsedResources,
+	placement *jobPodPlacement) (*nodeTypeAllocation, bool) {
 
 	podMatchingContext := NewPodMatchingContext(podSpec)
+	pod := placement.newPod(podSpec)
 
+	var bestNode *nodeTypeAllocation
+	bestScore := int64(0)
 	for _, node := range nodeAllocations {
 		available := node.availableResources.DeepCopy()
 		available.Sub(alreadyConsumed[node])
 		available.Sub(newlyConsumed[node])
 		available.LimitWith(common.ComputeResources(node.nodeType.AllocatableResources).AsFloat())
 
-		if podMatchingContext.Matches(&node.nodeType, available) {
-			return node, true
+		if !podMatchingContext.Matches(&node.nodeType, available) || !placement.allows(pod, node) {
+			continue
 		}
+		score := podMatchingContext.Score(&node.nodeType) + placement.score(pod, node)
+		if bestNode == nil || score > bestScore {
+			bestNode = node
+			bestScore = score
+		}
+	}
+	if bestNode == nil {
+		return nil, false
 	}
-	return nil, false
+	placement.place(pod, bestNode)
+	return bestNode, true
 }
 
 func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
@@ -135,6 +159,7 @@ func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
 		} else {
 			typeDescription.availableResources.Add(nodeAvailableResources)
 		}
+		typeDescription.nodeCount++
 	}
 
 	result := []*nodeTypeAllocation{}
@@ -80,6 +80,7 @@ func Test_AggregateNodeTypesAllocations(t *testing.T) {
 				AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("3Gi")},
 			},
 			availableResources: common.ComputeResourcesFloat{"cpu": 4, "memory": 4 * 1024 * 1024 * 1024},
+			nodeCount:          2,
 		},
 		{
 			nodeType: api.NodeType{
@@ -88,6 +89,7 @@ func Test_AggregateNodeTypesAllocations(t *testing.T) {
 				AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("5"), "memory": resource.MustParse("5Gi")},
 			},
 			availableResources: common.ComputeResourcesFloat{"cpu": 6, "memory": 6 * 1024 * 1024 * 1024},
+			nodeCount:          1,
 		},
 	}, aggregated)
 }
@@ -117,6 +119,7 @@ func Test_AggregateNodeTypesAllocations_NodesWithMoreTaintsGoFirst(t *testing.T)
 				AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("5"), "memory": resource.MustParse("5Gi")},
 			},
 			availableResources: common.ComputeResourcesFloat{"cpu": 6, "memory": 6 * 1024 * 1024 * 1024},
+			nodeCount:          1,
 		},
 		{
 			nodeType: api.NodeType{
@@ -125,6 +128,7 @@ func Test_AggregateNodeTypesAllocations_NodesWithMoreTaintsGoFirst(t *testing.T)
 				AllocatableResources: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("3Gi")},
 			},
 			availableResources: common.ComputeResourcesFloat{"cpu": 2, "memory": 1 * 1024 * 1024 * 1024},
+			nodeCount:          1,
 		},
 	}, aggregated)
 }
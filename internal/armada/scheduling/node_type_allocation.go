@@ -8,6 +8,7 @@ import (
 type nodeTypeAllocation struct {
 	nodeType           api.NodeType
 	availableResources common.ComputeResourcesFloat
+	nodeCount          int
 }
 
 type nodeTypeUsedResources map[*nodeTypeAllocation]common.ComputeResourcesFloat
@@ -0,0 +1,142 @@
+package scheduling
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// jobPodPlacement keeps the node types pods of a job were matched to, so pod affinity and anti-affinity between pods
+// of the same job can be evaluated while matching the rest of them. Pods of other jobs are not considered, kubernetes
+// still enforces those terms once the pods are created.
+//
+// Node types only carry the node labels tracked by executors. When a topology key is not one of them, pods on the same
+// node type are assumed to be in the same topology for preferred terms, and on different nodes for required
+// anti-affinity, which is exact for the hostname topology.
+type jobPodPlacement struct {
+	job    *api.Job
+	placed []*jobPod
+}
+
+type jobPod struct {
+	requiredAntiAffinity  []v1.PodAffinityTerm
+	preferredAffinity     []v1.WeightedPodAffinityTerm
+	preferredAntiAffinity []v1.WeightedPodAffinityTerm
+	nodeType              *nodeTypeAllocation
+}
+
+func newJobPodPlacement(job *api.Job) *jobPodPlacement {
+	return &jobPodPlacement{job: job}
+}
+
+// newPod collects the pod affinity terms of the pod selecting pods of the job
+func (p *jobPodPlacement) newPod(podSpec *v1.PodSpec) *jobPod {
+	pod := &jobPod{}
+	if p == nil || podSpec.Affinity == nil {
+		return pod
+	}
+
+	if podAffinity := podSpec.Affinity.PodAffinity; podAffinity != nil {
+		pod.preferredAffinity = p.selectingJobPodsWeighted(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	if podAntiAffinity := podSpec.Affinity.PodAntiAffinity; podAntiAffinity != nil {
+		for _, term := range podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			if p.selectsJobPods(&term) {
+				pod.requiredAntiAffinity = append(pod.requiredAntiAffinity, term)
+			}
+		}
+		pod.preferredAntiAffinity = p.selectingJobPodsWeighted(podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	return pod
+}
+
+func (p *jobPodPlacement) place(pod *jobPod, nodeType *nodeTypeAllocation) {
+	if p == nil {
+		return
+	}
+	pod.nodeType = nodeType
+	p.placed = append(p.placed, pod)
+}
+
+// allows checks required anti-affinity of the pod and of pods already placed, which kubernetes enforces both ways
+func (p *jobPodPlacement) allows(pod *jobPod, nodeType *nodeTypeAllocation) bool {
+	if p == nil {
+		return true
+	}
+
+	sharingNodeType := 0
+	for _, placed := range p.placed {
+		terms := append(append([]v1.PodAffinityTerm{}, pod.requiredAntiAffinity...), placed.requiredAntiAffinity...)
+		for _, term := range terms {
+			value, labelled := nodeType.nodeType.Labels[term.TopologyKey]
+			placedValue, placedLabelled := placed.nodeType.nodeType.Labels[term.TopologyKey]
+			if labelled && placedLabelled {
+				if value == placedValue {
+					return false
+				}
+			} else if placed.nodeType == nodeType {
+				sharingNodeType++
+				break
+			}
+		}
+	}
+	return sharingNodeType == 0 || sharingNodeType < nodeType.nodeCount
+}
+
+// score adds the weights of preferred pod affinity terms satisfied by pods already placed, and subtracts the weights
+// of preferred pod anti-affinity terms they violate
+func (p *jobPodPlacement) score(pod *jobPod, nodeType *nodeTypeAllocation) int64 {
+	if p == nil {
+		return 0
+	}
+
+	score := int64(0)
+	for _, placed := range p.placed {
+		for _, term := range pod.preferredAffinity {
+			if sameTopology(nodeType, placed.nodeType, term.PodAffinityTerm.TopologyKey) {
+				score += int64(term.Weight)
+			}
+		}
+		for _, term := range pod.preferredAntiAffinity {
+			if sameTopology(nodeType, placed.nodeType, term.PodAffinityTerm.TopologyKey) {
+				score -= int64(term.Weight)
+			}
+		}
+	}
+	return score
+}
+
+func (p *jobPodPlacement) selectingJobPodsWeighted(terms []v1.WeightedPodAffinityTerm) []v1.WeightedPodAffinityTerm {
+	result := []v1.WeightedPodAffinityTerm{}
+	for _, term := range terms {
+		if p.selectsJobPods(&term.PodAffinityTerm) {
+			result = append(result, term)
+		}
+	}
+	return result
+}
+
+// Pods of a job are labelled with the job labels and created in the job namespace, namespace selectors are assumed to
+// select it as namespace labels are not known to the server
+func (p *jobPodPlacement) selectsJobPods(term *v1.PodAffinityTerm) bool {
+	if len(term.Namespaces) > 0 && term.NamespaceSelector == nil && !util.ContainsString(term.Namespaces, p.job.Namespace) {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(p.job.Labels))
+}
+
+func sameTopology(a, b *nodeTypeAllocation, topologyKey string) bool {
+	aValue, aLabelled := a.nodeType.Labels[topologyKey]
+	bValue, bLabelled := b.nodeType.Labels[topologyKey]
+	if aLabelled && bLabelled {
+		return aValue == bValue
+	}
+	return a == b
+}
@@ -0,0 +1,122 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_matchAnyNodeTypeAllocation_WhenPodsOfJobAreAntiAffineOnHostname_RequiresANodePerPod(t *testing.T) {
+	job := jobWithPodAntiAffinity(3, "kubernetes.io/hostname")
+
+	nodeAllocation := defaultNodeTypeAllocation()
+	nodeAllocation.nodeCount = 2
+	_, ok := matchAnyNodeTypeAllocation(job, []*nodeTypeAllocation{nodeAllocation}, nodeTypeUsedResources{})
+	assert.False(t, ok)
+
+	nodeAllocation.nodeCount = 3
+	consumed, ok := matchAnyNodeTypeAllocation(job, []*nodeTypeAllocation{nodeAllocation}, nodeTypeUsedResources{})
+	assert.True(t, ok)
+	assert.Equal(t, float64(3), consumed[nodeAllocation]["cpu"])
+}
+
+func Test_matchAnyNodeTypeAllocation_WhenPodsOfJobAreAntiAffineOnTrackedLabel_SpreadsThemOverLabelValues(t *testing.T) {
+	job := jobWithPodAntiAffinity(2, "zone")
+
+	nodeAllocations := []*nodeTypeAllocation{defaultNodeTypeAllocation(), defaultNodeTypeAllocation(), defaultNodeTypeAllocation()}
+	nodeAllocations[0].nodeType.Labels = map[string]string{"zone": "a"}
+	nodeAllocations[1].nodeType.Labels = map[string]string{"zone": "a", "disk": "ssd"}
+	nodeAllocations[2].nodeType.Labels = map[string]string{"zone": "b"}
+
+	consumed, ok := matchAnyNodeTypeAllocation(job, nodeAllocations, nodeTypeUsedResources{})
+	assert.True(t, ok)
+	assert.Equal(t, float64(1), consumed[nodeAllocations[0]]["cpu"])
+	assert.Equal(t, float64(1), consumed[nodeAllocations[2]]["cpu"])
+
+	_, ok = matchAnyNodeTypeAllocation(job, nodeAllocations[:2], nodeTypeUsedResources{})
+	assert.False(t, ok)
+}
+
+func Test_matchAnyNodeTypeAllocation_WhenAntiAffinityDoesNotSelectJobPods_IgnoresIt(t *testing.T) {
+	job := jobWithPodAntiAffinity(3, "kubernetes.io/hostname")
+	job.Labels = map[string]string{"app": "other"}
+
+	nodeAllocation := defaultNodeTypeAllocation()
+	nodeAllocation.nodeCount = 1
+	_, ok := matchAnyNodeTypeAllocation(job, []*nodeTypeAllocation{nodeAllocation}, nodeTypeUsedResources{})
+	assert.True(t, ok)
+}
+
+func Test_matchAnyNodeTypeAllocation_WhenPreferredAntiAffinitySet_PrefersSpreadingPods(t *testing.T) {
+	podSpec := podWithResources(1)
+	podSpec.Affinity = &v1.Affinity{PodAntiAffinity: &v1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []v1.WeightedPodAffinityTerm{{
+			Weight: 10,
+			PodAffinityTerm: v1.PodAffinityTerm{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "spread"}},
+				TopologyKey:   "zone",
+			},
+		}},
+	}}
+	job := &api.Job{Labels: map[string]string{"app": "spread"}, PodSpecs: []*v1.PodSpec{podSpec, podSpec}}
+
+	nodeAllocations := []*nodeTypeAllocation{defaultNodeTypeAllocation(), defaultNodeTypeAllocation()}
+	nodeAllocations[0].nodeType.Labels = map[string]string{"zone": "a"}
+	nodeAllocations[1].nodeType.Labels = map[string]string{"zone": "b"}
+
+	consumed, ok := matchAnyNodeTypeAllocation(job, nodeAllocations, nodeTypeUsedResources{})
+	assert.True(t, ok)
+	assert.Equal(t, float64(1), consumed[nodeAllocations[0]]["cpu"])
+	assert.Equal(t, float64(1), consumed[nodeAllocations[1]]["cpu"])
+}
+
+func Test_MatchSchedulingRequirements_WhenPodsOfJobAreAntiAffineOnHostname_RequiresANodePerPod(t *testing.T) {
+	job := jobWithPodAntiAffinity(3, "kubernetes.io/hostname")
+
+	nodeType := defaultNodeTypeAllocation().nodeType
+	nodeType.NodeCount = 2
+	assert.False(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{NodeTypes: []*api.NodeType{&nodeType}}))
+
+	nodeType.NodeCount = 3
+	assert.True(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{NodeTypes: []*api.NodeType{&nodeType}}))
+}
+
+func Test_MatchSchedulingRequirements_WhenNodeCountIsNotReported_AssumesEnoughNodes(t *testing.T) {
+	job := jobWithPodAntiAffinity(3, "kubernetes.io/hostname")
+
+	nodeType := defaultNodeTypeAllocation().nodeType
+	assert.True(t, MatchSchedulingRequirements(job, &api.ClusterSchedulingInfoReport{NodeTypes: []*api.NodeType{&nodeType}}))
+}
+
+func Test_CreateClusterSchedulingInfoReport_ReportsNodeCounts(t *testing.T) {
+	nodeAllocation := defaultNodeTypeAllocation()
+	nodeAllocation.nodeCount = 2
+
+	report := CreateClusterSchedulingInfoReport(&api.LeaseRequest{ClusterId: "cluster"}, []*nodeTypeAllocation{nodeAllocation})
+	assert.Equal(t, int32(2), report.NodeTypes[0].NodeCount)
+}
+
+func jobWithPodAntiAffinity(pods int, topologyKey string) *api.Job {
+	podSpecs := []*v1.PodSpec{}
+	for i := 0; i < pods; i++ {
+		podSpec := podWithResources(1)
+		podSpec.Affinity = &v1.Affinity{PodAntiAffinity: &v1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "spread"}},
+				TopologyKey:   topologyKey,
+			}},
+		}}
+		podSpecs = append(podSpecs, podSpec)
+	}
+	return &api.Job{Labels: map[string]string{"app": "spread"}, PodSpecs: podSpecs}
+}
+
+func podWithResources(cpu int64) *v1.PodSpec {
+	resources := v1.ResourceList{"cpu": *resource.NewQuantity(cpu, resource.DecimalSI), "memory": resource.MustParse("1Gi")}
+	return &v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Requests: resources, Limits: resources}}}}
+}
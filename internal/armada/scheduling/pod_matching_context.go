@@ -11,19 +11,38 @@ import (
 type PodMatchingContext struct {
 	podSpec                      *v1.PodSpec
 	totalPodResourceRequest      common.ComputeResourcesFloat
+	totalPodResourceLimit        common.ComputeResourcesFloat
 	requiredNodeAffinitySelector *nodeaffinity.LazyErrorNodeSelector
+	preferredNodeAffinityTerms   *nodeaffinity.PreferredSchedulingTerms
 }
 
 func NewPodMatchingContext(podSpec *v1.PodSpec) *PodMatchingContext {
 	return &PodMatchingContext{
 		podSpec:                      podSpec,
 		totalPodResourceRequest:      common.TotalPodResourceRequest(podSpec).AsFloat(),
+		totalPodResourceLimit:        common.TotalPodResourceLimit(podSpec).AsFloat(),
 		requiredNodeAffinitySelector: makeRequiredNodeAffinitySelector(podSpec),
+		preferredNodeAffinityTerms:   makePreferredNodeAffinityTerms(podSpec),
 	}
 }
 
+// Matches checks the pod requests fit the available resources, and its limits fit a single node of the node type
 func (podCtx *PodMatchingContext) Matches(nodeType *api.NodeType, availableResources common.ComputeResourcesFloat) bool {
-	return fits(podCtx.totalPodResourceRequest, availableResources) && matchNodeSelector(podCtx.podSpec, nodeType.Labels) && tolerates(podCtx.podSpec, nodeType.Taints) && matchesRequiredNodeAffinity(podCtx.requiredNodeAffinitySelector, nodeType)
+	return fits(podCtx.totalPodResourceRequest, availableResources) &&
+		fits(podCtx.totalPodResourceLimit, common.ComputeResources(nodeType.AllocatableResources).AsFloat()) &&
+		matchNodeSelector(podCtx.podSpec, nodeType.Labels) && tolerates(podCtx.podSpec, nodeType.Taints) && matchesRequiredNodeAffinity(podCtx.requiredNodeAffinitySelector, nodeType)
+}
+
+// Score ranks the node types a pod matches, it is the sum of weights of the preferred node affinity terms the node type satisfies
+func (podCtx *PodMatchingContext) Score(nodeType *api.NodeType) int64 {
+	if podCtx.preferredNodeAffinityTerms == nil {
+		return 0
+	}
+
+	node := &v1.Node{}
+	node.Labels = nodeType.Labels
+
+	return podCtx.preferredNodeAffinityTerms.Score(node)
 }
 
 func fits(resourceRequest, availableResources common.ComputeResourcesFloat) bool {
@@ -101,3 +120,22 @@ func makeRequiredNodeAffinitySelector(podSpec *v1.PodSpec) *nodeaffinity.LazyErr
 
 	return nodeaffinity.NewLazyErrorNodeSelector(requiredNodeAffinity)
 }
+
+func makePreferredNodeAffinityTerms(podSpec *v1.PodSpec) *nodeaffinity.PreferredSchedulingTerms {
+	affinity := podSpec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil {
+		return nil
+	}
+
+	preferredNodeAffinity := affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	if len(preferredNodeAffinity) == 0 {
+		return nil
+	}
+
+	// Terms are validated on submission
+	terms, err := nodeaffinity.NewPreferredSchedulingTerms(preferredNodeAffinity)
+	if err != nil {
+		return nil
+	}
+	return terms
+}
@@ -20,6 +20,19 @@ func Test_Matches_BasicPod_ReturnsTrue(t *testing.T) {
 	assert.True(t, ctx.Matches(nodeType, available))
 }
 
+func Test_Matches_WhenLimitsExceedNodeAllocatable_ReturnsFalse(t *testing.T) {
+	podSpec := &v1.PodSpec{Containers: []v1.Container{{
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+			Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("16Gi")},
+		}}}}
+	ctx := NewPodMatchingContext(podSpec)
+
+	available := makeResourceList(1, 10).AsFloat()
+	assert.False(t, ctx.Matches(&api.NodeType{AllocatableResources: makeResourceList(4, 10)}, available))
+	assert.True(t, ctx.Matches(&api.NodeType{AllocatableResources: makeResourceList(4, 16)}, available))
+}
+
 func Test_fits(t *testing.T) {
 	available := makeResourceList(1, 10).AsFloat()
 
@@ -114,7 +127,7 @@ func Test_matchAnyNodeTypePodAllocation_WhenFindsMatch_ReturnsMatch(t *testing.T
 	alreadyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{"cpu": 3, "memory": 1 * 1024 * 1024 * 1024}}
 	newlyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{"cpu": 3, "memory": 1 * 1024 * 1024 * 1024}}
 
-	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed, nil)
 	assert.Equal(t, nodeAllocations[0], resultNode)
 	assert.True(t, resultFlag)
 }
@@ -125,7 +138,7 @@ func Test_matchAnyNodeTypePodAllocation_WhenAllAvailableCpuConsumed_ReturnsFalse
 	alreadyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{"cpu": 4, "memory": 1 * 1024 * 1024 * 1024}}
 	newlyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{"cpu": 4, "memory": 1 * 1024 * 1024 * 1024}}
 
-	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed, nil)
 	assert.Nil(t, resultNode)
 	assert.False(t, resultFlag)
 }
@@ -136,7 +149,7 @@ func Test_matchAnyNodeTypePodAllocation_WhenNodeSelectorMatchesNoNodes_ReturnsFa
 	alreadyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{}}
 	newlyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{}}
 
-	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed, nil)
 	assert.Nil(t, resultNode)
 	assert.False(t, resultFlag)
 }
@@ -150,7 +163,7 @@ func Test_matchAnyNodeTypePodAllocation_WhenNodeSelectorRulesOutFirstNode_Return
 	alreadyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{}}
 	newlyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{}}
 
-	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed, nil)
 	assert.Equal(t, nodeAllocations[1], resultNode)
 	assert.True(t, resultFlag)
 }
@@ -164,7 +177,7 @@ func Test_matchAnyNodeTypePodAllocation_WhenTaintRulesOutAllNodes_ReturnsFalse(t
 	alreadyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{}}
 	newlyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{}}
 
-	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed, nil)
 	assert.Nil(t, resultNode)
 	assert.False(t, resultFlag)
 }
@@ -178,9 +191,32 @@ func Test_matchAnyNodeTypePodAllocation_WhenTaintRulesOutFirstNode_ReturnsSecond
 	alreadyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{}}
 	newlyConsumed := nodeTypeUsedResources{nodeAllocations[0]: common.ComputeResourcesFloat{}}
 
-	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed, nil)
+	assert.Equal(t, nodeAllocations[1], resultNode)
+	assert.True(t, resultFlag)
+}
+
+func Test_matchAnyNodeTypePodAllocation_WhenPreferredNodeAffinitySet_ReturnsPreferredNode(t *testing.T) {
+	podSpec := podWithResources(1)
+	podSpec.Affinity = &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{{
+			Weight:     10,
+			Preference: v1.NodeSelectorTerm{MatchExpressions: []v1.NodeSelectorRequirement{{Key: "disk", Operator: v1.NodeSelectorOpIn, Values: []string{"ssd"}}}},
+		}},
+	}}
+
+	nodeAllocations := []*nodeTypeAllocation{defaultNodeTypeAllocation(), defaultNodeTypeAllocation()}
+	nodeAllocations[0].nodeType.Labels = map[string]string{"disk": "hdd"}
+	nodeAllocations[1].nodeType.Labels = map[string]string{"disk": "ssd"}
+
+	resultNode, resultFlag := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, nodeTypeUsedResources{}, nodeTypeUsedResources{}, nil)
 	assert.Equal(t, nodeAllocations[1], resultNode)
 	assert.True(t, resultFlag)
+
+	nodeAllocations[1].availableResources = common.ComputeResourcesFloat{"cpu": 0, "memory": 0}
+	resultNode, resultFlag = matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, nodeTypeUsedResources{}, nodeTypeUsedResources{}, nil)
+	assert.Equal(t, nodeAllocations[0], resultNode)
+	assert.True(t, resultFlag)
 }
 
 func Test_matchesRequiredNodeAffinity_WhenNoAffinitySet_ReturnsTrue(t *testing.T) {
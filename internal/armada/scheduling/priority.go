@@ -11,20 +11,23 @@ import (
 
 const minPriority = 0.5
 
+// Used by dominant resource fairness when the pool has no cpu
+const minDominantShare = 0.0001
+
 type QueuePriorityInfo struct {
 	Priority     float64
 	CurrentUsage common.ComputeResources
 }
 
-func CalculateQueuesPriorityInfo(clusterPriorities map[string]map[string]float64, activeClusterReports map[string]*api.ClusterUsageReport, queues []*api.Queue) map[*api.Queue]QueuePriorityInfo {
+func CalculateQueuesPriorityInfo(fairness Fairness, clusterPriorities map[string]map[string]float64, activeClusterReports map[string]*api.ClusterUsageReport, queues []*api.Queue) map[*api.Queue]QueuePriorityInfo {
 	queuePriority := aggregatePriority(clusterPriorities)
 	queueUsage := aggregateQueueUsage(activeClusterReports)
 	resultPriorityMap := map[*api.Queue]QueuePriorityInfo{}
 	for _, queue := range queues {
-		priority := minPriority
+		priority := fairness.MinimumPriority()
 		currentPriority, ok := queuePriority[queue.Name]
 		if ok {
-			priority = math.Max(currentPriority, minPriority) * queue.PriorityFactor
+			priority = math.Max(currentPriority, fairness.MinimumPriority()) * queue.PriorityFactor
 		}
 		resultPriorityMap[queue] = QueuePriorityInfo{
 			Priority:     priority,
@@ -34,12 +37,12 @@ func CalculateQueuesPriorityInfo(clusterPriorities map[string]map[string]float64
 	return resultPriorityMap
 }
 
-func CalculatePriorityUpdate(resourceScarcity map[string]float64, previousReport *api.ClusterUsageReport, report *api.ClusterUsageReport, previousPriority map[string]float64, halfTime time.Duration) map[string]float64 {
+func CalculatePriorityUpdate(fairness Fairness, previousReport *api.ClusterUsageReport, report *api.ClusterUsageReport, previousPriority map[string]float64, halfTime time.Duration) map[string]float64 {
 	timeChange := time.Minute
 	if previousReport != nil {
 		timeChange = report.ReportTime.Sub(previousReport.ReportTime)
 	}
-	usage := usageFromQueueReports(resourceScarcity, util.GetQueueReports(report))
+	usage := usageFromQueueReports(fairness, util.GetQueueReports(report))
 	newPriority := calculatePriorityUpdate(usage, previousPriority, timeChange, halfTime)
 	return newPriority
 }
@@ -0,0 +1,74 @@
+package scheduling
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// PriorityAger periodically rescores queued jobs with their effective priority, so jobs waiting long
+// enough are leased before jobs with a better submit priority
+type PriorityAger struct {
+	jobRepository   repository.JobRepository
+	queueRepository repository.QueueRepository
+
+	// Queues aged in the previous run, nil before the first run
+	aged map[string]bool
+}
+
+func NewPriorityAger(jobRepository repository.JobRepository, queueRepository repository.QueueRepository) *PriorityAger {
+	return &PriorityAger{
+		jobRepository:   jobRepository,
+		queueRepository: queueRepository,
+	}
+}
+
+// Queues without aging are skipped, except on the first run and the run after their aging was disabled,
+// which restores their submit priority
+func (a *PriorityAger) RescoreQueuedJobs() {
+	queues, e := a.queueRepository.GetAllQueues()
+	if e != nil {
+		log.Error(e)
+		return
+	}
+
+	now := time.Now()
+	aged := map[string]bool{}
+	for _, queue := range queues {
+		if queue.PriorityAgingRate != 0 {
+			aged[queue.Name] = true
+		} else if a.aged != nil && !a.aged[queue.Name] {
+			continue
+		}
+		e := a.rescoreQueue(queue, now)
+		if e != nil {
+			log.Errorf("Error while aging jobs in queue %s: %s", queue.Name, e)
+			// Retried in the next run
+			aged[queue.Name] = true
+		}
+	}
+	a.aged = aged
+}
+
+func (a *PriorityAger) rescoreQueue(queue *api.Queue, now time.Time) error {
+	jobIds, e := a.jobRepository.GetQueueJobIds(queue.Name)
+	if e != nil {
+		return e
+	}
+	queuedTimes, e := a.jobRepository.GetQueuedTimes(jobIds)
+	if e != nil {
+		return e
+	}
+
+	// Priorities are computed from the stored jobs, so a concurrent reprioritization is not overwritten
+	return a.jobRepository.UpdateQueuedPriorities(queue.Name, jobIds, func(job *api.Job) float64 {
+		queued, ok := queuedTimes[job.Id]
+		if !ok {
+			queued = job.SubmitQueuedTime()
+		}
+		return queue.EffectivePriority(job, queued, now)
+	})
+}
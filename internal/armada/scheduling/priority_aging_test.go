@@ -0,0 +1,107 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestPriorityAger_RescoreQueuedJobs_OlderJobOvertakesBetterPriority(t *testing.T) {
+	now := time.Now()
+	oldJob := &api.Job{Id: "old", Queue: "queue1", Priority: 5, Created: now.Add(-5 * time.Hour)}
+	newJob := &api.Job{Id: "new", Queue: "queue1", Priority: 1, Created: now}
+	jobRepository := &fakeAgingJobRepository{jobs: map[string][]*api.Job{"queue1": {newJob, oldJob}}}
+	queueRepository := &fakeAgingQueueRepository{queues: []*api.Queue{{Name: "queue1", PriorityAgingRate: 1, PriorityAgingCap: 10}}}
+
+	NewPriorityAger(jobRepository, queueRepository).RescoreQueuedJobs()
+
+	priorities := jobRepository.priorities["queue1"]
+	assert.InDelta(t, 0.0, priorities[oldJob.Id], 0.01)
+	assert.InDelta(t, 1.0, priorities[newJob.Id], 0.01)
+}
+
+func TestPriorityAger_RescoreQueuedJobs_AgesFromLastQueuedTime(t *testing.T) {
+	now := time.Now()
+	job := &api.Job{Id: "returned", Queue: "queue1", Priority: 5, Created: now.Add(-5 * time.Hour)}
+	jobRepository := &fakeAgingJobRepository{
+		jobs:        map[string][]*api.Job{"queue1": {job}},
+		queuedTimes: map[string]time.Time{job.Id: now.Add(-time.Hour)},
+	}
+	queueRepository := &fakeAgingQueueRepository{queues: []*api.Queue{{Name: "queue1", PriorityAgingRate: 1, PriorityAgingCap: 10}}}
+
+	NewPriorityAger(jobRepository, queueRepository).RescoreQueuedJobs()
+
+	assert.InDelta(t, 4.0, jobRepository.priorities["queue1"][job.Id], 0.01)
+}
+
+func TestPriorityAger_RescoreQueuedJobs_SkipsQueuesWithoutAging_AfterRestoringSubmitPriority(t *testing.T) {
+	now := time.Now()
+	job := &api.Job{Id: "job", Queue: "queue1", Priority: 5, Created: now.Add(-5 * time.Hour)}
+	jobRepository := &fakeAgingJobRepository{jobs: map[string][]*api.Job{"queue1": {job}}}
+	queue := &api.Queue{Name: "queue1", PriorityAgingRate: 1, PriorityAgingCap: 10}
+	queueRepository := &fakeAgingQueueRepository{queues: []*api.Queue{queue}}
+	ager := NewPriorityAger(jobRepository, queueRepository)
+
+	ager.RescoreQueuedJobs()
+	assert.InDelta(t, 0.0, jobRepository.priorities["queue1"][job.Id], 0.01)
+
+	queue.PriorityAgingRate = 0
+	ager.RescoreQueuedJobs()
+	assert.Equal(t, 5.0, jobRepository.priorities["queue1"][job.Id])
+	assert.Equal(t, 2, jobRepository.updates)
+
+	ager.RescoreQueuedJobs()
+	assert.Equal(t, 2, jobRepository.updates)
+}
+
+type fakeAgingJobRepository struct {
+	repository.JobRepository
+	jobs        map[string][]*api.Job
+	queuedTimes map[string]time.Time
+	priorities  map[string]map[string]float64
+	updates     int
+}
+
+func (r *fakeAgingJobRepository) GetQueueJobIds(queueName string) ([]string, error) {
+	jobIds := []string{}
+	for _, job := range r.jobs[queueName] {
+		jobIds = append(jobIds, job.Id)
+	}
+	return jobIds, nil
+}
+
+func (r *fakeAgingJobRepository) GetQueuedTimes(jobIds []string) (map[string]time.Time, error) {
+	queuedTimes := map[string]time.Time{}
+	for _, jobId := range jobIds {
+		if queued, ok := r.queuedTimes[jobId]; ok {
+			queuedTimes[jobId] = queued
+		}
+	}
+	return queuedTimes, nil
+}
+
+func (r *fakeAgingJobRepository) UpdateQueuedPriorities(queue string, jobIds []string, priority func(*api.Job) float64) error {
+	if r.priorities == nil {
+		r.priorities = map[string]map[string]float64{}
+	}
+	priorities := map[string]float64{}
+	for _, job := range r.jobs[queue] {
+		priorities[job.Id] = priority(job)
+	}
+	r.priorities[queue] = priorities
+	r.updates++
+	return nil
+}
+
+type fakeAgingQueueRepository struct {
+	repository.QueueRepository
+	queues []*api.Queue
+}
+
+func (r *fakeAgingQueueRepository) GetAllQueues() ([]*api.Queue, error) {
+	return r.queues, nil
+}
@@ -41,7 +41,7 @@ func TestPriorityService_GetQueuePriorities(t *testing.T) {
 	}
 	queues := []*api.Queue{q1, q2, q3, q4, q5}
 
-	priorities := CalculateQueuesPriorityInfo(clusterPriorities, clusterUsageReports, queues)
+	priorities := CalculateQueuesPriorityInfo(NewScarcityFairness(scarcity), clusterPriorities, clusterUsageReports, queues)
 
 	cpuSum := cpu.DeepCopy()
 	cpuSum.Add(cpu)
@@ -49,9 +49,9 @@ func (info *QueueSchedulingInfo) UpdateLimits(resourceUsed common.ComputeResourc
 	info.adjustedShare.LimitToZero()
 }
 
-func SliceResourceWithLimits(resourceScarcity map[string]float64, queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo, queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
+func SliceResourceWithLimits(fairness Fairness, queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo, queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
 	queuesWithCapacity := filterQueuesWithNoCapacity(queueSchedulingInfo, queuePriorities)
-	naiveSlicedResource := sliceResource(resourceScarcity, queuesWithCapacity, quantityToSlice)
+	naiveSlicedResource := sliceResource(fairness, queuesWithCapacity, quantityToSlice)
 
 	result := map[*api.Queue]*QueueSchedulingInfo{}
 	for queue, slice := range naiveSlicedResource {
@@ -77,7 +77,10 @@ func filterQueuesWithNoCapacity(queueSchedulingInfo map[*api.Queue]*QueueSchedul
 	return queuesWithCapacity
 }
 
-func sliceResource(resourceScarcity map[string]float64, queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+func sliceResource(fairness Fairness, queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+	if drf, ok := fairness.(*DominantResourceFairness); ok {
+		return drf.sliceResource(queuePriorities, quantityToSlice)
+	}
 
 	inversePriorities := make(map[*api.Queue]float64)
 	inverseSum := 0.0
@@ -90,12 +93,12 @@ func sliceResource(resourceScarcity map[string]float64, queuePriorities map[*api
 		inversePriorities[queue] = inverse
 		inverseSum += inverse
 
-		queueUsage := ResourcesAsUsage(resourceScarcity, info.CurrentUsage)
+		queueUsage := fairness.ResourcesAsUsage(info.CurrentUsage)
 		usages[queue] = queueUsage
 		allCurrentUsage += queueUsage
 	}
 
-	usageToSlice := ResourcesFloatAsUsage(resourceScarcity, quantityToSlice)
+	usageToSlice := fairness.ResourcesFloatAsUsage(quantityToSlice)
 	allUsage := usageToSlice + allCurrentUsage
 
 	shares := make(map[*api.Queue]float64)
@@ -131,10 +134,10 @@ func ResourcesFloatAsUsage(resourceScarcity map[string]float64, resources common
 	return usage
 }
 
-func QueueSlicesToShares(resourceScarcity map[string]float64, schedulingInfo map[*api.Queue]*QueueSchedulingInfo) map[*api.Queue]float64 {
+func QueueSlicesToShares(fairness Fairness, schedulingInfo map[*api.Queue]*QueueSchedulingInfo) map[*api.Queue]float64 {
 	shares := map[*api.Queue]float64{}
 	for queue, info := range schedulingInfo {
-		shares[queue] = ResourcesFloatAsUsage(resourceScarcity, info.schedulingShare)
+		shares[queue] = fairness.ResourcesFloatAsUsage(info.schedulingShare)
 	}
 	return shares
 }
@@ -171,7 +174,7 @@ func calculateResourceScarcity(res common.ComputeResourcesFloat) map[string]floa
 	return importance
 }
 
-func usageFromQueueReports(resourceScarcity map[string]float64, queues []*api.QueueReport) map[string]float64 {
+func usageFromQueueReports(fairness Fairness, queues []*api.QueueReport) map[string]float64 {
 	resourceUsageByQueue := map[string]common.ComputeResources{}
 	for _, queueReport := range queues {
 		if _, present := resourceUsageByQueue[queueReport.Name]; !present {
@@ -182,7 +185,7 @@ func usageFromQueueReports(resourceScarcity map[string]float64, queues []*api.Qu
 
 	usages := map[string]float64{}
 	for queueName, resourceRequest := range resourceUsageByQueue {
-		usages[queueName] = ResourcesAsUsage(resourceScarcity, resourceRequest)
+		usages[queueName] = fairness.ResourcesAsUsage(resourceRequest)
 	}
 	return usages
 }
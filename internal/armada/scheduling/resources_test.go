@@ -28,7 +28,7 @@ func Test_sliceResources(t *testing.T) {
 		q3: {Priority: 1, CurrentUsage: noResources},  // queue usage is 0
 	}
 
-	slices := sliceResource(scarcity, queuePriorities, common.ComputeResources{"cpu": resource.MustParse("8")}.AsFloat())
+	slices := sliceResource(NewScarcityFairness(scarcity), queuePriorities, common.ComputeResources{"cpu": resource.MustParse("8")}.AsFloat())
 
 	// resulted usage ration should be 4 : 4 : 4
 	twoCpu := common.ComputeResourcesFloat{"cpu": 2.0}
@@ -49,7 +49,7 @@ func Test_sliceResources_highImbalance(t *testing.T) {
 		q2: {Priority: 1, CurrentUsage: noResources},
 	}
 
-	slices := sliceResource(scarcity, queuePriorities, common.ComputeResources{"cpu": resource.MustParse("3")}.AsFloat())
+	slices := sliceResource(NewScarcityFairness(scarcity), queuePriorities, common.ComputeResources{"cpu": resource.MustParse("3")}.AsFloat())
 
 	noCpu := common.ComputeResourcesFloat{"cpu": 0.0}
 	allCpu := common.ComputeResourcesFloat{"cpu": 3.0}
@@ -79,7 +79,7 @@ func Test_SliceResourceWithLimits_SchedulingShareMatchesAdjusted_WhenNoQueuesAtL
 		q3: {remainingSchedulingLimit: resourceToSlice, schedulingShare: common.ComputeResourcesFloat{}, adjustedShare: common.ComputeResourcesFloat{}},
 	}
 
-	slices := SliceResourceWithLimits(scarcity, queueSchedulingInfo, queuePriorities, resourceToSlice)
+	slices := SliceResourceWithLimits(NewScarcityFairness(scarcity), queueSchedulingInfo, queuePriorities, resourceToSlice)
 
 	// resulted usage ration should be 4 : 4 : 4
 	twoCpu := common.ComputeResourcesFloat{"cpu": 2.0}
@@ -112,7 +112,7 @@ func Test_SliceResourceWithLimits_SchedulingShareCorrespondsWithPriority(t *test
 		q2: {remainingSchedulingLimit: resourceToSlice, schedulingShare: common.ComputeResourcesFloat{}, adjustedShare: common.ComputeResourcesFloat{}},
 	}
 
-	slices := SliceResourceWithLimits(scarcity, queueSchedulingInfo, queuePriorities, resourceToSlice)
+	slices := SliceResourceWithLimits(NewScarcityFairness(scarcity), queueSchedulingInfo, queuePriorities, resourceToSlice)
 
 	//Both queues have the same priority so should have the same scheduling share
 	assert.Equal(t, slices[q1].schedulingShare, fourCpu)
@@ -139,7 +139,7 @@ func Test_SliceResourceWithLimits_AdjustedShare(t *testing.T) {
 		q2: {remainingSchedulingLimit: resourceToSlice, schedulingShare: common.ComputeResourcesFloat{}, adjustedShare: common.ComputeResourcesFloat{}},
 	}
 
-	slices := SliceResourceWithLimits(scarcity, queueSchedulingInfo, queuePriorities, resourceToSlice)
+	slices := SliceResourceWithLimits(NewScarcityFairness(scarcity), queueSchedulingInfo, queuePriorities, resourceToSlice)
 
 	//Both queues have the same priority however q1 is limited to 2cpu
 	assert.Equal(t, slices[q1].adjustedShare, twoCpu)
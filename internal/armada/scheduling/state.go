@@ -0,0 +1,161 @@
+package scheduling
+
+import (
+	"sync"
+	"time"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// SchedulingState is a snapshot of the state read by lease requests, it is shared between requests and must not be modified.
+type SchedulingState struct {
+	// Queues with queued jobs
+	ActiveQueues      []*api.Queue
+	UsageReports      map[string]*api.ClusterUsageReport
+	LeasedReports     map[string]*api.ClusterLeasedReport
+	ClusterPriorities map[string]map[string]float64
+	SchedulingInfos   map[string]*api.ClusterSchedulingInfoReport
+	Cordons           []*api.ClusterCordon
+	Loaded            time.Time
+}
+
+// SchedulingStateCache keeps one snapshot of scheduling state shared by all lease requests a server handles, instead of
+// each request loading it from redis. The snapshot is reloaded as a whole once it is older than the refresh interval.
+// Between reloads only the leased reports of clusters leasing from this server are applied to it, changes to queues,
+// usage reports, priorities and cordons, and leases through other servers, are seen after the next reload.
+// With a zero refresh interval every request reloads the state.
+type SchedulingStateCache struct {
+	jobRepository            repository.JobRepository
+	queueRepository          repository.QueueRepository
+	usageRepository          repository.UsageRepository
+	schedulingInfoRepository repository.SchedulingInfoRepository
+	cordonRepository         repository.CordonRepository
+	refreshInterval          time.Duration
+
+	refreshMutex sync.Mutex
+	stateMutex   sync.RWMutex
+	state        *SchedulingState
+}
+
+func NewSchedulingStateCache(
+	jobRepository repository.JobRepository,
+	queueRepository repository.QueueRepository,
+	usageRepository repository.UsageRepository,
+	schedulingInfoRepository repository.SchedulingInfoRepository,
+	cordonRepository repository.CordonRepository,
+	refreshInterval time.Duration) *SchedulingStateCache {
+	return &SchedulingStateCache{
+		jobRepository:            jobRepository,
+		queueRepository:          queueRepository,
+		usageRepository:          usageRepository,
+		schedulingInfoRepository: schedulingInfoRepository,
+		cordonRepository:         cordonRepository,
+		refreshInterval:          refreshInterval,
+	}
+}
+
+// Get returns the current snapshot, reloading it first when it is stale. Concurrent callers wait for a single reload.
+func (c *SchedulingStateCache) Get() (*SchedulingState, error) {
+	if c.refreshInterval <= 0 {
+		return c.load()
+	}
+	if state := c.current(); state != nil {
+		return state, nil
+	}
+
+	c.refreshMutex.Lock()
+	defer c.refreshMutex.Unlock()
+	if state := c.current(); state != nil {
+		return state, nil
+	}
+
+	state, e := c.load()
+	if e != nil {
+		return nil, e
+	}
+	c.stateMutex.Lock()
+	c.state = state
+	c.stateMutex.Unlock()
+	return state, nil
+}
+
+// UpdateClusterLeased stores the leased report and applies it to the snapshot.
+func (c *SchedulingStateCache) UpdateClusterLeased(report *api.ClusterLeasedReport) error {
+	e := c.usageRepository.UpdateClusterLeased(report)
+	if e != nil {
+		return e
+	}
+
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+	if c.state == nil {
+		return nil
+	}
+	leasedReports := make(map[string]*api.ClusterLeasedReport, len(c.state.LeasedReports)+1)
+	for clusterId, leased := range c.state.LeasedReports {
+		leasedReports[clusterId] = leased
+	}
+	leasedReports[report.ClusterId] = report
+
+	updated := *c.state
+	updated.LeasedReports = leasedReports
+	c.state = &updated
+	return nil
+}
+
+// Returns the snapshot if it is still fresh, nil otherwise
+func (c *SchedulingStateCache) current() *SchedulingState {
+	c.stateMutex.RLock()
+	defer c.stateMutex.RUnlock()
+	if c.state == nil || time.Since(c.state.Loaded) >= c.refreshInterval {
+		return nil
+	}
+	return c.state
+}
+
+func (c *SchedulingStateCache) load() (*SchedulingState, error) {
+	loaded := time.Now()
+
+	queues, e := c.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, e
+	}
+	activeQueues, e := c.jobRepository.FilterActiveQueues(queues)
+	if e != nil {
+		return nil, e
+	}
+
+	usageReports, e := c.usageRepository.GetClusterUsageReports()
+	if e != nil {
+		return nil, e
+	}
+	clusterPriorities, e := c.usageRepository.GetClusterPriorities(GetClusterReportIds(FilterActiveClusters(usageReports)))
+	if e != nil {
+		return nil, e
+	}
+	leasedReports, e := c.usageRepository.GetClusterLeasedReports()
+	if e != nil {
+		return nil, e
+	}
+
+	schedulingInfos, e := c.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, e
+	}
+
+	cordons, e := c.cordonRepository.GetCordons()
+	if e != nil {
+		return nil, e
+	}
+
+	return &SchedulingState{
+		ActiveQueues:      activeQueues,
+		UsageReports:      usageReports,
+		LeasedReports:     leasedReports,
+		ClusterPriorities: clusterPriorities,
+		SchedulingInfos:   schedulingInfos,
+		Cordons:           cordons,
+		Loaded:            loaded,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestSchedulingStateCache_Get_SharesStateUntilStale(t *testing.T) {
+	cache := NewSchedulingStateCache(&slowJobRepository{}, &slowQueueRepository{}, &slowUsageRepository{}, &slowSchedulingInfoRepository{}, &slowCordonRepository{}, 50*time.Millisecond)
+
+	first, e := cache.Get()
+	assert.Nil(t, e)
+	second, e := cache.Get()
+	assert.Nil(t, e)
+	assert.Same(t, first, second)
+
+	time.Sleep(60 * time.Millisecond)
+	third, e := cache.Get()
+	assert.Nil(t, e)
+	assert.NotSame(t, first, third)
+}
+
+func TestSchedulingStateCache_UpdateClusterLeased_UpdatesState(t *testing.T) {
+	usageRepository := &slowUsageRepository{}
+	cache := NewSchedulingStateCache(&slowJobRepository{}, &slowQueueRepository{}, usageRepository, &slowSchedulingInfoRepository{}, &slowCordonRepository{}, time.Minute)
+
+	before, e := cache.Get()
+	assert.Nil(t, e)
+
+	report := &api.ClusterLeasedReport{ClusterId: "cluster1"}
+	assert.Nil(t, cache.UpdateClusterLeased(report))
+	assert.Equal(t, report, usageRepository.leased["cluster1"])
+
+	after, e := cache.Get()
+	assert.Nil(t, e)
+	assert.Equal(t, report, after.LeasedReports["cluster1"])
+	assert.Empty(t, before.LeasedReports)
+}
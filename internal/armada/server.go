@@ -1,7 +1,7 @@
 package armada
 
 import (
-	"strings"
+	"os"
 	"sync"
 	"time"
 
@@ -17,9 +17,9 @@ import (
 	"github.com/G-Research/armada/internal/armada/server"
 	"github.com/G-Research/armada/internal/common/auth"
 	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/eventstream"
 	grpcCommon "github.com/G-Research/armada/internal/common/grpc"
 	"github.com/G-Research/armada/internal/common/health"
-	stan_util "github.com/G-Research/armada/internal/common/stan-util"
 	"github.com/G-Research/armada/internal/common/task"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
@@ -40,6 +40,8 @@ func Serve(config *configuration.ArmadaConfig, healthChecks *health.MultiChecker
 	usageRepository := repository.NewRedisUsageRepository(db)
 	queueRepository := repository.NewRedisQueueRepository(db)
 	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(db)
+	cordonRepository := repository.NewRedisCordonRepository(db)
+	scheduleRepository := repository.NewRedisScheduleRepository(db)
 	healthChecks.Add(repository.NewRedisHealth(db))
 
 	queueCache := cache.NewQueueCache(queueRepository, jobRepository, schedulingInfoRepository)
@@ -50,30 +52,25 @@ func Serve(config *configuration.ArmadaConfig, healthChecks *health.MultiChecker
 
 	// TODO: move this to task manager
 	stopSubscription := func() {}
-	if len(config.EventsNats.Servers) > 0 {
-
-		conn, err := stan_util.DurableConnect(
-			config.EventsNats.ClusterID,
-			"armada-server-"+util.NewULID(),
-			strings.Join(config.EventsNats.Servers, ","),
-		)
-		if err != nil {
-			panic(err)
-		}
-		eventStore = repository.NewNatsEventStore(conn, config.EventsNats.Subject)
-		eventProcessor := repository.NewNatsEventRedisProcessor(conn, redisEventRepository, config.EventsNats.Subject, config.EventsNats.QueueGroup)
+	eventStream, queueGroup, jobStatusGroup, err := connectEventStream(config)
+	if err != nil {
+		panic(err)
+	}
+	if eventStream != nil {
+		eventStore = repository.NewNatsEventStore(eventStream)
+		eventProcessor := repository.NewNatsEventRedisProcessor(eventStream, redisEventRepository, queueGroup)
 		eventProcessor.Start()
-		jobStatusProcessor := repository.NewNatsEventJobStatusProcessor(conn, jobRepository, config.EventsNats.Subject, config.EventsNats.JobStatusGroup)
+		jobStatusProcessor := repository.NewNatsEventJobStatusProcessor(eventStream, jobRepository, jobStatusGroup)
 		jobStatusProcessor.Start()
 
 		stopSubscription = func() {
-			err := conn.Close()
+			err := eventStream.Close()
 			if err != nil {
-				log.Errorf("failed to close nats connection: %v", err)
+				log.Errorf("failed to close event stream connection: %v", err)
 			}
 		}
 
-		healthChecks.Add(conn)
+		healthChecks.Add(eventStream)
 
 	} else {
 		eventStore = redisEventRepository
@@ -81,17 +78,31 @@ func Serve(config *configuration.ArmadaConfig, healthChecks *health.MultiChecker
 
 	permissions := authorization.NewPrincipalPermissionChecker(config.Auth.PermissionGroupMapping, config.Auth.PermissionScopeMapping, config.Auth.PermissionClaimMapping)
 
-	submitServer := server.NewSubmitServer(permissions, jobRepository, queueRepository, eventStore, schedulingInfoRepository, &config.QueueManagement)
+	// Tasks changing shared state in redis run on the leader only, the queue cache is kept by every replica
+	// as it serves their lease requests
+	leaderElection := startLeaderElection(db, config.LeaderElection)
+
+	submitServer := server.NewSubmitServer(permissions, jobRepository, queueRepository, eventStore, schedulingInfoRepository, usageRepository, cordonRepository, scheduleRepository, &config.QueueManagement, &config.Scheduling, eventStream != nil, leaderElection)
 	usageServer := server.NewUsageServer(permissions, config.PriorityHalfTime, &config.Scheduling, usageRepository, queueRepository)
-	aggregatedQueueServer := server.NewAggregatedQueueServer(permissions, config.Scheduling, jobRepository, queueCache, queueRepository, usageRepository, eventStore, schedulingInfoRepository)
-	eventServer := server.NewEventServer(permissions, redisEventRepository, eventStore)
-	leaseManager := scheduling.NewLeaseManager(jobRepository, queueRepository, eventStore, config.Scheduling.Lease.ExpireAfter)
+	aggregatedQueueServer := server.NewAggregatedQueueServer(permissions, config.Scheduling, jobRepository, queueCache, queueRepository, usageRepository, eventStore, schedulingInfoRepository, cordonRepository)
+	eventServer := server.NewEventServer(permissions, redisEventRepository, eventStore, redisEventRepository)
+	leaseManager := scheduling.NewLeaseManager(jobRepository, queueRepository, eventStore, config.Scheduling.Lease.ExpireAfter, leaderElection)
+
+	taskManager.RegisterSingleton(leaseManager.ExpireLeases, config.Scheduling.Lease.ExpiryLoopInterval, "lease_expiry", leaderElection)
 
-	taskManager.Register(leaseManager.ExpireLeases, config.Scheduling.Lease.ExpiryLoopInterval, "lease_expiry")
+	deferredJobQueuer := scheduling.NewDeferredJobQueuer(jobRepository, queueRepository, eventStore)
+	taskManager.RegisterSingleton(deferredJobQueuer.QueueDueJobs, config.Scheduling.ScheduleCheckInterval, "queue_deferred_jobs", leaderElection)
+	taskManager.RegisterSingleton(submitServer.FireSchedules, config.Scheduling.ScheduleCheckInterval, "fire_schedules", leaderElection)
 
-	metrics.ExposeDataMetrics(queueRepository, jobRepository, usageRepository, schedulingInfoRepository, queueCache)
+	if config.Scheduling.PriorityAgingInterval > 0 {
+		priorityAger := scheduling.NewPriorityAger(jobRepository, queueRepository)
+		taskManager.RegisterSingleton(priorityAger.RescoreQueuedJobs, config.Scheduling.PriorityAgingInterval, "priority_aging", leaderElection)
+	}
+
+	metrics.ExposeDataMetrics(queueRepository, jobRepository, usageRepository, schedulingInfoRepository, cordonRepository, queueCache, &config.Scheduling)
 
 	api.RegisterSubmitServer(grpcServer, submitServer)
+	api.RegisterJobsServer(grpcServer, submitServer)
 	api.RegisterUsageServer(grpcServer, usageServer)
 	api.RegisterAggregatedQueueServer(grpcServer, aggregatedQueueServer)
 	api.RegisterEventServer(grpcServer, eventServer)
@@ -103,10 +114,52 @@ func Serve(config *configuration.ArmadaConfig, healthChecks *health.MultiChecker
 	return func() {
 		stopSubscription()
 		taskManager.StopAll(time.Second * 2)
+		if leaderElection != nil {
+			leaderElection.Stop()
+		}
 		grpcServer.GracefulStop()
 	}, wg
 }
 
+// Returns the configured event stream with the consumer groups of the event processors, or nil if events are stored
+// directly in redis
+func connectEventStream(config *configuration.ArmadaConfig) (eventstream.EventStream, string, string, error) {
+	clientID := "armada-server-" + util.NewULID()
+	if len(config.EventsKafka.Brokers) > 0 {
+		stream, err := eventstream.NewKafkaEventStream(clientID, config.EventsKafka.Brokers, config.EventsKafka.Topic, config.EventsKafka.Settings)
+		return stream, config.EventsKafka.QueueGroup, config.EventsKafka.JobStatusGroup, err
+	}
+	if len(config.EventsNats.Servers) > 0 {
+		stream, err := eventstream.Connect(
+			config.EventsNats.Transport,
+			config.EventsNats.Servers,
+			config.EventsNats.ClusterID,
+			clientID,
+			config.EventsNats.Subject,
+			config.EventsNats.JetStream,
+		)
+		return stream, config.EventsNats.QueueGroup, config.EventsNats.JobStatusGroup, err
+	}
+	return nil, "", "", nil
+}
+
+// Returns nil when leader election is disabled
+func startLeaderElection(db redis.UniversalClient, config configuration.LeaderElectionConfig) *task.LeaderElection {
+	if config.LeaseDuration <= 0 {
+		return nil
+	}
+	retryInterval := config.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = config.LeaseDuration / 3
+	}
+	hostname, _ := os.Hostname()
+	holderId := hostname + "-" + util.NewULID()
+	leaderElection := task.NewLeaderElection(repository.NewRedisLeaderLock(db, repository.ServerLeaderLockName), holderId,
+		config.LeaseDuration, retryInterval, metrics.MetricPrefix)
+	leaderElection.Start()
+	return leaderElection
+}
+
 func createRedisClient(config *redis.UniversalOptions) redis.UniversalClient {
 	return redis.NewUniversalClient(config)
 }
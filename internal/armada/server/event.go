@@ -13,34 +13,50 @@ import (
 )
 
 type EventServer struct {
-	permissions     authorization.PermissionChecker
-	eventRepository repository.EventRepository
-	eventStore      repository.EventStore
+	permissions       authorization.PermissionChecker
+	eventRepository   repository.EventRepository
+	eventStore        repository.EventStore
+	eventDeduplicator repository.EventDeduplicator
 }
 
 func NewEventServer(
 	permissions authorization.PermissionChecker,
 	eventRepository repository.EventRepository,
-	eventStore repository.EventStore) *EventServer {
+	eventStore repository.EventStore,
+	eventDeduplicator repository.EventDeduplicator) *EventServer {
 
 	return &EventServer{
-		permissions:     permissions,
-		eventRepository: eventRepository,
-		eventStore:      eventStore}
+		permissions:       permissions,
+		eventRepository:   eventRepository,
+		eventStore:        eventStore,
+		eventDeduplicator: eventDeduplicator}
 }
 
 func (s *EventServer) Report(ctx context.Context, message *api.EventMessage) (*types.Empty, error) {
 	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
-	return &types.Empty{}, s.eventStore.ReportEvents([]*api.EventMessage{message})
+	return &types.Empty{}, s.reportEvents([]*api.EventMessage{message})
 }
 
 func (s *EventServer) ReportMultiple(ctx context.Context, message *api.EventList) (*types.Empty, error) {
 	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
-	return &types.Empty{}, s.eventStore.ReportEvents(message.Events)
+	return &types.Empty{}, s.reportEvents(message.Events)
+}
+
+// Events are marked as reported only once stored, so a failed report can be retried with the same deduplication keys
+func (s *EventServer) reportEvents(messages []*api.EventMessage) error {
+	messages, e := s.eventDeduplicator.FilterReported(messages)
+	if e != nil {
+		return e
+	}
+	e = s.eventStore.ReportEvents(messages)
+	if e != nil {
+		return e
+	}
+	return s.eventDeduplicator.MarkReported(messages)
 }
 
 func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Event_GetJobSetEventsServer) error {
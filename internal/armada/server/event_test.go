@@ -76,6 +76,28 @@ func TestEventServer_EventsShouldBeRemovedAfterEventRetentionTime(t *testing.T)
 	})
 }
 
+func TestEventServer_ReportMultiple_IgnoresReplayedEvents(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		jobSetId := "set1"
+
+		pending, _ := api.Wrap(&api.JobPendingEvent{JobSetId: jobSetId})
+		pending.DeduplicationKey = "pending"
+		running, _ := api.Wrap(&api.JobRunningEvent{JobSetId: jobSetId})
+		running.DeduplicationKey = "running"
+		withoutKey, _ := api.Wrap(&api.JobUtilisationEvent{JobSetId: jobSetId})
+
+		_, e := s.ReportMultiple(context.Background(), &api.EventList{Events: []*api.EventMessage{pending, withoutKey}})
+		assert.Nil(t, e)
+		_, e = s.ReportMultiple(context.Background(), &api.EventList{Events: []*api.EventMessage{pending, running, withoutKey}})
+		assert.Nil(t, e)
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Id: jobSetId, Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 4, len(stream.sendMessages))
+	})
+}
+
 func reportEvent(t *testing.T, s *EventServer, event api.Event) {
 	msg, _ := api.Wrap(event)
 	_, e := s.Report(context.Background(), msg)
@@ -88,7 +110,7 @@ func withEventServer(eventRetention configuration.EventRetentionPolicy, action f
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 
 	repo := repository.NewRedisEventRepository(client, eventRetention)
-	server := NewEventServer(&FakePermissionChecker{}, repo, repo)
+	server := NewEventServer(&FakePermissionChecker{}, repo, repo, repo)
 
 	client.FlushDB()
 
@@ -2,18 +2,55 @@ package server
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 func validateJobsCanBeScheduled(jobs []*api.Job, allClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport) error {
 	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
 	for i, job := range jobs {
-		if !scheduling.MatchSchedulingRequirementsOnAnyCluster(job, activeClusterSchedulingInfo) {
-			return fmt.Errorf("job with index %d is not schedulable on any cluster", i)
+		allowedClusterSchedulingInfo := scheduling.FilterAllowedClusterSchedulingInfoReports(job, activeClusterSchedulingInfo)
+		if !scheduling.MatchSchedulingRequirementsOnAnyCluster(job, allowedClusterSchedulingInfo) {
+			return fmt.Errorf("job with index %d is not schedulable on any cluster%s", i, describeAllowedClusters(job))
 		}
 	}
 
 	return nil
 }
+
+func describeAllowedClusters(job *api.Job) string {
+	description := ""
+	if len(job.AllowedPools) > 0 {
+		description += fmt.Sprintf(" in allowed pools %s", strings.Join(job.AllowedPools, ", "))
+	}
+	if len(job.AllowedClusters) > 0 {
+		if description != "" {
+			description += " and"
+		}
+		description += fmt.Sprintf(" among allowed clusters %s", strings.Join(job.AllowedClusters, ", "))
+	}
+	return description
+}
+
+// Checks resource limits of each pod are within the max overcommit ratio of the queue times its resource requests
+func validateJobsOvercommit(queue *api.Queue, jobs []*api.Job) error {
+	if queue.MaxOvercommitRatio == 0 {
+		return nil
+	}
+	for i, job := range jobs {
+		for _, podSpec := range job.GetAllPodSpecs() {
+			requests := common.TotalPodResourceRequest(podSpec)
+			for resourceType, limit := range common.TotalPodResourceLimit(podSpec) {
+				request := requests[resourceType]
+				if common.QuantityAsFloat64(limit) > queue.MaxOvercommitRatio*common.QuantityAsFloat64(request) {
+					return fmt.Errorf("job with index %d has %s limit %s more than %g times its request %s, the max overcommit ratio of queue %s",
+						i, resourceType, limit.String(), queue.MaxOvercommitRatio, request.String(), queue.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
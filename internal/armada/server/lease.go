@@ -28,6 +28,8 @@ type AggregatedQueueServer struct {
 	usageRepository          repository.UsageRepository
 	eventStore               repository.EventStore
 	schedulingInfoRepository repository.SchedulingInfoRepository
+	cordonRepository         repository.CordonRepository
+	schedulingState          *scheduling.SchedulingStateCache
 }
 
 func NewAggregatedQueueServer(
@@ -39,6 +41,7 @@ func NewAggregatedQueueServer(
 	usageRepository repository.UsageRepository,
 	eventStore repository.EventStore,
 	schedulingInfoRepository repository.SchedulingInfoRepository,
+	cordonRepository repository.CordonRepository,
 ) *AggregatedQueueServer {
 	return &AggregatedQueueServer{
 		permissions:              permissions,
@@ -48,7 +51,11 @@ func NewAggregatedQueueServer(
 		queueRepository:          queueRepository,
 		usageRepository:          usageRepository,
 		eventStore:               eventStore,
-		schedulingInfoRepository: schedulingInfoRepository}
+		schedulingInfoRepository: schedulingInfoRepository,
+		cordonRepository:         cordonRepository,
+		schedulingState: scheduling.NewSchedulingStateCache(
+			jobRepository, queueRepository, usageRepository, schedulingInfoRepository, cordonRepository, schedulingConfig.StateRefreshInterval),
+	}
 }
 
 func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.LeaseRequest) (*api.JobLease, error) {
@@ -61,22 +68,13 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		return &api.JobLease{}, nil
 	}
 
-	queues, e := q.queueRepository.GetAllQueues()
-	if e != nil {
-		return nil, e
-	}
-
-	activeQueues, e := q.jobRepository.FilterActiveQueues(queues)
+	state, e := q.schedulingState.Get()
 	if e != nil {
 		return nil, e
 	}
+	activeQueues := filterSchedulableQueues(state.ActiveQueues)
 
-	usageReports, e := q.usageRepository.GetClusterUsageReports()
-	if e != nil {
-		return nil, e
-	}
-
-	e = q.usageRepository.UpdateClusterLeased(&request.ClusterLeasedReport)
+	e = q.schedulingState.UpdateClusterLeased(&request.ClusterLeasedReport)
 	if e != nil {
 		return nil, e
 	}
@@ -88,19 +86,20 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		return nil, e
 	}
 
-	activeClusterReports := scheduling.FilterActiveClusters(usageReports)
-	activePoolClusterReports := scheduling.FilterPoolClusters(request.Pool, activeClusterReports)
-	activePoolCLusterIds := scheduling.GetClusterReportIds(activePoolClusterReports)
-	clusterPriorities, e := q.usageRepository.GetClusterPriorities(activePoolCLusterIds)
-	if e != nil {
-		return nil, e
+	if isCordoned(state.Cordons, request.ClusterId, request.Pool) {
+		return &api.JobLease{}, nil
 	}
 
-	clusterLeasedJobReports, e := q.usageRepository.GetClusterLeasedReports()
-	if e != nil {
-		return nil, e
+	activeClusterReports := scheduling.FilterActiveClusters(state.UsageReports)
+	activePoolClusterReports := scheduling.FilterPoolClusters(request.Pool, activeClusterReports)
+	activePoolCLusterIds := scheduling.GetClusterReportIds(activePoolClusterReports)
+	clusterPriorities := scheduling.FilterClusterPriorities(activePoolCLusterIds, state.ClusterPriorities)
+	poolLeasedJobReports := scheduling.FilterClusterLeasedReports(activePoolCLusterIds, state.LeasedReports)
+	if _, ok := activePoolClusterReports[request.ClusterId]; ok {
+		poolLeasedJobReports[request.ClusterId] = &request.ClusterLeasedReport
 	}
-	poolLeasedJobReports := scheduling.FilterClusterLeasedReports(activePoolCLusterIds, clusterLeasedJobReports)
+	clusterPreference := scheduling.NewClusterPreference(
+		request.ClusterId, request.Pool, filterUncordonedClusters(state.Cordons, activeClusterReports), state.SchedulingInfos)
 	jobs, e := scheduling.LeaseJobs(
 		ctx,
 		&q.schedulingConfig,
@@ -111,14 +110,15 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		activePoolClusterReports,
 		poolLeasedJobReports,
 		clusterPriorities,
-		activeQueues)
+		activeQueues,
+		clusterPreference)
 
 	if e != nil {
 		return nil, e
 	}
 
 	clusterLeasedReport := scheduling.CreateClusterLeasedReport(request.ClusterLeasedReport.ClusterId, &request.ClusterLeasedReport, jobs)
-	e = q.usageRepository.UpdateClusterLeased(clusterLeasedReport)
+	e = q.schedulingState.UpdateClusterLeased(clusterLeasedReport)
 	if e != nil {
 		return nil, e
 	}
@@ -129,6 +129,35 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 	return &jobLease, nil
 }
 
+func filterSchedulableQueues(queues []*api.Queue) []*api.Queue {
+	schedulable := make([]*api.Queue, 0, len(queues))
+	for _, queue := range queues {
+		if !queue.SchedulingPaused {
+			schedulable = append(schedulable, queue)
+		}
+	}
+	return schedulable
+}
+
+func isCordoned(cordons []*api.ClusterCordon, clusterId string, pool string) bool {
+	for _, cordon := range cordons {
+		if (cordon.ClusterId != "" && cordon.ClusterId == clusterId) || (cordon.Pool != "" && cordon.Pool == pool) {
+			return true
+		}
+	}
+	return false
+}
+
+func filterUncordonedClusters(cordons []*api.ClusterCordon, reports map[string]*api.ClusterUsageReport) map[string]*api.ClusterUsageReport {
+	result := map[string]*api.ClusterUsageReport{}
+	for id, report := range reports {
+		if !isCordoned(cordons, id, report.Pool) {
+			result[id] = report
+		}
+	}
+	return result
+}
+
 func (q *AggregatedQueueServer) RenewLease(ctx context.Context, request *api.RenewLeaseRequest) (*api.IdList, error) {
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
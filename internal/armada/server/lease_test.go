@@ -109,6 +109,32 @@ func TestAggregatedQueueServer_ReturningLeaseMoreThanMaxRetriesSendsJobFailedEve
 	assert.Equal(t, fmt.Sprintf("Exceeded maximum number of retries: %d", maxRetries), failedEvent.Reason)
 }
 
+func TestAggregatedQueueServer_LeaseJobs_WhenClusterOrPoolIsCordoned_ReturnsNoJobs(t *testing.T) {
+	for _, cordon := range []*api.ClusterCordon{{ClusterId: "cluster-1"}, {Pool: "pool-1"}} {
+		_, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+		aggregatedQueueClient.cordonRepository = &fakeCordonRepository{cordons: []*api.ClusterCordon{cordon}}
+
+		lease, err := aggregatedQueueClient.LeaseJobs(context.TODO(), &api.LeaseRequest{ClusterId: "cluster-1", Pool: "pool-1"})
+		assert.Nil(t, err)
+		assert.Empty(t, lease.Job)
+	}
+}
+
+func TestIsCordoned(t *testing.T) {
+	cordons := []*api.ClusterCordon{{ClusterId: "cluster-1"}, {Pool: "pool-1"}}
+
+	assert.True(t, isCordoned(cordons, "cluster-1", "pool-2"))
+	assert.True(t, isCordoned(cordons, "cluster-2", "pool-1"))
+	assert.False(t, isCordoned(cordons, "cluster-2", "pool-2"))
+	assert.False(t, isCordoned(cordons, "cluster-2", ""))
+}
+
+func TestFilterSchedulableQueues(t *testing.T) {
+	queues := []*api.Queue{{Name: "queue-1"}, {Name: "queue-2", SchedulingPaused: true}}
+
+	assert.Equal(t, []*api.Queue{{Name: "queue-1"}}, filterSchedulableQueues(queues))
+}
+
 func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobRepository, *fakeEventStore, *AggregatedQueueServer) {
 	mockJobRepository := newMockJobRepository()
 	fakeEventStore := &fakeEventStore{}
@@ -124,7 +150,8 @@ func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobReposito
 		fakeQueueRepository,
 		&fakeUsageRepository{},
 		fakeEventStore,
-		fakeSchedulingInfoRepository)
+		fakeSchedulingInfoRepository,
+		&fakeCordonRepository{})
 }
 
 type mockJobRepository struct {
@@ -188,7 +215,7 @@ func (repo *mockJobRepository) RenewLease(clusterId string, jobIds []string) (re
 	return []string{}, nil
 }
 
-func (repo *mockJobRepository) ExpireLeases(queue string, deadline time.Time) (expired []*api.Job, e error) {
+func (repo *mockJobRepository) ExpireLeases(queue string, deadline time.Time, leaderToken int64) (expired []*api.Job, e error) {
 	return []*api.Job{}, nil
 }
 
@@ -251,6 +278,10 @@ func (repo *mockJobRepository) GetLeasedJobIds(queue string) ([]string, error) {
 	return []string{}, nil
 }
 
+func (repo *mockJobRepository) GetLeasedJobCounts(queue string, jobSetIds []string) (int, map[string]int, error) {
+	return 0, map[string]int{}, nil
+}
+
 func (repo *mockJobRepository) UpdateStartTime(jobId string, clusterId string, startTime time.Time) error {
 	return nil
 }
@@ -263,6 +294,38 @@ func (repo *mockJobRepository) GetJobRunInfos(jobIds []string) (map[string]*repo
 	return map[string]*repository.RunInfo{}, nil
 }
 
+func (repo *mockJobRepository) UpdateLastFailureReason(jobId string, reason string) error {
+	return nil
+}
+
+func (repo *mockJobRepository) GetJobStatuses(jobs []*api.Job) ([]*api.JobStatus, error) {
+	return []*api.JobStatus{}, nil
+}
+
+func (repo *mockJobRepository) HoldJobs(jobs []*api.Job) map[*api.Job]error {
+	return map[*api.Job]error{}
+}
+
+func (repo *mockJobRepository) ReleaseJobs(jobs []*api.Job) map[*api.Job]error {
+	return map[*api.Job]error{}
+}
+
+func (repo *mockJobRepository) GetHeldJobIds(queue string) ([]string, error) {
+	return []string{}, nil
+}
+
+func (repo *mockJobRepository) QueueDueJobs(queue string, now time.Time) ([]*api.Job, error) {
+	return []*api.Job{}, nil
+}
+
+func (repo *mockJobRepository) UpdateQueuedPriorities(queue string, jobIds []string, priority func(*api.Job) float64) error {
+	return nil
+}
+
+func (repo *mockJobRepository) GetQueuedTimes(jobIds []string) (map[string]time.Time, error) {
+	return map[string]time.Time{}, nil
+}
+
 type fakeQueueRepository struct{}
 
 func (repo *fakeQueueRepository) GetAllQueues() ([]*api.Queue, error) {
@@ -281,6 +344,10 @@ func (repo *fakeQueueRepository) UpdateQueue(queue *api.Queue) error {
 	return nil
 }
 
+func (repo *fakeQueueRepository) ModifyQueue(name string, mutator func(*api.Queue)) error {
+	return nil
+}
+
 func (repo *fakeQueueRepository) DeleteQueue(name string) error {
 	return nil
 }
@@ -329,3 +396,20 @@ func (repo *fakeSchedulingInfoRepository) GetClusterSchedulingInfo() (map[string
 func (repo *fakeSchedulingInfoRepository) UpdateClusterSchedulingInfo(report *api.ClusterSchedulingInfoReport) error {
 	return nil
 }
+
+type fakeCordonRepository struct {
+	cordons []*api.ClusterCordon
+}
+
+func (repo *fakeCordonRepository) GetCordons() ([]*api.ClusterCordon, error) {
+	return repo.cordons, nil
+}
+
+func (repo *fakeCordonRepository) Cordon(cordon *api.ClusterCordon) error {
+	repo.cordons = append(repo.cordons, cordon)
+	return nil
+}
+
+func (repo *fakeCordonRepository) Uncordon(clusterId string, pool string) error {
+	return nil
+}
@@ -195,6 +195,62 @@ func reportJobsCancelled(repository repository.EventStore, requestorName string,
 	return e
 }
 
+func reportJobsHeld(repository repository.EventStore, requestorName string, jobs []*api.Job) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, e := api.Wrap(&api.JobHeldEvent{
+			JobId:     job.Id,
+			Queue:     job.Queue,
+			JobSetId:  job.JobSetId,
+			Created:   now,
+			Requestor: requestorName,
+		})
+		if e != nil {
+			return e
+		}
+		events = append(events, event)
+	}
+	e := repository.ReportEvents(events)
+	return e
+}
+
+func reportJobsReleased(repository repository.EventStore, requestorName string, jobs []*api.Job) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, e := api.Wrap(&api.JobReleasedEvent{
+			JobId:     job.Id,
+			Queue:     job.Queue,
+			JobSetId:  job.JobSetId,
+			Created:   now,
+			Requestor: requestorName,
+		})
+		if e != nil {
+			return e
+		}
+		events = append(events, event)
+	}
+	e := repository.ReportEvents(events)
+	return e
+}
+
+func reportLeaseReturned(repository repository.EventStore, clusterId string, reason string, job *api.Job) error {
+	event, e := api.Wrap(&api.JobLeaseReturnedEvent{
+		JobId:     job.Id,
+		Queue:     job.Queue,
+		JobSetId:  job.JobSetId,
+		Created:   time.Now(),
+		ClusterId: clusterId,
+		Reason:    reason,
+	})
+	if e != nil {
+		return e
+	}
+	e = repository.ReportEvents([]*api.EventMessage{event})
+	return e
+}
+
 func reportTerminated(repository repository.EventStore, clusterId string, job *api.Job) error {
 	event, e := api.Wrap(&api.JobTerminatedEvent{
 		JobId:     job.Id,
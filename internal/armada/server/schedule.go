@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/permissions"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/cron"
+	"github.com/G-Research/armada/internal/common/task"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func (server *SubmitServer) CreateSchedule(ctx context.Context, schedule *api.JobSchedule) (*types.Empty, error) {
+	if schedule.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Schedule name is not specified")
+	}
+	if _, e := cron.Parse(schedule.Cron); e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+	if schedule.Template == nil || len(schedule.Template.JobRequestItems) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Schedule template has no jobs")
+	}
+	for _, item := range schedule.Template.JobRequestItems {
+		if item.ClientId != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Schedule template jobs can't have client ids, jobs of later firings would be detected as duplicates")
+		}
+	}
+
+	e, ownershipGroups := server.checkQueuePermission(ctx, schedule.Template.Queue, false, permissions.SubmitJobs, permissions.SubmitAnyJobs)
+	if e != nil {
+		return nil, e
+	}
+
+	principal := authorization.GetPrincipal(ctx)
+	schedule.Owner = principal.GetName()
+	schedule.OwnerGroups = principal.GetGroupNames()
+	schedule.OwnershipGroups = ownershipGroups
+	schedule.Created = time.Now()
+
+	// Jobs are created without being stored to validate the template, so a schedule which can never be leased
+	// is not accepted
+	if _, e := server.createJobs(schedule.Template, schedule.Owner, schedule.OwnershipGroups); e != nil {
+		return nil, e
+	}
+
+	e = server.scheduleRepository.CreateSchedule(schedule)
+	if e == repository.ErrScheduleAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "Schedule %q already exists", schedule.Name)
+	} else if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	log.Infof("Schedule %q of queue %s created by %s", schedule.Name, schedule.Template.Queue, schedule.Owner)
+	return &types.Empty{}, nil
+}
+
+func (server *SubmitServer) DeleteSchedule(ctx context.Context, request *api.JobScheduleDeleteRequest) (*types.Empty, error) {
+	schedule, e := server.scheduleRepository.GetSchedule(request.Name)
+	if e == repository.ErrScheduleNotFound {
+		return nil, status.Errorf(codes.NotFound, "Schedule %q not found", request.Name)
+	} else if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	if e, _ := server.checkQueuePermission(ctx, schedule.Template.Queue, false, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+		return nil, e
+	}
+
+	e = server.scheduleRepository.DeleteSchedule(request.Name)
+	if e == repository.ErrScheduleNotFound {
+		return nil, status.Errorf(codes.NotFound, "Schedule %q not found", request.Name)
+	} else if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return &types.Empty{}, nil
+}
+
+// Returns the schedules of queues the user could delete schedules of
+func (server *SubmitServer) GetSchedules(ctx context.Context, _ *types.Empty) (*api.JobScheduleList, error) {
+	allSchedules, e := server.scheduleRepository.GetSchedules()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	permitted := map[string]bool{}
+	schedules := []*api.JobSchedule{}
+	for _, schedule := range allSchedules {
+		queue := schedule.Template.Queue
+		allowed, checked := permitted[queue]
+		if !checked {
+			e, _ := server.checkQueuePermission(ctx, queue, false, permissions.SubmitJobs, permissions.SubmitAnyJobs)
+			if status.Code(e) == codes.Unavailable {
+				return nil, e
+			}
+			allowed = e == nil
+			permitted[queue] = allowed
+		}
+		if allowed {
+			schedules = append(schedules, schedule)
+		}
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		return schedules[i].Name < schedules[j].Name
+	})
+	return &api.JobScheduleList{Schedules: schedules}, nil
+}
+
+// Fires each schedule with a tick due since it last fired. Ticks missed while no server was running are
+// skipped, only the latest due tick fires. Servers claim ticks in redis, so each tick fires once. A tick is
+// released again when firing fails before any jobs were submitted, so the firing is retried by the next run.
+func (server *SubmitServer) FireSchedules() {
+	schedules, e := server.scheduleRepository.GetSchedules()
+	if e != nil {
+		log.Error(e)
+		return
+	}
+	lastFiredTimes, e := server.scheduleRepository.GetLastFiredTimes()
+	if e != nil {
+		log.Error(e)
+		return
+	}
+
+	now := time.Now()
+	for _, schedule := range schedules {
+		leaderToken, isLeader := task.LeaderToken(server.leader)
+		if !isLeader {
+			log.Warn("Stopped firing schedules, no longer the leader")
+			return
+		}
+
+		cronSchedule, e := cron.Parse(schedule.Cron)
+		if e != nil {
+			log.Errorf("Invalid cron expression of schedule %q: %s", schedule.Name, e)
+			continue
+		}
+
+		tick, due := latestDueTick(cronSchedule, lastFiredTimes[schedule.Name], now)
+		if !due {
+			continue
+		}
+
+		claimed, e := server.scheduleRepository.ClaimTick(schedule.Name, tick, leaderToken)
+		if e == repository.ErrStaleLeaderToken {
+			log.Warn("Stopped firing schedules, another server became the leader")
+			return
+		} else if e != nil {
+			log.Errorf("Error while firing schedule %q: %s", schedule.Name, e)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		e = server.fireSchedule(schedule)
+		if e != nil {
+			log.Errorf("Error while firing schedule %q, retrying: %s", schedule.Name, e)
+			e = server.scheduleRepository.ReleaseTick(schedule.Name, tick, lastFiredTimes[schedule.Name])
+			if e != nil {
+				log.Errorf("Error while releasing tick %s of schedule %q, the firing is lost: %s", tick, schedule.Name, e)
+			}
+		}
+	}
+}
+
+func latestDueTick(cronSchedule *cron.Schedule, lastFired time.Time, now time.Time) (time.Time, bool) {
+	tick := cronSchedule.Next(lastFired)
+	if tick.IsZero() || tick.After(now) {
+		return time.Time{}, false
+	}
+	for next := cronSchedule.Next(tick); !next.IsZero() && !next.After(now); next = cronSchedule.Next(tick) {
+		tick = next
+	}
+	return tick, true
+}
+
+// Returns an error when no jobs were submitted because of a failure, firings skipped on purpose return nil
+func (server *SubmitServer) fireSchedule(schedule *api.JobSchedule) error {
+	// The owner may have lost access to the queue since creating the schedule
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal(schedule.Owner, schedule.OwnerGroups))
+	e, ownershipGroups := server.checkQueuePermission(ctx, schedule.Template.Queue, false, permissions.SubmitJobs, permissions.SubmitAnyJobs)
+	if status.Code(e) == codes.Unavailable {
+		return e
+	} else if e != nil {
+		log.Errorf("Skipping firing of schedule %q, its owner %s can't submit jobs to queue %s: %s", schedule.Name, schedule.Owner, schedule.Template.Queue, e)
+		return nil
+	}
+
+	activeJobs, e := server.getActiveScheduledJobs(schedule.Name)
+	if e != nil {
+		return e
+	}
+
+	if len(activeJobs) > 0 {
+		switch schedule.ConcurrencyPolicy {
+		case api.ConcurrencyPolicy_Forbid:
+			log.Infof("Skipping firing of schedule %q, %d jobs of its previous firing are active", schedule.Name, len(activeJobs))
+			return nil
+		case api.ConcurrencyPolicy_Replace:
+			e := server.cancelScheduledJobs(schedule, activeJobs)
+			if e != nil {
+				return fmt.Errorf("replacing jobs failed: %s", e)
+			}
+		}
+	}
+
+	response, e := server.submitJobs(schedule.Template, schedule.Owner, ownershipGroups)
+	if e != nil {
+		if response == nil {
+			return e
+		}
+		log.Errorf("Error while submitting jobs of schedule %q: %s", schedule.Name, e)
+	}
+
+	jobIds := []string{}
+	for _, item := range response.JobResponseItems {
+		if item.Error != "" {
+			log.Errorf("Error while submitting job of schedule %q: %s", schedule.Name, item.Error)
+		} else {
+			jobIds = append(jobIds, item.JobId)
+		}
+	}
+	e = server.scheduleRepository.SetScheduledJobIds(schedule.Name, jobIds)
+	if e != nil {
+		log.Errorf("Error while recording jobs of schedule %q: %s", schedule.Name, e)
+	}
+	log.Infof("Schedule %q fired, submitted %d jobs", schedule.Name, len(jobIds))
+	return nil
+}
+
+// Returns the jobs submitted by the previous firing of the schedule which are not finished
+func (server *SubmitServer) getActiveScheduledJobs(name string) ([]*api.Job, error) {
+	jobIds, e := server.scheduleRepository.GetScheduledJobIds(name)
+	if e != nil {
+		return nil, e
+	}
+	jobs, e := server.jobRepository.GetExistingJobsByIds(jobIds)
+	if e != nil {
+		return nil, e
+	}
+	statuses, e := server.jobRepository.GetJobStatuses(jobs)
+	if e != nil {
+		return nil, e
+	}
+
+	active := []*api.Job{}
+	for i, jobStatus := range statuses {
+		if jobStatus.State != api.JobState_Finished {
+			active = append(active, jobs[i])
+		}
+	}
+	return active, nil
+}
+
+func (server *SubmitServer) cancelScheduledJobs(schedule *api.JobSchedule, jobs []*api.Job) error {
+	e := reportJobsCancelling(server.eventStore, schedule.Owner, jobs)
+	if e != nil {
+		return e
+	}
+
+	cancelled := []*api.Job{}
+	for job, e := range server.jobRepository.DeleteJobs(jobs) {
+		if e != nil {
+			log.Errorf("Error when cancelling job id %s: %s", job.Id, e)
+		} else {
+			cancelled = append(cancelled, job)
+		}
+	}
+	return reportJobsCancelled(server.eventStore, schedule.Owner, cancelled)
+}
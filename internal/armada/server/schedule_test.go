@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/cron"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestSubmitServer_CreateSchedule_CanBeReadBackAndDeleted(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateSchedule(context.Background(), createTestSchedule("nightly", api.ConcurrencyPolicy_Forbid))
+		assert.NoError(t, err)
+
+		_, err = s.CreateSchedule(context.Background(), createTestSchedule("nightly", api.ConcurrencyPolicy_Allow))
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		schedules, err := s.GetSchedules(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(schedules.Schedules))
+		assert.Equal(t, "nightly", schedules.Schedules[0].Name)
+		assert.Equal(t, api.ConcurrencyPolicy_Forbid, schedules.Schedules[0].ConcurrencyPolicy)
+
+		_, err = s.DeleteSchedule(context.Background(), &api.JobScheduleDeleteRequest{Name: "nightly"})
+		assert.NoError(t, err)
+
+		_, err = s.DeleteSchedule(context.Background(), &api.JobScheduleDeleteRequest{Name: "nightly"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CreateSchedule_WithInvalidSchedule_ReturnsInvalidArgument(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		invalidCron := createTestSchedule("invalid", api.ConcurrencyPolicy_Allow)
+		invalidCron.Cron = "0 25 * * *"
+		_, err := s.CreateSchedule(context.Background(), invalidCron)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		withClientId := createTestSchedule("invalid", api.ConcurrencyPolicy_Allow)
+		withClientId.Template = createJobRequest("set", 1)
+		_, err = s.CreateSchedule(context.Background(), withClientId)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		withoutJobs := createTestSchedule("invalid", api.ConcurrencyPolicy_Allow)
+		withoutJobs.Template.JobRequestItems = nil
+		_, err = s.CreateSchedule(context.Background(), withoutJobs)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CreateSchedule_WhenPermissionsCheckFails_ReturnsPermissionDenied(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.permissions = &FakeDenyAllPermissionChecker{}
+
+		_, err := s.CreateSchedule(context.Background(), createTestSchedule("nightly", api.ConcurrencyPolicy_Allow))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CreateSchedule_WhenJobsCanNotBeScheduled_ReturnsInvalidArgument(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		schedule := createTestSchedule("nightly", api.ConcurrencyPolicy_Allow)
+		schedule.Template.JobRequestItems[0].PodSpecs[0].Containers[0].Resources.Limits["cpu"] = resource.MustParse("1000")
+		schedule.Template.JobRequestItems[0].PodSpecs[0].Containers[0].Resources.Requests["cpu"] = resource.MustParse("1000")
+
+		_, err := s.CreateSchedule(context.Background(), schedule)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_GetSchedules_WhenPermissionsCheckFails_ReturnsNoSchedules(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateSchedule(context.Background(), createTestSchedule("nightly", api.ConcurrencyPolicy_Allow))
+		assert.NoError(t, err)
+
+		s.permissions = &FakeDenyAllPermissionChecker{}
+		schedules, err := s.GetSchedules(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.Empty(t, schedules.Schedules)
+	})
+}
+
+func TestSubmitServer_FireSchedules_FiresOncePerTick(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		addDueSchedule(t, s, "every-minute", api.ConcurrencyPolicy_Allow)
+
+		s.FireSchedules()
+		firstJobIds := getActiveScheduledJobIds(t, s, "every-minute")
+		assert.Equal(t, 2, len(firstJobIds))
+
+		s.FireSchedules()
+		assert.ElementsMatch(t, firstJobIds, getActiveScheduledJobIds(t, s, "every-minute"))
+	})
+}
+
+func TestSubmitServer_FireSchedules_ConcurrencyPolicies(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		for _, policy := range []api.ConcurrencyPolicy{api.ConcurrencyPolicy_Allow, api.ConcurrencyPolicy_Forbid, api.ConcurrencyPolicy_Replace} {
+			addDueSchedule(t, s, policy.String(), policy)
+		}
+		s.FireSchedules()
+		firstJobIds := map[string][]string{}
+		for _, policy := range []api.ConcurrencyPolicy{api.ConcurrencyPolicy_Allow, api.ConcurrencyPolicy_Forbid, api.ConcurrencyPolicy_Replace} {
+			firstJobIds[policy.String()] = getActiveScheduledJobIds(t, s, policy.String())
+			resetLastFired(t, s, policy.String())
+		}
+
+		s.FireSchedules()
+
+		allowJobIds := getActiveScheduledJobIds(t, s, api.ConcurrencyPolicy_Allow.String())
+		assert.NotEqual(t, firstJobIds[api.ConcurrencyPolicy_Allow.String()], allowJobIds)
+		assert.Equal(t, 2, countActive(t, jobRepo, firstJobIds[api.ConcurrencyPolicy_Allow.String()]))
+
+		forbidJobIds := getActiveScheduledJobIds(t, s, api.ConcurrencyPolicy_Forbid.String())
+		assert.ElementsMatch(t, firstJobIds[api.ConcurrencyPolicy_Forbid.String()], forbidJobIds)
+
+		replaceJobIds := getActiveScheduledJobIds(t, s, api.ConcurrencyPolicy_Replace.String())
+		assert.NotEqual(t, firstJobIds[api.ConcurrencyPolicy_Replace.String()], replaceJobIds)
+		assert.Equal(t, 0, countActive(t, jobRepo, firstJobIds[api.ConcurrencyPolicy_Replace.String()]))
+		assert.Equal(t, 2, countActive(t, jobRepo, replaceJobIds))
+	})
+}
+
+func TestSubmitServer_FireSchedules_WhenOwnerLostPermission_DoesNotSubmit(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		addDueSchedule(t, s, "every-minute", api.ConcurrencyPolicy_Allow)
+		s.permissions = &FakeDenyAllPermissionChecker{}
+
+		s.FireSchedules()
+
+		assert.Empty(t, getActiveScheduledJobIds(t, s, "every-minute"))
+	})
+}
+
+func TestSubmitServer_FireSchedules_WhenSubmitFails_ReleasesTick(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		addDueSchedule(t, s, "every-minute", api.ConcurrencyPolicy_Allow)
+		lastFired, err := s.scheduleRepository.GetLastFiredTimes()
+		assert.NoError(t, err)
+		schedulingInfoRepository := s.schedulingInfoRepository
+		s.schedulingInfoRepository = &failingSchedulingInfoRepository{}
+
+		s.FireSchedules()
+		assert.Empty(t, getActiveScheduledJobIds(t, s, "every-minute"))
+		released, err := s.scheduleRepository.GetLastFiredTimes()
+		assert.NoError(t, err)
+		assert.Equal(t, lastFired["every-minute"].UnixNano(), released["every-minute"].UnixNano())
+
+		s.schedulingInfoRepository = schedulingInfoRepository
+		s.FireSchedules()
+		assert.Equal(t, 2, len(getActiveScheduledJobIds(t, s, "every-minute")))
+	})
+}
+
+func TestSubmitServer_SubmitJobs_WithNotBefore_JobIsDeferred(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		request := createJobRequest("set", 1)
+		notBefore := time.Now().Add(time.Hour)
+		request.JobRequestItems[0].NotBefore = &notBefore
+
+		response, err := s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+
+		statuses, err := s.GetJobStatus(context.Background(), &api.JobStatusRequest{JobIds: []string{response.JobResponseItems[0].JobId}})
+		assert.NoError(t, err)
+		assert.Equal(t, api.JobState_Deferred, statuses.Statuses[0].State)
+	})
+}
+
+func TestLatestDueTick(t *testing.T) {
+	hourly, err := cron.Parse("0 * * * *")
+	assert.NoError(t, err)
+	now := time.Date(2021, 3, 10, 14, 37, 0, 0, time.UTC)
+
+	_, due := latestDueTick(hourly, now.Add(-10*time.Minute), now)
+	assert.False(t, due)
+
+	tick, due := latestDueTick(hourly, now.Add(-time.Hour), now)
+	assert.True(t, due)
+	assert.Equal(t, time.Date(2021, 3, 10, 14, 0, 0, 0, time.UTC), tick)
+
+	tick, due = latestDueTick(hourly, now.Add(-24*time.Hour), now)
+	assert.True(t, due)
+	assert.Equal(t, time.Date(2021, 3, 10, 14, 0, 0, 0, time.UTC), tick)
+}
+
+func createTestSchedule(name string, policy api.ConcurrencyPolicy) *api.JobSchedule {
+	template := createJobRequest("scheduled-set", 2)
+	for _, item := range template.JobRequestItems {
+		item.ClientId = ""
+	}
+	return &api.JobSchedule{
+		Name:              name,
+		Cron:              "* * * * *",
+		Template:          template,
+		ConcurrencyPolicy: policy,
+	}
+}
+
+// Adds a schedule created two minutes ago, so it is due to fire
+func addDueSchedule(t *testing.T, s *SubmitServer, name string, policy api.ConcurrencyPolicy) {
+	schedule := createTestSchedule(name, policy)
+	schedule.Owner = "owner"
+	schedule.Created = time.Now().Add(-2 * time.Minute)
+	assert.NoError(t, s.scheduleRepository.CreateSchedule(schedule))
+}
+
+// Recreates the schedule, so its next tick is due again
+func resetLastFired(t *testing.T, s *SubmitServer, name string) {
+	schedule, err := s.scheduleRepository.GetSchedule(name)
+	assert.NoError(t, err)
+	jobIds, err := s.scheduleRepository.GetScheduledJobIds(name)
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.scheduleRepository.DeleteSchedule(name))
+	schedule.Created = time.Now().Add(-2 * time.Minute)
+	assert.NoError(t, s.scheduleRepository.CreateSchedule(schedule))
+	assert.NoError(t, s.scheduleRepository.SetScheduledJobIds(name, jobIds))
+}
+
+func getActiveScheduledJobIds(t *testing.T, s *SubmitServer, name string) []string {
+	jobs, err := s.getActiveScheduledJobs(name)
+	assert.NoError(t, err)
+	ids := []string{}
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+func countActive(t *testing.T, jobRepo repository.JobRepository, jobIds []string) int {
+	jobs, err := jobRepo.GetExistingJobsByIds(jobIds)
+	assert.NoError(t, err)
+	statuses, err := jobRepo.GetJobStatuses(jobs)
+	assert.NoError(t, err)
+	active := 0
+	for _, jobStatus := range statuses {
+		if jobStatus.State != api.JobState_Finished {
+			active++
+		}
+	}
+	return active
+}
+
+type failingSchedulingInfoRepository struct {
+	repository.SchedulingInfoRepository
+}
+
+func (r *failingSchedulingInfoRepository) GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error) {
+	return nil, errors.New("redis unavailable")
+}
@@ -2,7 +2,10 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	log "github.com/sirupsen/logrus"
@@ -12,8 +15,12 @@ import (
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/permissions"
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/common/auth/permission"
+	"github.com/G-Research/armada/internal/common/task"
+	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -23,7 +30,13 @@ type SubmitServer struct {
 	queueRepository          repository.QueueRepository
 	eventStore               repository.EventStore
 	schedulingInfoRepository repository.SchedulingInfoRepository
+	usageRepository          repository.UsageRepository
+	cordonRepository         repository.CordonRepository
+	scheduleRepository       repository.ScheduleRepository
 	queueManagementConfig    *configuration.QueueManagementConfig
+	schedulingConfig         *configuration.SchedulingConfig
+	jobStartTimesRecorded    bool
+	leader                   *task.LeaderElection
 }
 
 func NewSubmitServer(
@@ -32,7 +45,13 @@ func NewSubmitServer(
 	queueRepository repository.QueueRepository,
 	eventStore repository.EventStore,
 	schedulingInfoRepository repository.SchedulingInfoRepository,
-	queueManagementConfig *configuration.QueueManagementConfig) *SubmitServer {
+	usageRepository repository.UsageRepository,
+	cordonRepository repository.CordonRepository,
+	scheduleRepository repository.ScheduleRepository,
+	queueManagementConfig *configuration.QueueManagementConfig,
+	schedulingConfig *configuration.SchedulingConfig,
+	jobStartTimesRecorded bool,
+	leader *task.LeaderElection) *SubmitServer {
 
 	return &SubmitServer{
 		permissions:              permissions,
@@ -40,7 +59,13 @@ func NewSubmitServer(
 		queueRepository:          queueRepository,
 		eventStore:               eventStore,
 		schedulingInfoRepository: schedulingInfoRepository,
-		queueManagementConfig:    queueManagementConfig}
+		usageRepository:          usageRepository,
+		cordonRepository:         cordonRepository,
+		scheduleRepository:       scheduleRepository,
+		queueManagementConfig:    queueManagementConfig,
+		schedulingConfig:         schedulingConfig,
+		jobStartTimesRecorded:    jobStartTimesRecorded,
+		leader:                   leader}
 }
 
 func (server *SubmitServer) GetQueueInfo(ctx context.Context, req *api.QueueInfoRequest) (*api.QueueInfo, error) {
@@ -57,6 +82,183 @@ func (server *SubmitServer) GetQueueInfo(ctx context.Context, req *api.QueueInfo
 	}, nil
 }
 
+func (server *SubmitServer) ListQueues(ctx context.Context, req *api.QueueListRequest) (*api.QueueListResponse, error) {
+	if req.IncludeStatus {
+		if e := checkPermission(server.permissions, ctx, permissions.WatchAllEvents); e != nil {
+			return nil, e
+		}
+	}
+
+	allQueues, e := server.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load queues: %s", e.Error())
+	}
+	queues, nextCursor := pageQueues(filterQueues(allQueues, req), req.Cursor, req.Take)
+
+	items := make([]*api.QueueListItem, 0, len(queues))
+	for _, queue := range queues {
+		items = append(items, &api.QueueListItem{Queue: queue})
+	}
+	if req.IncludeStatus && len(queues) > 0 {
+		statuses, e := server.getQueueStatuses(queues)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, "Could not load queue status: %s", e.Error())
+		}
+		for i, item := range items {
+			item.Status = statuses[i]
+		}
+	}
+	return &api.QueueListResponse{Queues: items, NextCursor: nextCursor}, nil
+}
+
+func filterQueues(queues []*api.Queue, req *api.QueueListRequest) []*api.Queue {
+	result := []*api.Queue{}
+	for _, queue := range queues {
+		if !strings.HasPrefix(queue.Name, req.NamePrefix) {
+			continue
+		}
+		if req.Owner != "" && !util.ContainsString(queue.UserOwners, req.Owner) {
+			continue
+		}
+		if req.Group != "" && !util.ContainsString(queue.GroupOwners, req.Group) {
+			continue
+		}
+		result = append(result, queue)
+	}
+	return result
+}
+
+// The cursor is the name of the last queue of the previous page, so queues created meanwhile do not shift pages
+func pageQueues(queues []*api.Queue, cursor string, take uint32) ([]*api.Queue, string) {
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].Name < queues[j].Name
+	})
+	start := sort.Search(len(queues), func(i int) bool {
+		return queues[i].Name > cursor
+	})
+	queues = queues[start:]
+	if take == 0 || int(take) >= len(queues) {
+		return queues, ""
+	}
+	queues = queues[:take]
+	return queues, queues[len(queues)-1].Name
+}
+
+func (server *SubmitServer) getQueueStatuses(queues []*api.Queue) ([]*api.QueueStatus, error) {
+	queueSizes, e := server.jobRepository.GetQueueSizes(queues)
+	if e != nil {
+		return nil, e
+	}
+
+	usageReports, e := server.usageRepository.GetClusterUsageReports()
+	if e != nil {
+		return nil, e
+	}
+	activeClusterReports := scheduling.FilterActiveClusters(usageReports)
+	clusterPriorities, e := server.usageRepository.GetClusterPriorities(scheduling.GetClusterReportIds(activeClusterReports))
+	if e != nil {
+		return nil, e
+	}
+
+	statuses := make([]*api.QueueStatus, 0, len(queues))
+	statusByQueue := map[*api.Queue]*api.QueueStatus{}
+	for i, queue := range queues {
+		jobSets, e := server.jobRepository.GetQueueActiveJobSets(queue.Name)
+		if e != nil {
+			return nil, e
+		}
+		leasedJobs := int64(0)
+		for _, jobSet := range jobSets {
+			leasedJobs += int64(jobSet.LeasedJobs)
+		}
+		queueStatus := &api.QueueStatus{
+			QueuedJobs:    queueSizes[i],
+			LeasedJobs:    leasedJobs,
+			Priority:      map[string]float64{},
+			Usage:         common.ComputeResources{},
+			ActiveJobSets: jobSets,
+		}
+		statuses = append(statuses, queueStatus)
+		statusByQueue[queue] = queueStatus
+	}
+
+	// Priority is calculated per pool, the same way as for scheduling
+	for pool, poolReports := range scheduling.GroupByPool(activeClusterReports) {
+		poolPriorities := map[string]map[string]float64{}
+		for cluster := range poolReports {
+			poolPriorities[cluster] = clusterPriorities[cluster]
+		}
+		fairness := scheduling.NewFairness(server.schedulingConfig, pool, poolReports)
+		for queue, priority := range scheduling.CalculateQueuesPriorityInfo(fairness, poolPriorities, poolReports, queues) {
+			queueStatus := statusByQueue[queue]
+			queueStatus.Priority[pool] = priority.Priority
+			common.ComputeResources(queueStatus.Usage).Add(priority.CurrentUsage)
+		}
+	}
+	return statuses, nil
+}
+
+func (server *SubmitServer) GetJobs(ctx context.Context, req *api.JobGetRequest) (*api.JobGetResponse, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	jobs, e := server.jobRepository.GetExistingJobsByIds(req.JobIds)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load jobs: %s", e.Error())
+	}
+	return &api.JobGetResponse{Jobs: jobs}, nil
+}
+
+func (server *SubmitServer) GetJobStatus(ctx context.Context, req *api.JobStatusRequest) (*api.JobStatusResponse, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	jobs, e := server.jobRepository.GetExistingJobsByIds(req.JobIds)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load jobs: %s", e.Error())
+	}
+	statuses, e := server.jobRepository.GetJobStatuses(jobs)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load job status: %s", e.Error())
+	}
+	e = server.setThrottledReasons(jobs, statuses)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load running job counts: %s", e.Error())
+	}
+	return &api.JobStatusResponse{Statuses: statuses}, nil
+}
+
+// Explains why queued jobs are held back by max running limits of their queue or job set
+func (server *SubmitServer) setThrottledReasons(jobs []*api.Job, statuses []*api.JobStatus) error {
+	queues := map[string]*api.Queue{}
+	for i, job := range jobs {
+		if statuses[i].State != api.JobState_Queued {
+			continue
+		}
+		queue, ok := queues[job.Queue]
+		if !ok {
+			var e error
+			queue, e = server.queueRepository.GetQueue(job.Queue)
+			if e == repository.ErrQueueNotFound {
+				continue
+			} else if e != nil {
+				return e
+			}
+			queues[job.Queue] = queue
+		}
+		if queue.MaxRunning == 0 && job.JobSetMaxRunning == 0 {
+			continue
+		}
+
+		queueRunning, jobSetRunning, e := server.jobRepository.GetLeasedJobCounts(job.Queue, []string{job.JobSetId})
+		if e != nil {
+			return e
+		}
+		statuses[i].ThrottledReason = queue.ThrottledReason(job, queueRunning, jobSetRunning[job.JobSetId])
+	}
+	return nil
+}
+
 func (server *SubmitServer) GetQueue(ctx context.Context, req *api.QueueGetRequest) (*api.Queue, error) {
 	queue, e := server.queueRepository.GetQueue(req.Name)
 	if e == repository.ErrQueueNotFound {
@@ -83,6 +285,9 @@ func (server *SubmitServer) CreateQueue(ctx context.Context, queue *api.Queue) (
 		return nil, e
 	}
 
+	// Scheduling is only paused by PauseQueueScheduling
+	queue.SchedulingPaused = false
+
 	e = server.queueRepository.CreateQueue(queue)
 	if e == repository.ErrQueueAlreadyExists {
 		return nil, status.Errorf(codes.AlreadyExists, "Queue %q already exists", queue.Name)
@@ -102,7 +307,11 @@ func (server *SubmitServer) UpdateQueue(ctx context.Context, queue *api.Queue) (
 		return nil, e
 	}
 
-	e = server.queueRepository.UpdateQueue(queue)
+	// Scheduling is only paused by PauseQueueScheduling, the stored state is kept
+	e = server.queueRepository.ModifyQueue(queue.Name, func(existingQueue *api.Queue) {
+		queue.SchedulingPaused = existingQueue.SchedulingPaused
+		*existingQueue = *queue
+	})
 	if e == repository.ErrQueueNotFound {
 		return nil, status.Errorf(codes.NotFound, "Queue %q not found", queue.Name)
 	} else if e != nil {
@@ -111,6 +320,162 @@ func (server *SubmitServer) UpdateQueue(ctx context.Context, queue *api.Queue) (
 	return &types.Empty{}, nil
 }
 
+func (server *SubmitServer) PauseQueueScheduling(ctx context.Context, request *api.QueueSchedulingRequest) (*types.Empty, error) {
+	return server.setQueueSchedulingPaused(ctx, request.Name, true)
+}
+
+func (server *SubmitServer) ResumeQueueScheduling(ctx context.Context, request *api.QueueSchedulingRequest) (*types.Empty, error) {
+	return server.setQueueSchedulingPaused(ctx, request.Name, false)
+}
+
+func (server *SubmitServer) setQueueSchedulingPaused(ctx context.Context, queueName string, paused bool) (*types.Empty, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.ManageScheduling); e != nil {
+		return nil, e
+	}
+
+	e := server.queueRepository.ModifyQueue(queueName, func(queue *api.Queue) {
+		queue.SchedulingPaused = paused
+	})
+	if e == repository.ErrQueueNotFound {
+		return nil, status.Errorf(codes.NotFound, "Queue %q not found", queueName)
+	} else if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not update queue %q: %s", queueName, e.Error())
+	}
+	log.Infof("Scheduling of queue %s paused: %t, requested by %s", queueName, paused, authorization.GetPrincipal(ctx).GetName())
+	return &types.Empty{}, nil
+}
+
+func (server *SubmitServer) CordonCluster(ctx context.Context, request *api.ClusterCordonRequest) (*api.ClusterCordonResponse, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.ManageScheduling); e != nil {
+		return nil, e
+	}
+	if (request.ClusterId == "") == (request.Pool == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Specify either cluster id or pool")
+	}
+	if request.Drain && !server.jobStartTimesRecorded {
+		return nil, status.Errorf(codes.FailedPrecondition, "Clusters can only be drained when job start times are recorded from NATS or Kafka events")
+	}
+
+	principalName := authorization.GetPrincipal(ctx).GetName()
+	e := server.cordonRepository.Cordon(&api.ClusterCordon{
+		ClusterId: request.ClusterId,
+		Pool:      request.Pool,
+		Reason:    request.Reason,
+		Requestor: principalName,
+		Created:   time.Now(),
+	})
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	log.Infof("Cluster %q pool %q cordoned by %s: %s", request.ClusterId, request.Pool, principalName, request.Reason)
+
+	if !request.Drain {
+		return &api.ClusterCordonResponse{}, nil
+	}
+
+	clusterIds := []string{request.ClusterId}
+	if request.Pool != "" {
+		clusterIds, e = server.getPoolClusterIds(request.Pool)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+	}
+	drainedIds, e := server.drainClusters(clusterIds, fmt.Sprintf("Cluster drained by %s", principalName))
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+	return &api.ClusterCordonResponse{DrainedJobIds: drainedIds}, nil
+}
+
+func (server *SubmitServer) UncordonCluster(ctx context.Context, request *api.ClusterUncordonRequest) (*types.Empty, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.ManageScheduling); e != nil {
+		return nil, e
+	}
+	if (request.ClusterId == "") == (request.Pool == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Specify either cluster id or pool")
+	}
+
+	e := server.cordonRepository.Uncordon(request.ClusterId, request.Pool)
+	if e == repository.ErrCordonNotFound {
+		return nil, status.Errorf(codes.NotFound, "Cluster %q pool %q is not cordoned", request.ClusterId, request.Pool)
+	} else if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	log.Infof("Cluster %q pool %q uncordoned by %s", request.ClusterId, request.Pool, authorization.GetPrincipal(ctx).GetName())
+	return &types.Empty{}, nil
+}
+
+func (server *SubmitServer) GetClusterCordons(ctx context.Context, _ *types.Empty) (*api.ClusterCordonList, error) {
+	cordons, e := server.cordonRepository.GetCordons()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	sort.Slice(cordons, func(i, j int) bool {
+		return cordons[i].Created.Before(cordons[j].Created)
+	})
+	return &api.ClusterCordonList{Cordons: cordons}, nil
+}
+
+func (server *SubmitServer) getPoolClusterIds(pool string) ([]string, error) {
+	clusterSchedulingInfo, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, e
+	}
+	clusterIds := []string{}
+	for clusterId, info := range clusterSchedulingInfo {
+		if info.Pool == pool {
+			clusterIds = append(clusterIds, clusterId)
+		}
+	}
+	return clusterIds, nil
+}
+
+// Returns the leases of jobs which are leased by the clusters but not running yet, so they are leased again by
+// other clusters. Jobs are only known to be running when job start times are recorded from NATS or Kafka events, so
+// clusters are not drained otherwise.
+func (server *SubmitServer) drainClusters(clusterIds []string, reason string) ([]string, error) {
+	clusters := util.StringListToSet(clusterIds)
+	queues, e := server.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, e
+	}
+
+	drainedIds := []string{}
+	for _, queue := range queues {
+		leasedIds, e := server.jobRepository.GetLeasedJobIds(queue.Name)
+		if e != nil {
+			return drainedIds, e
+		}
+		jobs, e := server.jobRepository.GetExistingJobsByIds(leasedIds)
+		if e != nil {
+			return drainedIds, e
+		}
+		statuses, e := server.jobRepository.GetJobStatuses(jobs)
+		if e != nil {
+			return drainedIds, e
+		}
+
+		for i, jobStatus := range statuses {
+			if jobStatus.State != api.JobState_Leased || !clusters[jobStatus.ClusterId] {
+				continue
+			}
+			returned, e := server.jobRepository.ReturnLease(jobStatus.ClusterId, jobStatus.JobId)
+			if e != nil {
+				return drainedIds, e
+			}
+			if returned == nil {
+				continue
+			}
+			e = reportLeaseReturned(server.eventStore, jobStatus.ClusterId, reason, jobs[i])
+			if e != nil {
+				log.Warnf("Failed to report lease returned event for job %s: %v", jobStatus.JobId, e)
+			}
+			drainedIds = append(drainedIds, jobStatus.JobId)
+		}
+	}
+	return drainedIds, nil
+}
+
 func (server *SubmitServer) DeleteQueue(ctx context.Context, request *api.QueueDeleteRequest) (*types.Empty, error) {
 	if e := checkPermission(server.permissions, ctx, permissions.DeleteQueue); e != nil {
 		return nil, e
@@ -139,22 +504,16 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 	}
 
 	principal := authorization.GetPrincipal(ctx)
+	return server.submitJobs(req, principal.GetName(), ownershipGroups)
+}
 
-	jobs, e := server.jobRepository.CreateJobs(req, principal.GetName(), ownershipGroups)
-	if e != nil {
-		return nil, status.Errorf(codes.InvalidArgument, e.Error())
-	}
-
-	allClusterSchedulingInfo, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+// Submits the jobs on behalf of the owner, permissions are checked by the caller
+func (server *SubmitServer) submitJobs(req *api.JobSubmitRequest, owner string, ownershipGroups []string) (*api.JobSubmitResponse, error) {
+	jobs, e := server.createJobs(req, owner, ownershipGroups)
 	if e != nil {
 		return nil, e
 	}
 
-	e = validateJobsCanBeScheduled(jobs, allClusterSchedulingInfo)
-	if e != nil {
-		return nil, status.Errorf(codes.InvalidArgument, e.Error())
-	}
-
 	e = reportSubmitted(server.eventStore, jobs)
 	if e != nil {
 		return nil, status.Errorf(codes.Aborted, e.Error())
@@ -169,7 +528,7 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 		JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(submissionResults)),
 	}
 
-	createdJobs := []*api.Job{}
+	queuedJobs := []*api.Job{}
 	doubleSubmits := []*repository.SubmitJobResult{}
 	for i, submissionResult := range submissionResults {
 		jobResponse := &api.JobSubmitResponseItem{JobId: submissionResult.JobId}
@@ -181,8 +540,8 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 		if submissionResult.Error == nil {
 			if submissionResult.DuplicateDetected {
 				doubleSubmits = append(doubleSubmits, submissionResult)
-			} else {
-				createdJobs = append(createdJobs, jobs[i])
+			} else if !submissionResult.Deferred {
+				queuedJobs = append(queuedJobs, jobs[i])
 			}
 		}
 	}
@@ -192,13 +551,42 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 		return result, status.Errorf(codes.Internal, e.Error())
 	}
 
-	e = reportQueued(server.eventStore, createdJobs)
+	// Deferred jobs are reported as queued when they become due
+	e = reportQueued(server.eventStore, queuedJobs)
 	if e != nil {
 		return result, status.Errorf(codes.Internal, e.Error())
 	}
 	return result, nil
 }
 
+// Creates the jobs of the request without storing them, and validates they can be scheduled
+func (server *SubmitServer) createJobs(req *api.JobSubmitRequest, owner string, ownershipGroups []string) ([]*api.Job, error) {
+	jobs, e := server.jobRepository.CreateJobs(req, owner, ownershipGroups)
+	if e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+
+	allClusterSchedulingInfo, e := server.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if e != nil {
+		return nil, e
+	}
+
+	e = validateJobsCanBeScheduled(jobs, allClusterSchedulingInfo)
+	if e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+
+	queue, e := server.queueRepository.GetQueue(req.Queue)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not load queue %q: %s", req.Queue, e.Error())
+	}
+	e = validateJobsOvercommit(queue, jobs)
+	if e != nil {
+		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+	}
+	return jobs, nil
+}
+
 func (server *SubmitServer) CancelJobs(ctx context.Context, request *api.JobCancelRequest) (*api.CancellationResult, error) {
 	if request.JobId != "" {
 		jobs, e := server.jobRepository.GetExistingJobsByIds([]string{request.JobId})
@@ -253,6 +641,120 @@ func (server *SubmitServer) cancelJobs(ctx context.Context, queue string, jobs [
 	return &api.CancellationResult{cancelledIds}, nil
 }
 
+// Returns mapping from job id to error (if present), for all selected jobs
+func (server *SubmitServer) HoldJobs(ctx context.Context, request *api.JobHoldRequest) (*api.JobHoldResponse, error) {
+	jobs, e := server.selectJobs(request.JobIds, request.Queue, request.JobSetId, request.Labels, server.jobRepository.GetQueueJobIds)
+	if e != nil {
+		return nil, e
+	}
+	e = server.checkHoldPerms(ctx, jobs)
+	if e != nil {
+		return nil, e
+	}
+
+	results, held := holdResults(server.jobRepository.HoldJobs(jobs))
+
+	e = reportJobsHeld(server.eventStore, authorization.GetPrincipal(ctx).GetName(), held)
+	if e != nil {
+		return nil, status.Errorf(codes.Unknown, e.Error())
+	}
+	return &api.JobHoldResponse{HoldResults: results}, nil
+}
+
+// Returns mapping from job id to error (if present), for all selected jobs
+func (server *SubmitServer) ReleaseJobs(ctx context.Context, request *api.JobReleaseRequest) (*api.JobReleaseResponse, error) {
+	jobs, e := server.selectJobs(request.JobIds, request.Queue, request.JobSetId, request.Labels, server.jobRepository.GetHeldJobIds)
+	if e != nil {
+		return nil, e
+	}
+	e = server.checkHoldPerms(ctx, jobs)
+	if e != nil {
+		return nil, e
+	}
+
+	results, released := holdResults(server.jobRepository.ReleaseJobs(jobs))
+
+	e = reportJobsReleased(server.eventStore, authorization.GetPrincipal(ctx).GetName(), released)
+	if e != nil {
+		return nil, status.Errorf(codes.Unknown, e.Error())
+	}
+	return &api.JobReleaseResponse{ReleaseResults: results}, nil
+}
+
+// Selects jobs by id, or jobs of a queue by job set and/or labels. Without a job set, the jobs of the queue are
+// looked up with queueJobIds.
+func (server *SubmitServer) selectJobs(
+	jobIds []string,
+	queue string,
+	jobSetId string,
+	labels map[string]string,
+	queueJobIds func(queue string) ([]string, error)) ([]*api.Job, error) {
+
+	if len(jobIds) == 0 {
+		if queue == "" || (jobSetId == "" && len(labels) == 0) {
+			return nil, status.Errorf(codes.InvalidArgument, "Specify job ids or queue with job set id and/or labels")
+		}
+		var e error
+		if jobSetId != "" {
+			jobIds, e = server.jobRepository.GetActiveJobIds(queue, jobSetId)
+		} else {
+			jobIds, e = queueJobIds(queue)
+		}
+		if e != nil {
+			return nil, status.Errorf(codes.Aborted, e.Error())
+		}
+	}
+
+	jobs, e := server.jobRepository.GetExistingJobsByIds(jobIds)
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+
+	selected := []*api.Job{}
+	for _, job := range jobs {
+		if hasLabels(job, labels) {
+			selected = append(selected, job)
+		}
+	}
+	return selected, nil
+}
+
+func hasLabels(job *api.Job, labels map[string]string) bool {
+	for key, value := range labels {
+		if jobValue, ok := job.Labels[key]; !ok || jobValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+func holdResults(moveResults map[*api.Job]error) (map[string]string, []*api.Job) {
+	results := map[string]string{}
+	moved := []*api.Job{}
+	for job, e := range moveResults {
+		if e != nil {
+			results[job.Id] = e.Error()
+		} else {
+			results[job.Id] = ""
+			moved = append(moved, job)
+		}
+	}
+	return results, moved
+}
+
+func (server *SubmitServer) checkHoldPerms(ctx context.Context, jobs []*api.Job) error {
+	queues := make(map[string]bool)
+	for _, job := range jobs {
+		queues[job.Queue] = true
+	}
+	for queue := range queues {
+		if e, _ := server.checkQueuePermission(ctx, queue, false, permissions.HoldJobs, permissions.HoldAnyJobs); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
 // Returns mapping from job id to error (if present), for all existing jobs
 func (server *SubmitServer) ReprioritizeJobs(ctx context.Context, request *api.JobReprioritizeRequest) (*api.JobReprioritizeResponse, error) {
 	var jobs []*api.Job
@@ -392,5 +894,11 @@ func validateQueue(queue *api.Queue) error {
 	if queue.PriorityFactor < 1.0 {
 		return status.Errorf(codes.InvalidArgument, "Minimum queue priority factor is 1.")
 	}
+	if queue.PriorityAgingRate < 0 || queue.PriorityAgingCap < 0 {
+		return status.Errorf(codes.InvalidArgument, "Queue priority aging rate and cap must not be negative.")
+	}
+	if queue.MaxOvercommitRatio != 0 && queue.MaxOvercommitRatio < 1.0 {
+		return status.Errorf(codes.InvalidArgument, "Queue max overcommit ratio must be 0 (not capped) or at least 1.")
+	}
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -49,6 +50,23 @@ func TestSubmitServer_CreateQueue_WithCustomSettings_CanBeReadBack(t *testing.T)
 	})
 }
 
+func TestSubmitServer_CreateQueue_WithNegativePriorityAging_ReturnsInvalidArgument(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "myQueue", PriorityFactor: 1, PriorityAgingRate: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "myQueue", PriorityFactor: 1, PriorityAgingRate: 1, PriorityAgingCap: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CreateQueue_WithMaxOvercommitRatioBelowOne_ReturnsInvalidArgument(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "myQueue", PriorityFactor: 1, MaxOvercommitRatio: 0.5})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestSubmitServer_CreateQueue_WhenQueueAlreadyExists_QueueIsNotChanged_AndReturnsAlreadyExists(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
 		const queueName = "myQueue"
@@ -165,6 +183,303 @@ func TestSubmitServer_GetQueueInfo_WhenPermissionsCheckFails_ReturnsPermissionDe
 	})
 }
 
+func TestSubmitServer_ListQueues_FiltersAndPages(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		for _, queue := range []*api.Queue{
+			{Name: "team-b", PriorityFactor: 1, UserOwners: []string{"alice"}},
+			{Name: "team-a", PriorityFactor: 1, UserOwners: []string{"alice"}, GroupOwners: []string{"research"}},
+			{Name: "other", PriorityFactor: 1, UserOwners: []string{"alice"}},
+			{Name: "team-c", PriorityFactor: 1, UserOwners: []string{"bob"}},
+		} {
+			_, err := s.CreateQueue(context.Background(), queue)
+			assert.NoError(t, err)
+		}
+
+		response, err := s.ListQueues(context.Background(), &api.QueueListRequest{Owner: "alice", NamePrefix: "team-", Take: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"team-a"}, listedQueueNames(response))
+		assert.Nil(t, response.Queues[0].Status)
+
+		response, err = s.ListQueues(context.Background(), &api.QueueListRequest{Owner: "alice", NamePrefix: "team-", Take: 1, Cursor: response.NextCursor})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"team-b"}, listedQueueNames(response))
+		assert.Empty(t, response.NextCursor)
+
+		response, err = s.ListQueues(context.Background(), &api.QueueListRequest{Group: "research"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"team-a"}, listedQueueNames(response))
+	})
+}
+
+func TestSubmitServer_ListQueues_IncludesStatus(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		assert.NoError(t, err)
+
+		response, err := s.ListQueues(context.Background(), &api.QueueListRequest{NamePrefix: "test", IncludeStatus: true})
+		assert.NoError(t, err)
+		assert.Len(t, response.Queues, 1)
+
+		queueStatus := response.Queues[0].Status
+		assert.Equal(t, int64(2), queueStatus.QueuedJobs)
+		assert.Equal(t, int64(0), queueStatus.LeasedJobs)
+		assert.Equal(t, []*api.JobSetInfo{{Name: jobSetId, QueuedJobs: 2}}, queueStatus.ActiveJobSets)
+	})
+}
+
+func TestSubmitServer_ListQueues_WithStatus_WhenPermissionsCheckFails_ReturnsPermissionDenied(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.permissions = &FakeDenyAllPermissionChecker{}
+
+		_, err := s.ListQueues(context.Background(), &api.QueueListRequest{IncludeStatus: true})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		response, err := s.ListQueues(context.Background(), &api.QueueListRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"test"}, listedQueueNames(response))
+	})
+}
+
+func TestSubmitServer_GetJobsAndStatus(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		submitted, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
+		assert.NoError(t, err)
+		jobId := submitted.JobResponseItems[0].JobId
+
+		jobsResponse, err := s.GetJobs(context.Background(), &api.JobGetRequest{JobIds: []string{jobId, "missing"}})
+		assert.NoError(t, err)
+		assert.Len(t, jobsResponse.Jobs, 1)
+		assert.Equal(t, jobId, jobsResponse.Jobs[0].Id)
+		assert.Equal(t, jobSetId, jobsResponse.Jobs[0].JobSetId)
+
+		statusResponse, err := s.GetJobStatus(context.Background(), &api.JobStatusRequest{JobIds: []string{jobId}})
+		assert.NoError(t, err)
+		assert.Equal(t, []*api.JobStatus{{JobId: jobId, Queue: "test", JobSetId: jobSetId, State: api.JobState_Queued}}, statusResponse.Statuses)
+	})
+}
+
+func TestSubmitServer_GetJobStatus_WhenJobSetReachedMaxRunning_IncludesThrottledReason(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 2)
+		jobRequest.MaxRunning = 1
+		submitted, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+		leasedJobId := submitted.JobResponseItems[0].JobId
+		queuedJobId := submitted.JobResponseItems[1].JobId
+
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{leasedJobId})
+		assert.NoError(t, err)
+		leased, err := jobRepo.TryLeaseJobs("test-cluster", "test", jobs)
+		assert.NoError(t, err)
+		assert.Len(t, leased, 1)
+
+		statusResponse, err := s.GetJobStatus(context.Background(), &api.JobStatusRequest{JobIds: []string{leasedJobId, queuedJobId}})
+		assert.NoError(t, err)
+		assert.Equal(t, api.JobState_Leased, statusResponse.Statuses[0].State)
+		assert.Equal(t, "", statusResponse.Statuses[0].ThrottledReason)
+		assert.Equal(t, api.JobState_Queued, statusResponse.Statuses[1].State)
+		assert.Equal(t, fmt.Sprintf("job set %s reached its limit of 1 running jobs", jobSetId), statusResponse.Statuses[1].ThrottledReason)
+	})
+}
+
+func TestSubmitServer_GetJobs_WhenPermissionsCheckFails_ReturnsPermissionDenied(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.permissions = &FakeDenyAllPermissionChecker{}
+
+		_, err := s.GetJobs(context.Background(), &api.JobGetRequest{JobIds: []string{"id"}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = s.GetJobStatus(context.Background(), &api.JobStatusRequest{JobIds: []string{"id"}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_HoldAndReleaseJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 2)
+		jobRequest.JobRequestItems[0].Labels = map[string]string{"hold": "true"}
+		submitted, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+		heldJobId := submitted.JobResponseItems[0].JobId
+
+		holdResponse, err := s.HoldJobs(context.Background(), &api.JobHoldRequest{
+			Queue:    "test",
+			JobSetId: jobSetId,
+			Labels:   map[string]string{"hold": "true"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{heldJobId: ""}, holdResponse.HoldResults)
+
+		queued, err := jobRepo.PeekQueue("test", 100)
+		assert.NoError(t, err)
+		assert.Len(t, queued, 1)
+		assert.NotEqual(t, heldJobId, queued[0].Id)
+
+		releaseResponse, err := s.ReleaseJobs(context.Background(), &api.JobReleaseRequest{JobIds: []string{heldJobId}})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{heldJobId: ""}, releaseResponse.ReleaseResults)
+
+		queued, err = jobRepo.PeekQueue("test", 100)
+		assert.NoError(t, err)
+		assert.Len(t, queued, 2)
+
+		messages, err := readJobEvents(events, jobSetId)
+		assert.NoError(t, err)
+		assert.NotNil(t, messages[len(messages)-2].Message.GetHeld())
+		assert.NotNil(t, messages[len(messages)-1].Message.GetReleased())
+	})
+}
+
+func TestSubmitServer_HoldJobs_WithoutJobSelector_ReturnsInvalidArgument(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.HoldJobs(context.Background(), &api.JobHoldRequest{Queue: "test"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.ReleaseJobs(context.Background(), &api.JobReleaseRequest{JobSetId: "job-set"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_HoldJobs_WhenPermissionsCheckFails_JobIsNotHeld_AndReturnsPermissionDenied(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		submitted, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+		jobId := submitted.JobResponseItems[0].JobId
+
+		s.permissions = &FakeDenyAllPermissionChecker{}
+
+		_, err = s.HoldJobs(context.Background(), &api.JobHoldRequest{JobIds: []string{jobId}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		queued, err := jobRepo.PeekQueue("test", 100)
+		assert.NoError(t, err)
+		assert.Len(t, queued, 1)
+	})
+}
+
+func TestSubmitServer_PauseAndResumeQueueScheduling(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.PauseQueueScheduling(context.Background(), &api.QueueSchedulingRequest{Name: "test"})
+		assert.NoError(t, err)
+
+		queue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		assert.NoError(t, err)
+		assert.True(t, queue.SchedulingPaused)
+
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: "test", PriorityFactor: 2})
+		assert.NoError(t, err)
+
+		queue, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		assert.NoError(t, err)
+		assert.True(t, queue.SchedulingPaused)
+
+		_, err = s.ResumeQueueScheduling(context.Background(), &api.QueueSchedulingRequest{Name: "test"})
+		assert.NoError(t, err)
+
+		queue, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		assert.NoError(t, err)
+		assert.False(t, queue.SchedulingPaused)
+	})
+}
+
+func TestSubmitServer_PauseQueueScheduling_WhenQueueDoesNotExist_ReturnsNotFound(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.PauseQueueScheduling(context.Background(), &api.QueueSchedulingRequest{Name: "non-existent"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CordonAndUncordonCluster(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CordonCluster(context.Background(), &api.ClusterCordonRequest{ClusterId: "test-cluster", Reason: "maintenance"})
+		assert.NoError(t, err)
+
+		cordons, err := s.GetClusterCordons(context.Background(), &types.Empty{})
+		assert.NoError(t, err)
+		assert.Len(t, cordons.Cordons, 1)
+		assert.Equal(t, "test-cluster", cordons.Cordons[0].ClusterId)
+		assert.Equal(t, "maintenance", cordons.Cordons[0].Reason)
+
+		_, err = s.UncordonCluster(context.Background(), &api.ClusterUncordonRequest{ClusterId: "test-cluster"})
+		assert.NoError(t, err)
+
+		_, err = s.UncordonCluster(context.Background(), &api.ClusterUncordonRequest{ClusterId: "test-cluster"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		cordons, err = s.GetClusterCordons(context.Background(), &types.Empty{})
+		assert.NoError(t, err)
+		assert.Empty(t, cordons.Cordons)
+	})
+}
+
+func TestSubmitServer_CordonCluster_WithoutSingleTarget_ReturnsInvalidArgument(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CordonCluster(context.Background(), &api.ClusterCordonRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.CordonCluster(context.Background(), &api.ClusterCordonRequest{ClusterId: "test-cluster", Pool: "pool"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CordonCluster_WhenPermissionsCheckFails_ReturnsPermissionDenied(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		s.permissions = &FakeDenyAllPermissionChecker{}
+
+		_, err := s.CordonCluster(context.Background(), &api.ClusterCordonRequest{ClusterId: "test-cluster"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = s.PauseQueueScheduling(context.Background(), &api.QueueSchedulingRequest{Name: "test"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_CordonCluster_WithDrain_WhenJobStartTimesAreNotRecorded_ReturnsFailedPrecondition(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.CordonCluster(context.Background(), &api.ClusterCordonRequest{ClusterId: "test-cluster", Drain: true})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		cordons, err := s.GetClusterCordons(context.Background(), &types.Empty{})
+		assert.NoError(t, err)
+		assert.Empty(t, cordons.Cordons)
+	})
+}
+
+func TestSubmitServer_CordonCluster_WithDrain_ReturnsLeasesOfJobsNotStarted(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events repository.EventRepository) {
+		s.jobStartTimesRecorded = true
+
+		submitted, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		assert.NoError(t, err)
+		startedJobId := submitted.JobResponseItems[0].JobId
+		notStartedJobId := submitted.JobResponseItems[1].JobId
+
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{startedJobId, notStartedJobId})
+		assert.NoError(t, err)
+		leased, err := jobRepo.TryLeaseJobs("test-cluster", "test", jobs)
+		assert.NoError(t, err)
+		assert.Len(t, leased, 2)
+		err = jobRepo.UpdateStartTime(startedJobId, "test-cluster", time.Now())
+		assert.NoError(t, err)
+
+		response, err := s.CordonCluster(context.Background(), &api.ClusterCordonRequest{ClusterId: "test-cluster", Drain: true})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{notStartedJobId}, response.DrainedJobIds)
+	})
+}
+
+func listedQueueNames(response *api.QueueListResponse) []string {
+	names := []string{}
+	for _, item := range response.Queues {
+		names = append(names, item.Queue.Name)
+	}
+	return names
+}
+
 func TestSubmitServer_SubmitJob(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
 		jobSetId := util.NewULID()
@@ -199,6 +514,46 @@ func TestSubmitServer_SubmitJob_WhenPodCannotBeScheduled(t *testing.T) {
 	})
 }
 
+func TestSubmitServer_SubmitJob_WhenPodCannotBeScheduledInAllowedPools(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].AllowedPools = []string{"gpu"}
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "not schedulable on any cluster in allowed pools gpu")
+
+		jobRequest = createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].AllowedClusters = []string{"other-cluster", "test-cluster"}
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		job, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"other-cluster", "test-cluster"}, job[0].AllowedClusters)
+	})
+}
+
+func TestSubmitServer_SubmitJob_WithBurstablePod_RespectsQueueMaxOvercommitRatio(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
+		_, err := s.UpdateQueue(context.Background(), &api.Queue{Name: "test", PriorityFactor: 1, MaxOvercommitRatio: 2})
+		assert.NoError(t, err)
+
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		resources := &jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Resources
+		resources.Limits = v1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("1Gi")}
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.NoError(t, err)
+
+		jobRequest = createJobRequest(util.NewULID(), 1)
+		resources = &jobRequest.JobRequestItems[0].PodSpecs[0].Containers[0].Resources
+		resources.Limits = v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("2Gi")}
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestSubmitServer_SubmitJob_AddsExpectedEventsInCorrectOrder(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events repository.EventRepository) {
 		jobSetId := util.NewULID()
@@ -552,7 +907,9 @@ func withSubmitServerAndRepos(action func(s *SubmitServer, jobRepo repository.Jo
 	queueRepo := repository.NewRedisQueueRepository(client)
 	eventRepo := repository.NewRedisEventRepository(client, configuration.EventRetentionPolicy{ExpiryEnabled: false})
 	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(client)
-	server := NewSubmitServer(&FakePermissionChecker{}, jobRepo, queueRepo, eventRepo, schedulingInfoRepository, &configuration.QueueManagementConfig{DefaultPriorityFactor: 1})
+	usageRepository := repository.NewRedisUsageRepository(client)
+	server := NewSubmitServer(&FakePermissionChecker{}, jobRepo, queueRepo, eventRepo, schedulingInfoRepository, usageRepository, repository.NewRedisCordonRepository(client),
+		repository.NewRedisScheduleRepository(client), &configuration.QueueManagementConfig{DefaultPriorityFactor: 1}, &configuration.SchedulingConfig{}, false, nil)
 
 	err := queueRepo.CreateQueue(&api.Queue{Name: "test"})
 	if err != nil {
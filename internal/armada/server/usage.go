@@ -59,14 +59,11 @@ func (s *UsageServer) ReportUsage(ctx context.Context, report *api.ClusterUsageR
 
 	previousReport := reports[report.ClusterId]
 
-	resourceScarcity := s.schedulingConfig.GetResourceScarcity(report.Pool)
-	if resourceScarcity == nil {
-		reports[report.ClusterId] = report
-		activeClusterReports := scheduling.FilterActiveClusters(reports)
-		activePoolClusterReports := scheduling.FilterPoolClusters(report.Pool, activeClusterReports)
-		resourceScarcity = scheduling.ResourceScarcityFromReports(activePoolClusterReports)
-	}
-	newPriority := scheduling.CalculatePriorityUpdate(resourceScarcity, previousReport, report, previousPriority, s.priorityHalfTime)
+	reports[report.ClusterId] = report
+	activeClusterReports := scheduling.FilterActiveClusters(reports)
+	activePoolClusterReports := scheduling.FilterPoolClusters(report.Pool, activeClusterReports)
+	fairness := scheduling.NewFairness(s.schedulingConfig, report.Pool, activePoolClusterReports)
+	newPriority := scheduling.CalculatePriorityUpdate(fairness, previousReport, report, previousPriority, s.priorityHalfTime)
 	filteredPriority := filterPriority(queues, newPriority)
 
 	err = s.usageRepository.UpdateCluster(report, filteredPriority)
@@ -0,0 +1,47 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_InvalidExpressions(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestNext(t *testing.T) {
+	from := time.Date(2021, 3, 10, 14, 37, 20, 0, time.UTC) // Wednesday
+
+	tests := map[string]time.Time{
+		"* * * * *":        time.Date(2021, 3, 10, 14, 38, 0, 0, time.UTC),
+		"*/15 * * * *":     time.Date(2021, 3, 10, 14, 45, 0, 0, time.UTC),
+		"5/20 * * * *":     time.Date(2021, 3, 10, 14, 45, 0, 0, time.UTC),
+		"0 * * * *":        time.Date(2021, 3, 10, 15, 0, 0, 0, time.UTC),
+		"30 2 * * *":       time.Date(2021, 3, 11, 2, 30, 0, 0, time.UTC),
+		"0 9-17 * * 1-5":   time.Date(2021, 3, 10, 15, 0, 0, 0, time.UTC),
+		"0 0 * * 0":        time.Date(2021, 3, 14, 0, 0, 0, 0, time.UTC),
+		"0 0 * * 7":        time.Date(2021, 3, 14, 0, 0, 0, 0, time.UTC),
+		"0 0 1 * *":        time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 1,15 * 6":     time.Date(2021, 3, 13, 0, 0, 0, 0, time.UTC),
+		"0 0 29 2 *":       time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		"0 12 31 12 *":     time.Date(2021, 12, 31, 12, 0, 0, 0, time.UTC),
+		"37 14 10 3 *":     time.Date(2022, 3, 10, 14, 37, 0, 0, time.UTC),
+		"0,30 14,16 * * *": time.Date(2021, 3, 10, 16, 0, 0, 0, time.UTC),
+	}
+	for spec, expected := range tests {
+		schedule, err := Parse(spec)
+		assert.NoError(t, err, spec)
+		assert.Equal(t, expected, schedule.Next(from), spec)
+	}
+}
+
+func TestNext_WhenNeverMatching_ReturnsZeroTime(t *testing.T) {
+	schedule, err := Parse("0 0 30 2 *")
+	assert.NoError(t, err)
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
@@ -0,0 +1,87 @@
+package eventstream
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/G-Research/armada/internal/common/health"
+	stanUtil "github.com/G-Research/armada/internal/common/stan-util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	TransportStan      = "stan"
+	TransportJetStream = "jetstream"
+)
+
+type JetStreamConfig struct {
+	// Name of the stream, created on startup if it does not exist
+	StreamName string
+	Replicas   int
+	// Maximum age of events kept in the stream, unlimited if 0
+	MaxAge time.Duration
+	// Window in which events published again with the same deduplication key are discarded
+	DuplicateWindow time.Duration
+	// Time after which an unacknowledged event is delivered again
+	AckWait time.Duration
+	// Number of deliveries after which an event is moved to the dead letter subject, unlimited if 0
+	MaxDeliver int
+	// Subject of the dead letter stream, must not overlap with the event subjects
+	DeadLetterSubject string
+}
+
+// Message is an event received from the stream, which is delivered again unless acknowledged
+type Message struct {
+	EventMessage *api.EventMessage
+	Timestamp    time.Time
+	Ack          func() error
+}
+
+type MessageHandler func(message *Message)
+
+type subscribeOptions struct {
+	maxInflight int
+}
+
+type SubscribeOption func(options *subscribeOptions)
+
+// MaxInflight limits the number of messages delivered to a subscriber which are not yet acknowledged
+func MaxInflight(maxInflight int) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.maxInflight = maxInflight
+	}
+}
+
+// EventStream publishes events and delivers them to durable subscriptions shared by each queue group
+type EventStream interface {
+	health.Checker
+	// Publish returns once all messages are persisted by the stream
+	Publish(messages []*api.EventMessage) error
+	QueueSubscribe(queueGroup string, handler MessageHandler, options ...SubscribeOption) error
+	Close() error
+}
+
+// Connect creates an event stream using the given transport, STAN when transport is empty
+func Connect(transport string, servers []string, clusterID string, clientID string, subject string, jetStreamConfig JetStreamConfig) (EventStream, error) {
+	urls := strings.Join(servers, ",")
+	switch transport {
+	case "", TransportStan:
+		conn, err := stanUtil.DurableConnect(clusterID, clientID, urls)
+		if err != nil {
+			return nil, err
+		}
+		return NewStanEventStream(conn, subject), nil
+	case TransportJetStream:
+		return NewJetStreamEventStream(urls, clientID, subject, jetStreamConfig)
+	}
+	return nil, fmt.Errorf("unknown event stream transport: %q", transport)
+}
+
+func applyOptions(options []SubscribeOption) *subscribeOptions {
+	result := &subscribeOptions{}
+	for _, option := range options {
+		option(result)
+	}
+	return result
+}
@@ -0,0 +1,252 @@
+package eventstream
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nats-io/nats.go"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	deadLetterStreamSuffix = "DeadLetter"
+	originalSubjectHeader  = "Armada-Original-Subject"
+	numDeliveredHeader     = "Armada-Num-Delivered"
+	emptyQueueToken        = "_"
+)
+
+// JetStreamEventStream publishes events to a subject per queue, <subject>.<queue>, so consumers can filter by queue.
+// Events which are delivered more than MaxDeliver times are moved to the dead letter subject.
+type JetStreamEventStream struct {
+	conn    *nats.Conn
+	js      nats.JetStreamContext
+	subject string
+	config  JetStreamConfig
+
+	subscriptions []*nats.Subscription
+}
+
+func NewJetStreamEventStream(urls string, clientID string, subject string, config JetStreamConfig) (*JetStreamEventStream, error) {
+	if config.StreamName == "" {
+		return nil, errors.New("jetstream stream name must be set")
+	}
+	if config.DeadLetterSubject != "" && strings.HasPrefix(config.DeadLetterSubject, subject+".") {
+		return nil, errors.New("jetstream dead letter subject must not be one of the event subjects")
+	}
+
+	// Subscriptions of a connection are renewed when it reconnects, durable consumers keep their position on the server
+	conn, err := nats.Connect(urls,
+		nats.Name(clientID),
+		nats.MaxReconnects(-1),
+		nats.ReconnectBufSize(-1))
+	if err != nil {
+		return nil, err
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	stream := &JetStreamEventStream{conn: conn, js: js, subject: subject, config: config}
+	err = stream.ensureStreams()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (s *JetStreamEventStream) Publish(messages []*api.EventMessage) error {
+	futures := make([]nats.PubAckFuture, 0, len(messages))
+	for _, m := range messages {
+		event, err := api.UnwrapEvent(m)
+		if err != nil {
+			return err
+		}
+		messageData, err := proto.Marshal(m)
+		if err != nil {
+			log.Errorf("Error while marshaling event: %v", err)
+			return err
+		}
+		options := []nats.PubOpt{nats.ExpectStream(s.config.StreamName)}
+		if m.DeduplicationKey != "" {
+			options = append(options, nats.MsgId(m.DeduplicationKey))
+		}
+		future, err := s.js.PublishAsync(queueSubject(s.subject, event.GetQueue()), messageData, options...)
+		if err != nil {
+			log.Errorf("Error while sending event to queue: %v", err)
+			return err
+		}
+		futures = append(futures, future)
+	}
+
+	var lastError error
+	for _, future := range futures {
+		select {
+		case <-future.Ok():
+		case err := <-future.Err():
+			log.Errorf("Error while publishing event to queue: %v", err)
+			lastError = err
+		}
+	}
+	return lastError
+}
+
+func (s *JetStreamEventStream) QueueSubscribe(queueGroup string, handler MessageHandler, options ...SubscribeOption) error {
+	subscriptionOptions := []nats.SubOpt{
+		nats.BindStream(s.config.StreamName),
+		nats.Durable(queueGroup),
+		nats.DeliverAll(),
+		nats.AckExplicit(),
+		nats.ManualAck(),
+	}
+	if s.config.AckWait > 0 {
+		subscriptionOptions = append(subscriptionOptions, nats.AckWait(s.config.AckWait))
+	}
+	if s.config.MaxDeliver > 0 && s.config.DeadLetterSubject == "" {
+		// Without a dead letter subject the server stops delivering the event
+		subscriptionOptions = append(subscriptionOptions, nats.MaxDeliver(s.config.MaxDeliver))
+	}
+	if maxInflight := applyOptions(options).maxInflight; maxInflight > 0 {
+		subscriptionOptions = append(subscriptionOptions, nats.MaxAckPending(maxInflight))
+	}
+
+	subscription, err := s.js.QueueSubscribe(s.subject+".>", queueGroup, func(msg *nats.Msg) {
+		s.handleMessage(msg, handler)
+	}, subscriptionOptions...)
+	if err != nil {
+		return err
+	}
+	s.subscriptions = append(s.subscriptions, subscription)
+	return nil
+}
+
+func (s *JetStreamEventStream) handleMessage(msg *nats.Msg, handler MessageHandler) {
+	metadata, err := msg.Metadata()
+	if err != nil {
+		log.Errorf("Error while reading jetstream message metadata: %v", err)
+		// Without metadata the message can not be handled, terminate it so it is not redelivered forever
+		err = msg.Term()
+		if err != nil {
+			log.Errorf("Error while terminating nats message: %v", err)
+		}
+		return
+	}
+	if exceedsMaxDeliver(metadata.NumDelivered, s.config.MaxDeliver) {
+		log.Errorf("Event %s was delivered %d times, moving it to the dead letter subject", msg.Subject, metadata.NumDelivered)
+		s.deadLetter(msg, metadata.NumDelivered)
+		return
+	}
+
+	eventMessage := &api.EventMessage{}
+	err = proto.Unmarshal(msg.Data, eventMessage)
+	if err != nil {
+		log.Errorf("Error while unmarshaling nats message: %v", err)
+		s.deadLetter(msg, metadata.NumDelivered)
+		return
+	}
+	handler(&Message{
+		EventMessage: eventMessage,
+		Timestamp:    metadata.Timestamp,
+		Ack: func() error {
+			return msg.Ack()
+		},
+	})
+}
+
+// Terminates delivery of the message once it is stored in the dead letter stream, if one is configured
+func (s *JetStreamEventStream) deadLetter(msg *nats.Msg, numDelivered uint64) {
+	if s.config.DeadLetterSubject != "" {
+		_, err := s.js.PublishMsg(&nats.Msg{
+			Subject: s.config.DeadLetterSubject,
+			Data:    msg.Data,
+			Header: nats.Header{
+				originalSubjectHeader: []string{msg.Subject},
+				numDeliveredHeader:    []string{strconv.FormatUint(numDelivered, 10)},
+			},
+		})
+		if err != nil {
+			// The message is delivered again and moving it is retried
+			log.Errorf("Error while publishing event to dead letter subject: %v", err)
+			return
+		}
+	}
+	err := msg.Term()
+	if err != nil {
+		log.Errorf("Error while terminating nats message: %v", err)
+	}
+}
+
+func (s *JetStreamEventStream) Check() error {
+	if !s.conn.IsConnected() {
+		return errors.New("Not connected to NATS")
+	}
+	return nil
+}
+
+func (s *JetStreamEventStream) Close() error {
+	for _, subscription := range s.subscriptions {
+		// Unsubscribing would delete the durable consumer, draining only stops this subscriber
+		err := subscription.Drain()
+		if err != nil {
+			log.Errorf("Error while draining jetstream subscription: %v", err)
+		}
+	}
+	s.conn.Close()
+	return nil
+}
+
+func (s *JetStreamEventStream) ensureStreams() error {
+	err := s.ensureStream(&nats.StreamConfig{
+		Name:       s.config.StreamName,
+		Subjects:   []string{s.subject + ".>"},
+		MaxAge:     s.config.MaxAge,
+		Duplicates: s.config.DuplicateWindow,
+		Replicas:   s.config.Replicas,
+		Storage:    nats.FileStorage,
+	})
+	if err != nil || s.config.DeadLetterSubject == "" {
+		return err
+	}
+	return s.ensureStream(&nats.StreamConfig{
+		Name:     s.config.StreamName + deadLetterStreamSuffix,
+		Subjects: []string{s.config.DeadLetterSubject},
+		Replicas: s.config.Replicas,
+		Storage:  nats.FileStorage,
+	})
+}
+
+func (s *JetStreamEventStream) ensureStream(config *nats.StreamConfig) error {
+	_, err := s.js.StreamInfo(config.Name)
+	if err != nil {
+		log.Infof("Creating jetstream stream %s", config.Name)
+		_, err = s.js.AddStream(config)
+		return err
+	}
+	_, err = s.js.UpdateStream(config)
+	return err
+}
+
+func exceedsMaxDeliver(numDelivered uint64, maxDeliver int) bool {
+	return maxDeliver > 0 && numDelivered > uint64(maxDeliver)
+}
+
+// Queue names are used as a single subject token, so may not contain token separators or wildcards
+func queueSubject(subject string, queue string) string {
+	token := strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return r
+	}, queue)
+	if token == "" {
+		token = emptyQueueToken
+	}
+	return subject + "." + token
+}
@@ -0,0 +1,36 @@
+package eventstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueSubject(t *testing.T) {
+	assert.Equal(t, "events.queue-a", queueSubject("events", "queue-a"))
+	assert.Equal(t, "events.team_a_b", queueSubject("events", "team.a.b"))
+	assert.Equal(t, "events.a__b_c", queueSubject("events", "a*>b c"))
+	assert.Equal(t, "events._", queueSubject("events", ""))
+}
+
+func TestExceedsMaxDeliver(t *testing.T) {
+	assert.False(t, exceedsMaxDeliver(3, 3))
+	assert.True(t, exceedsMaxDeliver(4, 3))
+	assert.False(t, exceedsMaxDeliver(100, 0))
+}
+
+func TestConnect_ErrorsOnUnknownTransport(t *testing.T) {
+	_, err := Connect("kafka", []string{"nats://localhost:4222"}, "", "client", "events", JetStreamConfig{})
+	assert.Error(t, err)
+}
+
+func TestNewJetStreamEventStream_ErrorsOnInvalidConfig(t *testing.T) {
+	_, err := NewJetStreamEventStream("nats://localhost:4222", "client", "events", JetStreamConfig{})
+	assert.Error(t, err)
+
+	_, err = NewJetStreamEventStream("nats://localhost:4222", "client", "events", JetStreamConfig{
+		StreamName:        "events",
+		DeadLetterSubject: "events.dead",
+	})
+	assert.Error(t, err)
+}
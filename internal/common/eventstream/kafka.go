@@ -0,0 +1,400 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	kafkaCheckTimeout       = 5 * time.Second
+	kafkaFetchRetryInterval = time.Second
+	defaultKafkaAckWait     = 30 * time.Second
+)
+
+type KafkaConfig struct {
+	// Topic is created on startup with the given number of partitions and replication factor, unless it exists
+	Partitions        int
+	ReplicationFactor int
+	// Published events are written in batches of up to BatchSize events, waiting at most BatchTimeout for a batch to fill up
+	BatchSize    int
+	BatchTimeout time.Duration
+	// Consumers fetch at least MinBytes and at most MaxBytes of events at once, waiting at most MaxWait for MinBytes
+	MinBytes int
+	MaxBytes int
+	MaxWait  time.Duration
+	// Time after which an unacknowledged event is delivered again
+	AckWait time.Duration
+}
+
+// KafkaEventStream publishes events to a single topic, keyed by queue and job set so events of a job set stay ordered.
+// Consumer groups commit the offset of the latest event up to which all events of a partition are acknowledged.
+type KafkaEventStream struct {
+	brokers []string
+	topic   string
+	config  KafkaConfig
+
+	writer *kafka.Writer
+	dialer *kafka.Dialer
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	mutex  sync.Mutex
+	wg     sync.WaitGroup
+
+	readers  []*kafka.Reader
+	trackers []*offsetTracker
+}
+
+func NewKafkaEventStream(clientID string, brokers []string, topic string, config KafkaConfig) (*KafkaEventStream, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be set")
+	}
+	if topic == "" {
+		return nil, errors.New("kafka topic must be set")
+	}
+	if config.AckWait <= 0 {
+		config.AckWait = defaultKafkaAckWait
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &KafkaEventStream{
+		brokers: brokers,
+		topic:   topic,
+		config:  config,
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			BatchSize:    config.BatchSize,
+			BatchTimeout: config.BatchTimeout,
+			RequiredAcks: kafka.RequireAll,
+		},
+		dialer: &kafka.Dialer{ClientID: clientID, Timeout: kafkaCheckTimeout},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	err := stream.ensureTopic()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (s *KafkaEventStream) Publish(messages []*api.EventMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	kafkaMessages := make([]kafka.Message, 0, len(messages))
+	for _, m := range messages {
+		event, err := api.UnwrapEvent(m)
+		if err != nil {
+			return err
+		}
+		messageData, err := proto.Marshal(m)
+		if err != nil {
+			log.Errorf("Error while marshaling event: %v", err)
+			return err
+		}
+		kafkaMessages = append(kafkaMessages, kafka.Message{
+			Key:   jobSetKey(event.GetQueue(), event.GetJobSetId()),
+			Value: messageData,
+		})
+	}
+
+	err := s.writer.WriteMessages(s.ctx, kafkaMessages...)
+	if err != nil {
+		log.Errorf("Error while publishing events to kafka: %v", err)
+	}
+	return err
+}
+
+func (s *KafkaEventStream) QueueSubscribe(queueGroup string, handler MessageHandler, options ...SubscribeOption) error {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  s.brokers,
+		GroupID:  queueGroup,
+		Topic:    s.topic,
+		Dialer:   s.dialer,
+		MinBytes: s.config.MinBytes,
+		MaxBytes: s.config.MaxBytes,
+		MaxWait:  s.config.MaxWait,
+		// New consumer groups start from the beginning of the topic, like durable NATS subscriptions
+		StartOffset: kafka.FirstOffset,
+	})
+
+	tracker := newOffsetTracker(applyOptions(options).maxInflight)
+
+	s.mutex.Lock()
+	s.readers = append(s.readers, reader)
+	s.trackers = append(s.trackers, tracker)
+	s.mutex.Unlock()
+	fetched := make(chan kafka.Message)
+
+	s.wg.Add(2)
+	go s.fetch(reader, tracker, fetched)
+	go s.deliver(reader, tracker, fetched, handler)
+	return nil
+}
+
+func (s *KafkaEventStream) Check() error {
+	ctx, cancel := context.WithTimeout(s.ctx, kafkaCheckTimeout)
+	defer cancel()
+
+	var lastError error
+	for _, broker := range s.brokers {
+		conn, err := s.dialer.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			return conn.Close()
+		}
+		lastError = err
+	}
+	return fmt.Errorf("no kafka broker is reachable: %v", lastError)
+}
+
+func (s *KafkaEventStream) Close() error {
+	s.cancel()
+
+	s.mutex.Lock()
+	readers := s.readers
+	trackers := s.trackers
+	s.mutex.Unlock()
+
+	for _, tracker := range trackers {
+		tracker.stop()
+	}
+	var lastError error
+	for _, reader := range readers {
+		err := reader.Close()
+		if err != nil {
+			lastError = err
+		}
+	}
+	s.wg.Wait()
+
+	err := s.writer.Close()
+	if err != nil {
+		lastError = err
+	}
+	return lastError
+}
+
+func (s *KafkaEventStream) fetch(reader *kafka.Reader, tracker *offsetTracker, fetched chan<- kafka.Message) {
+	defer s.wg.Done()
+	defer close(fetched)
+
+	for {
+		message, err := reader.FetchMessage(s.ctx)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			log.Errorf("Error while fetching events from kafka: %v", err)
+			time.Sleep(kafkaFetchRetryInterval)
+			continue
+		}
+		if !tracker.add(message, time.Now()) {
+			return
+		}
+		fetched <- message
+	}
+}
+
+// Handles fetched messages and messages which were not acknowledged in time from a single go routine
+func (s *KafkaEventStream) deliver(reader *kafka.Reader, tracker *offsetTracker, fetched <-chan kafka.Message, handler MessageHandler) {
+	defer s.wg.Done()
+
+	redeliver := time.NewTicker(s.config.AckWait / 2)
+	defer redeliver.Stop()
+
+	for {
+		select {
+		case message, ok := <-fetched:
+			if !ok {
+				return
+			}
+			s.handleMessage(reader, tracker, message, handler)
+		case now := <-redeliver.C:
+			for _, message := range tracker.expired(now, s.config.AckWait) {
+				s.handleMessage(reader, tracker, message, handler)
+			}
+		}
+	}
+}
+
+func (s *KafkaEventStream) handleMessage(reader *kafka.Reader, tracker *offsetTracker, message kafka.Message, handler MessageHandler) {
+	ack := func() error {
+		committable, ok := tracker.acknowledge(message)
+		if !ok {
+			return nil
+		}
+		return reader.CommitMessages(s.ctx, committable)
+	}
+
+	eventMessage := &api.EventMessage{}
+	err := proto.Unmarshal(message.Value, eventMessage)
+	if err != nil {
+		// Delivering the message again would not help
+		log.Errorf("Error while unmarshaling kafka message: %v", err)
+		err = ack()
+		if err != nil {
+			log.Errorf("Error while committing kafka offset: %v", err)
+		}
+		return
+	}
+	handler(&Message{
+		EventMessage: eventMessage,
+		Timestamp:    message.Time,
+		Ack:          ack,
+	})
+}
+
+func (s *KafkaEventStream) ensureTopic() error {
+	if s.config.Partitions <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, kafkaCheckTimeout)
+	defer cancel()
+
+	conn, err := s.dialer.DialContext(ctx, "tcp", s.brokers[0])
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	controller, err := conn.Controller()
+	if err != nil {
+		return err
+	}
+	controllerConn, err := s.dialer.DialContext(ctx, "tcp", fmt.Sprintf("%s:%d", controller.Host, controller.Port))
+	if err != nil {
+		return err
+	}
+	defer controllerConn.Close()
+
+	replicationFactor := s.config.ReplicationFactor
+	if replicationFactor <= 0 {
+		replicationFactor = 1
+	}
+	err = controllerConn.CreateTopics(kafka.TopicConfig{
+		Topic:             s.topic,
+		NumPartitions:     s.config.Partitions,
+		ReplicationFactor: replicationFactor,
+	})
+	if errors.Is(err, kafka.TopicAlreadyExists) {
+		return nil
+	}
+	return err
+}
+
+// Events of a job set always go to the same partition
+func jobSetKey(queue string, jobSetId string) []byte {
+	return []byte(queue + "/" + jobSetId)
+}
+
+type pendingMessage struct {
+	message      kafka.Message
+	delivered    time.Time
+	acknowledged bool
+}
+
+// offsetTracker keeps the messages which are fetched but not yet committed, per partition in offset order
+type offsetTracker struct {
+	mutex          sync.Mutex
+	spaceAvailable *sync.Cond
+	maxInflight    int
+	inflight       int
+	stopped        bool
+	partitions     map[int][]*pendingMessage
+}
+
+func newOffsetTracker(maxInflight int) *offsetTracker {
+	tracker := &offsetTracker{
+		maxInflight: maxInflight,
+		partitions:  map[int][]*pendingMessage{},
+	}
+	tracker.spaceAvailable = sync.NewCond(&tracker.mutex)
+	return tracker
+}
+
+// add waits while maxInflight messages are not acknowledged, returns false if the tracker is stopped meanwhile
+func (t *offsetTracker) add(message kafka.Message, now time.Time) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for t.maxInflight > 0 && t.inflight >= t.maxInflight && !t.stopped {
+		t.spaceAvailable.Wait()
+	}
+	if t.stopped {
+		return false
+	}
+
+	t.partitions[message.Partition] = append(t.partitions[message.Partition], &pendingMessage{message: message, delivered: now})
+	t.inflight++
+	return true
+}
+
+func (t *offsetTracker) stop() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.stopped = true
+	t.spaceAvailable.Broadcast()
+}
+
+// acknowledge returns the message up to which all messages of its partition are acknowledged, if any
+func (t *offsetTracker) acknowledge(message kafka.Message) (kafka.Message, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	pending := t.partitions[message.Partition]
+	for _, p := range pending {
+		if p.message.Offset == message.Offset && !p.acknowledged {
+			p.acknowledged = true
+			t.inflight--
+			t.spaceAvailable.Broadcast()
+		}
+	}
+
+	acknowledgedCount := 0
+	for acknowledgedCount < len(pending) && pending[acknowledgedCount].acknowledged {
+		acknowledgedCount++
+	}
+	if acknowledgedCount == 0 {
+		return kafka.Message{}, false
+	}
+	t.partitions[message.Partition] = pending[acknowledgedCount:]
+	return pending[acknowledgedCount-1].message, true
+}
+
+// expired returns the messages delivered at least ackWait ago which are not acknowledged, and marks them as delivered now
+func (t *offsetTracker) expired(now time.Time, ackWait time.Duration) []kafka.Message {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	partitions := make([]int, 0, len(t.partitions))
+	for partition := range t.partitions {
+		partitions = append(partitions, partition)
+	}
+	sort.Ints(partitions)
+
+	result := []kafka.Message{}
+	for _, partition := range partitions {
+		for _, p := range t.partitions[partition] {
+			if !p.acknowledged && !now.Before(p.delivered.Add(ackWait)) {
+				p.delivered = now
+				result = append(result, p.message)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,137 @@
+package eventstream
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Set to a comma separated list of brokers to run tests against a local broker
+const kafkaBrokersEnvVar = "KAFKA_BROKERS"
+
+var someTime = time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+
+func TestOffsetTracker_CommitsUpToFirstUnacknowledgedMessage(t *testing.T) {
+	tracker := newOffsetTracker(0)
+	for offset := int64(0); offset < 3; offset++ {
+		tracker.add(kafka.Message{Partition: 0, Offset: offset}, someTime)
+	}
+	tracker.add(kafka.Message{Partition: 1, Offset: 10}, someTime)
+
+	_, ok := tracker.acknowledge(kafka.Message{Partition: 0, Offset: 1})
+	assert.False(t, ok)
+
+	committable, ok := tracker.acknowledge(kafka.Message{Partition: 0, Offset: 0})
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), committable.Offset)
+
+	committable, ok = tracker.acknowledge(kafka.Message{Partition: 1, Offset: 10})
+	assert.True(t, ok)
+	assert.Equal(t, 1, committable.Partition)
+	assert.Equal(t, int64(10), committable.Offset)
+
+	_, ok = tracker.acknowledge(kafka.Message{Partition: 0, Offset: 1})
+	assert.False(t, ok)
+}
+
+func TestOffsetTracker_ExpiredMessagesAreRedelivered(t *testing.T) {
+	tracker := newOffsetTracker(0)
+	tracker.add(kafka.Message{Partition: 0, Offset: 0}, someTime)
+	tracker.add(kafka.Message{Partition: 0, Offset: 1}, someTime.Add(time.Minute))
+	tracker.acknowledge(kafka.Message{Partition: 0, Offset: 1})
+
+	assert.Empty(t, tracker.expired(someTime.Add(time.Second), time.Minute))
+
+	expired := tracker.expired(someTime.Add(time.Minute), time.Minute)
+	assert.Len(t, expired, 1)
+	assert.Equal(t, int64(0), expired[0].Offset)
+
+	assert.Empty(t, tracker.expired(someTime.Add(time.Minute), time.Minute))
+}
+
+func TestOffsetTracker_AddWaitsForMaxInflight(t *testing.T) {
+	tracker := newOffsetTracker(1)
+	tracker.add(kafka.Message{Offset: 0}, someTime)
+
+	added := make(chan bool)
+	go func() {
+		added <- tracker.add(kafka.Message{Offset: 1}, someTime)
+	}()
+
+	select {
+	case <-added:
+		t.Fatal("message was added while too many messages are in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	tracker.acknowledge(kafka.Message{Offset: 0})
+	assert.True(t, <-added)
+
+	go func() {
+		added <- tracker.add(kafka.Message{Offset: 2}, someTime)
+	}()
+	tracker.stop()
+	assert.False(t, <-added)
+}
+
+func TestJobSetKey(t *testing.T) {
+	assert.Equal(t, jobSetKey("queue", "job-set"), jobSetKey("queue", "job-set"))
+	assert.NotEqual(t, jobSetKey("queue", "job-set-a"), jobSetKey("queue", "job-set-b"))
+}
+
+func TestKafkaEventStream_PublishAndSubscribe(t *testing.T) {
+	brokers := os.Getenv(kafkaBrokersEnvVar)
+	if brokers == "" {
+		t.Skipf("Skipping as %s is not set", kafkaBrokersEnvVar)
+	}
+
+	stream, err := NewKafkaEventStream("test", strings.Split(brokers, ","), "armada-test-"+util.NewULID(), KafkaConfig{
+		Partitions:   3,
+		BatchTimeout: 10 * time.Millisecond,
+		AckWait:      time.Second,
+	})
+	assert.NoError(t, err)
+	defer stream.Close()
+	assert.NoError(t, stream.Check())
+
+	jobIds := []string{}
+	messages := []*api.EventMessage{}
+	for i := 0; i < 5; i++ {
+		jobId := util.NewULID()
+		jobIds = append(jobIds, jobId)
+		message, err := api.Wrap(&api.JobQueuedEvent{JobId: jobId, JobSetId: "job-set", Queue: "queue", Created: someTime})
+		assert.NoError(t, err)
+		messages = append(messages, message)
+	}
+	err = stream.Publish(messages)
+	assert.NoError(t, err)
+
+	received := make(chan *Message, 10)
+	err = stream.QueueSubscribe("group", func(message *Message) {
+		received <- message
+	})
+	assert.NoError(t, err)
+
+	// Events of a job set are received in order, the first one again as it is not acknowledged in time
+	for i, jobId := range jobIds {
+		message := <-received
+		assert.Equal(t, jobId, message.EventMessage.GetQueued().JobId)
+		if i > 0 {
+			assert.NoError(t, message.Ack())
+		}
+	}
+	select {
+	case message := <-received:
+		assert.Equal(t, jobIds[0], message.EventMessage.GetQueued().JobId)
+		assert.NoError(t, message.Ack())
+	case <-time.After(10 * time.Second):
+		t.Fatal("unacknowledged event was not delivered again")
+	}
+}
@@ -0,0 +1,98 @@
+package eventstream
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nats-io/stan.go"
+	stanPb "github.com/nats-io/stan.go/pb"
+	log "github.com/sirupsen/logrus"
+
+	stanUtil "github.com/G-Research/armada/internal/common/stan-util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// StanEventStream publishes all events to a single NATS Streaming subject
+type StanEventStream struct {
+	connection *stanUtil.DurableConnection
+	subject    string
+}
+
+func NewStanEventStream(connection *stanUtil.DurableConnection, subject string) *StanEventStream {
+	return &StanEventStream{connection: connection, subject: subject}
+}
+
+func (s *StanEventStream) Publish(messages []*api.EventMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	errors := make(chan error, len(messages))
+	for _, m := range messages {
+		messageData, err := proto.Marshal(m)
+		if err != nil {
+			log.Errorf("Error while marshaling event: %v", err)
+			return err
+		}
+		_, err = s.connection.PublishAsync(s.subject, messageData, func(subj string, err error) {
+			if err != nil {
+				log.Errorf("Error while publishing event to queue: %v", err)
+			}
+			errors <- err
+		})
+		if err != nil {
+			log.Errorf("Error while sending event to queue: %v", err)
+			return err
+		}
+	}
+
+	var lastError error
+	for i := 0; i < len(messages); i++ {
+		err := <-errors
+		if err != nil {
+			lastError = err
+		}
+	}
+	return lastError
+}
+
+func (s *StanEventStream) QueueSubscribe(queueGroup string, handler MessageHandler, options ...SubscribeOption) error {
+	subscriptionOptions := []stan.SubscriptionOption{
+		stan.SetManualAckMode(),
+		stan.StartAt(stanPb.StartPosition_LastReceived),
+		stan.DurableName(queueGroup),
+	}
+	if maxInflight := applyOptions(options).maxInflight; maxInflight > 0 {
+		subscriptionOptions = append(subscriptionOptions, stan.MaxInflight(maxInflight))
+	}
+
+	return s.connection.QueueSubscribe(s.subject, queueGroup, func(msg *stan.Msg) {
+		eventMessage := &api.EventMessage{}
+		err := proto.Unmarshal(msg.Data, eventMessage)
+		if err != nil {
+			// Delivering the message again would not help
+			log.Errorf("Error while unmarshaling nats message: %v", err)
+			ackStanMessage(msg)
+			return
+		}
+		handler(&Message{
+			EventMessage: eventMessage,
+			Timestamp:    time.Unix(0, msg.Timestamp),
+			Ack:          msg.Ack,
+		})
+	}, subscriptionOptions...)
+}
+
+func (s *StanEventStream) Check() error {
+	return s.connection.Check()
+}
+
+func (s *StanEventStream) Close() error {
+	return s.connection.Close()
+}
+
+func ackStanMessage(msg *stan.Msg) {
+	err := msg.Ack()
+	if err != nil {
+		log.Errorf("Error while ack nats message: %v", err)
+	}
+}
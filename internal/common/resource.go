@@ -297,6 +297,30 @@ func TotalPodResourceRequest(podSpec *v1.PodSpec) ComputeResources {
 	return totalResources
 }
 
+func TotalJobResourceLimit(job *api.Job) ComputeResources {
+	totalResources := make(ComputeResources)
+	for _, podSpec := range job.GetAllPodSpecs() {
+		podResource := TotalPodResourceLimit(podSpec)
+		totalResources.Add(podResource)
+	}
+	return totalResources
+}
+
+//Resource limit for a given pod is calculated the same way as its resource request
+func TotalPodResourceLimit(podSpec *v1.PodSpec) ComputeResources {
+	totalResources := make(ComputeResources)
+	for _, container := range podSpec.Containers {
+		containerResource := FromResourceList(container.Resources.Limits)
+		totalResources.Add(containerResource)
+	}
+
+	for _, initContainer := range podSpec.InitContainers {
+		containerResource := FromResourceList(initContainer.Resources.Limits)
+		totalResources.Max(containerResource)
+	}
+	return totalResources
+}
+
 func CalculateTotalResource(nodes []*v1.Node) ComputeResources {
 	totalResources := make(ComputeResources)
 	for _, node := range nodes {
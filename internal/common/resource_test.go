@@ -104,6 +104,19 @@ func TestTotalResourceRequest_ShouldCombineMaxInitContainerResourcesWithSummedCo
 	assert.Equal(t, result, FromResourceList(expectedResult))
 }
 
+func TestTotalResourceLimit_ShouldUseLimitsNotRequests(t *testing.T) {
+	request := makeContainerResource(1, 1)
+	limit := makeContainerResource(2, 4)
+	highLimitInit := makeContainerResource(1, 8)
+	pod := makePodWithResource([]*v1.ResourceList{&request, &request}, []*v1.ResourceList{&request})
+	pod.Spec.Containers[0].Resources.Limits = limit
+	pod.Spec.Containers[1].Resources.Limits = limit
+	pod.Spec.InitContainers[0].Resources.Limits = highLimitInit
+
+	assert.Equal(t, FromResourceList(makeContainerResource(2, 2)), TotalPodResourceRequest(&pod.Spec))
+	assert.Equal(t, FromResourceList(makeContainerResource(4, 8)), TotalPodResourceLimit(&pod.Spec))
+}
+
 func makeDefaultNodeResource() v1.ResourceList {
 	cpuResource := resource.NewQuantity(100, resource.DecimalSI)
 	memoryResource := resource.NewQuantity(50*1024*1024*1024, resource.DecimalSI)
@@ -12,6 +12,7 @@ type task struct {
 	function    func()
 	interval    time.Duration
 	metricName  string
+	leader      *LeaderElection
 	stopChannel chan bool
 }
 
@@ -31,12 +32,29 @@ func NewBackgroundTaskManager(metricsPrefix string) *BackgroundTaskManager {
 }
 
 func (m *BackgroundTaskManager) Register(backgroundTask func(), interval time.Duration, metricName string) {
-	task := &task{
+	m.register(&task{
 		function:    backgroundTask,
 		interval:    interval,
 		metricName:  metricName,
 		stopChannel: make(chan bool),
-	}
+	})
+}
+
+// RegisterSingleton registers a task which only runs while leader holds leadership, so it runs in one process at a time.
+// With nil leader the task runs in every process, same as with Register.
+// Leadership is only checked before each run starts, tasks which can outlive it should check LeaderToken
+// as they go, and pass the token to writes which must not happen after another process became the leader.
+func (m *BackgroundTaskManager) RegisterSingleton(backgroundTask func(), interval time.Duration, metricName string, leader *LeaderElection) {
+	m.register(&task{
+		function:    backgroundTask,
+		interval:    interval,
+		metricName:  metricName,
+		leader:      leader,
+		stopChannel: make(chan bool),
+	})
+}
+
+func (m *BackgroundTaskManager) register(task *task) {
 	m.startBackgroundTask(task)
 	m.tasks = append(m.tasks, task)
 }
@@ -46,6 +64,17 @@ func (m *BackgroundTaskManager) StopAll(timeout time.Duration) bool {
 	return m.waitForShutdownCompletion(timeout)
 }
 
+// Stop stops the task registered with the given metric name, waiting for its current run to finish.
+func (m *BackgroundTaskManager) Stop(metricName string) {
+	for i, task := range m.tasks {
+		if task.metricName == metricName {
+			task.stopChannel <- true
+			m.tasks = append(m.tasks[:i], m.tasks[i+1:]...)
+			return
+		}
+	}
+}
+
 func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
 	var taskDurationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
@@ -54,12 +83,19 @@ func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
 			Buckets: prometheus.ExponentialBuckets(0.01, 2, 15),
 		})
 
-	m.wg.Add(1)
-	go func() {
+	run := func() {
+		if task.leader != nil && !task.leader.IsLeader() {
+			return
+		}
 		start := time.Now()
 		task.function()
 		duration := time.Since(start)
 		taskDurationHistogram.Observe(duration.Seconds())
+	}
+
+	m.wg.Add(1)
+	go func() {
+		run()
 
 		for {
 			select {
@@ -68,10 +104,7 @@ func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
 				m.wg.Done()
 				return
 			}
-			innerStart := time.Now()
-			task.function()
-			innerDuration := time.Since(innerStart)
-			taskDurationHistogram.Observe(innerDuration.Seconds())
+			run()
 		}
 	}()
 }
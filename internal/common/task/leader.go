@@ -0,0 +1,172 @@
+package task
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+// LeaderLock is the shared lock processes compete for to become leader.
+type LeaderLock interface {
+	// TryAcquire acquires the lock for holderId for ttl, or extends it if holderId already holds it.
+	// Returns whether holderId holds the lock, and the fencing token of the holding, which increases
+	// each time the lock is acquired by a new holding.
+	TryAcquire(holderId string, ttl time.Duration) (bool, int64, error)
+	// Release releases the lock if it is held by holderId, so another process can take over without waiting for it to expire.
+	Release(holderId string) error
+}
+
+// LeaderElection campaigns for a LeaderLock in the background, singleton tasks only run while it holds the lock.
+//
+// Leadership is considered lost locally half way through the lock ttl unless the lock was renewed in time,
+// so a leader which can't reach the lock stops running tasks before another process can acquire it.
+type LeaderElection struct {
+	lock          LeaderLock
+	holderId      string
+	leaseDuration time.Duration
+	retryInterval time.Duration
+
+	mutex      sync.Mutex
+	validUntil time.Time
+	token      int64
+	wasLeader  bool
+
+	stopChannel chan bool
+	wg          sync.WaitGroup
+
+	leaderGauge     prometheus.Gauge
+	tokenGauge      prometheus.Gauge
+	changesCounter  prometheus.Counter
+	failuresCounter prometheus.Counter
+}
+
+func NewLeaderElection(lock LeaderLock, holderId string, leaseDuration time.Duration, retryInterval time.Duration, metricsPrefix string) *LeaderElection {
+	return &LeaderElection{
+		lock:          lock,
+		holderId:      holderId,
+		leaseDuration: leaseDuration,
+		retryInterval: retryInterval,
+		stopChannel:   make(chan bool),
+		leaderGauge: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: metricsPrefix + "leader",
+			Help: "1 if this process is the leader running singleton background tasks, 0 otherwise",
+		}),
+		tokenGauge: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: metricsPrefix + "leader_fencing_token",
+			Help: "Fencing token of the current leadership of this process, 0 when not leader",
+		}),
+		changesCounter: promauto.NewCounter(prometheus.CounterOpts{
+			Name: metricsPrefix + "leader_changes_total",
+			Help: "Number of times this process became or stopped being the leader",
+		}),
+		failuresCounter: promauto.NewCounter(prometheus.CounterOpts{
+			Name: metricsPrefix + "leader_lock_errors_total",
+			Help: "Number of failed attempts to acquire or renew the leader lock",
+		}),
+	}
+}
+
+// Start campaigns for leadership every retry interval until Stop is called.
+func (e *LeaderElection) Start() {
+	e.campaign()
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		for {
+			select {
+			case <-time.After(e.retryInterval):
+			case <-e.stopChannel:
+				return
+			}
+			e.campaign()
+		}
+	}()
+}
+
+// Stop stops campaigning and releases the lock if held, it should be called after singleton tasks are stopped.
+func (e *LeaderElection) Stop() {
+	e.stopChannel <- true
+	e.wg.Wait()
+
+	e.setLeadership(time.Time{}, 0)
+	if err := e.lock.Release(e.holderId); err != nil {
+		log.Errorf("Error while releasing leader lock: %s", err)
+	}
+}
+
+func (e *LeaderElection) IsLeader() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return time.Now().Before(e.validUntil)
+}
+
+// Token returns the fencing token of the current leadership, or 0 if this process is not the leader.
+// Stores shared by singleton tasks can reject writes carrying a token lower than one already seen.
+func (e *LeaderElection) Token() int64 {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if !time.Now().Before(e.validUntil) {
+		return 0
+	}
+	return e.token
+}
+
+// LeaderToken returns the fencing token singleton tasks pass to their writes, 0 when leader is nil as leader
+// election is disabled. Returns false when this process is no longer the leader, tasks should stop their run
+// rather than wait for their writes to be rejected.
+func LeaderToken(leader *LeaderElection) (int64, bool) {
+	if leader == nil {
+		return 0, true
+	}
+	token := leader.Token()
+	return token, token != 0
+}
+
+func (e *LeaderElection) campaign() {
+	start := time.Now()
+	acquired, token, err := e.lock.TryAcquire(e.holderId, e.leaseDuration)
+	if err != nil {
+		e.failuresCounter.Inc()
+		log.Errorf("Error while acquiring leader lock: %s", err)
+		// Keep leadership until it runs out, the next attempt may renew it in time
+		e.mutex.Lock()
+		validUntil, token := e.validUntil, e.token
+		e.mutex.Unlock()
+		e.setLeadership(validUntil, token)
+		return
+	}
+	if !acquired {
+		e.setLeadership(time.Time{}, 0)
+		return
+	}
+	e.setLeadership(start.Add(e.leaseDuration/2), token)
+}
+
+func (e *LeaderElection) setLeadership(validUntil time.Time, token int64) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	isLeader := time.Now().Before(validUntil)
+	if e.wasLeader != isLeader || (isLeader && token != e.token) {
+		e.changesCounter.Inc()
+		if isLeader {
+			log.Infof("%s became leader with fencing token %d", e.holderId, token)
+		} else {
+			log.Infof("%s is no longer leader", e.holderId)
+		}
+	}
+
+	e.validUntil = validUntil
+	e.token = token
+	e.wasLeader = isLeader
+	if isLeader {
+		e.leaderGauge.Set(1)
+		e.tokenGauge.Set(float64(token))
+	} else {
+		e.leaderGauge.Set(0)
+		e.tokenGauge.Set(0)
+	}
+}
@@ -0,0 +1,142 @@
+package task
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderElection_OnlyOneHolderIsLeader_OtherTakesOverAfterStop(t *testing.T) {
+	lock := &fakeLeaderLock{}
+	first := NewLeaderElection(lock, "first", time.Minute, 10*time.Millisecond, "test_takeover_first_")
+	second := NewLeaderElection(lock, "second", time.Minute, 10*time.Millisecond, "test_takeover_second_")
+
+	first.Start()
+	second.Start()
+	assert.True(t, first.IsLeader())
+	assert.False(t, second.IsLeader())
+	assert.Equal(t, int64(1), first.Token())
+	assert.Equal(t, int64(0), second.Token())
+
+	first.Stop()
+	assert.False(t, first.IsLeader())
+	assert.Eventually(t, second.IsLeader, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int64(2), second.Token())
+	second.Stop()
+}
+
+func TestLeaderElection_LosesLeadershipWhenLockCannotBeRenewed(t *testing.T) {
+	lock := &fakeLeaderLock{}
+	election := NewLeaderElection(lock, "leader", 100*time.Millisecond, 10*time.Millisecond, "test_renewal_")
+
+	election.Start()
+	assert.True(t, election.IsLeader())
+
+	lock.setFailing(true)
+	assert.Eventually(t, func() bool { return !election.IsLeader() }, time.Second, 5*time.Millisecond)
+
+	lock.setFailing(false)
+	assert.Eventually(t, election.IsLeader, time.Second, 5*time.Millisecond)
+	election.Stop()
+}
+
+func TestLeaderToken(t *testing.T) {
+	lock := &fakeLeaderLock{}
+	leader := NewLeaderElection(lock, "leader", time.Minute, time.Minute, "test_token_leader_")
+	follower := NewLeaderElection(lock, "follower", time.Minute, time.Minute, "test_token_follower_")
+	leader.Start()
+	follower.Start()
+	defer leader.Stop()
+	defer follower.Stop()
+
+	token, isLeader := LeaderToken(leader)
+	assert.True(t, isLeader)
+	assert.Equal(t, int64(1), token)
+
+	_, isLeader = LeaderToken(follower)
+	assert.False(t, isLeader)
+
+	token, isLeader = LeaderToken(nil)
+	assert.True(t, isLeader)
+	assert.Equal(t, int64(0), token)
+}
+
+func TestBackgroundTaskManager_RegisterSingleton_RunsOnlyOnLeader(t *testing.T) {
+	lock := &fakeLeaderLock{}
+	leader := NewLeaderElection(lock, "leader", time.Minute, time.Minute, "test_singleton_leader_")
+	follower := NewLeaderElection(lock, "follower", time.Minute, time.Minute, "test_singleton_follower_")
+	leader.Start()
+	follower.Start()
+
+	leaderRuns, followerRuns := &counter{}, &counter{}
+	taskManager := NewBackgroundTaskManager("test_singleton_")
+	taskManager.RegisterSingleton(leaderRuns.inc, 5*time.Millisecond, "leader_task", leader)
+	taskManager.RegisterSingleton(followerRuns.inc, 5*time.Millisecond, "follower_task", follower)
+
+	assert.Eventually(t, func() bool { return leaderRuns.get() > 2 }, time.Second, 5*time.Millisecond)
+	taskManager.StopAll(time.Second)
+	leader.Stop()
+	follower.Stop()
+
+	assert.Equal(t, 0, followerRuns.get())
+}
+
+type fakeLeaderLock struct {
+	mutex     sync.Mutex
+	holder    string
+	expiresAt time.Time
+	token     int64
+	failing   bool
+}
+
+func (l *fakeLeaderLock) TryAcquire(holderId string, ttl time.Duration) (bool, int64, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.failing {
+		return false, 0, errors.New("lock unavailable")
+	}
+	if l.holder != "" && l.holder != holderId && time.Now().Before(l.expiresAt) {
+		return false, 0, nil
+	}
+	if l.holder != holderId || !time.Now().Before(l.expiresAt) {
+		l.token++
+	}
+	l.holder = holderId
+	l.expiresAt = time.Now().Add(ttl)
+	return true, l.token, nil
+}
+
+func (l *fakeLeaderLock) Release(holderId string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.holder == holderId {
+		l.holder = ""
+	}
+	return nil
+}
+
+func (l *fakeLeaderLock) setFailing(failing bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.failing = failing
+}
+
+type counter struct {
+	mutex sync.Mutex
+	count int
+}
+
+func (c *counter) inc() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.count++
+}
+
+func (c *counter) get() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.count
+}
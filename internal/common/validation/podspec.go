@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 )
 
@@ -30,8 +31,9 @@ func ValidatePodSpec(spec *v1.PodSpec) error {
 			return fmt.Errorf("container %v has no resource requests specified", container.Name)
 		}
 
-		if !resourceListEquals(container.Resources.Requests, container.Resources.Limits) {
-			return fmt.Errorf("container %v does not have resource request and limit equal (this is currently not supported)", container.Name)
+		err := validateRequestsWithinLimits(container.Name, container.Resources)
+		if err != nil {
+			return err
 		}
 	}
 	return validatePorts(spec)
@@ -42,12 +44,17 @@ func validateAffinity(affinity *v1.Affinity) error {
 		return nil
 	}
 
+	err := validatePodAffinity(affinity.PodAffinity, affinity.PodAntiAffinity)
+	if err != nil {
+		return err
+	}
+
 	nodeAffinity := affinity.NodeAffinity
 	if nodeAffinity == nil {
 		return nil
 	}
 
-	err := validatePreferredNodeAffinityNotPresent(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	err = validatePreferredNodeAffinity(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
 	if err != nil {
 		return err
 	}
@@ -55,9 +62,41 @@ func validateAffinity(affinity *v1.Affinity) error {
 	return validateRequiredNodeAffinity(nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
 }
 
-func validatePreferredNodeAffinityNotPresent(preferred []v1.PreferredSchedulingTerm) error {
-	if preferred != nil && len(preferred) > 0 {
-		return errors.New("PreferredDuringSchedulingIgnoredDuringExecution node affinity is not supported by Armada")
+func validatePreferredNodeAffinity(preferred []v1.PreferredSchedulingTerm) error {
+	if len(preferred) == 0 {
+		return nil
+	}
+
+	_, err := nodeaffinity.NewPreferredSchedulingTerms(preferred)
+	if err != nil {
+		return fmt.Errorf("invalid PreferredDuringSchedulingIgnoredDuringExecution node affinity: %v", err)
+	}
+	return nil
+}
+
+func validatePodAffinity(podAffinity *v1.PodAffinity, podAntiAffinity *v1.PodAntiAffinity) error {
+	terms := []v1.PodAffinityTerm{}
+	if podAffinity != nil {
+		terms = append(terms, podAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for _, weighted := range podAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, weighted.PodAffinityTerm)
+		}
+	}
+	if podAntiAffinity != nil {
+		terms = append(terms, podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for _, weighted := range podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, weighted.PodAffinityTerm)
+		}
+	}
+
+	for _, term := range terms {
+		if term.TopologyKey == "" {
+			return errors.New("pod affinity term has no topology key specified")
+		}
+		_, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+		if err != nil {
+			return fmt.Errorf("invalid pod affinity label selector: %v", err)
+		}
 	}
 	return nil
 }
@@ -74,16 +113,21 @@ func validateRequiredNodeAffinity(required *v1.NodeSelector) error {
 	return nil
 }
 
-func resourceListEquals(a v1.ResourceList, b v1.ResourceList) bool {
-	if len(a) != len(b) {
-		return false
+// Requests can be lower than limits, but each resource needs both as the request is what the job is scheduled by
+func validateRequestsWithinLimits(containerName string, resources v1.ResourceRequirements) error {
+	if len(resources.Requests) != len(resources.Limits) {
+		return fmt.Errorf("container %v does not have resource requests and limits set for the same resources", containerName)
 	}
-	for k, v := range a {
-		if v != b[k] {
-			return false
+	for name, request := range resources.Requests {
+		limit, ok := resources.Limits[name]
+		if !ok {
+			return fmt.Errorf("container %v does not have resource requests and limits set for the same resources", containerName)
+		}
+		if request.Cmp(limit) > 0 {
+			return fmt.Errorf("container %v has %s request %s greater than its limit %s", containerName, name, request.String(), limit.String())
 		}
 	}
-	return true
+	return nil
 }
 
 func validatePorts(podSpec *v1.PodSpec) error {
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Test_ValidatePodSpec_checkForMissingValues(t *testing.T) {
@@ -44,6 +45,28 @@ func Test_ValidatePodSpec_checkForResources(t *testing.T) {
 	}))
 }
 
+func Test_ValidatePodSpec_WhenRequestsLowerThanLimits_Succeeds(t *testing.T) {
+	assert.NoError(t, ValidatePodSpec(&v1.PodSpec{
+		Containers: []v1.Container{{
+			Resources: v1.ResourceRequirements{
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("2Gi")},
+				Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")},
+			},
+		}},
+	}))
+}
+
+func Test_ValidatePodSpec_WhenLimitMissingForRequestedResource_Fails(t *testing.T) {
+	assert.Error(t, ValidatePodSpec(&v1.PodSpec{
+		Containers: []v1.Container{{
+			Resources: v1.ResourceRequirements{
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "nvidia.com/gpu": resource.MustParse("1")},
+				Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")},
+			},
+		}},
+	}))
+}
+
 func Test_ValidatePodSpec_checkForPortConfiguration(t *testing.T) {
 	portsUniqueToContainer := &v1.PodSpec{
 		Containers: []v1.Container{
@@ -78,7 +101,7 @@ func Test_ValidatePodSpec_checkForPortConfiguration(t *testing.T) {
 	assert.Error(t, ValidatePodSpec(portExposeOverMultipleContainers))
 }
 
-func Test_ValidatePodSpec_WhenPreferredAffinitySet_Fails(t *testing.T) {
+func Test_ValidatePodSpec_WhenValidPreferredAffinitySet_Succeeds(t *testing.T) {
 	preference := v1.NodeSelectorTerm{
 		MatchExpressions: []v1.NodeSelectorRequirement{
 			{
@@ -102,9 +125,47 @@ func Test_ValidatePodSpec_WhenPreferredAffinitySet_Fails(t *testing.T) {
 		},
 	}
 
+	assert.NoError(t, ValidatePodSpec(podSpec))
+}
+
+func Test_ValidatePodSpec_WhenInvalidPreferredAffinitySet_Fails(t *testing.T) {
+	preference := v1.NodeSelectorTerm{
+		MatchExpressions: []v1.NodeSelectorRequirement{
+			{
+				Key:      "a",
+				Values:   []string{"b"},
+				Operator: "Invalid",
+			},
+		},
+	}
+
+	podSpec := minimalValidPodSpec()
+	podSpec.Affinity = &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{{Weight: 5, Preference: preference}},
+		},
+	}
+
 	assert.Error(t, ValidatePodSpec(podSpec))
 }
 
+func Test_ValidatePodSpec_WhenPodAntiAffinitySet_RequiresTopologyKey(t *testing.T) {
+	term := v1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "spread"}},
+	}
+
+	podSpec := minimalValidPodSpec()
+	podSpec.Affinity = &v1.Affinity{
+		PodAntiAffinity: &v1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{term},
+		},
+	}
+	assert.Error(t, ValidatePodSpec(podSpec))
+
+	podSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0].TopologyKey = "kubernetes.io/hostname"
+	assert.NoError(t, ValidatePodSpec(podSpec))
+}
+
 func Test_ValidatePodSpec_WhenValidRequiredAffinitySet_Succeeds(t *testing.T) {
 
 	nodeSelector := &v1.NodeSelector{
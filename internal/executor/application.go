@@ -28,6 +28,11 @@ import (
 	"github.com/G-Research/armada/pkg/client"
 )
 
+const (
+	drainCheckInterval = 5 * time.Second
+	eventFlushTimeout  = 10 * time.Second
+)
+
 func StartUp(config configuration.ExecutorConfiguration) (func(), *sync.WaitGroup) {
 
 	err := validateConfig(config)
@@ -69,9 +74,20 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 	usageClient := api.NewUsageClient(conn)
 	eventClient := api.NewEventClient(conn)
 
+	var eventOutbox *reporter.EventOutbox
+	if config.EventOutbox.Enabled {
+		eventOutbox, err = reporter.OpenEventOutbox(config.EventOutbox.Directory, config.EventOutbox.MaxEvents)
+		if err != nil {
+			log.Errorf("Failed to open event outbox because: %s", err)
+			os.Exit(-1)
+		}
+	}
+
 	eventReporter, stopReporter := reporter.NewJobEventReporter(
 		clusterContext,
-		eventClient)
+		eventClient,
+		eventOutbox,
+		config.EventOutbox.RetryInterval)
 
 	jobLeaseService := service.NewJobLeaseService(
 		clusterContext,
@@ -127,6 +143,15 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 	taskManager.Register(clusterAllocationService.AllocateSpareClusterCapacity, config.Task.AllocateSpareClusterCapacityInterval, "job_lease_request")
 	taskManager.Register(jobManager.ManageJobLeases, config.Task.JobLeaseRenewalInterval, "job_management")
 
+	if config.BadNodeDetection.Enabled {
+		badNodeDetector, err := node.NewBadNodeDetector(clusterContext, config.BadNodeDetection)
+		if err != nil {
+			log.Errorf("Config error in bad node detection: %s", err)
+			os.Exit(-1)
+		}
+		taskManager.Register(badNodeDetector.CheckNodes, config.Task.BadNodeDetectionInterval, "bad_node_detection")
+	}
+
 	if config.Metric.ExposeQueueUsageMetrics {
 		taskManager.Register(queueUtilisationService.RefreshUtilisationData, config.Task.QueueUsageDataRefreshInterval, "pod_usage_data_refresh")
 
@@ -141,7 +166,17 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 	}
 
 	return func() {
+		if config.GracefulDrain.Enabled {
+			log.Infof("Draining executor, renewing leases of running jobs for up to %s", config.GracefulDrain.Deadline)
+			taskManager.Stop("job_lease_request")
+			if jobManager.Drain(config.GracefulDrain.Deadline, drainCheckInterval) {
+				log.Warnf("Drain deadline passed, leases of jobs still running will expire")
+			}
+		}
 		stopReporter <- true
+		if eventReporter.WaitForStop(eventFlushTimeout) {
+			log.Warnf("Timed out sending remaining events")
+		}
 		clusterContext.Stop()
 		conn.Close()
 		if taskManager.StopAll(2 * time.Second) {
@@ -45,6 +45,7 @@ type TaskConfiguration struct {
 	QueueUsageDataRefreshInterval         time.Duration
 	UtilisationEventProcessingInterval    time.Duration
 	UtilisationEventReportingInterval     time.Duration
+	BadNodeDetectionInterval              time.Duration
 }
 
 type MetricConfiguration struct {
@@ -52,11 +53,50 @@ type MetricConfiguration struct {
 	ExposeQueueUsageMetrics bool
 }
 
+type EventOutboxConfiguration struct {
+	// Persist events on local disk until the server acknowledges them, so they survive executor restarts and API outages
+	Enabled   bool
+	Directory string
+	// Reporting events blocks while this many events are waiting to be sent
+	MaxEvents int
+	// How long to wait before sending events again after a failure
+	RetryInterval time.Duration
+}
+
+type GracefulDrainConfiguration struct {
+	// On shutdown, stop leasing jobs, return the leases of jobs not scheduled to a node yet and keep renewing the leases of running jobs
+	Enabled bool
+	// How long to keep renewing leases of running jobs before stopping, leases of jobs still running then expire
+	Deadline time.Duration
+}
+
+type BadNodeDetectionConfiguration struct {
+	// Cordon nodes where too many jobs fail, so a broken node does not keep failing the jobs scheduled on it
+	Enabled bool
+	// Jobs finished on a node are counted over this sliding window
+	Window time.Duration
+	// A node is cordoned once at least this many jobs failed on it within the window
+	FailureThreshold int
+	// and failures are at least this fraction of the jobs finished on it within the window
+	FailureRateThreshold float64
+	// Failure causes counted as failures, e.g. Error or DeadlineExceeded. Jobs failing for other causes are counted as finished only
+	FailureCauses []string
+	// and the failures come from at least this many distinct job sets, so a single broken job set does not get nodes cordoned
+	MinFailedJobSets int
+	// No further nodes are cordoned once this fraction of the nodes is cordoned by the executor, there is no limit when 0
+	MaxCordonedFraction float64
+	// Nodes cordoned by the executor are uncordoned after this cool-down, they stay cordoned when 0
+	UncordonAfter time.Duration
+}
+
 type ExecutorConfiguration struct {
 	Metric        MetricConfiguration
 	Application   ApplicationConfiguration
 	ApiConnection client.ApiConnectionDetails
 
-	Kubernetes KubernetesConfiguration
-	Task       TaskConfiguration
+	Kubernetes       KubernetesConfiguration
+	Task             TaskConfiguration
+	EventOutbox      EventOutboxConfiguration
+	GracefulDrain    GracefulDrainConfiguration
+	BadNodeDetection BadNodeDetectionConfiguration
 }
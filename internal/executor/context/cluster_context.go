@@ -58,6 +58,12 @@ type ClusterContext interface {
 
 	AddAnnotation(pod *v1.Pod, annotations map[string]string) error
 
+	// CordonNode marks the node unschedulable and sets the annotations on it
+	CordonNode(node *v1.Node, annotations map[string]string) error
+	// UncordonNode marks the node schedulable again and removes the annotations from it
+	UncordonNode(node *v1.Node, annotations []string) error
+	RecordNodeEvent(node *v1.Node, eventType string, reason string, message string) error
+
 	Stop()
 }
 
@@ -265,6 +271,60 @@ func (c *KubernetesClusterContext) AddAnnotation(pod *v1.Pod, annotations map[st
 	return nil
 }
 
+func (c *KubernetesClusterContext) CordonNode(node *v1.Node, annotations map[string]string) error {
+	patchAnnotations := map[string]interface{}{}
+	for k, v := range annotations {
+		patchAnnotations[k] = v
+	}
+	return c.patchNodeSchedulable(node, true, patchAnnotations)
+}
+
+func (c *KubernetesClusterContext) UncordonNode(node *v1.Node, annotations []string) error {
+	patchAnnotations := map[string]interface{}{}
+	for _, k := range annotations {
+		// null removes the annotation in a merge patch
+		patchAnnotations[k] = nil
+	}
+	return c.patchNodeSchedulable(node, false, patchAnnotations)
+}
+
+func (c *KubernetesClusterContext) patchNodeSchedulable(node *v1.Node, unschedulable bool, annotations map[string]interface{}) error {
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+		"spec":     map[string]interface{}{"unschedulable": unschedulable},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	_, err = c.kubernetesClient.CoreV1().Nodes().Patch(ctx.Background(), node.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func (c *KubernetesClusterContext) RecordNodeEvent(node *v1.Node, eventType string, reason string, message string) error {
+	now := metav1.Now()
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: node.Name + ".",
+			Namespace:    metav1.NamespaceDefault,
+		},
+		InvolvedObject: v1.ObjectReference{
+			Kind: "Node",
+			Name: node.Name,
+			UID:  node.UID,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source:         v1.EventSource{Component: "armada-executor"},
+	}
+	_, err := c.kubernetesClient.CoreV1().Events(metav1.NamespaceDefault).Create(ctx.Background(), event, metav1.CreateOptions{})
+	return err
+}
+
 func (c *KubernetesClusterContext) DeletePods(pods []*v1.Pod) {
 	for _, podToDelete := range pods {
 		c.podsToDelete.AddIfNotExists(podToDelete)
@@ -584,6 +584,35 @@ func TestKubernetesClusterContext_GetNodes(t *testing.T) {
 	assert.True(t, nodeFound)
 }
 
+func TestKubernetesClusterContext_CordonNode_And_UncordonNode(t *testing.T) {
+	clusterContext, client := setupTest()
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "Node1",
+			Annotations: map[string]string{"other": "annotation"},
+		},
+	}
+	_, err := client.CoreV1().Nodes().Create(ctx.Background(), node, metav1.CreateOptions{})
+	assert.Nil(t, err)
+
+	err = clusterContext.CordonNode(node, map[string]string{"test": "annotation"})
+	assert.Nil(t, err)
+
+	cordoned, err := client.CoreV1().Nodes().Get(ctx.Background(), node.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.True(t, cordoned.Spec.Unschedulable)
+	assert.Equal(t, map[string]string{"other": "annotation", "test": "annotation"}, cordoned.Annotations)
+
+	err = clusterContext.UncordonNode(node, []string{"test"})
+	assert.Nil(t, err)
+
+	uncordoned, err := client.CoreV1().Nodes().Get(ctx.Background(), node.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.False(t, uncordoned.Spec.Unschedulable)
+	assert.Equal(t, map[string]string{"other": "annotation"}, uncordoned.Annotations)
+}
+
 func TestKubernetesClusterContext_Submit_UseUserSpecificClient(t *testing.T) {
 	clusterContext, provider := setupTestWithProvider()
 
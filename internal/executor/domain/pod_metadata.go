@@ -11,6 +11,8 @@ const (
 	AssociatedIngressesCount = "associated_ingresses_count"
 	AssociatedServicesCount  = "associated_services_count"
 	IngressReported          = "ingress_reported"
+	PeerService              = "armada_peer_service"
 	MarkedForDeletion        = "deletion_requested"
 	JobDoneAnnotation        = "reported_done"
+	BadNodeCordoned          = "armada_bad_node_cordoned"
 )
@@ -245,6 +245,41 @@ func (c *FakeClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]s
 	return nil
 }
 
+func (c *FakeClusterContext) CordonNode(node *v1.Node, annotations map[string]string) error {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+
+	for _, n := range c.nodes {
+		if n.Name == node.Name {
+			n.Spec.Unschedulable = true
+			n.Annotations = util.MergeMaps(n.Annotations, annotations)
+			return nil
+		}
+	}
+	return fmt.Errorf("node %s not found", node.Name)
+}
+
+func (c *FakeClusterContext) UncordonNode(node *v1.Node, annotations []string) error {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+
+	for _, n := range c.nodes {
+		if n.Name == node.Name {
+			n.Spec.Unschedulable = false
+			for _, k := range annotations {
+				delete(n.Annotations, k)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("node %s not found", node.Name)
+}
+
+func (c *FakeClusterContext) RecordNodeEvent(node *v1.Node, eventType string, reason string, message string) error {
+	log.Infof("%s event on node %s: %s %s", eventType, node.Name, reason, message)
+	return nil
+}
+
 func (c *FakeClusterContext) DeletePods(pods []*v1.Pod) {
 	go func() {
 		// wait a little before actual delete
@@ -332,6 +367,10 @@ func (c *FakeClusterContext) deallocate(pod *v1.Pod) {
 
 func (c *FakeClusterContext) isSchedulableOn(pod *v1.Pod, n *v1.Node) bool {
 	requiredResource := common.TotalPodResourceRequest(&pod.Spec)
+	if n.Spec.Unschedulable {
+		return false
+	}
+
 	availableResource := c.nodeAvailableResource[n.Name].DeepCopy()
 	availableResource.Sub(requiredResource)
 
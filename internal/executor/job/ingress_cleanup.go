@@ -3,9 +3,11 @@ package job
 import (
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
 	clusterContext "github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/internal/executor/util"
 )
 
@@ -26,10 +28,17 @@ func RunIngressCleanup(clusterContext clusterContext.ClusterContext) *IngressCle
 
 	 We do set ownerreference on the services to point to the pod.
 	 So in the case the cleanup below fails, the ownerreference will ensure it is cleaned up when the pod is
+
+	 The headless service of jobs with peer discovery is shared by all pods of the job, so is only removed once all of them finished
 	*/
 
 	clusterContext.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldPod, ok := oldObj.(*v1.Pod)
+			if !ok {
+				log.Errorf("Failed to process pod event due to it being an unexpected type. Failed to process %+v", oldObj)
+				return
+			}
 			pod, ok := newObj.(*v1.Pod)
 			if !ok {
 				log.Errorf("Failed to process pod event due to it being an unexpected type. Failed to process %+v", newObj)
@@ -38,6 +47,10 @@ func RunIngressCleanup(clusterContext clusterContext.ClusterContext) *IngressCle
 			if util.IsManagedPod(pod) && util.IsInTerminalState(pod) && util.HasIngress(pod) {
 				go service.removeAnyAssociatedIngress(pod)
 			}
+			// Only checked when a pod finishes, later updates of finished pods can't finish the job
+			if util.IsManagedPod(pod) && !util.IsInTerminalState(oldPod) && util.IsInTerminalState(pod) && util.GetPeerService(pod) != "" {
+				go service.removePeerServiceOnceJobFinished(pod)
+			}
 		},
 	})
 
@@ -72,3 +85,26 @@ func (i *IngressCleanupService) removeAnyAssociatedIngress(pod *v1.Pod) {
 		}
 	}
 }
+
+func (i *IngressCleanupService) removePeerServiceOnceJobFinished(pod *v1.Pod) {
+	pods, err := i.clusterContext.GetBatchPods()
+	if err != nil {
+		log.Errorf("Failed to get pods of job of pod %s (%s) because %s", pod.Name, pod.Namespace, err)
+		return
+	}
+	jobId := pod.Labels[domain.JobId]
+	for _, jobPod := range pods {
+		if jobPod.Labels[domain.JobId] == jobId && !util.IsInTerminalState(jobPod) {
+			return
+		}
+	}
+
+	serviceName := util.GetPeerService(pod)
+	log.Infof("Removing peer service %s of finished job %s (%s)", serviceName, jobId, pod.Namespace)
+	err = i.clusterContext.DeleteService(&v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: pod.Namespace},
+	})
+	if err != nil {
+		log.Errorf("Failed to remove peer service %s (%s) because %s", serviceName, pod.Namespace, err)
+	}
+}
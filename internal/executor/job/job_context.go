@@ -20,6 +20,7 @@ const (
 	UnableToSchedule  IssueType = iota
 	StuckTerminating  IssueType = iota
 	ExternallyDeleted IssueType = iota
+	ExecutorDraining  IssueType = iota
 )
 
 type RunningJob struct {
@@ -48,6 +49,7 @@ type JobContext interface {
 	MarkIssuesResolved(job *RunningJob)
 	DeleteJobs(jobs []*RunningJob)
 	AddAnnotation(jobs []*RunningJob, annotations map[string]string) error
+	ReturnUnscheduledJobs(message string) error
 }
 
 type ClusterJobContext struct {
@@ -127,6 +129,52 @@ func (c *ClusterJobContext) AddAnnotation(jobs []*RunningJob, annotations map[st
 	return nil
 }
 
+// Registers a retryable issue for jobs whose pods are all waiting to be scheduled to a node,
+// so their pods are deleted and their leases returned while the job manager handles pod issues
+func (c *ClusterJobContext) ReturnUnscheduledJobs(message string) error {
+	pods, err := c.clusterContext.GetActiveBatchPods()
+	if err != nil {
+		return err
+	}
+
+	c.activeJobIdsMutex.Lock()
+	defer c.activeJobIdsMutex.Unlock()
+
+	for _, runningJob := range groupRunningJobs(pods) {
+		if !isUnscheduled(runningJob) {
+			continue
+		}
+		record, exists := c.activeJobs[runningJob.JobId]
+		if !exists {
+			record = &jobRecord{
+				jobId: runningJob.JobId,
+			}
+			c.activeJobs[runningJob.JobId] = record
+		}
+		if record.issue != nil {
+			continue
+		}
+		log.Infof("Returning lease of unscheduled job %s: %s", runningJob.JobId, message)
+		c.registerIssue(runningJob, &PodIssue{
+			OriginatingPod: runningJob.ActivePods[0].DeepCopy(),
+			Pods:           runningJob.ActivePods,
+			Message:        message,
+			Retryable:      true,
+			Type:           ExecutorDraining,
+		})
+	}
+	return nil
+}
+
+func isUnscheduled(runningJob *RunningJob) bool {
+	for _, pod := range runningJob.ActivePods {
+		if pod.Status.Phase != v1.PodPending || pod.Spec.NodeName != "" || pod.DeletionTimestamp != nil || util.IsMarkedForDeletion(pod) {
+			return false
+		}
+	}
+	return len(runningJob.ActivePods) > 0
+}
+
 func groupRunningJobs(pods []*v1.Pod) []*RunningJob {
 	podsByJobId := map[string][]*v1.Pod{}
 	for _, pod := range pods {
@@ -6,6 +6,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -50,68 +51,90 @@ func (allocationService *SubmitService) SubmitJobs(jobsToSubmit []*api.Job) []*F
 	toBeFailedJobs := make([]*FailedSubmissionDetails, 0, 10)
 	for _, job := range jobsToSubmit {
 		jobPods := []*v1.Pod{}
+		submittedPods := []*v1.Pod{}
 		for i, _ := range job.GetAllPodSpecs() {
-			pod, err := allocationService.submitPod(job, i)
+			pod, submittedPod, err := allocationService.submitPod(job, i)
 			jobPods = append(jobPods, pod)
 
 			if err != nil {
-				log.Errorf("Failed to submit job %s because %s", job.Id, err)
-
-				status, ok := err.(errors.APIStatus)
-				recoverable := !ok || isNotRecoverable(status.Status())
-
-				errDetails := &FailedSubmissionDetails{
-					Job:         job,
-					Pod:         pod,
-					Error:       err,
-					Recoverable: recoverable,
-				}
-
-				toBeFailedJobs = append(toBeFailedJobs, errDetails)
-
-				// remove just created pods
-				allocationService.clusterContext.DeletePods(jobPods)
+				toBeFailedJobs = append(toBeFailedJobs, allocationService.failSubmission(job, pod, jobPods, err))
 				break
 			}
+			submittedPods = append(submittedPods, submittedPod)
+		}
+
+		if job.PeerDiscovery && len(submittedPods) == len(jobPods) {
+			err := allocationService.submitPeerService(job, submittedPods)
+			if err != nil {
+				toBeFailedJobs = append(toBeFailedJobs, allocationService.failSubmission(job, jobPods[0], jobPods, err))
+			}
 		}
 	}
 
 	return toBeFailedJobs
 }
 
-func (allocationService *SubmitService) submitPod(job *api.Job, i int) (*v1.Pod, error) {
+func (allocationService *SubmitService) failSubmission(job *api.Job, pod *v1.Pod, jobPods []*v1.Pod, err error) *FailedSubmissionDetails {
+	log.Errorf("Failed to submit job %s because %s", job.Id, err)
+
+	status, ok := err.(errors.APIStatus)
+	recoverable := !ok || isNotRecoverable(status.Status())
+
+	// remove just created pods
+	allocationService.clusterContext.DeletePods(jobPods)
+
+	return &FailedSubmissionDetails{
+		Job:         job,
+		Pod:         pod,
+		Error:       err,
+		Recoverable: recoverable,
+	}
+}
+
+func (allocationService *SubmitService) submitPod(job *api.Job, i int) (*v1.Pod, *v1.Pod, error) {
 	pod := util2.CreatePod(job, allocationService.podDefaults, i)
 
+	services := []*v1.Service{}
+	ingresses := []*networking.Ingress{}
 	if exposesPorts(job, &pod.Spec) {
-		services, ingresses := util2.GenerateIngresses(job, pod, allocationService.podDefaults.Ingress)
+		services, ingresses = util2.GenerateIngresses(job, pod, allocationService.podDefaults.Ingress)
 		pod.Annotations = util.MergeMaps(pod.Annotations, map[string]string{
 			domain.HasIngress:               "true",
 			domain.AssociatedServicesCount:  fmt.Sprintf("%d", len(services)),
 			domain.AssociatedIngressesCount: fmt.Sprintf("%d", len(ingresses)),
 		})
-		submittedPod, err := allocationService.clusterContext.SubmitPod(pod, job.Owner, job.QueueOwnershipUserGroups)
+	}
+
+	submittedPod, err := allocationService.clusterContext.SubmitPod(pod, job.Owner, job.QueueOwnershipUserGroups)
+	if err != nil {
+		return pod, nil, err
+	}
+	for _, service := range services {
+		service.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
+		_, err = allocationService.clusterContext.SubmitService(service)
 		if err != nil {
-			return pod, err
+			return pod, nil, err
 		}
-		for _, service := range services {
-			service.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
-			_, err = allocationService.clusterContext.SubmitService(service)
-			if err != nil {
-				return pod, err
-			}
-		}
-		for _, ingress := range ingresses {
-			ingress.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
-			_, err = allocationService.clusterContext.SubmitIngress(ingress)
-			if err != nil {
-				return pod, err
-			}
+	}
+	for _, ingress := range ingresses {
+		ingress.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
+		_, err = allocationService.clusterContext.SubmitIngress(ingress)
+		if err != nil {
+			return pod, nil, err
 		}
-		return pod, err
-	} else {
-		_, err := allocationService.clusterContext.SubmitPod(pod, job.Owner, job.QueueOwnershipUserGroups)
-		return pod, err
 	}
+	return pod, submittedPod, nil
+}
+
+// The peer service selects all pods of the job and is owned by all of them, so it is only garbage collected once
+// every pod of the job is deleted
+func (allocationService *SubmitService) submitPeerService(job *api.Job, submittedPods []*v1.Pod) error {
+	service := util2.CreatePeerService(job)
+	for _, pod := range submittedPods {
+		service.ObjectMeta.OwnerReferences = append(service.ObjectMeta.OwnerReferences, util2.CreateOwnerReference(pod))
+	}
+	_, err := allocationService.clusterContext.SubmitService(service)
+	return err
 }
 
 func exposesPorts(job *api.Job, podSpec *v1.PodSpec) bool {
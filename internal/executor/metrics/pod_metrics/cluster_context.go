@@ -39,6 +39,12 @@ var podResourceRequestDesc = prometheus.NewDesc(
 	[]string{queueLabel, phaseLabel, resourceTypeLabel, nodeTypeLabel}, nil,
 )
 
+var podResourceLimitDesc = prometheus.NewDesc(
+	metrics.ArmadaExecutorMetricsPrefix+"job_pod_resource_limit",
+	"Pod resource limits in different phases by queue",
+	[]string{queueLabel, phaseLabel, resourceTypeLabel, nodeTypeLabel}, nil,
+)
+
 var podResourceUsageDesc = prometheus.NewDesc(
 	metrics.ArmadaExecutorMetricsPrefix+"job_pod_resource_usage",
 	"Pod resource usage in different phases by queue",
@@ -122,6 +128,7 @@ func (m *ClusterContextMetrics) reportPhase(pod *v1.Pod) {
 
 type podMetric struct {
 	resourceRequest common.ComputeResources
+	resourceLimit   common.ComputeResources
 	resourceUsage   common.ComputeResources
 	count           float64
 }
@@ -129,6 +136,7 @@ type podMetric struct {
 func (m *ClusterContextMetrics) Describe(desc chan<- *prometheus.Desc) {
 	desc <- podCountDesc
 	desc <- podResourceRequestDesc
+	desc <- podResourceLimitDesc
 	desc <- podResourceUsageDesc
 	desc <- nodeCountDesc
 	desc <- nodeAvailableResourceDesc
@@ -187,10 +195,12 @@ func (m *ClusterContextMetrics) Collect(metrics chan<- prometheus.Metric) {
 		}
 
 		request := common.TotalPodResourceRequest(&pod.Spec)
+		limit := common.TotalPodResourceLimit(&pod.Spec)
 		usage := m.queueUtilisationService.GetPodUtilisation(pod)
 
 		nodeTypeMetric[phase].count++
 		nodeTypeMetric[phase].resourceRequest.Add(request)
+		nodeTypeMetric[phase].resourceLimit.Add(limit)
 		nodeTypeMetric[phase].resourceUsage.Add(usage.CurrentUsage)
 	}
 	m.setEmptyMetrics(podMetrics)
@@ -204,6 +214,10 @@ func (m *ClusterContextMetrics) Collect(metrics chan<- prometheus.Metric) {
 					metrics <- prometheus.MustNewConstMetric(podResourceRequestDesc, prometheus.GaugeValue,
 						common.QuantityAsFloat64(request), queue, phase, resourceType, nodeType)
 				}
+				for resourceType, limit := range phaseMetric.resourceLimit {
+					metrics <- prometheus.MustNewConstMetric(podResourceLimitDesc, prometheus.GaugeValue,
+						common.QuantityAsFloat64(limit), queue, phase, resourceType, nodeType)
+				}
 				for resourceType, usage := range phaseMetric.resourceUsage {
 					metrics <- prometheus.MustNewConstMetric(podResourceUsageDesc, prometheus.GaugeValue,
 						common.QuantityAsFloat64(usage), queue, phase, resourceType, nodeType)
@@ -280,19 +294,22 @@ func createPodPhaseMetric() map[string]*podMetric {
 		"memory":            resource.MustParse("0"),
 		"ephemeral-storage": resource.MustParse("0"),
 	}
-	return map[string]*podMetric{
-		leasedPhase:             {resourceRequest: zeroComputeResource.DeepCopy(), resourceUsage: zeroComputeResource.DeepCopy()},
-		string(v1.PodPending):   {resourceRequest: zeroComputeResource.DeepCopy(), resourceUsage: zeroComputeResource.DeepCopy()},
-		string(v1.PodRunning):   {resourceRequest: zeroComputeResource.DeepCopy(), resourceUsage: zeroComputeResource.DeepCopy()},
-		string(v1.PodSucceeded): {resourceRequest: zeroComputeResource.DeepCopy(), resourceUsage: zeroComputeResource.DeepCopy()},
-		string(v1.PodFailed):    {resourceRequest: zeroComputeResource.DeepCopy(), resourceUsage: zeroComputeResource.DeepCopy()},
-		string(v1.PodUnknown):   {resourceRequest: zeroComputeResource.DeepCopy(), resourceUsage: zeroComputeResource.DeepCopy()},
+	phases := []string{leasedPhase, string(v1.PodPending), string(v1.PodRunning), string(v1.PodSucceeded), string(v1.PodFailed), string(v1.PodUnknown)}
+	phaseMetrics := make(map[string]*podMetric, len(phases))
+	for _, phase := range phases {
+		phaseMetrics[phase] = &podMetric{
+			resourceRequest: zeroComputeResource.DeepCopy(),
+			resourceLimit:   zeroComputeResource.DeepCopy(),
+			resourceUsage:   zeroComputeResource.DeepCopy(),
+		}
 	}
+	return phaseMetrics
 }
 
 func recordInvalidMetrics(metrics chan<- prometheus.Metric, e error) {
 	metrics <- prometheus.NewInvalidMetric(podCountDesc, e)
 	metrics <- prometheus.NewInvalidMetric(podResourceRequestDesc, e)
+	metrics <- prometheus.NewInvalidMetric(podResourceLimitDesc, e)
 	metrics <- prometheus.NewInvalidMetric(podResourceUsageDesc, e)
 	metrics <- prometheus.NewInvalidMetric(nodeCountDesc, e)
 	metrics <- prometheus.NewInvalidMetric(nodeAvailableResourceDesc, e)
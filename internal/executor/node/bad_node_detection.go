@@ -0,0 +1,286 @@
+package node
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/internal/executor/metrics"
+	"github.com/G-Research/armada/internal/executor/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	badNodeReason           = "ArmadaBadNode"
+	badNodeUncordonReason   = "ArmadaBadNodeUncordoned"
+	badNodeCordonTimeFormat = time.RFC3339
+)
+
+var badNodesCordoned = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: metrics.ArmadaExecutorMetricsPrefix + "bad_nodes_cordoned",
+	Help: "Number of nodes currently cordoned by the executor because too many jobs failed on them",
+})
+
+var badNodeCordons = promauto.NewCounter(prometheus.CounterOpts{
+	Name: metrics.ArmadaExecutorMetricsPrefix + "bad_node_cordons_total",
+	Help: "Number of times the executor cordoned a node because too many jobs failed on it",
+})
+
+var badNodeUncordons = promauto.NewCounter(prometheus.CounterOpts{
+	Name: metrics.ArmadaExecutorMetricsPrefix + "bad_node_uncordons_total",
+	Help: "Number of times the executor uncordoned a bad node after its cool-down",
+})
+
+// BadNodeDetector cordons nodes where too many jobs fail, so a broken node does not keep failing job after job.
+// Jobs finishing on each node are tracked over a sliding window, with failures classified by their cause.
+// Cordoned nodes are annotated with the time they were cordoned, so only nodes cordoned by the detector are
+// uncordoned after the cool-down, also after an executor restart.
+type BadNodeDetector struct {
+	clusterContext context.ClusterContext
+	config         configuration.BadNodeDetectionConfiguration
+	failureCauses  map[api.Cause]bool
+
+	mutex    sync.Mutex
+	finished map[string][]finishedJob
+}
+
+type finishedJob struct {
+	time   time.Time
+	failed bool
+	// Queue and job set of the job, so failures of a single broken job set do not get a node cordoned
+	jobSet string
+}
+
+func NewBadNodeDetector(clusterContext context.ClusterContext, config configuration.BadNodeDetectionConfiguration) (*BadNodeDetector, error) {
+	if config.Window <= 0 {
+		return nil, fmt.Errorf("bad node detection window must be positive")
+	}
+	if config.FailureThreshold <= 0 {
+		return nil, fmt.Errorf("bad node detection failure threshold must be positive")
+	}
+	if config.MaxCordonedFraction < 0 || config.MaxCordonedFraction > 1 {
+		return nil, fmt.Errorf("bad node detection max cordoned fraction must be between 0 and 1")
+	}
+	failureCauses := map[api.Cause]bool{}
+	for _, name := range config.FailureCauses {
+		cause, ok := api.Cause_value[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown bad node detection failure cause %s", name)
+		}
+		failureCauses[api.Cause(cause)] = true
+	}
+
+	detector := &BadNodeDetector{
+		clusterContext: clusterContext,
+		config:         config,
+		failureCauses:  failureCauses,
+		finished:       map[string][]finishedJob{},
+	}
+
+	clusterContext.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldPod, ok := oldObj.(*v1.Pod)
+			if !ok {
+				log.Errorf("Failed to process pod event due to it being an unexpected type. Failed to process %+v", oldObj)
+				return
+			}
+			newPod, ok := newObj.(*v1.Pod)
+			if !ok {
+				log.Errorf("Failed to process pod event due to it being an unexpected type. Failed to process %+v", newObj)
+				return
+			}
+			detector.recordFinishedPod(oldPod, newPod, time.Now())
+		},
+	})
+	return detector, nil
+}
+
+func (d *BadNodeDetector) recordFinishedPod(oldPod *v1.Pod, newPod *v1.Pod, now time.Time) {
+	if !util.IsManagedPod(newPod) || newPod.Spec.NodeName == "" || util.IsInTerminalState(oldPod) || !util.IsInTerminalState(newPod) {
+		return
+	}
+	// Pods deleted by the executor itself, e.g. of cancelled jobs or expired leases, say nothing about the node
+	if newPod.DeletionTimestamp != nil || util.IsMarkedForDeletion(newPod) {
+		return
+	}
+	failed := newPod.Status.Phase == v1.PodFailed && d.failureCauses[util.ExtractPodFailedCause(newPod)]
+	jobSet := util.ExtractQueue(newPod) + "/" + newPod.Annotations[domain.JobSetId]
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.finished[newPod.Spec.NodeName] = append(d.finished[newPod.Spec.NodeName], finishedJob{time: now, failed: failed, jobSet: jobSet})
+}
+
+// CheckNodes cordons nodes over the failure thresholds, and uncordons nodes it cordoned once their cool-down passed.
+// Once the configured fraction of nodes is cordoned by the detector, no further nodes are cordoned, as failures
+// on that many nodes point at a problem with the jobs or the cluster rather than with the nodes.
+func (d *BadNodeDetector) CheckNodes() {
+	nodes, err := d.clusterContext.GetNodes()
+	if err != nil {
+		log.Errorf("Failed to check for bad nodes because %s", err)
+		return
+	}
+
+	now := time.Now()
+	d.pruneFinished(nodes, now)
+
+	cordoned := 0
+	candidates := []*v1.Node{}
+	for _, node := range nodes {
+		cordonedAt, ok := badNodeCordonTime(node)
+		if ok && !node.Spec.Unschedulable {
+			d.removeStaleCordon(node)
+			ok = false
+		}
+		if ok {
+			if d.config.UncordonAfter > 0 && now.Sub(cordonedAt) >= d.config.UncordonAfter {
+				d.uncordon(node)
+			} else {
+				cordoned++
+			}
+		} else if !node.Spec.Unschedulable {
+			candidates = append(candidates, node)
+		}
+	}
+
+	for _, node := range candidates {
+		failures, failedJobSets, finished := d.countFinished(node.Name)
+		if !d.isBad(failures, failedJobSets, finished) {
+			continue
+		}
+		if d.config.MaxCordonedFraction > 0 && float64(cordoned+1) > d.config.MaxCordonedFraction*float64(len(nodes)) {
+			log.Warnf("Not cordoning bad node %s as %d of %d nodes are already cordoned", node.Name, cordoned, len(nodes))
+			continue
+		}
+		if d.cordon(node, failures, finished, now) {
+			cordoned++
+		}
+	}
+	badNodesCordoned.Set(float64(cordoned))
+}
+
+func (d *BadNodeDetector) isBad(failures int, failedJobSets int, finished int) bool {
+	return failures >= d.config.FailureThreshold &&
+		float64(failures) >= d.config.FailureRateThreshold*float64(finished) &&
+		failedJobSets >= d.config.MinFailedJobSets
+}
+
+func (d *BadNodeDetector) cordon(node *v1.Node, failures int, finished int, now time.Time) bool {
+	err := d.clusterContext.CordonNode(node, map[string]string{domain.BadNodeCordoned: now.Format(badNodeCordonTimeFormat)})
+	if err != nil {
+		log.Errorf("Failed to cordon bad node %s because %s", node.Name, err)
+		return false
+	}
+	badNodeCordons.Inc()
+
+	message := fmt.Sprintf("Cordoned by the Armada executor after %d of %d jobs failed on the node within %s", failures, finished, d.config.Window)
+	log.Warnf("Node %s: %s", node.Name, message)
+	err = d.clusterContext.RecordNodeEvent(node, v1.EventTypeWarning, badNodeReason, message)
+	if err != nil {
+		log.Errorf("Failed to record event for bad node %s because %s", node.Name, err)
+	}
+	return true
+}
+
+func (d *BadNodeDetector) uncordon(node *v1.Node) {
+	err := d.clusterContext.UncordonNode(node, []string{domain.BadNodeCordoned})
+	if err != nil {
+		log.Errorf("Failed to uncordon bad node %s because %s", node.Name, err)
+		return
+	}
+	badNodeUncordons.Inc()
+
+	// Failures from before the cool-down should not cordon the node again
+	d.mutex.Lock()
+	delete(d.finished, node.Name)
+	d.mutex.Unlock()
+
+	message := fmt.Sprintf("Uncordoned by the Armada executor after its %s cool-down", d.config.UncordonAfter)
+	log.Infof("Node %s: %s", node.Name, message)
+	err = d.clusterContext.RecordNodeEvent(node, v1.EventTypeNormal, badNodeUncordonReason, message)
+	if err != nil {
+		log.Errorf("Failed to record event for bad node %s because %s", node.Name, err)
+	}
+}
+
+// Returns the number of failed jobs, the number of distinct job sets they belong to and the number of finished jobs
+// Nodes uncordoned by someone else still carry the annotation, which is removed so the node is evaluated again
+func (d *BadNodeDetector) removeStaleCordon(node *v1.Node) {
+	err := d.clusterContext.UncordonNode(node, []string{domain.BadNodeCordoned})
+	if err != nil {
+		log.Errorf("Failed to remove %s annotation from uncordoned node %s because %s", domain.BadNodeCordoned, node.Name, err)
+		return
+	}
+	log.Infof("Node %s was uncordoned outside of the Armada executor, removed its %s annotation", node.Name, domain.BadNodeCordoned)
+
+	// Failures from before the node was uncordoned should not cordon the node again
+	d.mutex.Lock()
+	delete(d.finished, node.Name)
+	d.mutex.Unlock()
+}
+
+func (d *BadNodeDetector) countFinished(nodeName string) (int, int, int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	jobs := d.finished[nodeName]
+	failures := 0
+	failedJobSets := map[string]bool{}
+	for _, job := range jobs {
+		if job.failed {
+			failures++
+			failedJobSets[job.jobSet] = true
+		}
+	}
+	return failures, len(failedJobSets), len(jobs)
+}
+
+// Drops jobs which finished before the window, and nodes no longer in the cluster
+func (d *BadNodeDetector) pruneFinished(nodes []*v1.Node, now time.Time) {
+	existing := map[string]bool{}
+	for _, node := range nodes {
+		existing[node.Name] = true
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	windowStart := now.Add(-d.config.Window)
+	for nodeName, jobs := range d.finished {
+		if !existing[nodeName] {
+			delete(d.finished, nodeName)
+			continue
+		}
+		i := 0
+		for i < len(jobs) && jobs[i].time.Before(windowStart) {
+			i++
+		}
+		if i == len(jobs) {
+			delete(d.finished, nodeName)
+		} else {
+			d.finished[nodeName] = jobs[i:]
+		}
+	}
+}
+
+// Returns when the detector cordoned the node, nodes with an invalid annotation are not treated as cordoned by it
+func badNodeCordonTime(node *v1.Node) (time.Time, bool) {
+	value, ok := node.Annotations[domain.BadNodeCordoned]
+	if !ok {
+		return time.Time{}, false
+	}
+	cordonedAt, err := time.Parse(badNodeCordonTimeFormat, value)
+	if err != nil {
+		log.Errorf("Invalid %s annotation on node %s: %s", domain.BadNodeCordoned, node.Name, value)
+		return time.Time{}, false
+	}
+	return cordonedAt, true
+}
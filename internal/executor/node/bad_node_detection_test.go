@@ -0,0 +1,297 @@
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+var testBadNodeDetectionConfig = configuration.BadNodeDetectionConfiguration{
+	Enabled:              true,
+	Window:               time.Hour,
+	FailureThreshold:     2,
+	FailureRateThreshold: 0.5,
+	FailureCauses:        []string{"Error"},
+	UncordonAfter:        time.Hour,
+}
+
+func TestNewBadNodeDetector_WhenUnknownFailureCause_Fails(t *testing.T) {
+	config := testBadNodeDetectionConfig
+	config.FailureCauses = []string{"Broken"}
+
+	_, err := NewBadNodeDetector(newFakeNodeContext(), config)
+	assert.Error(t, err)
+}
+
+func TestBadNodeDetector_CordonsNode_WhenFailuresOverThresholds(t *testing.T) {
+	clusterContext := newFakeNodeContext(makeNode("node1"), makeNode("node2"))
+	detector, err := NewBadNodeDetector(clusterContext, testBadNodeDetectionConfig)
+	assert.NoError(t, err)
+
+	clusterContext.finishPod("node1", v1.PodFailed)
+	clusterContext.finishPod("node1", v1.PodFailed)
+	clusterContext.finishPod("node1", v1.PodSucceeded)
+	clusterContext.finishPod("node2", v1.PodFailed)
+	detector.CheckNodes()
+
+	assert.Equal(t, []string{"node1"}, clusterContext.cordoned)
+	assert.True(t, clusterContext.nodes[0].Spec.Unschedulable)
+	assert.Contains(t, clusterContext.nodes[0].Annotations, domain.BadNodeCordoned)
+	assert.Equal(t, []string{badNodeReason}, clusterContext.events)
+	assert.False(t, clusterContext.nodes[1].Spec.Unschedulable)
+}
+
+func TestBadNodeDetector_DoesNotCordonNode_WhenFailureRateUnderThreshold(t *testing.T) {
+	clusterContext := newFakeNodeContext(makeNode("node1"))
+	detector, err := NewBadNodeDetector(clusterContext, testBadNodeDetectionConfig)
+	assert.NoError(t, err)
+
+	clusterContext.finishPod("node1", v1.PodFailed)
+	clusterContext.finishPod("node1", v1.PodFailed)
+	clusterContext.finishPod("node1", v1.PodSucceeded)
+	clusterContext.finishPod("node1", v1.PodSucceeded)
+	clusterContext.finishPod("node1", v1.PodSucceeded)
+	detector.CheckNodes()
+
+	assert.Empty(t, clusterContext.cordoned)
+}
+
+func TestBadNodeDetector_DoesNotCordonNode_WhenFailuresFromTooFewJobSets(t *testing.T) {
+	config := testBadNodeDetectionConfig
+	config.MinFailedJobSets = 2
+	clusterContext := newFakeNodeContext(makeNode("node1"), makeNode("node2"))
+	detector, err := NewBadNodeDetector(clusterContext, config)
+	assert.NoError(t, err)
+
+	clusterContext.updatePod(makeFinishedJobSetPod("node1", "jobSet1"))
+	clusterContext.updatePod(makeFinishedJobSetPod("node1", "jobSet1"))
+	clusterContext.updatePod(makeFinishedJobSetPod("node2", "jobSet1"))
+	clusterContext.updatePod(makeFinishedJobSetPod("node2", "jobSet2"))
+	detector.CheckNodes()
+
+	assert.Equal(t, []string{"node2"}, clusterContext.cordoned)
+}
+
+func TestBadNodeDetector_StopsCordoning_AtMaxCordonedFraction(t *testing.T) {
+	config := testBadNodeDetectionConfig
+	config.MaxCordonedFraction = 0.5
+	cordonedNode := makeNode("node1")
+	cordonedNode.Spec.Unschedulable = true
+	cordonedNode.Annotations = map[string]string{domain.BadNodeCordoned: time.Now().Format(time.RFC3339)}
+	clusterContext := newFakeNodeContext(cordonedNode, makeNode("node2"), makeNode("node3"), makeNode("node4"))
+	detector, err := NewBadNodeDetector(clusterContext, config)
+	assert.NoError(t, err)
+
+	for _, nodeName := range []string{"node2", "node3", "node4"} {
+		clusterContext.finishPod(nodeName, v1.PodFailed)
+		clusterContext.finishPod(nodeName, v1.PodFailed)
+	}
+	detector.CheckNodes()
+
+	assert.Equal(t, []string{"node2"}, clusterContext.cordoned)
+	assert.False(t, clusterContext.nodes[2].Spec.Unschedulable)
+	assert.False(t, clusterContext.nodes[3].Spec.Unschedulable)
+}
+
+func TestBadNodeDetector_IgnoresFailures_WithOtherCauses(t *testing.T) {
+	clusterContext := newFakeNodeContext(makeNode("node1"))
+	detector, err := NewBadNodeDetector(clusterContext, testBadNodeDetectionConfig)
+	assert.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		pod := makeFinishedPod("node1", v1.PodFailed)
+		pod.Status.Reason = "Evicted"
+		clusterContext.updatePod(pod)
+	}
+	detector.CheckNodes()
+
+	assert.Empty(t, clusterContext.cordoned)
+}
+
+func TestBadNodeDetector_IgnoresFailures_OfPodsDeletedByExecutor(t *testing.T) {
+	clusterContext := newFakeNodeContext(makeNode("node1"))
+	detector, err := NewBadNodeDetector(clusterContext, testBadNodeDetectionConfig)
+	assert.NoError(t, err)
+
+	markedPod := makeFinishedPod("node1", v1.PodFailed)
+	markedPod.Annotations = map[string]string{domain.MarkedForDeletion: time.Now().String()}
+	clusterContext.updatePod(markedPod)
+	deletedPod := makeFinishedPod("node1", v1.PodFailed)
+	deletionTime := metav1.Now()
+	deletedPod.DeletionTimestamp = &deletionTime
+	clusterContext.updatePod(deletedPod)
+	detector.CheckNodes()
+
+	assert.Empty(t, clusterContext.cordoned)
+}
+
+func TestBadNodeDetector_IgnoresFailures_OutsideWindow(t *testing.T) {
+	clusterContext := newFakeNodeContext(makeNode("node1"))
+	detector, err := NewBadNodeDetector(clusterContext, testBadNodeDetectionConfig)
+	assert.NoError(t, err)
+
+	old := time.Now().Add(-2 * time.Hour)
+	detector.recordFinishedPod(makeRunningPod("node1"), makeFinishedPod("node1", v1.PodFailed), old)
+	detector.recordFinishedPod(makeRunningPod("node1"), makeFinishedPod("node1", v1.PodFailed), old)
+	clusterContext.finishPod("node1", v1.PodFailed)
+	detector.CheckNodes()
+
+	assert.Empty(t, clusterContext.cordoned)
+}
+
+func TestBadNodeDetector_UncordonsNode_AfterCoolDown(t *testing.T) {
+	cordonedNode := makeNode("node1")
+	cordonedNode.Spec.Unschedulable = true
+	cordonedNode.Annotations = map[string]string{domain.BadNodeCordoned: time.Now().Add(-2 * time.Hour).Format(time.RFC3339)}
+	recentlyCordonedNode := makeNode("node2")
+	recentlyCordonedNode.Spec.Unschedulable = true
+	recentlyCordonedNode.Annotations = map[string]string{domain.BadNodeCordoned: time.Now().Format(time.RFC3339)}
+	clusterContext := newFakeNodeContext(cordonedNode, recentlyCordonedNode)
+	detector, err := NewBadNodeDetector(clusterContext, testBadNodeDetectionConfig)
+	assert.NoError(t, err)
+
+	detector.CheckNodes()
+
+	assert.Equal(t, []string{"node1"}, clusterContext.uncordoned)
+	assert.False(t, cordonedNode.Spec.Unschedulable)
+	assert.NotContains(t, cordonedNode.Annotations, domain.BadNodeCordoned)
+	assert.True(t, recentlyCordonedNode.Spec.Unschedulable)
+}
+
+func TestBadNodeDetector_RemovesAnnotation_WhenNodeUncordonedByOthers(t *testing.T) {
+	config := testBadNodeDetectionConfig
+	config.UncordonAfter = 0
+	config.MaxCordonedFraction = 0.5
+	uncordonedNode := makeNode("node1")
+	uncordonedNode.Annotations = map[string]string{domain.BadNodeCordoned: time.Now().Format(time.RFC3339)}
+	clusterContext := newFakeNodeContext(uncordonedNode, makeNode("node2"))
+	detector, err := NewBadNodeDetector(clusterContext, config)
+	assert.NoError(t, err)
+
+	clusterContext.finishPod("node2", v1.PodFailed)
+	clusterContext.finishPod("node2", v1.PodFailed)
+	detector.CheckNodes()
+
+	assert.NotContains(t, uncordonedNode.Annotations, domain.BadNodeCordoned)
+	assert.False(t, uncordonedNode.Spec.Unschedulable)
+	assert.Equal(t, []string{"node2"}, clusterContext.cordoned)
+}
+
+func TestBadNodeDetector_DoesNotUncordonNode_WithInvalidAnnotation(t *testing.T) {
+	node := makeNode("node1")
+	node.Spec.Unschedulable = true
+	node.Annotations = map[string]string{domain.BadNodeCordoned: "invalid"}
+	clusterContext := newFakeNodeContext(node)
+	detector, err := NewBadNodeDetector(clusterContext, testBadNodeDetectionConfig)
+	assert.NoError(t, err)
+
+	detector.CheckNodes()
+
+	assert.Empty(t, clusterContext.uncordoned)
+	assert.True(t, node.Spec.Unschedulable)
+}
+
+func TestBadNodeDetector_DoesNotUncordonNode_CordonedByOthers(t *testing.T) {
+	node := makeNode("node1")
+	node.Spec.Unschedulable = true
+	clusterContext := newFakeNodeContext(node)
+	detector, err := NewBadNodeDetector(clusterContext, testBadNodeDetectionConfig)
+	assert.NoError(t, err)
+
+	clusterContext.finishPod("node1", v1.PodFailed)
+	clusterContext.finishPod("node1", v1.PodFailed)
+	detector.CheckNodes()
+
+	assert.Empty(t, clusterContext.cordoned)
+	assert.Empty(t, clusterContext.uncordoned)
+	assert.True(t, node.Spec.Unschedulable)
+}
+
+type fakeNodeContext struct {
+	context.ClusterContext
+	nodes      []*v1.Node
+	handlers   []cache.ResourceEventHandlerFuncs
+	cordoned   []string
+	uncordoned []string
+	events     []string
+}
+
+func newFakeNodeContext(nodes ...*v1.Node) *fakeNodeContext {
+	return &fakeNodeContext{nodes: nodes}
+}
+
+func (c *fakeNodeContext) AddPodEventHandler(handler cache.ResourceEventHandlerFuncs) {
+	c.handlers = append(c.handlers, handler)
+}
+
+func (c *fakeNodeContext) GetNodes() ([]*v1.Node, error) {
+	return c.nodes, nil
+}
+
+func (c *fakeNodeContext) CordonNode(node *v1.Node, annotations map[string]string) error {
+	c.cordoned = append(c.cordoned, node.Name)
+	node.Spec.Unschedulable = true
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		node.Annotations[k] = v
+	}
+	return nil
+}
+
+func (c *fakeNodeContext) UncordonNode(node *v1.Node, annotations []string) error {
+	c.uncordoned = append(c.uncordoned, node.Name)
+	node.Spec.Unschedulable = false
+	for _, k := range annotations {
+		delete(node.Annotations, k)
+	}
+	return nil
+}
+
+func (c *fakeNodeContext) RecordNodeEvent(node *v1.Node, eventType string, reason string, message string) error {
+	c.events = append(c.events, reason)
+	return nil
+}
+
+func (c *fakeNodeContext) finishPod(nodeName string, phase v1.PodPhase) {
+	c.updatePod(makeFinishedPod(nodeName, phase))
+}
+
+func (c *fakeNodeContext) updatePod(pod *v1.Pod) {
+	for _, handler := range c.handlers {
+		handler.OnUpdate(makeRunningPod(pod.Spec.NodeName), pod)
+	}
+}
+
+func makeNode(name string) *v1.Node {
+	return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+func makeRunningPod(nodeName string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{domain.JobId: "job"}},
+		Spec:       v1.PodSpec{NodeName: nodeName},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func makeFinishedJobSetPod(nodeName string, jobSetId string) *v1.Pod {
+	pod := makeFinishedPod(nodeName, v1.PodFailed)
+	pod.Annotations = map[string]string{domain.JobSetId: jobSetId}
+	return pod
+}
+
+func makeFinishedPod(nodeName string, phase v1.PodPhase) *v1.Pod {
+	pod := makeRunningPod(nodeName)
+	pod.Status.Phase = phase
+	return pod
+}
@@ -112,7 +112,11 @@ func CreateJobIngressInfoEvent(pod *v1.Pod, clusterId string, associatedServices
 	if associatedServices == nil || associatedIngresses == nil {
 		return nil, fmt.Errorf("unable to create JobIngressInfoEvent for pod %s (%s), associated ingresses may not be nil", pod.Name, pod.Namespace)
 	}
-	if len(associatedServices) == 0 && len(associatedIngresses) == 0 {
+	peerHostname := ""
+	if util.GetPeerService(pod) != "" {
+		peerHostname = pod.Spec.Hostname + "." + pod.Spec.Subdomain
+	}
+	if len(associatedServices) == 0 && len(associatedIngresses) == 0 && peerHostname == "" {
 		return nil, fmt.Errorf("unable to create JobIngressInfoEvent for pod %s (%s), as no associated ingress provided", pod.Name, pod.Namespace)
 	}
 	containerPortMapping := map[int32]string{}
@@ -145,6 +149,7 @@ func CreateJobIngressInfoEvent(pod *v1.Pod, clusterId string, associatedServices
 		PodNamespace:     pod.Namespace,
 		NodeName:         pod.Spec.NodeName,
 		IngressAddresses: containerPortMapping,
+		PeerHostname:     peerHostname,
 	}, nil
 }
 
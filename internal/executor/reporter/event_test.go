@@ -8,6 +8,7 @@ import (
 	networking "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -157,6 +158,21 @@ func TestCreateJobIngressInfoEvent_EmptyIngresses(t *testing.T) {
 	assert.Nil(t, event)
 }
 
+func TestCreateJobIngressInfoEvent_PeerDiscovery(t *testing.T) {
+	pod := createNodeAllocatedPod()
+	pod.Annotations = map[string]string{domain.PeerService: "armada-id-peers"}
+	pod.Spec.Hostname = "armada-id-0"
+	pod.Spec.Subdomain = "armada-id-peers"
+
+	event, err := CreateJobIngressInfoEvent(pod, "cluster1", []*v1.Service{}, []*networking.Ingress{})
+	assert.NoError(t, err)
+
+	ingressEvent, ok := event.(*api.JobIngressInfoEvent)
+	assert.True(t, ok)
+	assert.Equal(t, "armada-id-0.armada-id-peers", ingressEvent.PeerHostname)
+	assert.Empty(t, ingressEvent.IngressAddresses)
+}
+
 func createNodeAllocatedPod() *v1.Pod {
 	return &v1.Pod{
 		Spec: v1.PodSpec{
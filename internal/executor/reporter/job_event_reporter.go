@@ -9,6 +9,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/G-Research/armada/internal/common"
+	commonUtil "github.com/G-Research/armada/internal/common/util"
 	clusterContext "github.com/G-Research/armada/internal/executor/context"
 	domain2 "github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/internal/executor/util"
@@ -35,17 +36,29 @@ type JobEventReporter struct {
 
 	clusterContext clusterContext.ClusterContext
 	stop           chan bool
+	// Done once events queued before stopping have been sent
+	stopped sync.WaitGroup
+
+	// When set, events are acknowledged to callers once persisted in the outbox, and sent to the server from there
+	outbox              *EventOutbox
+	outboxRetryInterval time.Duration
 }
 
-func NewJobEventReporter(clusterContext clusterContext.ClusterContext, eventClient api.EventClient) (*JobEventReporter, chan bool) {
+func NewJobEventReporter(
+	clusterContext clusterContext.ClusterContext,
+	eventClient api.EventClient,
+	outbox *EventOutbox,
+	outboxRetryInterval time.Duration) (*JobEventReporter, chan bool) {
 
 	stop := make(chan bool)
 	reporter := &JobEventReporter{
-		eventClient:      eventClient,
-		clusterContext:   clusterContext,
-		eventBuffer:      make(chan *queuedEvent, 1000000),
-		eventQueued:      map[string]uint8{},
-		eventQueuedMutex: sync.Mutex{}}
+		eventClient:         eventClient,
+		clusterContext:      clusterContext,
+		eventBuffer:         make(chan *queuedEvent, 1000000),
+		eventQueued:         map[string]uint8{},
+		eventQueuedMutex:    sync.Mutex{},
+		outbox:              outbox,
+		outboxRetryInterval: outboxRetryInterval}
 
 	clusterContext.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -71,12 +84,21 @@ func NewJobEventReporter(clusterContext clusterContext.ClusterContext, eventClie
 		},
 	})
 
-	go reporter.processEventQueue(stop)
+	outboxStop := make(chan bool)
+	reporter.stopped.Add(1)
+	go reporter.processEventQueue(stop, outboxStop)
+	if outbox != nil {
+		reporter.stopped.Add(1)
+		go reporter.processOutbox(outboxStop)
+	}
 
 	return reporter, stop
 }
 
 func (eventReporter *JobEventReporter) Report(event api.Event) error {
+	if eventReporter.outbox != nil {
+		return eventReporter.persistEvents([]*queuedEvent{{Event: event}})
+	}
 	return eventReporter.sendEvent(event)
 }
 
@@ -126,7 +148,23 @@ func (eventReporter *JobEventReporter) QueueEvent(event api.Event, callback func
 	eventReporter.eventBuffer <- &queuedEvent{event, callback}
 }
 
-func (eventReporter *JobEventReporter) processEventQueue(stop chan bool) {
+// WaitForStop waits until events queued before the reporter was stopped have been sent, returning true if the timeout passed first
+func (eventReporter *JobEventReporter) WaitForStop(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		eventReporter.stopped.Wait()
+	}()
+	select {
+	case <-done:
+		return false
+	case <-time.After(timeout):
+		return true
+	}
+}
+
+func (eventReporter *JobEventReporter) processEventQueue(stop chan bool, outboxStop chan bool) {
+	defer eventReporter.stopped.Done()
 	for {
 
 		select {
@@ -135,6 +173,7 @@ func (eventReporter *JobEventReporter) processEventQueue(stop chan bool) {
 				batch := eventReporter.fillBatch()
 				eventReporter.sendBatch(batch)
 			}
+			close(outboxStop)
 			return
 		case event := <-eventReporter.eventBuffer:
 			batch := eventReporter.fillBatch(event)
@@ -151,7 +190,12 @@ func (eventReporter *JobEventReporter) fillBatch(batch ...*queuedEvent) []*queue
 }
 
 func (eventReporter *JobEventReporter) sendBatch(batch []*queuedEvent) {
-	err := eventReporter.sendEvents(batch)
+	var err error
+	if eventReporter.outbox != nil {
+		err = eventReporter.persistEvents(batch)
+	} else {
+		err = eventReporter.sendEvents(batch)
+	}
 	go func() {
 		for _, e := range batch {
 			e.Callback(err)
@@ -178,6 +222,12 @@ func (eventReporter *JobEventReporter) sendEvents(events []*queuedEvent) error {
 		if err != nil {
 			return err
 		}
+	}
+	return eventReporter.sendMessages(eventMessages)
+}
+
+func (eventReporter *JobEventReporter) sendMessages(eventMessages []*api.EventMessage) error {
+	for _, m := range eventMessages {
 		log.Infof("Reporting event %+v", m)
 	}
 	ctx, cancel := common.ContextWithDefaultTimeout()
@@ -186,6 +236,60 @@ func (eventReporter *JobEventReporter) sendEvents(events []*queuedEvent) error {
 	return err
 }
 
+// Each event gets a deduplication key, so the server ignores it if it is sent again after a failure or restart
+func (eventReporter *JobEventReporter) persistEvents(events []*queuedEvent) error {
+	eventMessages := []*api.EventMessage{}
+	for _, e := range events {
+		m, err := api.Wrap(e.Event)
+		if err != nil {
+			return err
+		}
+		m.DeduplicationKey = commonUtil.NewULID()
+		eventMessages = append(eventMessages, m)
+	}
+	return eventReporter.outbox.Append(eventMessages...)
+}
+
+// Sends events from the outbox in order, retrying the oldest batch until the server accepts it
+func (eventReporter *JobEventReporter) processOutbox(stop chan bool) {
+	defer eventReporter.stopped.Done()
+	defer func() {
+		err := eventReporter.outbox.Close()
+		if err != nil {
+			log.Errorf("Failed to close event outbox because %s", err)
+		}
+	}()
+
+	for {
+		records := eventReporter.outbox.Peek(batchSize)
+		if len(records) == 0 {
+			select {
+			case <-stop:
+				return
+			case <-eventReporter.outbox.EventsAdded():
+			}
+			continue
+		}
+
+		eventMessages := make([]*api.EventMessage, 0, len(records))
+		for _, r := range records {
+			eventMessages = append(eventMessages, r.message)
+		}
+		err := eventReporter.sendMessages(eventMessages)
+		if err == nil {
+			err = eventReporter.outbox.Acknowledge(records[len(records)-1].sequence)
+		}
+		if err != nil {
+			log.Errorf("Failed to report %d events from outbox because %s", len(records), err)
+			select {
+			case <-stop:
+				return
+			case <-time.After(eventReporter.outboxRetryInterval):
+			}
+		}
+	}
+}
+
 func (eventReporter *JobEventReporter) sendEvent(event api.Event) error {
 	eventMessage, err := api.Wrap(event)
 	if err != nil {
@@ -283,7 +387,7 @@ func (eventReporter *JobEventReporter) attemptToReportIngressInfoEvent(pod *v1.P
 }
 
 func requiresIngressToBeReported(pod *v1.Pod) bool {
-	if !util.HasIngress(pod) {
+	if !util.HasIngress(pod) && util.GetPeerService(pod) == "" {
 		return false
 	}
 	if _, exists := pod.Annotations[domain2.IngressReported]; exists {
@@ -36,3 +36,12 @@ func TestRequiresIngressToBeReported_TrueWhenHasIngressButNotIngressReportedAnno
 	}
 	assert.True(t, requiresIngressToBeReported(pod))
 }
+
+func TestRequiresIngressToBeReported_TrueWhenHasPeerService(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{domain.PeerService: "armada-id-peers"},
+		},
+	}
+	assert.True(t, requiresIngressToBeReported(pod))
+}
@@ -0,0 +1,384 @@
+package reporter
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/executor/metrics"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	outboxSegmentSuffix    = ".log"
+	outboxAcknowledgedFile = "acknowledged"
+	outboxRecordHeaderSize = 8
+	defaultSegmentSize     = 1000
+)
+
+var outboxPendingEvents = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: metrics.ArmadaExecutorMetricsPrefix + "event_outbox_pending_events",
+	Help: "Number of events persisted in the outbox which are not yet acknowledged by the server",
+})
+
+var outboxBlockedSeconds = promauto.NewCounter(prometheus.CounterOpts{
+	Name: metrics.ArmadaExecutorMetricsPrefix + "event_outbox_blocked_seconds_total",
+	Help: "Time spent waiting for space in a full outbox",
+})
+
+var outboxReplayedEvents = promauto.NewCounter(prometheus.CounterOpts{
+	Name: metrics.ArmadaExecutorMetricsPrefix + "event_outbox_replayed_events_total",
+	Help: "Number of unacknowledged events recovered from disk on startup",
+})
+
+type outboxRecord struct {
+	sequence uint64
+	message  *api.EventMessage
+}
+
+type outboxSegment struct {
+	firstSequence uint64
+	size          int
+}
+
+// EventOutbox is a write-ahead log of events on local disk.
+// Events are appended to segment files, named after the sequence number of their first event, and removed once all
+// events of a segment are acknowledged. The sequence number of the last acknowledged event is kept in a separate file.
+type EventOutbox struct {
+	directory   string
+	maxEvents   int
+	segmentSize int
+
+	mutex          sync.Mutex
+	spaceAvailable *sync.Cond
+	eventsAdded    chan bool
+
+	pending      []*outboxRecord
+	nextSequence uint64
+	acknowledged uint64
+	segments     []*outboxSegment
+	current      *os.File
+}
+
+// OpenEventOutbox loads the events which were not acknowledged before the executor stopped.
+// Appending blocks while maxEvents events are pending.
+func OpenEventOutbox(directory string, maxEvents int) (*EventOutbox, error) {
+	return openEventOutbox(directory, maxEvents, defaultSegmentSize)
+}
+
+func openEventOutbox(directory string, maxEvents int, segmentSize int) (*EventOutbox, error) {
+	if maxEvents <= 0 {
+		return nil, fmt.Errorf("outbox size must be positive, got %d", maxEvents)
+	}
+	err := os.MkdirAll(directory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	outbox := &EventOutbox{
+		directory:   directory,
+		maxEvents:   maxEvents,
+		segmentSize: segmentSize,
+		eventsAdded: make(chan bool, 1),
+		pending:     []*outboxRecord{},
+	}
+	outbox.spaceAvailable = sync.NewCond(&outbox.mutex)
+
+	err = outbox.load()
+	if err != nil {
+		return nil, err
+	}
+	outboxReplayedEvents.Add(float64(len(outbox.pending)))
+	outboxPendingEvents.Set(float64(len(outbox.pending)))
+	if len(outbox.pending) > 0 {
+		outbox.eventsAdded <- true
+		log.Infof("Replaying %d unacknowledged events from outbox %s", len(outbox.pending), directory)
+	}
+	return outbox, nil
+}
+
+// Append persists the messages, waiting while the outbox is full
+func (o *EventOutbox) Append(messages ...*api.EventMessage) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if len(o.pending)+len(messages) > o.maxEvents {
+		start := time.Now()
+		for len(o.pending) > 0 && len(o.pending)+len(messages) > o.maxEvents {
+			o.spaceAvailable.Wait()
+		}
+		outboxBlockedSeconds.Add(time.Since(start).Seconds())
+	}
+
+	if o.current == nil || o.segments[len(o.segments)-1].size >= o.segmentSize {
+		err := o.startSegment()
+		if err != nil {
+			return err
+		}
+	}
+
+	data := []byte{}
+	for _, m := range messages {
+		record, err := encodeOutboxRecord(m)
+		if err != nil {
+			return err
+		}
+		data = append(data, record...)
+	}
+	_, err := o.current.Write(data)
+	if err == nil {
+		err = o.current.Sync()
+	}
+	if err != nil {
+		// The segment may end with a partial record, continue in a new one
+		o.closeSegment()
+		return err
+	}
+
+	for _, m := range messages {
+		o.pending = append(o.pending, &outboxRecord{sequence: o.nextSequence, message: m})
+		o.nextSequence++
+	}
+	o.segments[len(o.segments)-1].size += len(messages)
+	outboxPendingEvents.Set(float64(len(o.pending)))
+
+	select {
+	case o.eventsAdded <- true:
+	default:
+	}
+	return nil
+}
+
+// EventsAdded signals when events are appended
+func (o *EventOutbox) EventsAdded() <-chan bool {
+	return o.eventsAdded
+}
+
+// Peek returns up to limit of the oldest unacknowledged events
+func (o *EventOutbox) Peek(limit int) []*outboxRecord {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if limit > len(o.pending) {
+		limit = len(o.pending)
+	}
+	result := make([]*outboxRecord, limit)
+	copy(result, o.pending)
+	return result
+}
+
+// Acknowledge removes all events up to and including the given sequence number
+func (o *EventOutbox) Acknowledge(sequence uint64) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if sequence <= o.acknowledged {
+		return nil
+	}
+	err := o.writeAcknowledged(sequence)
+	if err != nil {
+		return err
+	}
+	o.acknowledged = sequence
+
+	acknowledgedCount := sort.Search(len(o.pending), func(i int) bool { return o.pending[i].sequence > sequence })
+	o.pending = o.pending[acknowledgedCount:]
+	outboxPendingEvents.Set(float64(len(o.pending)))
+	o.spaceAvailable.Broadcast()
+
+	o.removeAcknowledgedSegments()
+	return nil
+}
+
+func (o *EventOutbox) Close() error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.closeSegment()
+}
+
+func (o *EventOutbox) removeAcknowledgedSegments() {
+	for len(o.segments) > 1 && o.segments[1].firstSequence <= o.acknowledged+1 {
+		err := os.Remove(o.segmentPath(o.segments[0].firstSequence))
+		if err != nil {
+			log.Errorf("Failed to remove outbox segment because %s", err)
+			return
+		}
+		o.segments = o.segments[1:]
+	}
+}
+
+func (o *EventOutbox) startSegment() error {
+	err := o.closeSegment()
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(o.segmentPath(o.nextSequence), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	o.current = file
+	if last := len(o.segments) - 1; last >= 0 && o.segments[last].firstSequence == o.nextSequence {
+		// Replaces an empty segment left behind by a failed write
+		o.segments = o.segments[:last]
+	}
+	o.segments = append(o.segments, &outboxSegment{firstSequence: o.nextSequence})
+	o.removeAcknowledgedSegments()
+	return nil
+}
+
+func (o *EventOutbox) closeSegment() error {
+	if o.current == nil {
+		return nil
+	}
+	err := o.current.Close()
+	o.current = nil
+	return err
+}
+
+func (o *EventOutbox) segmentPath(firstSequence uint64) string {
+	return filepath.Join(o.directory, fmt.Sprintf("%020d%s", firstSequence, outboxSegmentSuffix))
+}
+
+func (o *EventOutbox) writeAcknowledged(sequence uint64) error {
+	path := filepath.Join(o.directory, outboxAcknowledgedFile)
+	tmp, err := ioutil.TempFile(o.directory, outboxAcknowledgedFile)
+	if err != nil {
+		return err
+	}
+	_, err = tmp.WriteString(strconv.FormatUint(sequence, 10))
+	if err == nil {
+		err = tmp.Sync()
+	}
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (o *EventOutbox) load() error {
+	acknowledged, err := ioutil.ReadFile(filepath.Join(o.directory, outboxAcknowledgedFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(acknowledged) > 0 {
+		o.acknowledged, err = strconv.ParseUint(strings.TrimSpace(string(acknowledged)), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid outbox acknowledgement: %s", err)
+		}
+	}
+	o.nextSequence = o.acknowledged + 1
+
+	segments, err := o.listSegments()
+	if err != nil {
+		return err
+	}
+	for _, firstSequence := range segments {
+		path := o.segmentPath(firstSequence)
+		messages, err := readOutboxSegment(path)
+		if err != nil {
+			return err
+		}
+		o.segments = append(o.segments, &outboxSegment{firstSequence: firstSequence, size: len(messages)})
+		for i, m := range messages {
+			sequence := firstSequence + uint64(i)
+			if sequence > o.acknowledged {
+				o.pending = append(o.pending, &outboxRecord{sequence: sequence, message: m})
+			}
+			if sequence >= o.nextSequence {
+				o.nextSequence = sequence + 1
+			}
+		}
+	}
+	// Appending always starts a new segment, as the last one may end with a partially written record
+	o.removeAcknowledgedSegments()
+	return nil
+}
+
+func (o *EventOutbox) listSegments() ([]uint64, error) {
+	files, err := ioutil.ReadDir(o.directory)
+	if err != nil {
+		return nil, err
+	}
+	segments := []uint64{}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), outboxSegmentSuffix) {
+			continue
+		}
+		firstSequence, err := strconv.ParseUint(strings.TrimSuffix(f.Name(), outboxSegmentSuffix), 10, 64)
+		if err != nil {
+			log.Warnf("Ignoring unexpected file %s in outbox", f.Name())
+			continue
+		}
+		segments = append(segments, firstSequence)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+// Records are stored as length, crc32 checksum and the serialized event message
+func encodeOutboxRecord(message *api.EventMessage) ([]byte, error) {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	record := make([]byte, outboxRecordHeaderSize+len(data))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(data))
+	copy(record[outboxRecordHeaderSize:], data)
+	return record, nil
+}
+
+// Reading stops at the first incomplete or corrupted record, which can only be the result of an interrupted write
+func readOutboxSegment(path string) ([]*api.EventMessage, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	messages := []*api.EventMessage{}
+	header := make([]byte, outboxRecordHeaderSize)
+	for {
+		_, err := io.ReadFull(reader, header)
+		if err == io.EOF {
+			return messages, nil
+		}
+		if err != nil {
+			log.Warnf("Ignoring incomplete record at the end of outbox segment %s", path)
+			return messages, nil
+		}
+		data := make([]byte, binary.BigEndian.Uint32(header[0:4]))
+		_, err = io.ReadFull(reader, data)
+		if err != nil || crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(header[4:8]) {
+			log.Warnf("Ignoring incomplete record at the end of outbox segment %s", path)
+			return messages, nil
+		}
+		message := &api.EventMessage{}
+		err = proto.Unmarshal(data, message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read outbox segment %s: %s", path, err)
+		}
+		messages = append(messages, message)
+	}
+}
@@ -0,0 +1,142 @@
+package reporter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestEventOutbox_AppendPeekAndAcknowledge(t *testing.T) {
+	withOutboxDirectory(t, func(directory string) {
+		outbox, err := openEventOutbox(directory, 10, 2)
+		assert.NoError(t, err)
+
+		err = outbox.Append(testEventMessages("job-1", "job-2", "job-3")...)
+		assert.NoError(t, err)
+
+		records := outbox.Peek(2)
+		assert.Equal(t, []string{"job-1", "job-2"}, recordJobIds(records))
+
+		err = outbox.Acknowledge(records[1].sequence)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"job-3"}, recordJobIds(outbox.Peek(10)))
+		assert.NoError(t, outbox.Close())
+	})
+}
+
+func TestEventOutbox_ReplaysUnacknowledgedEventsAfterRestart(t *testing.T) {
+	withOutboxDirectory(t, func(directory string) {
+		outbox, err := openEventOutbox(directory, 10, 2)
+		assert.NoError(t, err)
+		assert.NoError(t, outbox.Append(testEventMessages("job-1", "job-2", "job-3")...))
+		assert.NoError(t, outbox.Append(testEventMessages("job-4")...))
+		assert.NoError(t, outbox.Acknowledge(outbox.Peek(1)[0].sequence))
+		assert.NoError(t, outbox.Close())
+
+		reopened, err := openEventOutbox(directory, 10, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"job-2", "job-3", "job-4"}, recordJobIds(reopened.Peek(10)))
+
+		assert.NoError(t, reopened.Append(testEventMessages("job-5")...))
+		records := reopened.Peek(10)
+		assert.Equal(t, []string{"job-2", "job-3", "job-4", "job-5"}, recordJobIds(records))
+		assert.Equal(t, records[2].sequence+1, records[3].sequence)
+		assert.NoError(t, reopened.Close())
+	})
+}
+
+func TestEventOutbox_IgnoresPartiallyWrittenRecord(t *testing.T) {
+	withOutboxDirectory(t, func(directory string) {
+		outbox, err := openEventOutbox(directory, 10, 10)
+		assert.NoError(t, err)
+		assert.NoError(t, outbox.Append(testEventMessages("job-1", "job-2")...))
+		assert.NoError(t, outbox.Close())
+
+		segment := outbox.segmentPath(1)
+		data, err := ioutil.ReadFile(segment)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(segment, data[:len(data)-3], 0644))
+
+		reopened, err := openEventOutbox(directory, 10, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"job-1"}, recordJobIds(reopened.Peek(10)))
+		assert.NoError(t, reopened.Close())
+	})
+}
+
+func TestEventOutbox_RemovesAcknowledgedSegments(t *testing.T) {
+	withOutboxDirectory(t, func(directory string) {
+		outbox, err := openEventOutbox(directory, 10, 2)
+		assert.NoError(t, err)
+		for _, jobId := range []string{"job-1", "job-2", "job-3", "job-4", "job-5"} {
+			assert.NoError(t, outbox.Append(testEventMessages(jobId)...))
+		}
+		assert.Len(t, segmentFiles(t, directory), 3)
+
+		assert.NoError(t, outbox.Acknowledge(outbox.Peek(4)[3].sequence))
+		assert.Len(t, segmentFiles(t, directory), 1)
+		assert.Equal(t, []string{"job-5"}, recordJobIds(outbox.Peek(10)))
+		assert.NoError(t, outbox.Close())
+	})
+}
+
+func TestEventOutbox_AppendWaitsWhileFull(t *testing.T) {
+	withOutboxDirectory(t, func(directory string) {
+		outbox, err := openEventOutbox(directory, 2, 10)
+		assert.NoError(t, err)
+		assert.NoError(t, outbox.Append(testEventMessages("job-1", "job-2")...))
+
+		appended := make(chan error)
+		go func() {
+			appended <- outbox.Append(testEventMessages("job-3")...)
+		}()
+
+		select {
+		case <-appended:
+			t.Fatal("append should wait for space in the outbox")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		assert.NoError(t, outbox.Acknowledge(outbox.Peek(1)[0].sequence))
+		assert.NoError(t, <-appended)
+		assert.Equal(t, []string{"job-2", "job-3"}, recordJobIds(outbox.Peek(10)))
+		assert.NoError(t, outbox.Close())
+	})
+}
+
+func withOutboxDirectory(t *testing.T, action func(directory string)) {
+	directory, err := ioutil.TempDir("", "outbox")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	action(directory)
+}
+
+func testEventMessages(jobIds ...string) []*api.EventMessage {
+	messages := []*api.EventMessage{}
+	for _, jobId := range jobIds {
+		m, _ := api.Wrap(&api.JobRunningEvent{JobId: jobId})
+		m.DeduplicationKey = jobId
+		messages = append(messages, m)
+	}
+	return messages
+}
+
+func recordJobIds(records []*outboxRecord) []string {
+	jobIds := []string{}
+	for _, r := range records {
+		jobIds = append(jobIds, r.message.GetRunning().JobId)
+	}
+	return jobIds
+}
+
+func segmentFiles(t *testing.T, directory string) []string {
+	files, err := filepath.Glob(filepath.Join(directory, "*"+outboxSegmentSuffix))
+	assert.NoError(t, err)
+	return files
+}
@@ -86,6 +86,18 @@ func (c *SyncFakeClusterContext) AddAnnotation(pod *v1.Pod, annotations map[stri
 	return nil
 }
 
+func (c *SyncFakeClusterContext) CordonNode(node *v1.Node, annotations map[string]string) error {
+	return errors.New("CordonNode Not implemented")
+}
+
+func (c *SyncFakeClusterContext) UncordonNode(node *v1.Node, annotations []string) error {
+	return errors.New("UncordonNode Not implemented")
+}
+
+func (c *SyncFakeClusterContext) RecordNodeEvent(node *v1.Node, eventType string, reason string, message string) error {
+	return nil
+}
+
 func (c *SyncFakeClusterContext) DeletePods(pods []*v1.Pod) {
 	for _, p := range pods {
 		delete(c.Pods, p.Labels[domain.JobId])
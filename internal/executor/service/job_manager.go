@@ -73,6 +73,46 @@ func (m *JobManager) ManageJobLeases() {
 	m.handlePodIssues(jobs)
 }
 
+// Drain returns the leases of jobs not yet scheduled to a node, then waits until no job lease needs renewing
+// or the deadline passes, returning true if it did. ManageJobLeases has to keep running meanwhile,
+// as it renews the leases of running jobs and returns the leases of the unscheduled ones.
+func (m *JobManager) Drain(deadline time.Duration, checkInterval time.Duration) bool {
+	err := m.jobContext.ReturnUnscheduledJobs("Executor is shutting down, Armada will return lease and retry.")
+	if err != nil {
+		log.Errorf("Failed to return leases of unscheduled jobs due to %s", err)
+	}
+
+	timeout := time.After(deadline)
+	for {
+		active, err := m.hasActiveJobs()
+		if err != nil {
+			log.Errorf("Failed to check for active jobs due to %s", err)
+		} else if !active {
+			return false
+		}
+
+		select {
+		case <-timeout:
+			return true
+		case <-time.After(checkInterval):
+		}
+	}
+}
+
+// A job is active while its lease is renewed or an issue with its pods is being handled
+func (m *JobManager) hasActiveJobs() (bool, error) {
+	jobs, err := m.jobContext.GetJobs()
+	if err != nil {
+		return false, err
+	}
+	for _, runningJob := range jobs {
+		if runningJob.Issue != nil || jobShouldBeRenewed(runningJob) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (m *JobManager) reportDoneAndMarkReported(jobs []*job.RunningJob) error {
 	if len(jobs) <= 0 {
 		return nil
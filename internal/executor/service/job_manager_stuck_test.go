@@ -125,6 +125,68 @@ func TestJobManager_ReturnsLeaseAndDeletesRetryableStuckPod(t *testing.T) {
 	assert.Equal(t, retryableStuckPod, mockLeaseService.ReturnLeaseArg)
 }
 
+func TestJobManager_Drain_ReturnsLeaseOfUnscheduledJob(t *testing.T) {
+	unscheduledPod := makeTestPod(v1.PodStatus{Phase: "Pending"})
+
+	fakeClusterContext, mockLeaseService, eventsReporter, jobManager := makejobManagerWithTestDoubles()
+
+	addPod(t, fakeClusterContext, unscheduledPod)
+
+	// Lease is returned by managing job leases, so the job is still active
+	timedOut := jobManager.Drain(time.Millisecond, time.Millisecond)
+	assert.True(t, timedOut)
+
+	jobManager.ManageJobLeases()
+
+	// Deletes pod before returning lease
+	remainingActivePods := getActivePods(t, fakeClusterContext)
+	assert.Equal(t, []*v1.Pod{}, remainingActivePods)
+	assert.Equal(t, 0, mockLeaseService.ReturnLeaseCalls)
+
+	jobManager.ManageJobLeases()
+
+	assert.Equal(t, 1, mockLeaseService.ReturnLeaseCalls)
+	assert.Equal(t, unscheduledPod, mockLeaseService.ReturnLeaseArg)
+	assert.Equal(t, []string{}, mockLeaseService.ReportDoneArg)
+
+	leaseReturnedEvent, ok := eventsReporter.ReceivedEvents[0].(*api.JobLeaseReturnedEvent)
+	assert.True(t, ok)
+	assert.Contains(t, leaseReturnedEvent.Reason, "shutting down")
+
+	timedOut = jobManager.Drain(time.Second, time.Millisecond)
+	assert.False(t, timedOut)
+}
+
+func TestJobManager_Drain_KeepsLeasesOfScheduledJobsUntilDeadline(t *testing.T) {
+	scheduledPod := makeTestPod(v1.PodStatus{Phase: "Pending"})
+	scheduledPod.Spec.NodeName = "node-1"
+	runningPod := makeRunningPod()
+	runningPod.Labels[domain.JobId] = "job-id-2"
+
+	fakeClusterContext, mockLeaseService, eventsReporter, jobManager := makejobManagerWithTestDoubles()
+
+	addPod(t, fakeClusterContext, scheduledPod)
+	addPod(t, fakeClusterContext, runningPod)
+
+	timedOut := jobManager.Drain(10*time.Millisecond, time.Millisecond)
+	assert.True(t, timedOut)
+
+	jobManager.ManageJobLeases()
+	jobManager.ManageJobLeases()
+
+	assert.Len(t, getActivePods(t, fakeClusterContext), 2)
+	assert.Zero(t, mockLeaseService.ReturnLeaseCalls)
+	assert.Empty(t, eventsReporter.ReceivedEvents)
+}
+
+func TestJobManager_Drain_WhenNoJobsAreActive_ReturnsImmediately(t *testing.T) {
+	_, mockLeaseService, _, jobManager := makejobManagerWithTestDoubles()
+
+	timedOut := jobManager.Drain(time.Minute, time.Minute)
+	assert.False(t, timedOut)
+	assert.Zero(t, mockLeaseService.ReturnLeaseCalls)
+}
+
 func getActivePods(t *testing.T, clusterContext context.ClusterContext) []*v1.Pod {
 	t.Helper()
 	remainingActivePods, err := clusterContext.GetActiveBatchPods()
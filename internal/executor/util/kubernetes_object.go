@@ -52,6 +52,37 @@ func CreateService(job *api.Job, pod *v1.Pod, ports []v1.ServicePort, ingressTyp
 	return service
 }
 
+// CreatePeerService creates a headless service, which gives each pod of the job a hostname within the service
+func CreatePeerService(job *api.Job) *v1.Service {
+	selector := map[string]string{
+		domain.JobId: job.Id,
+		domain.Queue: job.Queue,
+	}
+	annotation := util.MergeMaps(job.Annotations, map[string]string{
+		domain.JobSetId: job.JobSetId,
+		domain.Owner:    job.Owner,
+	})
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        PeerServiceName(job.Id),
+			Labels:      util.MergeMaps(job.Labels, selector),
+			Annotations: annotation,
+			Namespace:   job.Namespace,
+		},
+		Spec: v1.ServiceSpec{
+			ClusterIP: v1.ClusterIPNone,
+			Selector:  selector,
+			// Peers need to find each other before they are ready
+			PublishNotReadyAddresses: true,
+		},
+	}
+	return service
+}
+
+func PeerServiceName(jobId string) string {
+	return common.PodNamePrefix + jobId + "-peers"
+}
+
 func CreateIngress(name string, job *api.Job, pod *v1.Pod, service *v1.Service, executorIngressConfig *configuration.IngressConfiguration, jobConfig *api.IngressConfig) *networking.Ingress {
 	labels := util.MergeMaps(job.Labels, map[string]string{
 		domain.JobId:     pod.Labels[domain.JobId],
@@ -132,7 +163,7 @@ func CreatePod(job *api.Job, defaults *configuration.PodDefaults, i int) *v1.Pod
 
 	pod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        common.PodNamePrefix + job.Id + "-" + strconv.Itoa(i),
+			Name:        podName(job.Id, i),
 			Labels:      labels,
 			Annotations: annotation,
 			Namespace:   job.Namespace,
@@ -140,9 +171,62 @@ func CreatePod(job *api.Job, defaults *configuration.PodDefaults, i int) *v1.Pod
 		Spec: *podSpec,
 	}
 
+	if job.PeerDiscovery {
+		addPeerDiscovery(pod, job.Id, i, len(allPodSpecs))
+	}
+
 	return pod
 }
 
+func podName(jobId string, i int) string {
+	return common.PodNamePrefix + jobId + "-" + strconv.Itoa(i)
+}
+
+// Pods are resolvable as <pod name>.<peer service> within the namespace of the job. Environment variables already
+// set by the user are kept, so frameworks can be configured differently.
+func addPeerDiscovery(pod *v1.Pod, jobId string, rank int, worldSize int) {
+	pod.Spec = *pod.Spec.DeepCopy()
+	pod.Spec.Hostname = pod.Name
+	pod.Spec.Subdomain = PeerServiceName(jobId)
+	pod.Annotations[domain.PeerService] = pod.Spec.Subdomain
+
+	peers := make([]string, 0, worldSize)
+	for i := 0; i < worldSize; i++ {
+		peers = append(peers, peerHostname(jobId, i))
+	}
+	envVars := []v1.EnvVar{
+		{Name: "ARMADA_RANK", Value: strconv.Itoa(rank)},
+		{Name: "ARMADA_WORLD_SIZE", Value: strconv.Itoa(worldSize)},
+		{Name: "ARMADA_PEERS", Value: strings.Join(peers, ",")},
+		{Name: "ARMADA_PEER_SERVICE", Value: PeerServiceName(jobId)},
+		// Variables read by torch.distributed
+		{Name: "RANK", Value: strconv.Itoa(rank)},
+		{Name: "WORLD_SIZE", Value: strconv.Itoa(worldSize)},
+		{Name: "MASTER_ADDR", Value: peers[0]},
+	}
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		for _, envVar := range envVars {
+			if !hasEnvVar(container, envVar.Name) {
+				container.Env = append(container.Env, envVar)
+			}
+		}
+	}
+}
+
+func peerHostname(jobId string, i int) string {
+	return podName(jobId, i) + "." + PeerServiceName(jobId)
+}
+
+func hasEnvVar(container *v1.Container, name string) bool {
+	for _, envVar := range container.Env {
+		if envVar.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func applyDefaults(spec *v1.PodSpec, defaults *configuration.PodDefaults) {
 	if defaults == nil {
 		return
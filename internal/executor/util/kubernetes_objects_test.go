@@ -87,6 +87,57 @@ func TestCreatePod_CreatesExpectedPod(t *testing.T) {
 	assert.Equal(t, result, &expectedOutput)
 }
 
+func TestCreatePod_WithPeerDiscovery(t *testing.T) {
+	podSpec := makePodSpec()
+	podSpec.Containers[0].Env = []v1.EnvVar{{Name: "MASTER_ADDR", Value: "custom"}}
+	job := api.Job{
+		Id:            "id",
+		Queue:         "queue",
+		PodSpecs:      []*v1.PodSpec{podSpec, makePodSpec()},
+		PeerDiscovery: true,
+	}
+
+	result := CreatePod(&job, &configuration.PodDefaults{}, 1)
+
+	assert.Equal(t, "armada-id-1", result.Spec.Hostname)
+	assert.Equal(t, "armada-id-peers", result.Spec.Subdomain)
+	assert.Equal(t, "armada-id-peers", result.Annotations[domain.PeerService])
+	assert.Equal(t, []v1.EnvVar{
+		{Name: "ARMADA_RANK", Value: "1"},
+		{Name: "ARMADA_WORLD_SIZE", Value: "2"},
+		{Name: "ARMADA_PEERS", Value: "armada-id-0.armada-id-peers,armada-id-1.armada-id-peers"},
+		{Name: "ARMADA_PEER_SERVICE", Value: "armada-id-peers"},
+		{Name: "RANK", Value: "1"},
+		{Name: "WORLD_SIZE", Value: "2"},
+		{Name: "MASTER_ADDR", Value: "armada-id-0.armada-id-peers"},
+	}, result.Spec.Containers[0].Env)
+
+	first := CreatePod(&job, &configuration.PodDefaults{}, 0)
+	assert.Contains(t, first.Spec.Containers[0].Env, v1.EnvVar{Name: "MASTER_ADDR", Value: "custom"})
+	assert.NotContains(t, first.Spec.Containers[0].Env, v1.EnvVar{Name: "MASTER_ADDR", Value: "armada-id-0.armada-id-peers"})
+	assert.Len(t, podSpec.Containers[0].Env, 1, "job pod spec is not modified")
+}
+
+func TestCreatePeerService(t *testing.T) {
+	job := api.Job{
+		Id:        "id",
+		Queue:     "queue",
+		JobSetId:  "job-set",
+		Owner:     "owner",
+		Namespace: "namespace",
+	}
+
+	service := CreatePeerService(&job)
+
+	assert.Equal(t, "armada-id-peers", service.Name)
+	assert.Equal(t, "namespace", service.Namespace)
+	assert.Equal(t, v1.ClusterIPNone, service.Spec.ClusterIP)
+	assert.True(t, service.Spec.PublishNotReadyAddresses)
+	assert.Equal(t, map[string]string{domain.JobId: "id", domain.Queue: "queue"}, service.Spec.Selector)
+	// Not associated with a single pod, so it is not counted as one of its ingress services
+	assert.NotContains(t, service.Labels, domain.PodNumber)
+}
+
 func TestApplyDefaults(t *testing.T) {
 	schedulerName := "OtherScheduler"
 
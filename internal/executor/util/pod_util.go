@@ -26,6 +26,11 @@ func HasIngress(pod *v1.Pod) bool {
 	return exists && value == "true"
 }
 
+// GetPeerService returns the name of the headless service of jobs with peer discovery, empty otherwise
+func GetPeerService(pod *v1.Pod) string {
+	return pod.Annotations[domain.PeerService]
+}
+
 func GetExpectedNumberOfAssociatedServices(pod *v1.Pod) int {
 	value, exists := pod.Annotations[domain.AssociatedServicesCount]
 	if !exists {
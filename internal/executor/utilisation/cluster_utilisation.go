@@ -244,6 +244,7 @@ func (clusterUtilisationService *ClusterUtilisationService) createReportsOfQueue
 	runningPods := FilterPodsWithPhase(pods, v1.PodRunning)
 
 	allocationByQueue := GetAllocationByQueue(runningPods)
+	limitByQueue := GetLimitByQueue(runningPods)
 	usageByQueue := clusterUtilisationService.getUsageByQueue(runningPods)
 
 	queueReports := make([]*api.QueueReport, 0, len(allocationByQueue))
@@ -266,6 +267,7 @@ func (clusterUtilisationService *ClusterUtilisationService) createReportsOfQueue
 			Name:               queueName,
 			Resources:          queueUsage,
 			ResourcesUsed:      resourceUsed,
+			ResourcesLimit:     limitByQueue[queueName],
 			CountOfPodsByPhase: phaseSummary,
 		}
 		queueReports = append(queueReports, &queueReport)
@@ -327,3 +329,24 @@ func GetAllocationByQueue(pods []*v1.Pod) map[string]common.ComputeResources {
 
 	return utilisationByQueue
 }
+
+func GetLimitByQueue(pods []*v1.Pod) map[string]common.ComputeResources {
+	limitByQueue := make(map[string]common.ComputeResources)
+
+	for _, pod := range pods {
+		queue, present := pod.Labels[domain.Queue]
+		if !present {
+			continue
+		}
+
+		podLimit := common.TotalPodResourceLimit(&pod.Spec)
+
+		if _, ok := limitByQueue[queue]; ok {
+			limitByQueue[queue].Add(podLimit)
+		} else {
+			limitByQueue[queue] = podLimit
+		}
+	}
+
+	return limitByQueue
+}
@@ -171,6 +171,16 @@ func TestGetUsageByQueue_HandlesEmptyList(t *testing.T) {
 	assert.Equal(t, len(result), 0)
 }
 
+func TestGetLimitByQueue_AggregatesPodLimitsInAQueue(t *testing.T) {
+	queue1Pod1 := makePodWithResource("queue1", makeResourceList(2, 50))
+	queue1Pod2 := makePodWithResource("queue1", makeResourceList(2, 50))
+	queue1Pod2.Spec.Containers[0].Resources.Limits = makeResourceList(4, 100)
+
+	result := GetLimitByQueue([]*v1.Pod{&queue1Pod1, &queue1Pod2})
+	assert.Equal(t, map[string]common.ComputeResources{"queue1": common.FromResourceList(makeResourceList(6, 150))}, result)
+	assert.Equal(t, map[string]common.ComputeResources{"queue1": common.FromResourceList(makeResourceList(4, 100))}, GetAllocationByQueue([]*v1.Pod{&queue1Pod1, &queue1Pod2}))
+}
+
 func TestGetAllocatedResourceByNodeName(t *testing.T) {
 	podResource := makeResourceList(2, 50)
 	pod1 := makePodWithResource("queue1", podResource)
@@ -1,26 +1,32 @@
 package lookout
 
 import (
-	"strings"
 	"sync"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	_ "github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/G-Research/armada/internal/common/auth"
 	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/eventstream"
 	"github.com/G-Research/armada/internal/common/grpc"
 	"github.com/G-Research/armada/internal/common/health"
-	stanUtil "github.com/G-Research/armada/internal/common/stan-util"
+	"github.com/G-Research/armada/internal/common/task"
 	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/lookout/cache"
 	"github.com/G-Research/armada/internal/lookout/configuration"
 	"github.com/G-Research/armada/internal/lookout/events"
 	"github.com/G-Research/armada/internal/lookout/metrics"
 	"github.com/G-Research/armada/internal/lookout/postgres"
+	"github.com/G-Research/armada/internal/lookout/pruner"
 	"github.com/G-Research/armada/internal/lookout/repository"
 	"github.com/G-Research/armada/internal/lookout/server"
+	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/api/lookout"
+	"github.com/G-Research/armada/pkg/client"
 )
 
 type LogRusLogger struct{}
@@ -34,7 +40,7 @@ func StartUp(config configuration.LookoutConfiguration, healthChecks *health.Mul
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 
-	grpcServer := grpc.CreateGrpcServer([]authorization.AuthService{&authorization.AnonymousAuthService{}})
+	grpcServer := grpc.CreateGrpcServer(auth.ConfigureAuth(config.Auth))
 
 	db, err := postgres.Open(config.Postgres)
 	if err != nil {
@@ -49,24 +55,39 @@ func StartUp(config configuration.LookoutConfiguration, healthChecks *health.Mul
 
 	healthChecks.Add(repository.NewSqlHealth(db))
 
-	conn, err := stanUtil.DurableConnect(
-		config.Nats.ClusterID,
-		"armada-server-"+util.NewULID(),
-		strings.Join(config.Nats.Servers, ","),
-	)
-
-	healthChecks.Add(conn)
-
+	conn, queueGroup, err := connectEventStream(config)
 	if err != nil {
 		panic(err)
 	}
-	eventProcessor := events.NewEventProcessor(conn, jobStore, config.Nats.Subject, config.Nats.QueueGroup)
+
+	healthChecks.Add(conn)
+
+	eventProcessor := events.NewEventProcessor(conn, jobStore, queueGroup, config.EventIngestion)
 	eventProcessor.Start()
 
+	taskManager := task.NewBackgroundTaskManager(metrics.MetricPrefix)
+	if config.DataRetention.Enabled {
+		jobPruner := pruner.NewPruner(repository.NewSQLJobPruner(goquDb), &repository.DefaultClock{}, config.DataRetention)
+		taskManager.Register(jobPruner.PruneJobs, config.DataRetention.Interval, "prune_jobs")
+	}
+
 	dbMetricsProvider := metrics.NewLookoutSqlDbMetricsProvider(db, config.Postgres)
 	metrics.ExposeLookoutMetrics(dbMetricsProvider)
 
-	lookoutServer := server.NewLookoutServer(jobRepository)
+	queueCache := cache.NewQueueCache(jobRepository, nil)
+	if config.QueueOwnership.ApiConnection.ArmadaUrl != "" {
+		apiConn, err := client.CreateApiConnection(&config.QueueOwnership.ApiConnection)
+		if err != nil {
+			panic(err)
+		}
+		queueCache = cache.NewQueueCache(jobRepository, api.NewSubmitClient(apiConn))
+		taskManager.Register(queueCache.Refresh, config.QueueOwnership.RefreshInterval, "queue_ownership_refresh")
+	} else {
+		log.Warn("No armada server configured for queue ownership, only users with the view_all_jobs permission can view jobs")
+	}
+
+	permissions := authorization.NewPrincipalPermissionChecker(config.Auth.PermissionGroupMapping, config.Auth.PermissionScopeMapping, config.Auth.PermissionClaimMapping)
+	lookoutServer := server.NewLookoutServer(jobRepository, permissions, queueCache)
 	lookout.RegisterLookoutServer(grpcServer, lookoutServer)
 
 	grpc_prometheus.Register(grpcServer)
@@ -74,9 +95,10 @@ func StartUp(config configuration.LookoutConfiguration, healthChecks *health.Mul
 	grpc.Listen(config.GrpcPort, grpcServer, wg)
 
 	stop := func() {
+		taskManager.StopAll(time.Second * 2)
 		err := conn.Close()
 		if err != nil {
-			log.Errorf("failed to close nats connection: %v", err)
+			log.Errorf("failed to close event stream connection: %v", err)
 		}
 		err = db.Close()
 		if err != nil {
@@ -87,3 +109,21 @@ func StartUp(config configuration.LookoutConfiguration, healthChecks *health.Mul
 
 	return stop, wg
 }
+
+// Returns the event stream lookout ingests events from, with the consumer group of the ingester
+func connectEventStream(config configuration.LookoutConfiguration) (eventstream.EventStream, string, error) {
+	clientID := "armada-server-" + util.NewULID()
+	if len(config.Kafka.Brokers) > 0 {
+		stream, err := eventstream.NewKafkaEventStream(clientID, config.Kafka.Brokers, config.Kafka.Topic, config.Kafka.Settings)
+		return stream, config.Kafka.QueueGroup, err
+	}
+	stream, err := eventstream.Connect(
+		config.Nats.Transport,
+		config.Nats.Servers,
+		config.Nats.ClusterID,
+		clientID,
+		config.Nats.Subject,
+		config.Nats.JetStream,
+	)
+	return stream, config.Nats.QueueGroup, err
+}
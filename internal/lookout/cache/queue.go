@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const refreshTimeout = time.Minute
+
+type QueueNameRepository interface {
+	GetQueueNames(ctx context.Context) ([]string, error)
+}
+
+// QueueCache holds the queues with jobs in lookout, together with their owners as defined in the armada server
+type QueueCache struct {
+	queueNameRepository QueueNameRepository
+	submitClient        api.SubmitClient
+
+	mutex  sync.RWMutex
+	queues []*api.Queue
+}
+
+func NewQueueCache(queueNameRepository QueueNameRepository, submitClient api.SubmitClient) *QueueCache {
+	return &QueueCache{
+		queueNameRepository: queueNameRepository,
+		submitClient:        submitClient,
+		queues:              []*api.Queue{},
+	}
+}
+
+func (c *QueueCache) Refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	defer cancel()
+
+	names, err := c.queueNameRepository.GetQueueNames(ctx)
+	if err != nil {
+		log.Errorf("Error while getting queue names %s", err)
+		return
+	}
+
+	queues := make([]*api.Queue, 0, len(names))
+	for _, name := range names {
+		queue, err := c.submitClient.GetQueue(ctx, &api.QueueGetRequest{Name: name})
+		if status.Code(err) == codes.NotFound {
+			// Jobs of deleted queues are only visible to users allowed to view all jobs
+			continue
+		}
+		if err != nil {
+			log.Errorf("Error while getting queue %s %s", name, err)
+			return
+		}
+		queues = append(queues, queue)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.queues = queues
+}
+
+func (c *QueueCache) GetAllQueues() []*api.Queue {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.queues
+}
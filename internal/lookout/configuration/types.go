@@ -1,12 +1,36 @@
 package configuration
 
-import "time"
+import (
+	"time"
+
+	authconfig "github.com/G-Research/armada/internal/common/auth/configuration"
+	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/pkg/client"
+)
 
 type NatsConfig struct {
+	// Either stan (NATS Streaming, default) or jetstream
+	Transport  string
 	Servers    []string
 	ClusterID  string
 	Subject    string
 	QueueGroup string
+	JetStream  eventstream.JetStreamConfig
+}
+
+// Events are consumed from Kafka instead of NATS if brokers are set
+type KafkaConfig struct {
+	Brokers    []string
+	Topic      string
+	QueueGroup string
+	Settings   eventstream.KafkaConfig
+}
+
+type EventIngestionConfig struct {
+	// Maximum number of events written to the database together
+	BatchSize int
+	// Maximum time an event waits for its batch to fill up before the batch is written
+	BatchTimeout time.Duration
 }
 
 type LookoutUIConfig struct {
@@ -27,6 +51,28 @@ type PostgresConfig struct {
 	Connection      map[string]string
 }
 
+type DataRetentionPolicy struct {
+	Enabled bool
+	// How often to look for jobs to prune
+	Interval time.Duration
+	// Jobs which finished, failed, were cancelled or found to be duplicates before this duration ago are pruned
+	JobRetentionDuration time.Duration
+	// Overrides JobRetentionDuration for individual queues, zero keeps jobs of a queue indefinitely
+	QueueJobRetentionDurations map[string]time.Duration
+	// Number of jobs removed per transaction, small batches keep locks on the hot tables short-lived
+	BatchSize int
+	// Copy pruned jobs to the *_archive tables before removing them
+	Archive bool
+}
+
+type QueueOwnershipConfig struct {
+	// Connection to the armada server, used to look up the owners of queues for users without the view_all_jobs
+	// permission. Such users see no jobs if no server is configured.
+	ApiConnection client.ApiConnectionDetails
+	// How often queue owners are refreshed
+	RefreshInterval time.Duration
+}
+
 type LookoutConfiguration struct {
 	HttpPort    uint16
 	GrpcPort    uint16
@@ -34,6 +80,12 @@ type LookoutConfiguration struct {
 
 	UIConfig LookoutUIConfig
 
-	Nats     NatsConfig
-	Postgres PostgresConfig
+	Auth           authconfig.AuthConfig
+	QueueOwnership QueueOwnershipConfig
+
+	Nats           NatsConfig
+	Kafka          KafkaConfig
+	EventIngestion EventIngestionConfig
+	Postgres       PostgresConfig
+	DataRetention  DataRetentionPolicy
 }
@@ -1,60 +1,157 @@
 package events
 
 import (
-	"github.com/gogo/protobuf/proto"
-	"github.com/nats-io/stan.go"
-	stanPb "github.com/nats-io/stan.go/pb"
+	"time"
+
 	log "github.com/sirupsen/logrus"
 
-	stanUtil "github.com/G-Research/armada/internal/common/stan-util"
+	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/lookout/metrics"
 	"github.com/G-Research/armada/internal/lookout/repository"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 type EventProcessor struct {
-	connection *stanUtil.DurableConnection
-	subject    string
-	group      string
-	recorder   repository.JobRecorder
+	stream   eventstream.EventStream
+	group    string
+	recorder repository.JobRecorder
+
+	batchSize    int
+	batchTimeout time.Duration
+	messages     chan *eventstream.Message
 }
 
-func NewEventProcessor(connection *stanUtil.DurableConnection, repository repository.JobRecorder, subject string, group string) *EventProcessor {
-	return &EventProcessor{connection: connection, recorder: repository, subject: subject, group: group}
+type eventMessage struct {
+	event api.Event
+	msg   *eventstream.Message
+}
+
+func NewEventProcessor(
+	stream eventstream.EventStream,
+	repository repository.JobRecorder,
+	group string,
+	config configuration.EventIngestionConfig) *EventProcessor {
+
+	batchSize := config.BatchSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &EventProcessor{
+		stream:       stream,
+		recorder:     repository,
+		group:        group,
+		batchSize:    batchSize,
+		batchTimeout: config.BatchTimeout,
+		messages:     make(chan *eventstream.Message, batchSize),
+	}
 }
 
 func (p *EventProcessor) Start() {
-	err := p.connection.QueueSubscribe(p.subject, p.group,
+	go batchMessages(p.messages, p.batchSize, p.batchTimeout, p.processBatch)
+
+	err := p.stream.QueueSubscribe(p.group,
 		p.handleMessage,
-		stan.SetManualAckMode(),
-		stan.StartAt(stanPb.StartPosition_LastReceived),
-		stan.DurableName(p.group))
+		// Messages are acknowledged once their batch is written, leave room for the next batch to fill up meanwhile
+		eventstream.MaxInflight(2*p.batchSize))
 
 	if err != nil {
 		panic(err)
 	}
 }
 
-func (p *EventProcessor) handleMessage(msg *stan.Msg) {
-	// TODO: batching???
-	eventMessage := &api.EventMessage{}
-	err := proto.Unmarshal(msg.Data, eventMessage)
-	if err != nil {
-		log.Errorf("Error while unmarshaling nats message: %v", err)
-	} else {
-		event, err := api.UnwrapEvent(eventMessage)
+func (p *EventProcessor) handleMessage(msg *eventstream.Message) {
+	p.messages <- msg
+}
+
+func (p *EventProcessor) processBatch(msgs []*eventstream.Message) {
+	start := time.Now()
+
+	toAck := make([]*eventstream.Message, 0, len(msgs))
+	eventMessages := make([]*eventMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		event, err := api.UnwrapEvent(msg.EventMessage)
 		if err != nil {
 			log.Errorf("Error while unwrapping event message: %v", err)
-			return
+			continue
+		}
+		eventMessages = append(eventMessages, &eventMessage{event: event, msg: msg})
+	}
+
+	events := make([]api.Event, 0, len(eventMessages))
+	for _, m := range eventMessages {
+		events = append(events, m.event)
+	}
+
+	err := p.recorder.RecordEvents(events)
+	if err == nil {
+		for _, m := range eventMessages {
+			toAck = append(toAck, m.msg)
+		}
+	} else {
+		// Recording events one at a time keeps a single bad event from holding back the rest of the batch
+		log.Errorf("Error while reporting batch of %d events from nats, reporting events individually: %v", len(events), err)
+		metrics.RecordFailedEventBatch()
+		for _, m := range eventMessages {
+			err := p.processEvent(m.event)
+			if err != nil {
+				log.Errorf("Error while reporting event from nats: %v (event: %v)", err, m.event)
+				continue
+			}
+			toAck = append(toAck, m.msg)
 		}
-		err = p.processEvent(event)
+	}
+
+	for _, msg := range toAck {
+		err := msg.Ack()
 		if err != nil {
-			log.Errorf("Error while reporting event from nats: %v (event: %v)", err, eventMessage)
-			return
+			log.Errorf("Error while ack nats message: %v", err)
 		}
 	}
-	err = msg.Ack()
-	if err != nil {
-		log.Errorf("Error while ack nats message: %v", err)
+
+	lastPublished := msgs[len(msgs)-1].Timestamp
+	metrics.RecordEventBatch(len(msgs), time.Since(start), time.Since(lastPublished))
+}
+
+// Passes on batches of up to batchSize messages, a batch is passed on before it is full once its first message
+// has waited for batchTimeout. Returns after passing on the last batch once messages is closed.
+func batchMessages(messages <-chan *eventstream.Message, batchSize int, batchTimeout time.Duration, process func([]*eventstream.Message)) {
+	batch := make([]*eventstream.Message, 0, batchSize)
+	timeout := time.NewTimer(batchTimeout)
+	stopTimer(timeout)
+
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				stopTimer(timeout)
+				if len(batch) > 0 {
+					process(batch)
+				}
+				return
+			}
+			batch = append(batch, msg)
+			if len(batch) == 1 {
+				timeout.Reset(batchTimeout)
+			}
+			if len(batch) < batchSize {
+				continue
+			}
+			stopTimer(timeout)
+		case <-timeout.C:
+		}
+
+		process(batch)
+		batch = make([]*eventstream.Message, 0, batchSize)
+	}
+}
+
+func stopTimer(timer *time.Timer) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
 	}
 }
 
@@ -103,6 +200,12 @@ func (p *EventProcessor) processEvent(event api.Event) error {
 	case *api.JobTerminatedEvent:
 		return p.recorder.RecordJobTerminated(typed)
 
+	case *api.JobHeldEvent:
+		return p.recorder.RecordJobHeld(typed)
+
+	case *api.JobReleasedEvent:
+		return p.recorder.RecordJobReleased(typed)
+
 	case *api.JobUtilisationEvent:
 		// TODO
 
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/common/eventstream"
+)
+
+func TestBatchMessages_PassesOnFullBatches(t *testing.T) {
+	messages := make(chan *eventstream.Message, 5)
+	for i := 0; i < 5; i++ {
+		messages <- newMessage(uint64(i))
+	}
+	close(messages)
+
+	batches := collectBatches(messages, 2, time.Hour)
+
+	assert.Equal(t, [][]uint64{{0, 1}, {2, 3}, {4}}, batches)
+}
+
+func TestBatchMessages_PassesOnBatchAfterTimeout(t *testing.T) {
+	messages := make(chan *eventstream.Message)
+	processed := make(chan []*eventstream.Message)
+	go batchMessages(messages, 10, 10*time.Millisecond, func(batch []*eventstream.Message) {
+		processed <- batch
+	})
+
+	messages <- newMessage(1)
+	messages <- newMessage(2)
+
+	select {
+	case batch := <-processed:
+		assert.Equal(t, []uint64{1, 2}, sequences(batch))
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch was not passed on after timeout")
+	}
+
+	messages <- newMessage(3)
+	close(messages)
+	assert.Equal(t, []uint64{3}, sequences(<-processed))
+}
+
+func TestBatchMessages_BatchSizeOfOne(t *testing.T) {
+	messages := make(chan *eventstream.Message, 3)
+	for i := 0; i < 3; i++ {
+		messages <- newMessage(uint64(i))
+	}
+	close(messages)
+
+	batches := collectBatches(messages, 1, time.Hour)
+
+	assert.Equal(t, [][]uint64{{0}, {1}, {2}}, batches)
+}
+
+func collectBatches(messages chan *eventstream.Message, batchSize int, batchTimeout time.Duration) [][]uint64 {
+	var batches [][]uint64
+	batchMessages(messages, batchSize, batchTimeout, func(batch []*eventstream.Message) {
+		batches = append(batches, sequences(batch))
+	})
+	return batches
+}
+
+// Messages are told apart by their timestamp
+func newMessage(sequence uint64) *eventstream.Message {
+	return &eventstream.Message{Timestamp: time.Unix(int64(sequence), 0)}
+}
+
+func sequences(batch []*eventstream.Message) []uint64 {
+	result := make([]uint64, 0, len(batch))
+	for _, msg := range batch {
+		result = append(result, uint64(msg.Timestamp.Unix()))
+	}
+	return result
+}
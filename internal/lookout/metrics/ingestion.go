@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var processedEventsCounter = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: MetricPrefix + "processed_events_total",
+		Help: "Number of events recorded in the database",
+	})
+
+var failedEventBatchesCounter = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: MetricPrefix + "failed_event_batches_total",
+		Help: "Number of batches of events which could not be recorded together, and were recorded one event at a time",
+	})
+
+var eventBatchSizeHistogram = promauto.NewHistogram(
+	prometheus.HistogramOpts{
+		Name:    MetricPrefix + "event_batch_size",
+		Help:    "Number of events in each batch written to the database",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+	})
+
+var eventBatchDurationHistogram = promauto.NewHistogram(
+	prometheus.HistogramOpts{
+		Name:    MetricPrefix + "event_batch_duration_seconds",
+		Help:    "Time taken to write a batch of events to the database",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+	})
+
+var eventLagGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: MetricPrefix + "event_lag_seconds",
+		Help: "Time between the most recently recorded event being published and it being recorded",
+	})
+
+func RecordEventBatch(size int, duration time.Duration, lag time.Duration) {
+	processedEventsCounter.Add(float64(size))
+	eventBatchSizeHistogram.Observe(float64(size))
+	eventBatchDurationHistogram.Observe(duration.Seconds())
+	eventLagGauge.Set(lag.Seconds())
+}
+
+func RecordFailedEventBatch() {
+	failedEventBatchesCounter.Inc()
+}
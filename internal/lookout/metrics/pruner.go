@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var prunedJobsCounter = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricPrefix + "pruned_jobs_total",
+		Help: "Number of jobs removed from the database after their retention period",
+	},
+	[]string{"action"})
+
+var prunedBatchesCounter = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: MetricPrefix + "prune_batches_total",
+		Help: "Number of batches of jobs removed from the database",
+	})
+
+var lastPruneCompletedGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: MetricPrefix + "prune_last_completed_timestamp_seconds",
+		Help: "Time at which all jobs past their retention period were last removed",
+	})
+
+func RecordPrunedJobs(count int, archived bool) {
+	action := "deleted"
+	if archived {
+		action = "archived"
+	}
+	prunedJobsCounter.WithLabelValues(action).Add(float64(count))
+	prunedBatchesCounter.Inc()
+}
+
+func RecordPruneCompleted(completed time.Time) {
+	lastPruneCompletedGauge.Set(float64(completed.Unix()))
+}
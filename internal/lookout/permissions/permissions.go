@@ -0,0 +1,9 @@
+package permissions
+
+import (
+	"github.com/G-Research/armada/internal/common/auth/permission"
+)
+
+const (
+	ViewAllJobs permission.Permission = "view_all_jobs"
+)
@@ -0,0 +1,76 @@
+package pruner
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/lookout/metrics"
+	"github.com/G-Research/armada/internal/lookout/repository"
+)
+
+const defaultBatchSize = 1000
+
+type Pruner struct {
+	jobPruner repository.JobPruner
+	clock     repository.Clock
+	policy    configuration.DataRetentionPolicy
+}
+
+func NewPruner(jobPruner repository.JobPruner, clock repository.Clock, policy configuration.DataRetentionPolicy) *Pruner {
+	return &Pruner{
+		jobPruner: jobPruner,
+		clock:     clock,
+		policy:    policy,
+	}
+}
+
+// PruneJobs removes all jobs past their retention period, batch by batch.
+// Queues with their own retention period are pruned first, then all other queues using the default period.
+func (p *Pruner) PruneJobs() {
+	now := p.clock.Now()
+	completed := true
+
+	overriddenQueues := make([]string, 0, len(p.policy.QueueJobRetentionDurations))
+	for queue, retention := range p.policy.QueueJobRetentionDurations {
+		overriddenQueues = append(overriddenQueues, queue)
+		if retention <= 0 {
+			continue
+		}
+		completed = p.pruneInBatches(&repository.PruneFilter{
+			Queues:  []string{queue},
+			Before:  now.Add(-retention),
+			Archive: p.policy.Archive,
+		}) && completed
+	}
+
+	if p.policy.JobRetentionDuration > 0 {
+		completed = p.pruneInBatches(&repository.PruneFilter{
+			ExcludedQueues: overriddenQueues,
+			Before:         now.Add(-p.policy.JobRetentionDuration),
+			Archive:        p.policy.Archive,
+		}) && completed
+	}
+
+	if completed {
+		metrics.RecordPruneCompleted(p.clock.Now())
+	}
+}
+
+func (p *Pruner) pruneInBatches(filter *repository.PruneFilter) bool {
+	batchSize := p.policy.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	for {
+		pruned, err := p.jobPruner.PruneJobs(filter, batchSize)
+		if err != nil {
+			log.Errorf("failed to prune jobs finished before %s: %v", filter.Before, err)
+			return false
+		}
+		metrics.RecordPrunedJobs(pruned, filter.Archive)
+		if pruned < batchSize {
+			return true
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package pruner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/lookout/repository"
+)
+
+var now = time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+
+func TestPruneJobs_UsesDefaultRetentionForAllQueues(t *testing.T) {
+	jobPruner := &fakeJobPruner{}
+	pruner := NewPruner(jobPruner, &fakeClock{}, configuration.DataRetentionPolicy{
+		JobRetentionDuration: 24 * time.Hour,
+		BatchSize:            10,
+	})
+
+	pruner.PruneJobs()
+
+	assert.Equal(t, []*repository.PruneFilter{
+		{ExcludedQueues: []string{}, Before: now.Add(-24 * time.Hour)},
+	}, jobPruner.filters)
+}
+
+func TestPruneJobs_UsesQueueRetentionOverrides(t *testing.T) {
+	jobPruner := &fakeJobPruner{}
+	pruner := NewPruner(jobPruner, &fakeClock{}, configuration.DataRetentionPolicy{
+		JobRetentionDuration: 24 * time.Hour,
+		QueueJobRetentionDurations: map[string]time.Duration{
+			"short": time.Hour,
+			"keep":  0,
+		},
+		BatchSize: 10,
+		Archive:   true,
+	})
+
+	pruner.PruneJobs()
+
+	assert.Equal(t, 2, len(jobPruner.filters))
+	assert.Equal(t, &repository.PruneFilter{
+		Queues:  []string{"short"},
+		Before:  now.Add(-time.Hour),
+		Archive: true,
+	}, jobPruner.filters[0])
+	assert.ElementsMatch(t, []string{"short", "keep"}, jobPruner.filters[1].ExcludedQueues)
+	assert.Equal(t, now.Add(-24*time.Hour), jobPruner.filters[1].Before)
+	assert.True(t, jobPruner.filters[1].Archive)
+}
+
+func TestPruneJobs_DoesNothingWithoutRetention(t *testing.T) {
+	jobPruner := &fakeJobPruner{}
+	pruner := NewPruner(jobPruner, &fakeClock{}, configuration.DataRetentionPolicy{})
+
+	pruner.PruneJobs()
+
+	assert.Empty(t, jobPruner.filters)
+}
+
+func TestPruneJobs_PrunesInBatchesUntilBatchIsNotFull(t *testing.T) {
+	jobPruner := &fakeJobPruner{remaining: 25}
+	pruner := NewPruner(jobPruner, &fakeClock{}, configuration.DataRetentionPolicy{
+		JobRetentionDuration: time.Hour,
+		BatchSize:            10,
+	})
+
+	pruner.PruneJobs()
+
+	assert.Equal(t, 3, len(jobPruner.filters))
+	assert.Equal(t, 0, jobPruner.remaining)
+}
+
+type fakeJobPruner struct {
+	filters   []*repository.PruneFilter
+	remaining int
+}
+
+func (p *fakeJobPruner) PruneJobs(filter *repository.PruneFilter, batchSize int) (int, error) {
+	p.filters = append(p.filters, filter)
+	pruned := batchSize
+	if p.remaining < batchSize {
+		pruned = p.remaining
+	}
+	p.remaining -= pruned
+	return pruned, nil
+}
+
+type fakeClock struct{}
+
+func (c *fakeClock) Now() time.Time {
+	return now
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+// Position of the last job returned by GetJobs, serialized as an opaque string for clients.
+// Key is the value of the sort key as text, nil if it was null or jobs are ordered by job id.
+type jobsCursor struct {
+	OrderBy    string  `json:"orderBy,omitempty"`
+	Descending bool    `json:"descending,omitempty"`
+	Key        *string `json:"key,omitempty"`
+	JobId      string  `json:"jobId"`
+}
+
+func newJobsCursor(opts *lookout.GetJobsRequest, lastRow *JobRow) *jobsCursor {
+	cursor := &jobsCursor{
+		OrderBy:    opts.OrderBy,
+		Descending: isDescending(opts),
+		JobId:      ParseNullString(lastRow.JobId),
+	}
+
+	switch opts.OrderBy {
+	case orderByPriority:
+		if lastRow.Priority.Valid {
+			key := strconv.FormatFloat(lastRow.Priority.Float64, 'g', -1, 64)
+			cursor.Key = &key
+		}
+	case orderByDuration:
+		if lastRow.Duration.Valid {
+			key := lastRow.Duration.String
+			cursor.Key = &key
+		}
+	}
+
+	return cursor
+}
+
+func decodeJobsCursor(encoded string) (*jobsCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", err)
+	}
+
+	var cursor jobsCursor
+	err = json.Unmarshal(decoded, &cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", err)
+	}
+	if cursor.JobId == "" || !isJobOrder(cursor.OrderBy) {
+		return nil, fmt.Errorf("invalid cursor: %q", encoded)
+	}
+	if cursor.OrderBy == orderByPriority && cursor.Key != nil {
+		if _, err := strconv.ParseFloat(*cursor.Key, 64); err != nil {
+			return nil, fmt.Errorf("invalid cursor: %v", err)
+		}
+	}
+
+	return &cursor, nil
+}
+
+func (c *jobsCursor) encode() (string, error) {
+	encoded, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+func (c *jobsCursor) matches(opts *lookout.GetJobsRequest) bool {
+	return c.OrderBy == opts.OrderBy && c.Descending == isDescending(opts)
+}
+
+// Selects the jobs following the cursor position in the ordering given by createJobOrdering
+func (c *jobsCursor) filter() goqu.Expression {
+	switch c.OrderBy {
+	case orderByPriority:
+		return c.keysetFilter(job_priority, c.priorityKey())
+	case orderByDuration:
+		return c.keysetFilter(job_duration, c.durationKey())
+	}
+	if c.Descending {
+		return job_jobId.Lt(c.JobId)
+	}
+	return job_jobId.Gt(c.JobId)
+}
+
+type sortKey interface {
+	exp.Comparable
+	exp.Isable
+}
+
+// Nulls sort as if larger than any other value
+func (c *jobsCursor) keysetFilter(field sortKey, key interface{}) goqu.Expression {
+	if c.Descending {
+		if c.Key == nil {
+			return goqu.Or(
+				field.IsNotNull(),
+				goqu.And(field.IsNull(), job_jobId.Lt(c.JobId)))
+		}
+		return goqu.Or(
+			field.Lt(key),
+			goqu.And(field.Eq(key), job_jobId.Lt(c.JobId)))
+	}
+
+	if c.Key == nil {
+		return goqu.And(field.IsNull(), job_jobId.Gt(c.JobId))
+	}
+	return goqu.Or(
+		field.Gt(key),
+		goqu.And(field.Eq(key), job_jobId.Gt(c.JobId)),
+		field.IsNull())
+}
+
+func (c *jobsCursor) priorityKey() interface{} {
+	if c.Key == nil {
+		return nil
+	}
+	priority, _ := strconv.ParseFloat(*c.Key, 64)
+	return priority
+}
+
+func (c *jobsCursor) durationKey() interface{} {
+	if c.Key == nil {
+		return nil
+	}
+	return goqu.L("?::interval", *c.Key)
+}
+
+func isDescending(opts *lookout.GetJobsRequest) bool {
+	if opts.OrderBy == "" {
+		return opts.NewestFirst
+	}
+	return opts.Descending
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestJobsCursor_RoundTrip(t *testing.T) {
+	opts := &lookout.GetJobsRequest{OrderBy: orderByPriority, Descending: true}
+	row := &JobRow{
+		JobId:    sql.NullString{String: "job-id", Valid: true},
+		Priority: sql.NullFloat64{Float64: 0.1, Valid: true},
+	}
+
+	encoded, err := newJobsCursor(opts, row).encode()
+	assert.NoError(t, err)
+
+	cursor, err := decodeJobsCursor(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, "job-id", cursor.JobId)
+	assert.Equal(t, 0.1, cursor.priorityKey())
+	assert.True(t, cursor.matches(opts))
+	assert.False(t, cursor.matches(&lookout.GetJobsRequest{OrderBy: orderByPriority}))
+	assert.False(t, cursor.matches(&lookout.GetJobsRequest{OrderBy: orderByDuration, Descending: true}))
+}
+
+func TestJobsCursor_NullKey(t *testing.T) {
+	opts := &lookout.GetJobsRequest{OrderBy: orderByDuration}
+	row := &JobRow{JobId: sql.NullString{String: "job-id", Valid: true}}
+
+	encoded, err := newJobsCursor(opts, row).encode()
+	assert.NoError(t, err)
+
+	cursor, err := decodeJobsCursor(encoded)
+	assert.NoError(t, err)
+	assert.Nil(t, cursor.Key)
+	assert.Nil(t, cursor.durationKey())
+}
+
+func TestJobsCursor_JobIdOrderingUsesNewestFirst(t *testing.T) {
+	opts := &lookout.GetJobsRequest{NewestFirst: true}
+	row := &JobRow{JobId: sql.NullString{String: "job-id", Valid: true}}
+
+	cursor := newJobsCursor(opts, row)
+	assert.True(t, cursor.Descending)
+	assert.True(t, cursor.matches(opts))
+	assert.False(t, cursor.matches(&lookout.GetJobsRequest{Descending: true}))
+}
+
+func TestDecodeJobsCursor_Invalid(t *testing.T) {
+	for _, encoded := range []string{"not base64!", "bm90IGpzb24", "e30"} {
+		_, err := decodeJobsCursor(encoded)
+		assert.Error(t, err, encoded)
+	}
+}
@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+const (
+	statsBucketHour = "hour"
+	statsBucketDay  = "day"
+
+	statsGroupByQueue   = "queue"
+	statsGroupByCluster = "cluster"
+	statsGroupByOwner   = "owner"
+)
+
+var (
+	defaultStatsPeriods = map[string]time.Duration{
+		statsBucketHour: 24 * time.Hour,
+		statsBucketDay:  30 * 24 * time.Hour,
+	}
+
+	jobStats_queueWait = goqu.L("jobs.started - jobs.submitted")
+	jobStats_runTime   = goqu.L("jobs.finished - jobs.started")
+)
+
+type jobStatsRow struct {
+	BucketStart pq.NullTime     `db:"bucket_start"`
+	Group       sql.NullString  `db:"group_key"`
+	Jobs        sql.NullInt64   `db:"jobs"`
+	Succeeded   sql.NullInt64   `db:"succeeded"`
+	Failed      sql.NullInt64   `db:"failed"`
+	P50         sql.NullFloat64 `db:"p50"`
+	P90         sql.NullFloat64 `db:"p90"`
+	P95         sql.NullFloat64 `db:"p95"`
+	P99         sql.NullFloat64 `db:"p99"`
+	Max         sql.NullFloat64 `db:"max"`
+}
+
+type jobStatsKey struct {
+	bucketStart time.Time
+	group       string
+}
+
+func (r *SQLJobRepository) GetJobStats(ctx context.Context, opts *lookout.GetJobStatsRequest) ([]*lookout.JobStats, error) {
+	bucket := opts.Bucket
+	if bucket == "" {
+		bucket = statsBucketHour
+	}
+	if bucket != statsBucketHour && bucket != statsBucketDay {
+		return nil, fmt.Errorf("unknown bucket: %q", opts.Bucket)
+	}
+	if !isStatsGroupBy(opts.GroupBy) {
+		return nil, fmt.Errorf("unknown grouping: %q", opts.GroupBy)
+	}
+
+	to := r.clock.Now()
+	if opts.To != nil {
+		to = *opts.To
+	}
+	from := to.Add(-defaultStatsPeriods[bucket])
+	if opts.From != nil {
+		from = *opts.From
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("from (%s) must be before to (%s)", from, to)
+	}
+
+	queueWaitRows, err := r.queryJobStats(ctx, r.createQueueWaitStatsDataset(opts, bucket, from, to))
+	if err != nil {
+		return nil, err
+	}
+	runTimeRows, err := r.queryJobStats(ctx, r.createRunTimeStatsDataset(opts, bucket, from, to))
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeJobStats(queueWaitRows, runTimeRows), nil
+}
+
+func isStatsGroupBy(groupBy string) bool {
+	return groupBy == "" ||
+		groupBy == statsGroupByQueue ||
+		groupBy == statsGroupByCluster ||
+		groupBy == statsGroupByOwner
+}
+
+func (r *SQLJobRepository) queryJobStats(ctx context.Context, ds *goqu.SelectDataset) ([]*jobStatsRow, error) {
+	rows := make([]*jobStatsRow, 0)
+	err := ds.Prepared(true).ScanStructsContext(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// Queue wait of jobs which started running between from and to, bucketed by the time they started
+func (r *SQLJobRepository) createQueueWaitStatsDataset(opts *lookout.GetJobStatsRequest, bucket string, from time.Time, to time.Time) *goqu.SelectDataset {
+	jobs := r.createStatsJobsDataset(opts, job_started, from, to)
+
+	return r.goquDb.
+		From(jobs).
+		Select(append([]interface{}{
+			goqu.L("date_trunc(?, jobs.started)", goqu.L(quoteLiteral(bucket))).As("bucket_start"),
+			goqu.I("jobs.group_key"),
+			goqu.COUNT("*").As("jobs")},
+			durationPercentiles(jobStats_queueWait)...)...).
+		GroupBy(goqu.I("bucket_start"), goqu.I("jobs.group_key"))
+}
+
+// Run time and outcome of jobs which finished between from and to, bucketed by the time they finished
+func (r *SQLJobRepository) createRunTimeStatsDataset(opts *lookout.GetJobStatsRequest, bucket string, from time.Time, to time.Time) *goqu.SelectDataset {
+	jobs := r.createStatsJobsDataset(opts, job_finished, from, to)
+
+	return r.goquDb.
+		From(jobs).
+		Select(append([]interface{}{
+			goqu.L("date_trunc(?, jobs.finished)", goqu.L(quoteLiteral(bucket))).As("bucket_start"),
+			goqu.I("jobs.group_key"),
+			goqu.L(fmt.Sprintf("COUNT(*) FILTER (WHERE jobs.state = %d)", JobStateToIntMap[JobSucceeded])).As("succeeded"),
+			goqu.L(fmt.Sprintf("COUNT(*) FILTER (WHERE jobs.state = %d)", JobStateToIntMap[JobFailed])).As("failed")},
+			durationPercentiles(jobStats_runTime)...)...).
+		Where(goqu.I("jobs.state").In(stateAsLiteral(JobSucceeded), stateAsLiteral(JobFailed))).
+		GroupBy(goqu.I("bucket_start"), goqu.I("jobs.group_key"))
+}
+
+func (r *SQLJobRepository) createStatsJobsDataset(opts *lookout.GetJobStatsRequest, bucketedBy exp.IdentifierExpression, from time.Time, to time.Time) *goqu.SelectDataset {
+	filters := []goqu.Expression{
+		bucketedBy.Gte(ToUTC(from)),
+		bucketedBy.Lt(ToUTC(to)),
+	}
+	if opts.Queue != "" {
+		filters = append(filters, job_queue.Eq(opts.Queue))
+	}
+
+	return r.goquDb.
+		From(jobTable).
+		Select(
+			job_submitted,
+			job_started,
+			job_finished,
+			job_state,
+			goqu.L("?", r.statsGroupKey(opts.GroupBy)).As("group_key")).
+		Where(filters...).
+		As("jobs")
+}
+
+// Jobs are attributed to the cluster on which they first started running
+func (r *SQLJobRepository) statsGroupKey(groupBy string) exp.Expression {
+	switch groupBy {
+	case statsGroupByQueue:
+		return job_queue
+	case statsGroupByOwner:
+		return job_owner
+	case statsGroupByCluster:
+		return r.goquDb.
+			From(jobRunTable).
+			Select(jobRun_cluster).
+			Where(
+				jobRun_jobId.Eq(job_jobId),
+				jobRun_started.IsNotNull()).
+			Order(jobRun_started.Asc()).
+			Limit(1)
+	}
+	return goqu.L("''")
+}
+
+func durationPercentiles(duration exp.LiteralExpression) []interface{} {
+	return []interface{}{
+		goqu.L("percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ?))", duration).As("p50"),
+		goqu.L("percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ?))", duration).As("p90"),
+		goqu.L("percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ?))", duration).As("p95"),
+		goqu.L("percentile_cont(0.99) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ?))", duration).As("p99"),
+		goqu.L("MAX(EXTRACT(EPOCH FROM ?))", duration).As("max"),
+	}
+}
+
+// Bucket names are validated, so can be inlined
+func quoteLiteral(value string) string {
+	return "'" + value + "'"
+}
+
+func mergeJobStats(queueWaitRows []*jobStatsRow, runTimeRows []*jobStatsRow) []*lookout.JobStats {
+	statsMap := map[jobStatsKey]*lookout.JobStats{}
+	getStats := func(row *jobStatsRow) *lookout.JobStats {
+		key := jobStatsKey{
+			bucketStart: ParseNullTimeDefault(row.BucketStart).UTC(),
+			group:       ParseNullString(row.Group),
+		}
+		stats, ok := statsMap[key]
+		if !ok {
+			stats = &lookout.JobStats{BucketStart: key.bucketStart, Group: key.group}
+			statsMap[key] = stats
+		}
+		return stats
+	}
+
+	for _, row := range queueWaitRows {
+		stats := getStats(row)
+		stats.JobsStarted = uint32(ParseNullInt(row.Jobs))
+		stats.QueueWait = rowToDurationPercentiles(row)
+	}
+
+	for _, row := range runTimeRows {
+		stats := getStats(row)
+		stats.JobsSucceeded = uint32(ParseNullInt(row.Succeeded))
+		stats.JobsFailed = uint32(ParseNullInt(row.Failed))
+		if finished := stats.JobsSucceeded + stats.JobsFailed; finished > 0 {
+			stats.FailureRate = float64(stats.JobsFailed) / float64(finished)
+		}
+		stats.RunTime = rowToDurationPercentiles(row)
+	}
+
+	result := make([]*lookout.JobStats, 0, len(statsMap))
+	for _, stats := range statsMap {
+		result = append(result, stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].BucketStart.Equal(result[j].BucketStart) {
+			return result[i].BucketStart.Before(result[j].BucketStart)
+		}
+		return result[i].Group < result[j].Group
+	})
+	return result
+}
+
+func rowToDurationPercentiles(row *jobStatsRow) *lookout.DurationPercentiles {
+	return &lookout.DurationPercentiles{
+		P50: secondsToDuration(row.P50),
+		P90: secondsToDuration(row.P90),
+		P95: secondsToDuration(row.P95),
+		P99: secondsToDuration(row.P99),
+		Max: secondsToDuration(row.Max),
+	}
+}
+
+func secondsToDuration(seconds sql.NullFloat64) *types.Duration {
+	if !seconds.Valid {
+		return nil
+	}
+	return types.DurationProto(time.Duration(seconds.Float64 * float64(time.Second)).Round(time.Millisecond))
+}
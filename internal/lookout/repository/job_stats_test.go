@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestGetJobStats_QueueWaitAndRunTime(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		for i := 1; i <= 4; i++ {
+			k8sId := util.NewULID()
+			NewJobSimulator(t, jobStore).
+				CreateJobAtTime(queue, someTime).
+				RunningAtTime(cluster, k8sId, node, someTime.Add(time.Duration(i)*time.Minute)).
+				SucceededAtTime(cluster, k8sId, node, someTime.Add(time.Duration(i)*time.Minute+time.Hour))
+		}
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			RunningAtTime(cluster, k8sId2, node, someTime.Add(10*time.Minute)).
+			FailedAtTime(cluster, k8sId2, node, "error", someTime.Add(10*time.Minute+time.Hour))
+
+		from := someTime.Truncate(24 * time.Hour)
+		to := from.Add(48 * time.Hour)
+		stats, err := jobRepo.GetJobStats(ctx, &lookout.GetJobStatsRequest{From: &from, To: &to, Bucket: "day"})
+		assert.NoError(t, err)
+		assert.Len(t, stats, 1)
+
+		assert.Equal(t, uint32(5), stats[0].JobsStarted)
+		assert.Equal(t, uint32(4), stats[0].JobsSucceeded)
+		assert.Equal(t, uint32(1), stats[0].JobsFailed)
+		assert.Equal(t, 0.2, stats[0].FailureRate)
+		assert.Equal(t, types.DurationProto(10*time.Minute), stats[0].QueueWait.Max)
+		assert.Equal(t, types.DurationProto(time.Hour), stats[0].RunTime.P50)
+		assert.Equal(t, types.DurationProto(time.Hour), stats[0].RunTime.Max)
+	})
+}
+
+func TestGetJobStats_GroupByQueueAndCluster(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime("queue-a", someTime).
+			RunningAtTime("cluster-a", k8sId1, node, someTime.Add(time.Minute))
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime("queue-b", someTime).
+			RunningAtTime("cluster-b", k8sId2, node, someTime.Add(2*time.Minute))
+
+		from := someTime.Add(-time.Hour)
+		to := someTime.Add(time.Hour)
+
+		byQueue, err := jobRepo.GetJobStats(ctx, &lookout.GetJobStatsRequest{From: &from, To: &to, GroupBy: "queue"})
+		assert.NoError(t, err)
+		assert.Len(t, byQueue, 2)
+		assert.Equal(t, "queue-a", byQueue[0].Group)
+		assert.Equal(t, types.DurationProto(time.Minute), byQueue[0].QueueWait.P50)
+		assert.Equal(t, "queue-b", byQueue[1].Group)
+		assert.Equal(t, types.DurationProto(2*time.Minute), byQueue[1].QueueWait.P50)
+
+		byCluster, err := jobRepo.GetJobStats(ctx, &lookout.GetJobStatsRequest{From: &from, To: &to, GroupBy: "cluster", Queue: "queue-b"})
+		assert.NoError(t, err)
+		assert.Len(t, byCluster, 1)
+		assert.Equal(t, "cluster-b", byCluster[0].Group)
+		assert.Equal(t, uint32(1), byCluster[0].JobsStarted)
+	})
+}
+
+func TestGetJobStats_DefaultsToLastDay(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DummyClock{someTime.Add(time.Hour)})
+
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime.Add(-48*time.Hour)).
+			RunningAtTime(cluster, k8sId1, node, someTime.Add(-47*time.Hour))
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			RunningAtTime(cluster, k8sId2, node, someTime)
+
+		stats, err := jobRepo.GetJobStats(ctx, &lookout.GetJobStatsRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, stats, 1)
+		assert.Equal(t, someTime.UTC().Truncate(time.Hour), stats[0].BucketStart)
+		assert.Equal(t, uint32(1), stats[0].JobsStarted)
+	})
+}
+
+func TestGetJobStats_ErrorsOnInvalidRequest(t *testing.T) {
+	jobRepo := NewSQLJobRepository(nil, &DefaultClock{})
+	from := someTime
+	to := someTime.Add(-time.Hour)
+
+	for name, request := range map[string]*lookout.GetJobStatsRequest{
+		"unknown bucket":   {Bucket: "week"},
+		"unknown grouping": {GroupBy: "node"},
+		"empty range":      {From: &from, To: &to},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := jobRepo.GetJobStats(ctx, request)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMergeJobStats(t *testing.T) {
+	firstHour := someTime.UTC().Truncate(time.Hour)
+	secondHour := firstHour.Add(time.Hour)
+
+	stats := mergeJobStats(
+		[]*jobStatsRow{
+			{
+				BucketStart: pq.NullTime{Time: secondHour, Valid: true},
+				Group:       sql.NullString{String: "a", Valid: true},
+				Jobs:        sql.NullInt64{Int64: 2, Valid: true},
+				P50:         sql.NullFloat64{Float64: 1.5, Valid: true},
+			},
+			{
+				BucketStart: pq.NullTime{Time: firstHour, Valid: true},
+				Group:       sql.NullString{String: "b", Valid: true},
+				Jobs:        sql.NullInt64{Int64: 1, Valid: true},
+			},
+		},
+		[]*jobStatsRow{
+			{
+				BucketStart: pq.NullTime{Time: secondHour, Valid: true},
+				Group:       sql.NullString{String: "a", Valid: true},
+				Succeeded:   sql.NullInt64{Int64: 3, Valid: true},
+				Failed:      sql.NullInt64{Int64: 1, Valid: true},
+				Max:         sql.NullFloat64{Float64: 60, Valid: true},
+			},
+			{
+				BucketStart: pq.NullTime{Time: firstHour, Valid: true},
+				Group:       sql.NullString{String: "a", Valid: true},
+				Succeeded:   sql.NullInt64{Int64: 1, Valid: true},
+			},
+		})
+
+	assert.Len(t, stats, 3)
+
+	assert.Equal(t, firstHour, stats[0].BucketStart)
+	assert.Equal(t, "a", stats[0].Group)
+	assert.Equal(t, uint32(1), stats[0].JobsSucceeded)
+	assert.Equal(t, 0.0, stats[0].FailureRate)
+	assert.Nil(t, stats[0].QueueWait)
+
+	assert.Equal(t, firstHour, stats[1].BucketStart)
+	assert.Equal(t, "b", stats[1].Group)
+	assert.Equal(t, uint32(1), stats[1].JobsStarted)
+	assert.Nil(t, stats[1].RunTime)
+
+	assert.Equal(t, secondHour, stats[2].BucketStart)
+	assert.Equal(t, uint32(2), stats[2].JobsStarted)
+	assert.Equal(t, uint32(4), stats[2].JobsSucceeded+stats[2].JobsFailed)
+	assert.Equal(t, 0.25, stats[2].FailureRate)
+	assert.Equal(t, types.DurationProto(1500*time.Millisecond), stats[2].QueueWait.P50)
+	assert.Nil(t, stats[2].QueueWait.P99)
+	assert.Equal(t, types.DurationProto(time.Minute), stats[2].RunTime.Max)
+}
@@ -2,9 +2,10 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
-	"sort"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
@@ -13,23 +14,70 @@ import (
 	"github.com/G-Research/armada/pkg/api/lookout"
 )
 
-func (r *SQLJobRepository) GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) ([]*lookout.JobInfo, error) {
+const (
+	orderByPriority = "priority"
+	orderByDuration = "duration"
+)
+
+// Jobs that have not finished have no duration, and sort as if longer than all finished jobs
+var job_duration = goqu.L("job.finished - job.started")
+
+func (r *SQLJobRepository) GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) ([]*lookout.JobInfo, string, error) {
+	return r.GetJobsInQueues(ctx, opts, nil)
+}
+
+// GetJobsInQueues behaves like GetJobs, but only returns jobs in the given queues. Nil queues allow jobs in any queue.
+func (r *SQLJobRepository) GetJobsInQueues(ctx context.Context, opts *lookout.GetJobsRequest, queues []string) ([]*lookout.JobInfo, string, error) {
 	if valid, jobState := validateJobStates(opts.JobStates); !valid {
-		return nil, fmt.Errorf("unknown job state: %q", jobState)
+		return nil, "", fmt.Errorf("unknown job state: %q", jobState)
+	}
+	if valid, cause := validateFailureCauses(opts.FailureCauses); !valid {
+		return nil, "", fmt.Errorf("unknown failure cause: %q", cause)
+	}
+	if !isJobOrder(opts.OrderBy) {
+		return nil, "", fmt.Errorf("unknown job ordering: %q", opts.OrderBy)
+	}
+
+	var cursor *jobsCursor
+	if opts.Cursor != "" {
+		c, err := decodeJobsCursor(opts.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		if !c.matches(opts) {
+			return nil, "", fmt.Errorf("cursor does not match requested job ordering")
+		}
+		cursor = c
 	}
 
-	rows, err := r.queryJobs(ctx, opts)
+	if queues != nil && len(queues) == 0 {
+		return []*lookout.JobInfo{}, "", nil
+	}
+
+	rows, err := r.queryJobs(ctx, opts, cursor, queues)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	result, err := rowsToJobs(rows)
+	containers, err := r.queryContainers(ctx, rows)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	sortJobsByJobId(result, opts.NewestFirst)
 
-	return result, nil
+	result, err := rowsToJobs(rows, containers)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if opts.Take > 0 && len(result) == int(opts.Take) {
+		nextCursor, err = newJobsCursor(opts, rows[len(rows)-1]).encode()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return result, nextCursor, nil
 }
 
 func validateJobStates(jobStates []string) (bool, JobState) {
@@ -50,8 +98,21 @@ func isJobState(val string) bool {
 	return false
 }
 
-func (r *SQLJobRepository) queryJobs(ctx context.Context, opts *lookout.GetJobsRequest) ([]*JobRow, error) {
-	ds := r.createJobsDataset(opts)
+func validateFailureCauses(causes []string) (bool, string) {
+	for _, cause := range causes {
+		if _, ok := api.Cause_value[cause]; !ok {
+			return false, cause
+		}
+	}
+	return true, ""
+}
+
+func isJobOrder(orderBy string) bool {
+	return orderBy == "" || orderBy == orderByPriority || orderBy == orderByDuration
+}
+
+func (r *SQLJobRepository) queryJobs(ctx context.Context, opts *lookout.GetJobsRequest, cursor *jobsCursor, queues []string) ([]*JobRow, error) {
+	ds := r.createJobsDataset(opts, cursor, queues)
 
 	jobsInQueueRows := make([]*JobRow, 0)
 	err := ds.Prepared(true).ScanStructsContext(ctx, &jobsInQueueRows)
@@ -62,14 +123,69 @@ func (r *SQLJobRepository) queryJobs(ctx context.Context, opts *lookout.GetJobsR
 	return jobsInQueueRows, nil
 }
 
-func (r *SQLJobRepository) createJobsDataset(opts *lookout.GetJobsRequest) *goqu.SelectDataset {
+// Containers of the runs in rows, by run id
+func (r *SQLJobRepository) queryContainers(ctx context.Context, rows []*JobRow) (map[string][]*lookout.ContainerInfo, error) {
+	var runIds []string
+	for _, row := range rows {
+		if row.RunId.Valid {
+			runIds = append(runIds, row.RunId.String)
+		}
+	}
+	if len(runIds) == 0 {
+		return map[string][]*lookout.ContainerInfo{}, nil
+	}
+
+	ds := r.goquDb.
+		From(jobRunContainerTable).
+		Select(
+			jobRunContainer_runId,
+			jobRunContainer_containerName,
+			jobRunContainer_exitCode,
+			jobRunContainer_message,
+			jobRunContainer_reason,
+			jobRunContainer_cause).
+		Where(jobRunContainer_runId.In(runIds)).
+		Order(jobRunContainer_runId.Asc(), jobRunContainer_containerName.Asc())
+
+	containerRows := make([]*ContainerRow, 0)
+	err := ds.Prepared(true).ScanStructsContext(ctx, &containerRows)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := map[string][]*lookout.ContainerInfo{}
+	for _, row := range containerRows {
+		runId := ParseNullString(row.RunId)
+		containers[runId] = append(containers[runId], &lookout.ContainerInfo{
+			Name:     ParseNullString(row.ContainerName),
+			ExitCode: int32(ParseNullInt(row.ExitCode)),
+			Message:  ParseNullString(row.Message),
+			Reason:   ParseNullString(row.Reason),
+			Cause:    parseCause(row.Cause),
+		})
+	}
+	return containers, nil
+}
+
+func (r *SQLJobRepository) createJobsDataset(opts *lookout.GetJobsRequest, cursor *jobsCursor, queues []string) *goqu.SelectDataset {
+	filters := r.createWhereFilters(opts)
+	if cursor != nil {
+		filters = append(filters, cursor.filter())
+	}
+	if queues != nil {
+		filters = append(filters, job_queue.In(queues))
+	}
+
 	subDs := r.goquDb.
 		From(jobTable).
 		Select(job_jobId).
-		Where(goqu.And(r.createWhereFilters(opts)...)).
-		Order(createJobOrdering(opts.NewestFirst)).
-		Limit(uint(opts.Take)).
-		Offset(uint(opts.Skip))
+		Where(goqu.And(filters...)).
+		Order(createJobOrdering(opts)...).
+		Limit(uint(opts.Take))
+
+	if cursor == nil {
+		subDs = subDs.Offset(uint(opts.Skip))
+	}
 
 	ds := r.goquDb.
 		From(jobTable).
@@ -92,8 +208,11 @@ func (r *SQLJobRepository) createJobsDataset(opts *lookout.GetJobsRequest) *goqu
 			jobRun_started,
 			jobRun_finished,
 			jobRun_succeeded,
-			jobRun_error).
-		Where(job_jobId.In(subDs))
+			jobRun_error,
+			jobRun_cause,
+			job_duration.As("duration")).
+		Where(job_jobId.In(subDs)).
+		Order(createJobOrdering(opts)...)
 
 	return ds
 }
@@ -125,6 +244,62 @@ func (r *SQLJobRepository) createWhereFilters(opts *lookout.GetJobsRequest) []go
 		filters = append(filters, createJobStateFilter(defaultQueryStates))
 	}
 
+	if len(opts.JobLabels) > 0 {
+		filters = append(filters, createJobLabelsFilter(opts.JobLabels))
+	}
+
+	if opts.Cluster != "" {
+		filters = append(filters, r.createJobRunFilter(StartsWith(jobRun_cluster, opts.Cluster)))
+	}
+
+	if opts.Node != "" {
+		filters = append(filters, r.createJobRunFilter(StartsWith(jobRun_node, opts.Node)))
+	}
+
+	if opts.FailureReason != "" {
+		filters = append(filters, r.createJobRunFilter(Contains(jobRun_error, opts.FailureReason)))
+	}
+
+	if len(opts.FailureCauses) > 0 {
+		filters = append(filters, r.createJobRunFilter(createFailureCauseFilter(opts.FailureCauses)))
+	}
+
+	filters = append(filters, createTimeRangeFilters(job_submitted, opts.SubmittedAfter, opts.SubmittedBefore)...)
+	filters = append(filters, createTimeRangeFilters(job_started, opts.StartedAfter, opts.StartedBefore)...)
+	filters = append(filters, createTimeRangeFilters(job_finished, opts.FinishedAfter, opts.FinishedBefore)...)
+
+	return filters
+}
+
+// Labels are matched exactly, using jsonb containment on the stored job
+func createJobLabelsFilter(labels map[string]string) goqu.Expression {
+	labelsJson, _ := json.Marshal(labels)
+	return goqu.L("(job.job -> 'labels') @> ?::jsonb", string(labelsJson))
+}
+
+func (r *SQLJobRepository) createJobRunFilter(runFilter goqu.Expression) goqu.Expression {
+	return job_jobId.In(
+		r.goquDb.From(jobRunTable).
+			Select(jobRun_jobId).
+			Where(runFilter))
+}
+
+func createFailureCauseFilter(causes []string) goqu.Expression {
+	causeInts := make([]interface{}, len(causes))
+	for i, cause := range causes {
+		causeInts[i] = api.Cause_value[cause]
+	}
+	return jobRun_cause.In(causeInts...)
+}
+
+func createTimeRangeFilters(field exp.IdentifierExpression, after *time.Time, before *time.Time) []goqu.Expression {
+	var filters []goqu.Expression
+	if after != nil {
+		filters = append(filters, field.Gte(ToUTC(*after)))
+	}
+	if before != nil {
+		filters = append(filters, field.Lt(ToUTC(*before)))
+	}
 	return filters
 }
 
@@ -181,15 +356,31 @@ func createJobStateFilter(jobStates []JobState) goqu.Expression {
 	return job_state.In(stateInts...)
 }
 
-func createJobOrdering(newestFirst bool) exp.OrderedExpression {
-	if newestFirst {
-		return job_jobId.Desc()
+func createJobOrdering(opts *lookout.GetJobsRequest) []exp.OrderedExpression {
+	switch opts.OrderBy {
+	case orderByPriority:
+		return orderByKeyThenJobId(job_priority, opts.Descending)
+	case orderByDuration:
+		return orderByKeyThenJobId(job_duration, opts.Descending)
+	}
+	if opts.NewestFirst {
+		return []exp.OrderedExpression{job_jobId.Desc()}
 	}
-	return job_jobId.Asc()
+	return []exp.OrderedExpression{job_jobId.Asc()}
 }
 
-func rowsToJobs(rows []*JobRow) ([]*lookout.JobInfo, error) {
+// Postgres sorts nulls as if larger than any other value, which matches the indexes on the sort keys
+func orderByKeyThenJobId(key exp.Orderable, descending bool) []exp.OrderedExpression {
+	if descending {
+		return []exp.OrderedExpression{key.Desc(), job_jobId.Desc()}
+	}
+	return []exp.OrderedExpression{key.Asc(), job_jobId.Asc()}
+}
+
+// Jobs are returned in the order in which they first appear in rows
+func rowsToJobs(rows []*JobRow, containers map[string][]*lookout.ContainerInfo) ([]*lookout.JobInfo, error) {
 	jobMap := make(map[string]*lookout.JobInfo)
+	var jobIds []string
 
 	for _, row := range rows {
 		if row.JobId.Valid {
@@ -210,41 +401,27 @@ func rowsToJobs(rows []*JobRow) ([]*lookout.JobInfo, error) {
 					Runs:      []*lookout.RunInfo{},
 					JobJson:   ParseNullString(row.JobJson),
 				}
+				jobIds = append(jobIds, jobId)
 			}
 
 			if row.RunId.Valid {
 				if jobInfo, ok := jobMap[jobId]; ok {
-					jobInfo.Runs = append(jobInfo.Runs, makeRunFromRow(row))
+					run := makeRunFromRow(row)
+					run.Containers = containers[run.K8SId]
+					jobInfo.Runs = append(jobInfo.Runs, run)
 				}
 			}
 		}
 	}
 
-	for _, jobInfo := range jobMap {
+	result := make([]*lookout.JobInfo, 0, len(jobIds))
+	for _, jobId := range jobIds {
+		jobInfo := jobMap[jobId]
 		updateRunStates(jobInfo)
-	}
-
-	return jobMapToSlice(jobMap), nil
-}
-
-func jobMapToSlice(jobMap map[string]*lookout.JobInfo) []*lookout.JobInfo {
-	result := make([]*lookout.JobInfo, 0)
-
-	for _, jobInfo := range jobMap {
 		result = append(result, jobInfo)
 	}
 
-	return result
-}
-
-func sortJobsByJobId(jobInfos []*lookout.JobInfo, descending bool) {
-	sort.SliceStable(jobInfos, func(i, j int) bool {
-		if descending {
-			return jobInfos[i].Job.Id > jobInfos[j].Job.Id
-		} else {
-			return jobInfos[i].Job.Id < jobInfos[j].Job.Id
-		}
-	})
+	return result, nil
 }
 
 func makeJobFromRow(row *JobRow) (*api.Job, error) {
@@ -266,6 +443,7 @@ func makeJobFromRow(row *JobRow) (*api.Job, error) {
 		Owner:       ParseNullString(row.Owner),
 		Priority:    ParseNullFloat(row.Priority),
 		Created:     ParseNullTimeDefault(row.Submitted),
+		Labels:      jobFromJson.Labels,
 		Annotations: jobFromJson.Annotations,
 	}, nil
 }
@@ -281,12 +459,20 @@ func makeRunFromRow(row *JobRow) *lookout.RunInfo {
 		Node:      ParseNullString(row.Node),
 		Succeeded: ParseNullBool(row.Succeeded),
 		Error:     ParseNullString(row.Error),
+		Cause:     parseCause(row.Cause),
 		Created:   ParseNullTime(row.Created), // Pod created (Pending)
 		Started:   ParseNullTime(row.Started), // Pod Running
 		Finished:  ParseNullTime(row.Finished),
 	}
 }
 
+func parseCause(cause sql.NullInt64) string {
+	if !cause.Valid {
+		return ""
+	}
+	return api.Cause_name[int32(cause.Int64)]
+}
+
 func determineRunState(runInfo *lookout.RunInfo) JobState {
 	if runInfo.Finished != nil && runInfo.Succeeded {
 		return JobSucceeded
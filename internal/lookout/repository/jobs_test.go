@@ -28,7 +28,7 @@ func TestGetJobs_GetSucceededJob(t *testing.T) {
 			RunningAtTime(cluster, k8sId1, node, runningTime).
 			SucceededAtTime(cluster, k8sId1, node, succeededTime)
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{Take: 10})
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{Take: 10})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(jobInfos))
 
@@ -69,7 +69,7 @@ func TestGetJobs_GetFailedJob(t *testing.T) {
 			RunningAtTime(cluster, k8sId1, node, runningTime).
 			FailedAtTime(cluster, k8sId1, node, failureReason, failedTime)
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{Take: 10})
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{Take: 10})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(jobInfos))
 
@@ -109,7 +109,7 @@ func TestGetJobs_GetCancelledJob(t *testing.T) {
 			RunningAtTime(cluster, k8sId1, node, runningTime).
 			CancelledAtTime(cancelledTime)
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{Take: 10})
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{Take: 10})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(jobInfos))
 
@@ -152,7 +152,7 @@ func TestGetJobs_GetMultipleRunJob(t *testing.T) {
 			RunningAtTime(cluster, k8sId2, node, runningTime).
 			SucceededAtTime(cluster, k8sId2, node, succeededTime)
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{Take: 10})
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{Take: 10})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(jobInfos))
 
@@ -191,7 +191,7 @@ func TestGetJobs_GetJobJson(t *testing.T) {
 		queued := NewJobSimulator(t, jobStore).
 			CreateJob(queue)
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			NewestFirst: true,
 			Take:        10,
 			Skip:        0,
@@ -224,7 +224,7 @@ func TestGetJobs_GetNoJobsIfQueueDoesNotExist(t *testing.T) {
 
 		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Queue: "other-queue",
 			Take:  10,
 		})
@@ -251,7 +251,7 @@ func TestGetJobs_FilterByQueue(t *testing.T) {
 
 		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Queue: "queue-3",
 			Take:  10,
 		})
@@ -261,6 +261,59 @@ func TestGetJobs_FilterByQueue(t *testing.T) {
 	})
 }
 
+func TestGetJobsInQueues(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+
+		first := NewJobSimulator(t, jobStore).
+			CreateJob("queue-1")
+
+		NewJobSimulator(t, jobStore).
+			CreateJob("queue-2")
+
+		third := NewJobSimulator(t, jobStore).
+			CreateJob("queue-3")
+
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		jobInfos, _, err := jobRepo.GetJobsInQueues(ctx, &lookout.GetJobsRequest{
+			Take:        10,
+			NewestFirst: true,
+		}, []string{"queue-1", "queue-3"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(jobInfos))
+		AssertJobsAreEquivalent(t, third.job, jobInfos[0].Job)
+		AssertJobsAreEquivalent(t, first.job, jobInfos[1].Job)
+
+		jobInfos, _, err = jobRepo.GetJobsInQueues(ctx, &lookout.GetJobsRequest{
+			Queue: "queue-2",
+			Take:  10,
+		}, []string{"queue-1", "queue-3"})
+		assert.NoError(t, err)
+		assert.Empty(t, jobInfos)
+
+		jobInfos, _, err = jobRepo.GetJobsInQueues(ctx, &lookout.GetJobsRequest{Take: 10}, []string{})
+		assert.NoError(t, err)
+		assert.Empty(t, jobInfos)
+	})
+}
+
+func TestGetQueueNames(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+
+		NewJobSimulator(t, jobStore).CreateJob("queue-2")
+		NewJobSimulator(t, jobStore).CreateJob("queue-1")
+		NewJobSimulator(t, jobStore).CreateJob("queue-2")
+
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		queues, err := jobRepo.GetQueueNames(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"queue-1", "queue-2"}, queues)
+	})
+}
+
 func TestGetJobs_FilterByQueueStartsWith(t *testing.T) {
 	withDatabase(t, func(db *goqu.Database) {
 		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
@@ -279,7 +332,7 @@ func TestGetJobs_FilterByQueueStartsWith(t *testing.T) {
 
 		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Queue: "queue",
 			Take:  10,
 		})
@@ -324,7 +377,7 @@ func TestGetJobs_FilterQueuedJobs(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobStates: []string{string(JobQueued)},
 		})
@@ -370,7 +423,7 @@ func TestGetJobs_FilterPendingJobs(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobStates: []string{string(JobPending)},
 		})
@@ -416,7 +469,7 @@ func TestGetJobs_FilterRunningJobs(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobStates: []string{string(JobRunning)},
 		})
@@ -462,7 +515,7 @@ func TestGetJobs_FilterSucceededJobs(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobStates: []string{string(JobSucceeded)},
 		})
@@ -508,7 +561,7 @@ func TestGetJobs_FilterFailedJobs(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobStates: []string{string(JobFailed)},
 		})
@@ -554,7 +607,7 @@ func TestGetJobs_FilterCancelledJobs(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobStates: []string{string(JobCancelled)},
 		})
@@ -571,7 +624,7 @@ func TestGetJobs_ErrorsIfUnknownStateIsGiven(t *testing.T) {
 	withDatabase(t, func(db *goqu.Database) {
 		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
 
-		_, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		_, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Queue:     queue,
 			Take:      10,
 			JobStates: []string{"Unknown"},
@@ -613,7 +666,7 @@ func TestGetJobs_FilterMultipleStates(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobStates: []string{string(JobQueued), string(JobRunning), string(JobFailed)},
 		})
@@ -623,7 +676,7 @@ func TestGetJobs_FilterMultipleStates(t *testing.T) {
 		AssertJobsAreEquivalent(t, running.job, jobInfos[1].Job)
 		AssertJobsAreEquivalent(t, failed.job, jobInfos[2].Job)
 
-		jobInfos, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobStates: []string{string(JobPending), string(JobSucceeded), string(JobCancelled)},
 		})
@@ -672,7 +725,7 @@ func TestGetJobs_FilterBySingleJobSet(t *testing.T) {
 			CreateJobWithJobSet(queue, jobSet3).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobSetIds: []string{jobSet1},
 		})
@@ -681,7 +734,7 @@ func TestGetJobs_FilterBySingleJobSet(t *testing.T) {
 		AssertJobsAreEquivalent(t, job1.job, jobInfos[0].Job)
 		AssertJobsAreEquivalent(t, job2.job, jobInfos[1].Job)
 
-		jobInfos, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Queue:     queue,
 			Take:      10,
 			JobSetIds: []string{jobSet2},
@@ -691,7 +744,7 @@ func TestGetJobs_FilterBySingleJobSet(t *testing.T) {
 		AssertJobsAreEquivalent(t, job3.job, jobInfos[0].Job)
 		AssertJobsAreEquivalent(t, job4.job, jobInfos[1].Job)
 
-		jobInfos, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Queue:     queue,
 			Take:      10,
 			JobSetIds: []string{jobSet3},
@@ -740,7 +793,7 @@ func TestGetJobs_FilterByMultipleJobSets(t *testing.T) {
 			CreateJobWithJobSet(queue, jobSet3).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobSetIds: []string{jobSet1, jobSet2},
 		})
@@ -790,7 +843,7 @@ func TestGetJobs_FilterByJobSetStartingWith(t *testing.T) {
 			CreateJobWithJobSet(queue, jobSet3).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobSetIds: []string{"job-se"},
 		})
@@ -843,7 +896,7 @@ func TestGetJobs_FilterByMultipleJobSetStartingWith(t *testing.T) {
 			CreateJobWithJobSet(queue, jobSet4).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:      10,
 			JobSetIds: []string{"hello", "world"},
 		})
@@ -890,7 +943,7 @@ func TestGetJobs_FilterByJobId(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			JobId: job.job.Id,
 			Take:  10,
 		})
@@ -933,7 +986,7 @@ func TestGetJobs_FilterByJobIdWithWrongQueue(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Queue: "queue-2",
 			JobId: job.job.Id,
 			Take:  10,
@@ -976,7 +1029,7 @@ func TestGetJobs_FilterByJobIdWithWrongJobSet(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			JobId:     job.job.Id,
 			JobSetIds: []string{"other-job-set"},
 			Take:      10,
@@ -1019,7 +1072,7 @@ func TestGetJobs_FilterByOwner(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Owner: "other-user",
 			Take:  10,
 		})
@@ -1066,7 +1119,7 @@ func TestGetJobs_FilterByOwnerStartsWith(t *testing.T) {
 			CreateJob(queue).
 			Cancelled()
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Owner: "other-user",
 			Take:  10,
 		})
@@ -1094,7 +1147,7 @@ func TestGetJobs_FilterBySingleAnnotation(t *testing.T) {
 				"prefix/b": "b",
 			})
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			UserAnnotations: map[string]string{
 				"a": "a",
 			},
@@ -1131,7 +1184,7 @@ func TestGetJobs_FilterByMultipleAnnotations(t *testing.T) {
 				"prefix/c": "c",
 			})
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			UserAnnotations: map[string]string{
 				"a": "a",
 				"b": "b",
@@ -1166,7 +1219,7 @@ func TestGetJobs_FilterByAnnotationWithValueStartingWith(t *testing.T) {
 				"prefix/a": "abc",
 			})
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			UserAnnotations: map[string]string{
 				"a": "aa",
 			},
@@ -1204,7 +1257,7 @@ func TestGetJobs_GetJobsOrderedFromOldestToNewest(t *testing.T) {
 			Pending(cluster, k8sId3).
 			Running(cluster, k8sId3, node)
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:        10,
 			NewestFirst: false,
 		})
@@ -1242,7 +1295,7 @@ func TestGetJobs_GetJobsOrderedFromNewestToOldest(t *testing.T) {
 			Pending(cluster, k8sId3).
 			Running(cluster, k8sId3, node)
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:        10,
 			NewestFirst: true,
 		})
@@ -1280,7 +1333,7 @@ func TestGetJobs_TakeOldestJobsFirst(t *testing.T) {
 				Running(cluster, otherK8sId, node)
 		}
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take: uint32(take),
 		})
 		assert.NoError(t, err)
@@ -1316,7 +1369,7 @@ func TestGetJobs_TakeNewestJobsFirst(t *testing.T) {
 				Running(cluster, otherK8sId, node)
 		}
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			NewestFirst: true,
 			Take:        uint32(take),
 		})
@@ -1350,7 +1403,7 @@ func TestGetJobs_SkipFirstOldestJobs(t *testing.T) {
 				Running(cluster, otherK8sId, node)
 		}
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take: uint32(take),
 			Skip: uint32(skip),
 		})
@@ -1383,7 +1436,7 @@ func TestGetJobs_SkipFirstNewestJobs(t *testing.T) {
 				Running(cluster, otherK8sId, node)
 		}
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			NewestFirst: true,
 			Take:        uint32(take),
 			Skip:        uint32(skip),
@@ -1410,7 +1463,7 @@ func TestGetJobs_RemovesDuplicateJobsByDefault(t *testing.T) {
 			CreateJobWithId(queue, "duplicate").
 			Duplicate("correct")
 
-		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:        10,
 			NewestFirst: false,
 		})
@@ -1418,7 +1471,7 @@ func TestGetJobs_RemovesDuplicateJobsByDefault(t *testing.T) {
 		assert.Equal(t, 1, len(jobInfos))
 		AssertJobsAreEquivalent(t, correctJob.job, jobInfos[0].Job)
 
-		jobInfos, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+		jobInfos, _, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
 			Take:        10,
 			NewestFirst: false,
 			JobStates:   []string{string(JobDuplicate)},
@@ -1429,3 +1482,420 @@ func TestGetJobs_RemovesDuplicateJobsByDefault(t *testing.T) {
 
 	})
 }
+
+func TestGetJobs_FilterByJobLabels(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		job := NewJobSimulator(t, jobStore).
+			CreateJobWithLabels(queue, map[string]string{
+				"a": "a",
+				"b": "b",
+			})
+
+		NewJobSimulator(t, jobStore).
+			CreateJobWithLabels(queue, map[string]string{
+				"a": "a",
+				"b": "c",
+			})
+
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue)
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			JobLabels: map[string]string{
+				"a": "a",
+				"b": "b",
+			},
+			Take: 10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, job.job, jobInfos[0].Job)
+		assert.Equal(t, job.job.Labels, jobInfos[0].Job.Labels)
+	})
+}
+
+func TestGetJobs_FilterByClusterStartsWith(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		job := NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending("cluster-a", k8sId1)
+
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending("other-cluster", k8sId2)
+
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue)
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Cluster: "cluster",
+			Take:    10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, job.job, jobInfos[0].Job)
+	})
+}
+
+func TestGetJobs_FilterByNode(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		job := NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending(cluster, k8sId1).
+			Running(cluster, k8sId1, "node-1")
+
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending(cluster, k8sId2).
+			Running(cluster, k8sId2, "other-node")
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Node: "node-1",
+			Take: 10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, job.job, jobInfos[0].Job)
+	})
+}
+
+func TestGetJobs_FilterByFailureReasonContaining(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		job := NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending(cluster, k8sId1).
+			Running(cluster, k8sId1, node).
+			Failed(cluster, k8sId1, node, "container was OOMKilled by the kernel")
+
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending(cluster, k8sId2).
+			Running(cluster, k8sId2, node).
+			Failed(cluster, k8sId2, node, "exit code 1")
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			FailureReason: "oomkilled",
+			Take:          10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, job.job, jobInfos[0].Job)
+	})
+}
+
+func TestGetJobs_FilterByFailureCause(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		job := NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending(cluster, k8sId1).
+			Running(cluster, k8sId1, node).
+			FailedWithCause(cluster, k8sId1, node, "out of memory", api.Cause_OOM, nil)
+
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending(cluster, k8sId2).
+			Running(cluster, k8sId2, node).
+			FailedWithCause(cluster, k8sId2, node, "exit code 1", api.Cause_Error, nil)
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			FailureCauses: []string{"OOM", "Evicted"},
+			Take:          10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, job.job, jobInfos[0].Job)
+		assert.Equal(t, "OOM", jobInfos[0].Runs[0].Cause)
+	})
+}
+
+func TestGetJobs_ErrorsIfUnknownFailureCauseIsGiven(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		_, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			FailureCauses: []string{"OOMKilled"},
+			Take:          10,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetJobs_GetContainerDetails(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Pending(cluster, k8sId1).
+			Running(cluster, k8sId1, node).
+			FailedWithCause(cluster, k8sId1, node, "Container trainer failed", api.Cause_OOM, []*api.ContainerStatus{
+				{Name: "trainer", ExitCode: 137, Reason: "OOMKilled", Message: "out of memory", Cause: api.Cause_OOM},
+				{Name: "sidecar", ExitCode: 0, Reason: "Completed"},
+			})
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take: 10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		assert.Equal(t, 1, len(jobInfos[0].Runs))
+
+		run := jobInfos[0].Runs[0]
+		assert.Equal(t, "Container trainer failed", run.Error)
+		assert.Equal(t, "OOM", run.Cause)
+		assert.Equal(t, []*lookout.ContainerInfo{
+			{Name: "sidecar", ExitCode: 0, Reason: "Completed", Cause: "Error"},
+			{Name: "trainer", ExitCode: 137, Reason: "OOMKilled", Message: "out of memory", Cause: "OOM"},
+		}, run.Containers)
+	})
+}
+
+func TestGetJobs_FilterBySubmittedTimeRange(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime)
+
+		job := NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime.Add(time.Hour))
+
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime.Add(2*time.Hour))
+
+		after := someTime.Add(time.Minute)
+		before := someTime.Add(2 * time.Hour)
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			SubmittedAfter:  &after,
+			SubmittedBefore: &before,
+			Take:            10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, job.job, jobInfos[0].Job)
+	})
+}
+
+func TestGetJobs_FilterByStartedAndFinishedTimeRanges(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		startedEarly := NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			PendingAtTime(cluster, k8sId1, someTime).
+			RunningAtTime(cluster, k8sId1, node, someTime.Add(time.Minute)).
+			SucceededAtTime(cluster, k8sId1, node, someTime.Add(3*time.Hour))
+
+		startedLate := NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			PendingAtTime(cluster, k8sId2, someTime).
+			RunningAtTime(cluster, k8sId2, node, someTime.Add(2*time.Hour)).
+			SucceededAtTime(cluster, k8sId2, node, someTime.Add(4*time.Hour))
+
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime)
+
+		startedBefore := someTime.Add(time.Hour)
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			StartedBefore: &startedBefore,
+			Take:          10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, startedEarly.job, jobInfos[0].Job)
+
+		finishedAfter := someTime.Add(3*time.Hour + time.Minute)
+		jobInfos, _, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			FinishedAfter: &finishedAfter,
+			Take:          10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, startedLate.job, jobInfos[0].Job)
+	})
+}
+
+func TestGetJobs_OrderByPriority(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		medium := NewJobSimulator(t, jobStore).CreateJobWithPriority(queue, 5)
+		high := NewJobSimulator(t, jobStore).CreateJobWithPriority(queue, 10)
+		low := NewJobSimulator(t, jobStore).CreateJobWithPriority(queue, 1)
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			OrderBy: "priority",
+			Take:    10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(jobInfos))
+		AssertJobsAreEquivalent(t, low.job, jobInfos[0].Job)
+		AssertJobsAreEquivalent(t, medium.job, jobInfos[1].Job)
+		AssertJobsAreEquivalent(t, high.job, jobInfos[2].Job)
+
+		jobInfos, _, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			OrderBy:    "priority",
+			Descending: true,
+			Take:       10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(jobInfos))
+		AssertJobsAreEquivalent(t, high.job, jobInfos[0].Job)
+		AssertJobsAreEquivalent(t, medium.job, jobInfos[1].Job)
+		AssertJobsAreEquivalent(t, low.job, jobInfos[2].Job)
+	})
+}
+
+func TestGetJobs_OrderByDuration(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		long := NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			RunningAtTime(cluster, k8sId1, node, someTime).
+			SucceededAtTime(cluster, k8sId1, node, someTime.Add(time.Hour))
+
+		short := NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			RunningAtTime(cluster, k8sId2, node, someTime).
+			FailedAtTime(cluster, k8sId2, node, "error", someTime.Add(time.Minute))
+
+		running := NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			RunningAtTime(cluster, k8sId3, node, someTime)
+
+		jobInfos, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			OrderBy: "duration",
+			Take:    10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(jobInfos))
+		AssertJobsAreEquivalent(t, short.job, jobInfos[0].Job)
+		AssertJobsAreEquivalent(t, long.job, jobInfos[1].Job)
+		AssertJobsAreEquivalent(t, running.job, jobInfos[2].Job)
+	})
+}
+
+func TestGetJobs_ErrorsIfUnknownOrderingIsGiven(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		_, _, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			OrderBy: "owner",
+			Take:    10,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetJobs_PageThroughNewestJobsWithCursor(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		nJobs := 35
+		take := 10
+
+		allJobs := make([]*JobSimulator, nJobs)
+		for i := 0; i < nJobs; i++ {
+			allJobs[i] = NewJobSimulator(t, jobStore).CreateJob(queue)
+		}
+
+		var pagedJobs []*lookout.JobInfo
+		cursor := ""
+		for page := 0; page < 4; page++ {
+			jobInfos, nextCursor, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+				NewestFirst: true,
+				Take:        uint32(take),
+				Cursor:      cursor,
+			})
+			assert.NoError(t, err)
+			pagedJobs = append(pagedJobs, jobInfos...)
+			cursor = nextCursor
+		}
+
+		assert.Empty(t, cursor)
+		assert.Equal(t, nJobs, len(pagedJobs))
+		for i := 0; i < nJobs; i++ {
+			AssertJobsAreEquivalent(t, allJobs[nJobs-i-1].job, pagedJobs[i].Job)
+		}
+	})
+}
+
+func TestGetJobs_PageThroughJobsByPriorityWithCursor(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		priorities := []float64{3, 1, 2, 3, 1, 2, 3}
+		for _, priority := range priorities {
+			NewJobSimulator(t, jobStore).CreateJobWithPriority(queue, priority)
+		}
+
+		var pagedJobs []*lookout.JobInfo
+		cursor := ""
+		for page := 0; page < 3; page++ {
+			jobInfos, nextCursor, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+				OrderBy:    "priority",
+				Descending: true,
+				Take:       3,
+				Cursor:     cursor,
+			})
+			assert.NoError(t, err)
+			pagedJobs = append(pagedJobs, jobInfos...)
+			cursor = nextCursor
+		}
+
+		assert.Empty(t, cursor)
+		assert.Equal(t, len(priorities), len(pagedJobs))
+		for i := 1; i < len(pagedJobs); i++ {
+			previous, current := pagedJobs[i-1].Job, pagedJobs[i].Job
+			assert.True(t, previous.Priority > current.Priority ||
+				(previous.Priority == current.Priority && previous.Id > current.Id))
+		}
+	})
+}
+
+func TestGetJobs_ErrorsIfCursorDoesNotMatchOrdering(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+
+		_, cursor, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take: 1,
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, cursor)
+
+		_, _, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			OrderBy: "priority",
+			Take:    1,
+			Cursor:  cursor,
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+)
+
+type JobPruner interface {
+	PruneJobs(filter *PruneFilter, batchSize int) (int, error)
+}
+
+// PruneFilter selects terminal jobs which finished before a point in time.
+// Jobs are restricted to Queues if any are given, and jobs in ExcludedQueues are never selected.
+type PruneFilter struct {
+	Queues         []string
+	ExcludedQueues []string
+	Before         time.Time
+	Archive        bool
+}
+
+var (
+	jobArchiveTable             = goqu.T("job_archive")
+	jobRunArchiveTable          = goqu.T("job_run_archive")
+	jobRunContainerArchiveTable = goqu.T("job_run_container_archive")
+
+	// Time at which a job reached its terminal state, matches idx_job_queue_terminal_time
+	job_terminalTime = goqu.L("COALESCE(job.finished, job.cancelled, job.submitted)")
+
+	terminalJobStates = []JobState{
+		JobSucceeded,
+		JobFailed,
+		JobCancelled,
+		JobDuplicate,
+	}
+)
+
+type SQLJobPruner struct {
+	db *goqu.Database
+}
+
+func NewSQLJobPruner(db *goqu.Database) *SQLJobPruner {
+	return &SQLJobPruner{db: db}
+}
+
+// PruneJobs removes a single batch of at most batchSize jobs matching the filter, along with their runs,
+// containers and user annotations, and returns the number of jobs removed.
+// Rows locked by event ingestion are skipped rather than waited for, they are picked up by a later batch.
+func (p *SQLJobPruner) PruneJobs(filter *PruneFilter, batchSize int) (int, error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	var jobIds []string
+	err = tx.Wrap(func() error {
+		err := tx.From(jobTable).
+			Select(job_jobId).
+			Where(createPruneFilters(filter)...).
+			Limit(uint(batchSize)).
+			ForUpdate(goqu.SkipLocked).
+			Prepared(true).
+			ScanVals(&jobIds)
+		if err != nil || len(jobIds) == 0 {
+			return err
+		}
+
+		runIds := tx.From(jobRunTable).
+			Select(jobRun_runId).
+			Where(jobRun_jobId.In(jobIds))
+
+		if filter.Archive {
+			err = archive(tx, jobRunContainerArchiveTable, tx.From(jobRunContainerTable).Where(jobRunContainer_runId.In(runIds)))
+			if err != nil {
+				return err
+			}
+			err = archive(tx, jobRunArchiveTable, tx.From(jobRunTable).Where(jobRun_jobId.In(jobIds)))
+			if err != nil {
+				return err
+			}
+			err = archive(tx, jobArchiveTable, tx.From(jobTable).Where(job_jobId.In(jobIds)))
+			if err != nil {
+				return err
+			}
+		}
+
+		deletes := []*goqu.DeleteDataset{
+			tx.Delete(jobRunContainerTable).Where(jobRunContainer_runId.In(runIds)),
+			tx.Delete(jobRunTable).Where(jobRun_jobId.In(jobIds)),
+			tx.Delete(userAnnotationLookupTable).Where(annotation_jobId.In(jobIds)),
+			tx.Delete(jobTable).Where(job_jobId.In(jobIds)),
+		}
+		for _, ds := range deletes {
+			_, err := ds.Prepared(true).Executor().Exec()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(jobIds), nil
+}
+
+func createPruneFilters(filter *PruneFilter) []goqu.Expression {
+	// States are inlined so that the partial index can be used
+	states := make([]interface{}, len(terminalJobStates))
+	for i, state := range terminalJobStates {
+		states[i] = stateAsLiteral(state)
+	}
+
+	filters := []goqu.Expression{
+		job_state.In(states...),
+		job_terminalTime.Lt(ToUTC(filter.Before)),
+	}
+	if len(filter.Queues) > 0 {
+		filters = append(filters, job_queue.In(filter.Queues))
+	}
+	if len(filter.ExcludedQueues) > 0 {
+		filters = append(filters, job_queue.NotIn(filter.ExcludedQueues))
+	}
+	return filters
+}
+
+func archive(tx *goqu.TxDatabase, archiveTable interface{}, rows *goqu.SelectDataset) error {
+	_, err := tx.Insert(archiveTable).
+		FromQuery(rows).
+		Prepared(true).
+		Executor().
+		Exec()
+	return err
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestPruneJobs_RemovesTerminalJobsFinishedBeforeCutoff(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobPruner := NewSQLJobPruner(db)
+
+		oldSucceeded := NewJobSimulator(t, jobStore).
+			CreateJobWithAnnotations(queue, map[string]string{userAnnotationPrefix + "a": "a"}).
+			PendingAtTime(cluster, k8sId1, someTime).
+			RunningAtTime(cluster, k8sId1, node, someTime).
+			SucceededAtTime(cluster, k8sId1, node, someTime.Add(time.Minute))
+
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			PendingAtTime(cluster, k8sId2, someTime).
+			RunningAtTime(cluster, k8sId2, node, someTime).
+			SucceededAtTime(cluster, k8sId2, node, someTime.Add(2*time.Hour))
+
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			PendingAtTime(cluster, k8sId3, someTime).
+			RunningAtTime(cluster, k8sId3, node, someTime)
+
+		err := jobStore.RecordJobFailed(&api.JobFailedEvent{
+			JobId:        oldSucceeded.job.Id,
+			Queue:        queue,
+			Created:      someTime.Add(time.Minute),
+			KubernetesId: k8sId4,
+			ExitCodes:    map[string]int32{"container": 1},
+		})
+		assert.NoError(t, err)
+
+		pruned, err := jobPruner.PruneJobs(&PruneFilter{Before: someTime.Add(time.Hour)}, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, pruned)
+
+		assert.Equal(t, 2, selectInt(t, db, "SELECT COUNT(*) FROM job"))
+		assert.Equal(t, 0, selectInt(t, db, "SELECT COUNT(*) FROM job WHERE job_id = '"+oldSucceeded.job.Id+"'"))
+		assert.Equal(t, 2, selectInt(t, db, "SELECT COUNT(*) FROM job_run"))
+		assert.Equal(t, 0, selectInt(t, db, "SELECT COUNT(*) FROM job_run_container"))
+		assert.Equal(t, 0, selectInt(t, db, "SELECT COUNT(*) FROM user_annotation_lookup"))
+		assert.Equal(t, 0, selectInt(t, db, "SELECT COUNT(*) FROM job_archive"))
+	})
+}
+
+func TestPruneJobs_RemovesJobsInBatches(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobPruner := NewSQLJobPruner(db)
+
+		for i := 0; i < 5; i++ {
+			NewJobSimulator(t, jobStore).
+				CreateJobAtTime(queue, someTime).
+				CancelledAtTime(someTime)
+		}
+
+		filter := &PruneFilter{Before: someTime.Add(time.Hour)}
+		for _, expected := range []int{2, 2, 1, 0} {
+			pruned, err := jobPruner.PruneJobs(filter, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, pruned)
+		}
+		assert.Equal(t, 0, selectInt(t, db, "SELECT COUNT(*) FROM job"))
+	})
+}
+
+func TestPruneJobs_FiltersByQueue(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobPruner := NewSQLJobPruner(db)
+
+		NewJobSimulator(t, jobStore).CreateJobAtTime(queue, someTime).CancelledAtTime(someTime)
+		NewJobSimulator(t, jobStore).CreateJobAtTime(queue2, someTime).CancelledAtTime(someTime)
+
+		pruned, err := jobPruner.PruneJobs(&PruneFilter{
+			ExcludedQueues: []string{queue},
+			Before:         someTime.Add(time.Hour),
+		}, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, pruned)
+		assert.Equal(t, 1, selectInt(t, db, "SELECT COUNT(*) FROM job WHERE queue = '"+queue+"'"))
+
+		pruned, err = jobPruner.PruneJobs(&PruneFilter{
+			Queues: []string{queue},
+			Before: someTime.Add(time.Hour),
+		}, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, pruned)
+		assert.Equal(t, 0, selectInt(t, db, "SELECT COUNT(*) FROM job"))
+	})
+}
+
+func TestPruneJobs_ArchivesJobs(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobPruner := NewSQLJobPruner(db)
+
+		NewJobSimulator(t, jobStore).
+			CreateJobAtTime(queue, someTime).
+			PendingAtTime(cluster, k8sId1, someTime).
+			RunningAtTime(cluster, k8sId1, node, someTime).
+			FailedAtTime(cluster, k8sId1, node, "error", someTime)
+
+		pruned, err := jobPruner.PruneJobs(&PruneFilter{Before: someTime.Add(time.Hour), Archive: true}, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, pruned)
+
+		assert.Equal(t, 0, selectInt(t, db, "SELECT COUNT(*) FROM job"))
+		assert.Equal(t, 0, selectInt(t, db, "SELECT COUNT(*) FROM job_run"))
+		assert.Equal(t, 1, selectInt(t, db, "SELECT COUNT(*) FROM job_archive"))
+		assert.Equal(t, 1, selectInt(t, db, "SELECT COUNT(*) FROM job_run_archive"))
+	})
+}
@@ -46,6 +46,21 @@ func (r *SQLJobRepository) GetQueueInfos(ctx context.Context) ([]*lookout.QueueI
 	return result, nil
 }
 
+// GetQueueNames returns the names of all queues with jobs recorded in lookout
+func (r *SQLJobRepository) GetQueueNames(ctx context.Context) ([]string, error) {
+	queues := make([]string, 0)
+	err := r.goquDb.
+		From(jobTable).
+		Select(job_queue).
+		Distinct().
+		Order(job_queue.Asc()).
+		ScanValsContext(ctx, &queues)
+	if err != nil {
+		return nil, err
+	}
+	return queues, nil
+}
+
 func (r *SQLJobRepository) getQueuesSql() (string, error) {
 	countsDs := r.goquDb.
 		From(jobTable).
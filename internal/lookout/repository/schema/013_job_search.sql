@@ -0,0 +1,30 @@
+ALTER TABLE job ADD COLUMN started timestamp NULL;
+ALTER TABLE job ADD COLUMN finished timestamp NULL;
+
+UPDATE job
+SET started = run_times.started,
+    finished = run_times.finished
+FROM (
+    SELECT job_id, MIN(started) AS started, MAX(finished) AS finished
+    FROM job_run
+    GROUP BY job_id
+) AS run_times
+WHERE job.job_id = run_times.job_id;
+
+-- keyset pagination for each of the supported orderings
+CREATE INDEX idx_job_priority_job_id ON job (priority, job_id);
+
+CREATE INDEX idx_job_duration_job_id ON job ((finished - started), job_id);
+
+-- time range filters
+CREATE INDEX idx_job_started ON job (started);
+
+CREATE INDEX idx_job_finished ON job (finished);
+
+-- label filters
+CREATE INDEX idx_job_labels ON job USING gin ((job -> 'labels') jsonb_path_ops);
+
+-- run filters
+CREATE INDEX idx_job_run_cluster ON job_run (cluster);
+
+CREATE INDEX idx_job_run_node ON job_run (node);
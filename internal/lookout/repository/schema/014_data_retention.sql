@@ -0,0 +1,9 @@
+-- finding terminal jobs past their retention period
+CREATE INDEX idx_job_queue_terminal_time ON job (queue, (COALESCE(finished, cancelled, submitted)))
+    WHERE state IN (4, 5, 6, 7);
+
+CREATE TABLE job_archive (LIKE job INCLUDING DEFAULTS);
+
+CREATE TABLE job_run_archive (LIKE job_run INCLUDING DEFAULTS);
+
+CREATE TABLE job_run_container_archive (LIKE job_run_container INCLUDING DEFAULTS);
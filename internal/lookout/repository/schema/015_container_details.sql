@@ -0,0 +1,14 @@
+ALTER TABLE job_run ADD COLUMN cause smallint NULL;
+
+ALTER TABLE job_run_container ADD COLUMN message varchar(2048) NULL;
+ALTER TABLE job_run_container ADD COLUMN reason varchar(512) NULL;
+ALTER TABLE job_run_container ADD COLUMN cause smallint NULL;
+
+-- archived rows are copied column by column, keep the archive tables in line
+ALTER TABLE job_run_archive ADD COLUMN cause smallint NULL;
+
+ALTER TABLE job_run_container_archive ADD COLUMN message varchar(2048) NULL;
+ALTER TABLE job_run_container_archive ADD COLUMN reason varchar(512) NULL;
+ALTER TABLE job_run_container_archive ADD COLUMN cause smallint NULL;
+
+CREATE INDEX idx_job_run_cause ON job_run (cause) WHERE cause IS NOT NULL;
@@ -23,12 +23,16 @@ const (
 	JobFailed    JobState = "FAILED"
 	JobCancelled JobState = "CANCELLED"
 	JobDuplicate JobState = "DUPLICATE"
+	JobHeld      JobState = "HELD"
 )
 
 type JobRepository interface {
 	GetQueueInfos(ctx context.Context) ([]*lookout.QueueInfo, error)
 	GetJobSetInfos(ctx context.Context, opts *lookout.GetJobSetsRequest) ([]*lookout.JobSetInfo, error)
-	GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) ([]*lookout.JobInfo, error)
+	GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) (jobInfos []*lookout.JobInfo, nextCursor string, err error)
+	GetJobsInQueues(ctx context.Context, opts *lookout.GetJobsRequest, queues []string) (jobInfos []*lookout.JobInfo, nextCursor string, err error)
+	GetJobStats(ctx context.Context, opts *lookout.GetJobStatsRequest) ([]*lookout.JobStats, error)
+	GetQueueNames(ctx context.Context) ([]string, error)
 }
 
 type SQLJobRepository struct {
@@ -55,6 +59,8 @@ var (
 	job_state      = goqu.I("job.state")
 	job_duplicate  = goqu.I("job.duplicate")
 	job_jobUpdated = goqu.I("job.job_updated")
+	job_started    = goqu.I("job.started")
+	job_finished   = goqu.I("job.finished")
 
 	// Columns: job_run table
 	jobRun_runId     = goqu.I("job_run.run_id")
@@ -67,6 +73,15 @@ var (
 	jobRun_finished  = goqu.I("job_run.finished")
 	jobRun_succeeded = goqu.I("job_run.succeeded")
 	jobRun_error     = goqu.I("job_run.error")
+	jobRun_cause     = goqu.I("job_run.cause")
+
+	// Columns: job_run_container table
+	jobRunContainer_runId         = goqu.I("job_run_container.run_id")
+	jobRunContainer_containerName = goqu.I("job_run_container.container_name")
+	jobRunContainer_exitCode      = goqu.I("job_run_container.exit_code")
+	jobRunContainer_message       = goqu.I("job_run_container.message")
+	jobRunContainer_reason        = goqu.I("job_run_container.reason")
+	jobRunContainer_cause         = goqu.I("job_run_container.cause")
 
 	// Columns: annotation table
 	annotation_jobId = goqu.I("user_annotation_lookup.job_id")
@@ -93,6 +108,17 @@ type JobRow struct {
 	Finished  pq.NullTime     `db:"finished"`
 	Succeeded sql.NullBool    `db:"succeeded"`
 	Error     sql.NullString  `db:"error"`
+	Cause     sql.NullInt64   `db:"cause"`
+	Duration  sql.NullString  `db:"duration"`
+}
+
+type ContainerRow struct {
+	RunId         sql.NullString `db:"run_id"`
+	ContainerName sql.NullString `db:"container_name"`
+	ExitCode      sql.NullInt64  `db:"exit_code"`
+	Message       sql.NullString `db:"message"`
+	Reason        sql.NullString `db:"reason"`
+	Cause         sql.NullInt64  `db:"cause"`
 }
 
 var AllJobStates = []JobState{
@@ -103,6 +129,7 @@ var AllJobStates = []JobState{
 	JobFailed,
 	JobCancelled,
 	JobDuplicate,
+	JobHeld,
 }
 
 var JobStateToIntMap = map[JobState]int{
@@ -113,6 +140,7 @@ var JobStateToIntMap = map[JobState]int{
 	JobFailed:    5,
 	JobCancelled: 6,
 	JobDuplicate: 7,
+	JobHeld:      8,
 }
 
 var IntToJobStateMap = map[int]JobState{
@@ -123,6 +151,7 @@ var IntToJobStateMap = map[int]JobState{
 	5: JobFailed,
 	6: JobCancelled,
 	7: JobDuplicate,
+	8: JobHeld,
 }
 
 var defaultQueryStates = []JobState{
@@ -132,6 +161,7 @@ var defaultQueryStates = []JobState{
 	JobSucceeded,
 	JobFailed,
 	JobCancelled,
+	JobHeld,
 }
 
 func NewSQLJobRepository(db *goqu.Database, clock Clock) *SQLJobRepository {
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -10,8 +12,8 @@ import (
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
 	_ "github.com/lib/pq"
+	"github.com/oklog/ulid"
 
-	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -27,6 +29,10 @@ type JobRecorder interface {
 	RecordJobDuplicate(event *api.JobDuplicateFoundEvent) error
 	RecordJobTerminated(event *api.JobTerminatedEvent) error
 	RecordJobReprioritized(event *api.JobReprioritizedEvent) error
+	RecordJobHeld(event *api.JobHeldEvent) error
+	RecordJobReleased(event *api.JobReleasedEvent) error
+
+	RecordEvents(events []api.Event) error
 }
 
 type SQLJobStore struct {
@@ -112,6 +118,35 @@ func (r *SQLJobStore) MarkCancelled(event *api.JobCancelledEvent) error {
 	return err
 }
 
+func (r *SQLJobStore) RecordJobHeld(event *api.JobHeldEvent) error {
+	return r.recordHoldState(event.JobId, event.Queue, event.JobSetId, JobHeld)
+}
+
+func (r *SQLJobStore) RecordJobReleased(event *api.JobReleasedEvent) error {
+	return r.recordHoldState(event.JobId, event.Queue, event.JobSetId, JobQueued)
+}
+
+// Only queued and held jobs can be held or released, so any other state recorded meanwhile is kept
+func (r *SQLJobStore) recordHoldState(jobId string, queue string, jobSet string, state JobState) error {
+	ds := r.db.Insert(jobTable).
+		Rows(goqu.Record{
+			"job_id": jobId,
+			"queue":  queue,
+			"jobset": jobSet,
+			"state":  JobStateToIntMap[state],
+		}).
+		OnConflict(goqu.DoUpdate("job_id", goqu.Record{
+			"state": JobStateToIntMap[state],
+		}).Where(holdableState()))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
+}
+
+func holdableState() goqu.Expression {
+	return job_state.In(JobStateToIntMap[JobQueued], JobStateToIntMap[JobHeld])
+}
+
 type jobJsonRow struct {
 	JobJson sql.NullString `db:"job"`
 }
@@ -175,13 +210,7 @@ func (r *SQLJobStore) RecordJobPending(event *api.JobPendingEvent) error {
 	}
 
 	return tx.Wrap(func() error {
-		if err := upsertJobRun(tx, goqu.Record{
-			"run_id":     event.GetKubernetesId(),
-			"job_id":     event.GetJobId(),
-			"cluster":    event.GetClusterId(),
-			"pod_number": event.GetPodNumber(),
-			"created":    ToUTC(event.GetCreated()),
-		}); err != nil {
+		if err := upsertJobRun(tx, pendingJobRunRecord(event)); err != nil {
 			return err
 		}
 
@@ -205,16 +234,7 @@ func (r *SQLJobStore) RecordJobPending(event *api.JobPendingEvent) error {
 }
 
 func (r *SQLJobStore) RecordJobRunning(event *api.JobRunningEvent) error {
-	jobRunRecord := goqu.Record{
-		"run_id":     event.GetKubernetesId(),
-		"job_id":     event.GetJobId(),
-		"cluster":    event.GetClusterId(),
-		"pod_number": event.GetPodNumber(),
-		"started":    ToUTC(event.GetCreated()),
-	}
-	if event.GetNodeName() != "" {
-		jobRunRecord["node"] = event.GetNodeName()
-	}
+	jobRunRecord := runningJobRunRecord(event)
 
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -229,13 +249,15 @@ func (r *SQLJobStore) RecordJobRunning(event *api.JobRunningEvent) error {
 		jobDs := tx.Insert(jobTable).
 			With("run_states", getRunStateCounts(tx, event.GetJobId())).
 			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobRunning],
+				"job_id":  event.JobId,
+				"queue":   event.Queue,
+				"jobset":  event.JobSetId,
+				"state":   JobStateToIntMap[JobRunning],
+				"started": ToUTC(event.GetCreated()),
 			}).
 			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state": determineJobState(tx),
+				"state":   determineJobState(tx),
+				"started": earliestStarted(),
 			}))
 
 		_, err := jobDs.Prepared(true).Executor().Exec()
@@ -244,17 +266,7 @@ func (r *SQLJobStore) RecordJobRunning(event *api.JobRunningEvent) error {
 }
 
 func (r *SQLJobStore) RecordJobSucceeded(event *api.JobSucceededEvent) error {
-	jobRunRecord := goqu.Record{
-		"run_id":     event.GetKubernetesId(),
-		"job_id":     event.GetJobId(),
-		"cluster":    event.GetClusterId(),
-		"pod_number": event.GetPodNumber(),
-		"finished":   ToUTC(event.GetCreated()),
-		"succeeded":  true,
-	}
-	if event.GetNodeName() != "" {
-		jobRunRecord["node"] = event.GetNodeName()
-	}
+	jobRunRecord := succeededJobRunRecord(event)
 
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -270,13 +282,15 @@ func (r *SQLJobStore) RecordJobSucceeded(event *api.JobSucceededEvent) error {
 		ds := tx.Insert(jobTable).
 			With("run_states", getRunStateCounts(tx, event.GetJobId())).
 			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobSucceeded],
+				"job_id":   event.JobId,
+				"queue":    event.Queue,
+				"jobset":   event.JobSetId,
+				"state":    JobStateToIntMap[JobSucceeded],
+				"finished": ToUTC(event.GetCreated()),
 			}).
 			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state": determineJobState(tx),
+				"state":    determineJobState(tx),
+				"finished": latestFinished(),
 			}))
 
 		_, err := ds.Prepared(true).Executor().Exec()
@@ -285,24 +299,7 @@ func (r *SQLJobStore) RecordJobSucceeded(event *api.JobSucceededEvent) error {
 }
 
 func (r *SQLJobStore) RecordJobFailed(event *api.JobFailedEvent) error {
-	// If job fails before a pod is created, we generate a new ULID
-	k8sId := event.KubernetesId
-	if k8sId == "" {
-		k8sId = util.NewULID() + "-nopod"
-	}
-
-	jobRunRecord := goqu.Record{
-		"run_id":     k8sId,
-		"job_id":     event.GetJobId(),
-		"cluster":    event.GetClusterId(),
-		"pod_number": event.GetPodNumber(),
-		"finished":   ToUTC(event.GetCreated()),
-		"succeeded":  false,
-		"error":      truncateError(event.GetReason()),
-	}
-	if event.GetNodeName() != "" {
-		jobRunRecord["node"] = event.GetNodeName()
-	}
+	jobRunRecord := failedJobRunRecord(event)
 
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -317,37 +314,27 @@ func (r *SQLJobStore) RecordJobFailed(event *api.JobFailedEvent) error {
 		jobDs := tx.Insert(jobTable).
 			With("run_states", getRunStateCounts(tx, event.GetJobId())).
 			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobFailed],
+				"job_id":   event.JobId,
+				"queue":    event.Queue,
+				"jobset":   event.JobSetId,
+				"state":    JobStateToIntMap[JobFailed],
+				"finished": ToUTC(event.GetCreated()),
 			}).
 			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state": determineJobState(tx),
+				"state":    determineJobState(tx),
+				"finished": latestFinished(),
 			}))
 
 		if _, err := jobDs.Prepared(true).Executor().Exec(); err != nil {
 			return err
 		}
 
-		return upsertContainers(tx, k8sId, event.ExitCodes)
+		return upsertContainers(tx, containerRecords(event))
 	})
 }
 
 func (r *SQLJobStore) RecordJobUnableToSchedule(event *api.JobUnableToScheduleEvent) error {
-
-	jobRunRecord := goqu.Record{
-		"run_id":             event.GetKubernetesId(),
-		"job_id":             event.GetJobId(),
-		"cluster":            event.GetClusterId(),
-		"pod_number":         event.GetPodNumber(),
-		"finished":           ToUTC(event.GetCreated()),
-		"unable_to_schedule": true,
-		"error":              truncateError(event.GetReason()),
-	}
-	if event.GetNodeName() != "" {
-		jobRunRecord["node"] = event.GetNodeName()
-	}
+	jobRunRecord := unableToScheduleJobRunRecord(event)
 
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -360,15 +347,7 @@ func (r *SQLJobStore) RecordJobUnableToSchedule(event *api.JobUnableToScheduleEv
 }
 
 func (r *SQLJobStore) RecordJobTerminated(event *api.JobTerminatedEvent) error {
-	jobRunRecord := goqu.Record{
-		"run_id":     event.GetKubernetesId(),
-		"job_id":     event.GetJobId(),
-		"cluster":    event.GetClusterId(),
-		"pod_number": event.GetPodNumber(),
-		"finished":   ToUTC(event.GetCreated()),
-		"succeeded":  false,
-		"error":      truncateError(event.GetReason()),
-	}
+	jobRunRecord := terminatedJobRunRecord(event)
 
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -384,13 +363,15 @@ func (r *SQLJobStore) RecordJobTerminated(event *api.JobTerminatedEvent) error {
 		jobDs := tx.Insert(jobTable).
 			With("run_states", getRunStateCounts(tx, event.GetJobId())).
 			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobFailed],
+				"job_id":   event.JobId,
+				"queue":    event.Queue,
+				"jobset":   event.JobSetId,
+				"state":    JobStateToIntMap[JobFailed],
+				"finished": ToUTC(event.GetCreated()),
 			}).
 			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state": determineJobState(tx),
+				"state":    determineJobState(tx),
+				"finished": latestFinished(),
 			}))
 
 		_, err := jobDs.Prepared(true).Executor().Exec()
@@ -436,38 +417,151 @@ func (r *SQLJobStore) getUpdatedJobJson(event *api.JobUpdatedEvent) (sql.NullStr
 	return NewNullString(string(updatedJobJson)), nil
 }
 
+func pendingJobRunRecord(event *api.JobPendingEvent) goqu.Record {
+	return goqu.Record{
+		"run_id":     event.GetKubernetesId(),
+		"job_id":     event.GetJobId(),
+		"cluster":    event.GetClusterId(),
+		"pod_number": event.GetPodNumber(),
+		"created":    ToUTC(event.GetCreated()),
+	}
+}
+
+func runningJobRunRecord(event *api.JobRunningEvent) goqu.Record {
+	jobRunRecord := goqu.Record{
+		"run_id":     event.GetKubernetesId(),
+		"job_id":     event.GetJobId(),
+		"cluster":    event.GetClusterId(),
+		"pod_number": event.GetPodNumber(),
+		"started":    ToUTC(event.GetCreated()),
+	}
+	if event.GetNodeName() != "" {
+		jobRunRecord["node"] = event.GetNodeName()
+	}
+	return jobRunRecord
+}
+
+func succeededJobRunRecord(event *api.JobSucceededEvent) goqu.Record {
+	jobRunRecord := goqu.Record{
+		"run_id":     event.GetKubernetesId(),
+		"job_id":     event.GetJobId(),
+		"cluster":    event.GetClusterId(),
+		"pod_number": event.GetPodNumber(),
+		"finished":   ToUTC(event.GetCreated()),
+		"succeeded":  true,
+	}
+	if event.GetNodeName() != "" {
+		jobRunRecord["node"] = event.GetNodeName()
+	}
+	return jobRunRecord
+}
+
+func failedJobRunRecord(event *api.JobFailedEvent) goqu.Record {
+	jobRunRecord := goqu.Record{
+		"run_id":     failedRunId(event),
+		"job_id":     event.GetJobId(),
+		"cluster":    event.GetClusterId(),
+		"pod_number": event.GetPodNumber(),
+		"finished":   ToUTC(event.GetCreated()),
+		"succeeded":  false,
+		"error":      truncateError(event.GetReason()),
+		"cause":      int32(event.GetCause()),
+	}
+	if event.GetNodeName() != "" {
+		jobRunRecord["node"] = event.GetNodeName()
+	}
+	return jobRunRecord
+}
+
+func unableToScheduleJobRunRecord(event *api.JobUnableToScheduleEvent) goqu.Record {
+	jobRunRecord := goqu.Record{
+		"run_id":             event.GetKubernetesId(),
+		"job_id":             event.GetJobId(),
+		"cluster":            event.GetClusterId(),
+		"pod_number":         event.GetPodNumber(),
+		"finished":           ToUTC(event.GetCreated()),
+		"unable_to_schedule": true,
+		"error":              truncateError(event.GetReason()),
+	}
+	if event.GetNodeName() != "" {
+		jobRunRecord["node"] = event.GetNodeName()
+	}
+	return jobRunRecord
+}
+
+func terminatedJobRunRecord(event *api.JobTerminatedEvent) goqu.Record {
+	return goqu.Record{
+		"run_id":     event.GetKubernetesId(),
+		"job_id":     event.GetJobId(),
+		"cluster":    event.GetClusterId(),
+		"pod_number": event.GetPodNumber(),
+		"finished":   ToUTC(event.GetCreated()),
+		"succeeded":  false,
+		"error":      truncateError(event.GetReason()),
+	}
+}
+
+// If job fails before a pod is created, we derive a ULID from the event,
+// so recording the same event again does not add another run
+func failedRunId(event *api.JobFailedEvent) string {
+	if event.KubernetesId != "" {
+		return event.KubernetesId
+	}
+
+	var ms uint64
+	if event.Created.After(time.Unix(0, 0)) {
+		ms = ulid.Timestamp(event.Created)
+	}
+	entropy := sha256.Sum256([]byte(fmt.Sprintf("%s-%d", event.JobId, event.PodNumber)))
+	id := ulid.MustNew(ms, bytes.NewReader(entropy[:]))
+	return strings.ToLower(id.String()) + "-nopod"
+}
+
 func upsertJobRun(tx *goqu.TxDatabase, record goqu.Record) error {
 	return upsert(tx, jobRunTable, []string{"run_id"}, []goqu.Record{record})
 }
 
-func upsertContainers(tx *goqu.TxDatabase, k8sId string, exitCodes map[string]int32) error {
-	containerRecords := make([]goqu.Record, len(exitCodes))
-	i := 0
-	for name, code := range exitCodes {
-		containerRecords[i] = goqu.Record{
-			"run_id":         k8sId,
-			"container_name": name,
-			"exit_code":      code,
+func upsertContainers(tx *goqu.TxDatabase, containerRecords []goqu.Record) error {
+	return upsert(tx, jobRunContainerTable, []string{"run_id", "container_name"}, containerRecords)
+}
+
+// Executors which don't report container statuses only report exit codes
+func containerRecords(event *api.JobFailedEvent) []goqu.Record {
+	runId := failedRunId(event)
+	var containerRecords []goqu.Record
+
+	if len(event.ContainerStatuses) == 0 {
+		for name, code := range event.ExitCodes {
+			containerRecords = append(containerRecords, goqu.Record{
+				"run_id":         runId,
+				"container_name": name,
+				"exit_code":      code,
+				"message":        nil,
+				"reason":         nil,
+				"cause":          nil,
+			})
 		}
-		i++
+		return containerRecords
 	}
 
-	return upsert(tx, jobRunContainerTable, []string{"run_id", "container_name"}, containerRecords)
+	for _, status := range event.ContainerStatuses {
+		containerRecords = append(containerRecords, goqu.Record{
+			"run_id":         runId,
+			"container_name": status.Name,
+			"exit_code":      status.ExitCode,
+			"message":        NewNullString(truncateError(status.Message)),
+			"reason":         NewNullString(truncate(status.Reason, 512)),
+			"cause":          int32(status.Cause),
+		})
+	}
+	return containerRecords
 }
 
 func upsertUserAnnotations(tx *goqu.TxDatabase, userAnnotationPrefix string, jobId string, annotations map[string]string) error {
+	annotationRecords := userAnnotationRecords(userAnnotationPrefix, jobId, annotations)
 	trimmedKeys := []string{}
-	var annotationRecords []goqu.Record
-	for key, value := range annotations {
-		if strings.HasPrefix(key, userAnnotationPrefix) && len(key) > len(userAnnotationPrefix) {
-			trimmedKey := key[len(userAnnotationPrefix):]
-			trimmedKeys = append(trimmedKeys, trimmedKey)
-			annotationRecords = append(annotationRecords, goqu.Record{
-				"job_id": jobId,
-				"key":    trimmedKey,
-				"value":  value,
-			})
-		}
+	for _, record := range annotationRecords {
+		trimmedKeys = append(trimmedKeys, record["key"].(string))
 	}
 
 	deleteWhereClause := []exp.Expression{annotation_jobId.Eq(jobId)}
@@ -482,10 +576,26 @@ func upsertUserAnnotations(tx *goqu.TxDatabase, userAnnotationPrefix string, job
 	return upsert(tx, userAnnotationLookupTable, []string{"job_id", "key"}, annotationRecords)
 }
 
+func userAnnotationRecords(userAnnotationPrefix string, jobId string, annotations map[string]string) []goqu.Record {
+	var annotationRecords []goqu.Record
+	for key, value := range annotations {
+		if strings.HasPrefix(key, userAnnotationPrefix) && len(key) > len(userAnnotationPrefix) {
+			trimmedKey := key[len(userAnnotationPrefix):]
+			annotationRecords = append(annotationRecords, goqu.Record{
+				"job_id": jobId,
+				"key":    trimmedKey,
+				"value":  value,
+			})
+		}
+	}
+	return annotationRecords
+}
+
 func determineJobState(tx *goqu.TxDatabase) exp.CaseExpression {
 	return goqu.Case().
 		When(job_duplicate.Eq(true), stateAsLiteral(JobDuplicate)).
 		When(job_state.Eq(stateAsLiteral(JobCancelled)), stateAsLiteral(JobCancelled)).
+		When(job_state.Eq(stateAsLiteral(JobHeld)), stateAsLiteral(JobHeld)).
 		When(tx.Select(goqu.I("run_states.failed").Gt(0)).
 			From("run_states"), stateAsLiteral(JobFailed)).
 		When(tx.Select(goqu.I("run_states.pending").Gt(0)).
@@ -498,41 +608,84 @@ func determineJobState(tx *goqu.TxDatabase) exp.CaseExpression {
 }
 
 func getRunStateCounts(tx *goqu.TxDatabase, jobId string) *goqu.SelectDataset {
-	ds := tx.Select(
-		goqu.COUNT("*").As("total"),
-		goqu.L("COUNT(*) FILTER (WHERE run_state = 1)").As("queued"),
-		goqu.L("COUNT(*) FILTER (WHERE run_state = 2)").As("pending"),
-		goqu.L("COUNT(*) FILTER (WHERE run_state = 3)").As("running"),
-		goqu.L("COUNT(*) FILTER (WHERE run_state = 4)").As("succeeded"),
-		goqu.L("COUNT(*) FILTER (WHERE run_state = 5)").As("failed")).
+	ds := tx.Select(runStateCounts()...).
 		From(
 			// State based on latest run for each pod
-			tx.Select(
-				goqu.Case().
-					When(goqu.And(
-						jobRun_finished.IsNotNull(),
-						jobRun_succeeded.IsTrue()), stateAsLiteral(JobSucceeded)).
-					When(goqu.And(
-						jobRun_finished.IsNotNull(),
-						jobRun_succeeded.IsFalse()), stateAsLiteral(JobFailed)).
-					When(jobRun_started.IsNotNull(), stateAsLiteral(JobRunning)).
-					When(jobRun_created.IsNotNull(), stateAsLiteral(JobPending)).
-					Else(stateAsLiteral(JobQueued)).As("run_state")).
+			tx.Select(runState()).
 				From(jobRunTable).
 				Distinct(jobRun_podNumber).
 				Where(jobRun_jobId.Eq(jobId)).
 				Order(
 					jobRun_podNumber.Asc(),
-					goqu.L("GREATEST(job_run.created, job_run.started, job_run.finished)").Desc()))
+					latestRunUpdate().Desc()))
 
 	return ds
 }
 
+// Same as getRunStateCounts, with one row for each of the jobs
+func getRunStateCountsByJob(tx *goqu.TxDatabase, jobIds []string) *goqu.SelectDataset {
+	ds := tx.Select(append([]interface{}{goqu.C("job_id")}, runStateCounts()...)...).
+		From(
+			tx.Select(jobRun_jobId, runState()).
+				From(jobRunTable).
+				Distinct(jobRun_jobId, jobRun_podNumber).
+				Where(jobRun_jobId.In(jobIds)).
+				Order(
+					jobRun_jobId.Asc(),
+					jobRun_podNumber.Asc(),
+					latestRunUpdate().Desc())).
+		GroupBy(goqu.C("job_id"))
+
+	return ds
+}
+
+func runStateCounts() []interface{} {
+	return []interface{}{
+		goqu.COUNT("*").As("total"),
+		goqu.L("COUNT(*) FILTER (WHERE run_state = 1)").As("queued"),
+		goqu.L("COUNT(*) FILTER (WHERE run_state = 2)").As("pending"),
+		goqu.L("COUNT(*) FILTER (WHERE run_state = 3)").As("running"),
+		goqu.L("COUNT(*) FILTER (WHERE run_state = 4)").As("succeeded"),
+		goqu.L("COUNT(*) FILTER (WHERE run_state = 5)").As("failed"),
+	}
+}
+
+func runState() exp.AliasedExpression {
+	return goqu.Case().
+		When(goqu.And(
+			jobRun_finished.IsNotNull(),
+			jobRun_succeeded.IsTrue()), stateAsLiteral(JobSucceeded)).
+		When(goqu.And(
+			jobRun_finished.IsNotNull(),
+			jobRun_succeeded.IsFalse()), stateAsLiteral(JobFailed)).
+		When(jobRun_started.IsNotNull(), stateAsLiteral(JobRunning)).
+		When(jobRun_created.IsNotNull(), stateAsLiteral(JobPending)).
+		Else(stateAsLiteral(JobQueued)).As("run_state")
+}
+
+func latestRunUpdate() exp.LiteralExpression {
+	return goqu.L("GREATEST(job_run.created, job_run.started, job_run.finished)")
+}
+
+// Jobs start when the first of their pods starts running
+func earliestStarted() exp.LiteralExpression {
+	return goqu.L("LEAST(job.started, EXCLUDED.started)")
+}
+
+// Jobs finish when the last of their pods finishes
+func latestFinished() exp.LiteralExpression {
+	return goqu.L("GREATEST(job.finished, EXCLUDED.finished)")
+}
+
 // Avoid interpolating states
 func stateAsLiteral(state JobState) exp.LiteralExpression {
 	return goqu.L(fmt.Sprintf("%d", JobStateToIntMap[state]))
 }
 
 func truncateError(err string) string {
-	return fmt.Sprintf("%.2048s", err)
+	return truncate(err, 2048)
+}
+
+func truncate(value string, length int) string {
+	return fmt.Sprintf("%.*s", length, value)
 }
@@ -0,0 +1,563 @@
+package repository
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var jobRunColumns = []string{
+	"run_id",
+	"job_id",
+	"cluster",
+	"node",
+	"pod_number",
+	"created",
+	"started",
+	"finished",
+	"succeeded",
+	"unable_to_schedule",
+	"error",
+	"cause",
+}
+
+// Events recorded together are coalesced, so that every job, run and container is written by a single row
+// of a multi-row upsert, and the whole batch is written in one transaction.
+// Recording the same events again, e.g. when they are redelivered because acknowledging them failed,
+// leaves the database as it is.
+func (r *SQLJobStore) RecordEvents(events []api.Event) error {
+	batch := newEventBatch(events)
+	if batch.empty() {
+		return nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		return batch.write(tx, r.userAnnotationPrefix)
+	})
+}
+
+type jobRecord struct {
+	job      *api.Job
+	updated  time.Time
+	position int
+}
+
+// Latest hold or release of a job in the batch
+type holdState struct {
+	queue  string
+	jobSet string
+	state  JobState
+}
+
+type reprioritization struct {
+	event    *api.JobReprioritizedEvent
+	position int
+}
+
+// Job rows updated by run events, for jobs which might not have been recorded yet
+type runJobRecord struct {
+	queue    string
+	jobSet   string
+	started  *time.Time
+	finished *time.Time
+}
+
+type containerKey struct {
+	runId string
+	name  string
+}
+
+type eventBatch struct {
+	jobs              map[string]*jobRecord
+	runJobs           map[string]*runJobRecord
+	runs              map[string]goqu.Record
+	containers        map[containerKey]goqu.Record
+	duplicates        map[string]*api.JobDuplicateFoundEvent
+	cancellations     map[string]*api.JobCancelledEvent
+	holds             map[string]*holdState
+	reprioritizations map[string]*reprioritization
+
+	// Jobs for which the state is determined again from their runs once the batch is written
+	jobsToUpdateState map[string]bool
+}
+
+func newEventBatch(events []api.Event) *eventBatch {
+	batch := &eventBatch{
+		jobs:              map[string]*jobRecord{},
+		runJobs:           map[string]*runJobRecord{},
+		runs:              map[string]goqu.Record{},
+		containers:        map[containerKey]goqu.Record{},
+		duplicates:        map[string]*api.JobDuplicateFoundEvent{},
+		cancellations:     map[string]*api.JobCancelledEvent{},
+		holds:             map[string]*holdState{},
+		reprioritizations: map[string]*reprioritization{},
+		jobsToUpdateState: map[string]bool{},
+	}
+
+	for i, event := range events {
+		switch typed := event.(type) {
+		case *api.JobSubmittedEvent:
+			batch.addJob(&typed.Job, typed.Created, i)
+
+		case *api.JobUpdatedEvent:
+			batch.addJob(&typed.Job, typed.Created, i)
+
+		case *api.JobDuplicateFoundEvent:
+			batch.duplicates[typed.JobId] = typed
+			batch.jobsToUpdateState[typed.JobId] = true
+
+		case *api.JobPendingEvent:
+			batch.addRun(pendingJobRunRecord(typed))
+			batch.addRunJob(typed.JobId, typed.Queue, typed.JobSetId, nil, nil)
+
+		case *api.JobRunningEvent:
+			started := ToUTC(typed.Created)
+			batch.addRun(runningJobRunRecord(typed))
+			batch.addRunJob(typed.JobId, typed.Queue, typed.JobSetId, &started, nil)
+
+		case *api.JobSucceededEvent:
+			finished := ToUTC(typed.Created)
+			batch.addRun(succeededJobRunRecord(typed))
+			batch.addRunJob(typed.JobId, typed.Queue, typed.JobSetId, nil, &finished)
+
+		case *api.JobFailedEvent:
+			finished := ToUTC(typed.Created)
+			batch.addRun(failedJobRunRecord(typed))
+			batch.addRunJob(typed.JobId, typed.Queue, typed.JobSetId, nil, &finished)
+			for _, record := range containerRecords(typed) {
+				batch.containers[containerKey{runId: record["run_id"].(string), name: record["container_name"].(string)}] = record
+			}
+
+		case *api.JobUnableToScheduleEvent:
+			batch.addRun(unableToScheduleJobRunRecord(typed))
+
+		case *api.JobTerminatedEvent:
+			finished := ToUTC(typed.Created)
+			batch.addRun(terminatedJobRunRecord(typed))
+			batch.addRunJob(typed.JobId, typed.Queue, typed.JobSetId, nil, &finished)
+
+		case *api.JobReprioritizedEvent:
+			batch.reprioritizations[typed.JobId] = &reprioritization{event: typed, position: i}
+
+		case *api.JobCancelledEvent:
+			batch.cancellations[typed.JobId] = typed
+
+		case *api.JobHeldEvent:
+			batch.holds[typed.JobId] = &holdState{queue: typed.Queue, jobSet: typed.JobSetId, state: JobHeld}
+
+		case *api.JobReleasedEvent:
+			batch.holds[typed.JobId] = &holdState{queue: typed.Queue, jobSet: typed.JobSetId, state: JobQueued}
+		}
+	}
+
+	return batch
+}
+
+// Keeps the job with the latest timestamp, the same way job_updated does for jobs already recorded
+func (b *eventBatch) addJob(job *api.Job, updated time.Time, position int) {
+	b.jobsToUpdateState[job.Id] = true
+	existing, ok := b.jobs[job.Id]
+	if ok && !existing.updated.Before(updated) {
+		return
+	}
+	b.jobs[job.Id] = &jobRecord{job: job, updated: updated, position: position}
+}
+
+// Later events overwrite the columns set by earlier events for the same run
+func (b *eventBatch) addRun(record goqu.Record) {
+	runId := record["run_id"].(string)
+	existing, ok := b.runs[runId]
+	if !ok {
+		existing = goqu.Record{}
+		b.runs[runId] = existing
+	}
+	for column, value := range record {
+		existing[column] = value
+	}
+}
+
+func (b *eventBatch) addRunJob(jobId, queue, jobSet string, started *time.Time, finished *time.Time) {
+	b.jobsToUpdateState[jobId] = true
+	existing, ok := b.runJobs[jobId]
+	if !ok {
+		b.runJobs[jobId] = &runJobRecord{queue: queue, jobSet: jobSet, started: started, finished: finished}
+		return
+	}
+	if started != nil && (existing.started == nil || started.Before(*existing.started)) {
+		existing.started = started
+	}
+	if finished != nil && (existing.finished == nil || finished.After(*existing.finished)) {
+		existing.finished = finished
+	}
+}
+
+func (b *eventBatch) empty() bool {
+	return len(b.jobs) == 0 &&
+		len(b.runs) == 0 &&
+		len(b.duplicates) == 0 &&
+		len(b.cancellations) == 0 &&
+		len(b.holds) == 0 &&
+		len(b.reprioritizations) == 0
+}
+
+// Reprioritizations preceding the latest job record in the batch are written before it, so that they are
+// overwritten by the job record if it is newer than the job in the database, as if recorded one at a time
+func (b *eventBatch) splitReprioritizations() (beforeJobs []*api.JobReprioritizedEvent, afterJobs []*api.JobReprioritizedEvent) {
+	for _, jobId := range sortedKeys(b.reprioritizations) {
+		reprioritization := b.reprioritizations[jobId]
+		job, ok := b.jobs[jobId]
+		if ok && reprioritization.position < job.position {
+			beforeJobs = append(beforeJobs, reprioritization.event)
+		} else {
+			afterJobs = append(afterJobs, reprioritization.event)
+		}
+	}
+	return beforeJobs, afterJobs
+}
+
+// Rows are written in order of their keys, so that concurrent batches lock rows in the same order
+func (b *eventBatch) write(tx *goqu.TxDatabase, userAnnotationPrefix string) error {
+	reprioritizedBeforeJobs, reprioritizedAfterJobs := b.splitReprioritizations()
+
+	if err := upsertReprioritizations(tx, reprioritizedBeforeJobs); err != nil {
+		return err
+	}
+	if err := b.upsertJobs(tx, userAnnotationPrefix); err != nil {
+		return err
+	}
+	if err := upsertReprioritizations(tx, reprioritizedAfterJobs); err != nil {
+		return err
+	}
+	if err := b.upsertRuns(tx); err != nil {
+		return err
+	}
+	if err := b.upsertRunJobs(tx); err != nil {
+		return err
+	}
+	if err := b.upsertContainers(tx); err != nil {
+		return err
+	}
+	if err := b.upsertDuplicates(tx); err != nil {
+		return err
+	}
+	if err := b.upsertHolds(tx); err != nil {
+		return err
+	}
+	if err := b.upsertCancellations(tx); err != nil {
+		return err
+	}
+	return updateJobStates(tx, sortedKeys(b.jobsToUpdateState))
+}
+
+func (b *eventBatch) upsertJobs(tx *goqu.TxDatabase, userAnnotationPrefix string) error {
+	if len(b.jobs) == 0 {
+		return nil
+	}
+
+	jobIds := sortedKeys(b.jobs)
+	rows := make([]interface{}, 0, len(jobIds))
+	for _, jobId := range jobIds {
+		record := b.jobs[jobId]
+		jobJson, err := json.Marshal(record.job)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, goqu.Record{
+			"job_id":      record.job.Id,
+			"queue":       record.job.Queue,
+			"owner":       record.job.Owner,
+			"jobset":      record.job.JobSetId,
+			"priority":    record.job.Priority,
+			"submitted":   ToUTC(record.job.Created),
+			"job":         jobJson,
+			"state":       JobStateToIntMap[JobQueued],
+			"job_updated": record.updated,
+		})
+	}
+
+	ds := tx.Insert(jobTable).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("job_id", excluded(
+			"queue",
+			"owner",
+			"jobset",
+			"priority",
+			"submitted",
+			"job",
+			"job_updated")).Where(job_jobUpdated.Lt(goqu.I("excluded.job_updated")))).
+		Returning(job_jobId)
+
+	// Only jobs which were newer than the ones already recorded
+	var updatedJobIds []string
+	err := ds.Prepared(true).Executor().ScanVals(&updatedJobIds)
+	if err != nil {
+		return err
+	}
+	if len(updatedJobIds) == 0 {
+		return nil
+	}
+	sort.Strings(updatedJobIds)
+
+	_, err = tx.Delete(userAnnotationLookupTable).
+		Where(annotation_jobId.In(updatedJobIds)).
+		Prepared(true).Executor().Exec()
+	if err != nil {
+		return err
+	}
+
+	var annotationRecords []goqu.Record
+	for _, jobId := range updatedJobIds {
+		annotationRecords = append(annotationRecords, userAnnotationRecords(userAnnotationPrefix, jobId, b.jobs[jobId].job.Annotations)...)
+	}
+	return upsert(tx, userAnnotationLookupTable, []string{"job_id", "key"}, annotationRecords)
+}
+
+// Columns missing from the events of a run keep their current value
+func (b *eventBatch) upsertRuns(tx *goqu.TxDatabase) error {
+	if len(b.runs) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(b.runs))
+	for _, runId := range sortedKeys(b.runs) {
+		record := goqu.Record{}
+		for _, column := range jobRunColumns {
+			record[column] = b.runs[runId][column]
+		}
+		rows = append(rows, record)
+	}
+
+	onConflict := goqu.Record{}
+	for _, column := range jobRunColumns[1:] {
+		onConflict[column] = goqu.COALESCE(goqu.I("excluded."+column), goqu.I("job_run."+column))
+	}
+
+	ds := tx.Insert(jobRunTable).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("run_id", onConflict))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
+}
+
+func (b *eventBatch) upsertRunJobs(tx *goqu.TxDatabase) error {
+	if len(b.runJobs) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(b.runJobs))
+	for _, jobId := range sortedKeys(b.runJobs) {
+		record := b.runJobs[jobId]
+		rows = append(rows, goqu.Record{
+			"job_id":   jobId,
+			"queue":    record.queue,
+			"jobset":   record.jobSet,
+			"state":    JobStateToIntMap[JobQueued],
+			"started":  nullableTime(record.started),
+			"finished": nullableTime(record.finished),
+		})
+	}
+
+	ds := tx.Insert(jobTable).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("job_id", goqu.Record{
+			"started":  earliestStarted(),
+			"finished": latestFinished(),
+		}))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
+}
+
+func (b *eventBatch) upsertContainers(tx *goqu.TxDatabase) error {
+	keys := make([]containerKey, 0, len(b.containers))
+	for key := range b.containers {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].runId != keys[j].runId {
+			return keys[i].runId < keys[j].runId
+		}
+		return keys[i].name < keys[j].name
+	})
+
+	records := make([]goqu.Record, 0, len(keys))
+	for _, key := range keys {
+		records = append(records, b.containers[key])
+	}
+	return upsert(tx, jobRunContainerTable, []string{"run_id", "container_name"}, records)
+}
+
+func (b *eventBatch) upsertDuplicates(tx *goqu.TxDatabase) error {
+	if len(b.duplicates) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(b.duplicates))
+	for _, jobId := range sortedKeys(b.duplicates) {
+		event := b.duplicates[jobId]
+		rows = append(rows, goqu.Record{
+			"job_id":    event.JobId,
+			"queue":     event.Queue,
+			"jobset":    event.JobSetId,
+			"duplicate": true,
+			"state":     JobStateToIntMap[JobDuplicate],
+		})
+	}
+
+	ds := tx.Insert(jobTable).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("job_id", goqu.Record{
+			"state":     JobStateToIntMap[JobDuplicate],
+			"duplicate": true,
+		}))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
+}
+
+func (b *eventBatch) upsertCancellations(tx *goqu.TxDatabase) error {
+	if len(b.cancellations) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(b.cancellations))
+	for _, jobId := range sortedKeys(b.cancellations) {
+		event := b.cancellations[jobId]
+		rows = append(rows, goqu.Record{
+			"job_id":    event.JobId,
+			"queue":     event.Queue,
+			"jobset":    event.JobSetId,
+			"cancelled": ToUTC(event.Created),
+			"state":     JobStateToIntMap[JobCancelled],
+		})
+	}
+
+	ds := tx.Insert(jobTable).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("job_id", excluded(
+			"queue",
+			"jobset",
+			"cancelled",
+			"state")))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
+}
+
+// Same as RecordJobHeld and RecordJobReleased
+func (b *eventBatch) upsertHolds(tx *goqu.TxDatabase) error {
+	if len(b.holds) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(b.holds))
+	for _, jobId := range sortedKeys(b.holds) {
+		hold := b.holds[jobId]
+		rows = append(rows, goqu.Record{
+			"job_id": jobId,
+			"queue":  hold.queue,
+			"jobset": hold.jobSet,
+			"state":  JobStateToIntMap[hold.state],
+		})
+	}
+
+	ds := tx.Insert(jobTable).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("job_id", excluded("state")).Where(holdableState()))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
+}
+
+// Same as RecordJobReprioritized, with the priority of the job JSON set in the database
+func upsertReprioritizations(tx *goqu.TxDatabase, events []*api.JobReprioritizedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		rows = append(rows, goqu.Record{
+			"job_id":   event.JobId,
+			"queue":    event.Queue,
+			"jobset":   event.JobSetId,
+			"priority": event.NewPriority,
+			"job":      nil,
+		})
+	}
+
+	ds := tx.Insert(jobTable).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("job_id", goqu.Record{
+			"queue":    goqu.I("excluded.queue"),
+			"jobset":   goqu.I("excluded.jobset"),
+			"priority": goqu.I("excluded.priority"),
+			"job":      goqu.L("jsonb_set(job.job, '{priority}', to_jsonb(excluded.priority))"),
+		}))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
+}
+
+// Jobs without any runs keep their state
+func updateJobStates(tx *goqu.TxDatabase, jobIds []string) error {
+	if len(jobIds) == 0 {
+		return nil
+	}
+
+	ds := tx.Update(jobTable).
+		Set(goqu.Record{"state": determineJobStateFromRunStates()}).
+		From(getRunStateCountsByJob(tx, jobIds).As("run_states")).
+		Where(job_jobId.Eq(goqu.I("run_states.job_id")))
+
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
+}
+
+func determineJobStateFromRunStates() exp.CaseExpression {
+	return goqu.Case().
+		When(job_duplicate.Eq(true), stateAsLiteral(JobDuplicate)).
+		When(job_state.Eq(stateAsLiteral(JobCancelled)), stateAsLiteral(JobCancelled)).
+		When(job_state.Eq(stateAsLiteral(JobHeld)), stateAsLiteral(JobHeld)).
+		When(goqu.I("run_states.failed").Gt(0), stateAsLiteral(JobFailed)).
+		When(goqu.I("run_states.pending").Gt(0), stateAsLiteral(JobPending)).
+		When(goqu.I("run_states.running").Gt(0), stateAsLiteral(JobRunning)).
+		When(goqu.I("run_states.succeeded").Eq(goqu.I("run_states.total")), stateAsLiteral(JobSucceeded)).
+		Else(stateAsLiteral(JobQueued))
+}
+
+func excluded(columns ...string) goqu.Record {
+	record := goqu.Record{}
+	for _, column := range columns {
+		record[column] = goqu.I("excluded." + column)
+	}
+	return record
+}
+
+func nullableTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
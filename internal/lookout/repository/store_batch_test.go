@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_EventBatch_KeepsLatestJob(t *testing.T) {
+	jobId := util.NewULID()
+
+	batch := newEventBatch([]api.Event{
+		&api.JobUpdatedEvent{JobId: jobId, Created: someTime.Add(time.Minute), Job: api.Job{Id: jobId, Priority: 2}},
+		&api.JobSubmittedEvent{JobId: jobId, Created: someTime, Job: api.Job{Id: jobId, Priority: 1}},
+	})
+
+	assert.Len(t, batch.jobs, 1)
+	assert.Equal(t, 2.0, batch.jobs[jobId].job.Priority)
+	assert.Equal(t, someTime.Add(time.Minute), batch.jobs[jobId].updated)
+}
+
+func Test_EventBatch_MergesRunEvents(t *testing.T) {
+	jobId := util.NewULID()
+
+	batch := newEventBatch([]api.Event{
+		&api.JobPendingEvent{JobId: jobId, Queue: queue, Created: someTime, KubernetesId: k8sId1, ClusterId: cluster},
+		&api.JobRunningEvent{JobId: jobId, Queue: queue, Created: someTime.Add(time.Minute), KubernetesId: k8sId1, ClusterId: cluster, NodeName: node},
+		&api.JobSucceededEvent{JobId: jobId, Queue: queue, Created: someTime.Add(2 * time.Minute), KubernetesId: k8sId1, ClusterId: cluster},
+	})
+
+	assert.Len(t, batch.runs, 1)
+	run := batch.runs[k8sId1]
+	assert.Equal(t, someTime.UTC(), run["created"])
+	assert.Equal(t, someTime.Add(time.Minute).UTC(), run["started"])
+	assert.Equal(t, someTime.Add(2*time.Minute).UTC(), run["finished"])
+	assert.Equal(t, node, run["node"])
+	assert.Equal(t, true, run["succeeded"])
+
+	assert.Len(t, batch.runJobs, 1)
+	assert.Equal(t, someTime.Add(time.Minute).UTC(), *batch.runJobs[jobId].started)
+	assert.Equal(t, someTime.Add(2*time.Minute).UTC(), *batch.runJobs[jobId].finished)
+	assert.True(t, batch.jobsToUpdateState[jobId])
+}
+
+func Test_EventBatch_JobStartsWithFirstPodAndFinishesWithLast(t *testing.T) {
+	jobId := util.NewULID()
+
+	batch := newEventBatch([]api.Event{
+		&api.JobRunningEvent{JobId: jobId, Queue: queue, Created: someTime.Add(time.Minute), KubernetesId: k8sId1, PodNumber: 0},
+		&api.JobRunningEvent{JobId: jobId, Queue: queue, Created: someTime, KubernetesId: k8sId2, PodNumber: 1},
+		&api.JobFailedEvent{JobId: jobId, Queue: queue, Created: someTime.Add(3 * time.Minute), KubernetesId: k8sId2, PodNumber: 1},
+		&api.JobSucceededEvent{JobId: jobId, Queue: queue, Created: someTime.Add(2 * time.Minute), KubernetesId: k8sId1, PodNumber: 0},
+	})
+
+	assert.Len(t, batch.runs, 2)
+	assert.Equal(t, someTime.UTC(), *batch.runJobs[jobId].started)
+	assert.Equal(t, someTime.Add(3*time.Minute).UTC(), *batch.runJobs[jobId].finished)
+}
+
+func Test_EventBatch_FailedWithoutPodIsRecordedOnce(t *testing.T) {
+	event := &api.JobFailedEvent{
+		JobId:     util.NewULID(),
+		Queue:     queue,
+		Created:   someTime,
+		ExitCodes: map[string]int32{"container": 1},
+	}
+
+	batch := newEventBatch([]api.Event{event, event})
+
+	assert.Len(t, batch.runs, 1)
+	assert.Len(t, batch.containers, 1)
+	assert.Equal(t, failedRunId(event), failedRunId(event))
+}
+
+func Test_EventBatch_Reprioritizations(t *testing.T) {
+	jobId := util.NewULID()
+	otherJobId := util.NewULID()
+
+	batch := newEventBatch([]api.Event{
+		&api.JobReprioritizedEvent{JobId: jobId, Queue: queue, NewPriority: 1},
+		&api.JobReprioritizedEvent{JobId: otherJobId, Queue: queue, NewPriority: 1},
+		&api.JobUpdatedEvent{JobId: jobId, Created: someTime, Job: api.Job{Id: jobId, Priority: 2}},
+		&api.JobUpdatedEvent{JobId: otherJobId, Created: someTime, Job: api.Job{Id: otherJobId, Priority: 2}},
+		&api.JobReprioritizedEvent{JobId: otherJobId, Queue: queue, NewPriority: 3},
+	})
+
+	beforeJobs, afterJobs := batch.splitReprioritizations()
+
+	assert.Len(t, beforeJobs, 1)
+	assert.Equal(t, jobId, beforeJobs[0].JobId)
+	assert.Len(t, afterJobs, 1)
+	assert.Equal(t, otherJobId, afterJobs[0].JobId)
+	assert.Equal(t, 3.0, afterJobs[0].NewPriority)
+}
+
+func Test_EventBatch_KeepsLatestHoldState(t *testing.T) {
+	jobId := util.NewULID()
+	otherJobId := util.NewULID()
+
+	batch := newEventBatch([]api.Event{
+		&api.JobHeldEvent{JobId: jobId, Queue: queue, Created: someTime},
+		&api.JobReleasedEvent{JobId: jobId, Queue: queue, Created: someTime.Add(time.Minute)},
+		&api.JobHeldEvent{JobId: otherJobId, Queue: queue, Created: someTime},
+	})
+
+	assert.False(t, batch.empty())
+	assert.Equal(t, JobQueued, batch.holds[jobId].state)
+	assert.Equal(t, JobHeld, batch.holds[otherJobId].state)
+}
+
+func Test_RecordEvents(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobId := util.NewULID()
+		cancelledJobId := util.NewULID()
+
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{
+				JobId:   jobId,
+				Queue:   queue,
+				Created: someTime,
+				Job: api.Job{
+					Id:          jobId,
+					Queue:       queue,
+					Created:     someTime,
+					Annotations: map[string]string{userAnnotationPrefix + "a": "b"},
+				},
+			},
+			&api.JobPendingEvent{JobId: jobId, Queue: queue, Created: someTime, KubernetesId: k8sId1},
+			&api.JobRunningEvent{JobId: jobId, Queue: queue, Created: someTime.Add(time.Minute), KubernetesId: k8sId1, NodeName: node},
+			&api.JobReprioritizedEvent{JobId: jobId, Queue: queue, Created: someTime, NewPriority: 123},
+			&api.JobCancelledEvent{JobId: cancelledJobId, Queue: queue, Created: someTime},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, JobStateToIntMap[JobRunning], selectInt(t, db,
+			"SELECT state FROM job WHERE job_id = '"+jobId+"'"))
+		assert.Equal(t, JobStateToIntMap[JobCancelled], selectInt(t, db,
+			"SELECT state FROM job WHERE job_id = '"+cancelledJobId+"'"))
+		assert.Equal(t, 1, selectInt(t, db,
+			"SELECT COUNT(*) FROM job_run WHERE created IS NOT NULL AND started IS NOT NULL AND node = '"+node+"'"))
+		assert.Equal(t, 1, selectInt(t, db,
+			"SELECT COUNT(*) FROM job WHERE started IS NOT NULL"))
+		assert.Equal(t, float64(123), getPriority(t, db, jobId))
+		assert.Equal(t, float64(123), getJob(t, db, jobId).Priority)
+		assert.True(t, hasUserAnnotation(t, db, jobId, "a", "b"))
+	})
+}
+
+func Test_RecordEvents_RecordingTwiceHasNoEffect(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobId := util.NewULID()
+
+		events := []api.Event{
+			&api.JobSubmittedEvent{JobId: jobId, Queue: queue, Created: someTime, Job: api.Job{Id: jobId, Queue: queue, Created: someTime}},
+			&api.JobFailedEvent{
+				JobId:     jobId,
+				Queue:     queue,
+				Created:   someTime.Add(time.Minute),
+				ExitCodes: map[string]int32{"container": 1},
+			},
+		}
+
+		err := jobStore.RecordEvents(events)
+		assert.NoError(t, err)
+		err = jobStore.RecordEvents(events)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, selectInt(t, db, "SELECT COUNT(*) FROM job"))
+		assert.Equal(t, 1, selectInt(t, db, "SELECT COUNT(*) FROM job_run"))
+		assert.Equal(t, 1, selectInt(t, db, "SELECT COUNT(*) FROM job_run_container"))
+		assert.Equal(t, JobStateToIntMap[JobFailed], selectInt(t, db, "SELECT state FROM job"))
+	})
+}
+
+func Test_RecordEvents_IgnoresOlderJobUpdates(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobId := util.NewULID()
+		oldAnnotations := map[string]string{userAnnotationPrefix + "a": "b"}
+
+		err := jobStore.RecordJob(&api.Job{
+			Id:          jobId,
+			Queue:       queue,
+			Created:     someTime,
+			Annotations: oldAnnotations,
+			Priority:    1,
+		}, someTime)
+		assert.NoError(t, err)
+
+		err = jobStore.RecordEvents([]api.Event{
+			&api.JobUpdatedEvent{
+				JobId:   jobId,
+				Queue:   queue,
+				Created: someTime.Add(-time.Minute),
+				Job: api.Job{
+					Id:          jobId,
+					Queue:       queue,
+					Created:     someTime,
+					Annotations: map[string]string{userAnnotationPrefix + "c": "d"},
+					Priority:    2,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(1), getPriority(t, db, jobId))
+		assert.Equal(t, oldAnnotations, getJob(t, db, jobId).Annotations)
+		assert.True(t, hasUserAnnotation(t, db, jobId, "a", "b"))
+		assert.False(t, hasUserAnnotation(t, db, jobId, "c", "d"))
+	})
+}
+
+func Test_RecordEvents_DuplicateAndCancelledStates(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		duplicateJobId := util.NewULID()
+		cancelledJobId := util.NewULID()
+
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobDuplicateFoundEvent{JobId: duplicateJobId, Queue: queue, Created: someTime, OriginalJobId: util.NewULID()},
+			&api.JobSubmittedEvent{JobId: duplicateJobId, Queue: queue, Created: someTime, Job: api.Job{Id: duplicateJobId, Queue: queue}},
+			&api.JobCancelledEvent{JobId: cancelledJobId, Queue: queue, Created: someTime},
+			&api.JobRunningEvent{JobId: cancelledJobId, Queue: queue, Created: someTime, KubernetesId: k8sId1},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, JobStateToIntMap[JobDuplicate], selectInt(t, db,
+			"SELECT state FROM job WHERE job_id = '"+duplicateJobId+"'"))
+		assert.Equal(t, JobStateToIntMap[JobCancelled], selectInt(t, db,
+			"SELECT state FROM job WHERE job_id = '"+cancelledJobId+"'"))
+	})
+}
+
+func Test_RecordEvents_HeldState(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		heldJobId := util.NewULID()
+		cancelledJobId := util.NewULID()
+
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{JobId: heldJobId, Queue: queue, Created: someTime, Job: api.Job{Id: heldJobId, Queue: queue}},
+			&api.JobHeldEvent{JobId: heldJobId, Queue: queue, Created: someTime},
+			&api.JobCancelledEvent{JobId: cancelledJobId, Queue: queue, Created: someTime},
+			&api.JobHeldEvent{JobId: cancelledJobId, Queue: queue, Created: someTime},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, JobStateToIntMap[JobHeld], selectInt(t, db,
+			"SELECT state FROM job WHERE job_id = '"+heldJobId+"'"))
+		assert.Equal(t, JobStateToIntMap[JobCancelled], selectInt(t, db,
+			"SELECT state FROM job WHERE job_id = '"+cancelledJobId+"'"))
+	})
+}
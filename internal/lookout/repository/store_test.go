@@ -101,6 +101,40 @@ func Test_RunContainers(t *testing.T) {
 	})
 }
 
+func Test_RunContainerStatuses(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+
+		err := jobStore.RecordJobFailed(&api.JobFailedEvent{
+			JobId:        "job-1",
+			Queue:        queue,
+			Created:      time.Now(),
+			KubernetesId: "a1",
+			Reason:       "Container trainer failed",
+			Cause:        api.Cause_OOM,
+			ExitCodes:    map[string]int32{"trainer": 137, "sidecar": 0},
+			ContainerStatuses: []*api.ContainerStatus{
+				{Name: "trainer", ExitCode: 137, Reason: "OOMKilled", Message: "out of memory", Cause: api.Cause_OOM},
+				{Name: "sidecar", ExitCode: 0, Reason: "Completed"},
+			},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, int(api.Cause_OOM), selectInt(t, db,
+			"SELECT cause FROM job_run WHERE run_id = 'a1'"))
+		assert.Equal(t, 2, selectInt(t, db,
+			"SELECT COUNT(*) FROM job_run_container WHERE run_id = 'a1'"))
+		assert.Equal(t, "OOMKilled", ParseNullString(selectNullString(t, db,
+			"SELECT reason FROM job_run_container WHERE run_id = 'a1' AND container_name = 'trainer'")))
+		assert.Equal(t, "out of memory", ParseNullString(selectNullString(t, db,
+			"SELECT message FROM job_run_container WHERE run_id = 'a1' AND container_name = 'trainer'")))
+		assert.Equal(t, int(api.Cause_OOM), selectInt(t, db,
+			"SELECT cause FROM job_run_container WHERE run_id = 'a1' AND container_name = 'trainer'"))
+		assert.False(t, selectNullString(t, db,
+			"SELECT message FROM job_run_container WHERE run_id = 'a1' AND container_name = 'sidecar'").Valid)
+	})
+}
+
 func Test_RecordNullNodeIfEmptyString(t *testing.T) {
 	withDatabase(t, func(db *goqu.Database) {
 		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
@@ -637,6 +671,51 @@ func Test_Cancelled(t *testing.T) {
 	})
 }
 
+func Test_HeldAndReleased(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		job := &api.Job{
+			Id:       util.NewULID(),
+			Queue:    "queue",
+			JobSetId: "job-set",
+			Priority: 1,
+			Created:  someTime,
+		}
+
+		err := jobStore.RecordJob(job, someTime)
+		assert.NoError(t, err)
+
+		err = jobStore.RecordJobHeld(&api.JobHeldEvent{JobId: job.Id, Queue: job.Queue, JobSetId: job.JobSetId, Created: someTime})
+		assert.NoError(t, err)
+		assert.Equal(t, JobStateToIntMap[JobHeld], selectInt(t, db, "SELECT state FROM job"))
+
+		// Updates of the held job, e.g. when it is reprioritized, keep it held
+		job.Priority = 2
+		err = jobStore.RecordJob(job, someTime.Add(time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, JobStateToIntMap[JobHeld], selectInt(t, db, "SELECT state FROM job"))
+
+		err = jobStore.RecordJobReleased(&api.JobReleasedEvent{JobId: job.Id, Queue: job.Queue, JobSetId: job.JobSetId, Created: someTime})
+		assert.NoError(t, err)
+		assert.Equal(t, JobStateToIntMap[JobQueued], selectInt(t, db, "SELECT state FROM job"))
+	})
+}
+
+func Test_HeldDoesNotOverrideCancelled(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobId := util.NewULID()
+
+		err := jobStore.MarkCancelled(&api.JobCancelledEvent{JobId: jobId, Queue: "queue", Created: someTime})
+		assert.NoError(t, err)
+
+		err = jobStore.RecordJobHeld(&api.JobHeldEvent{JobId: jobId, Queue: "queue", Created: someTime})
+		assert.NoError(t, err)
+
+		assert.Equal(t, JobStateToIntMap[JobCancelled], selectInt(t, db, "SELECT state FROM job"))
+	})
+}
+
 func Test_Duplicate(t *testing.T) {
 	withDatabase(t, func(db *goqu.Database) {
 		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
@@ -59,6 +59,10 @@ func StartsWith(field exp.IdentifierExpression, pattern string) goqu.Expression
 	return field.Like(pattern + "%")
 }
 
+func Contains(field exp.IdentifierExpression, pattern string) goqu.Expression {
+	return field.ILike("%" + pattern + "%")
+}
+
 func NewNullString(s string) sql.NullString {
 	if len(s) == 0 {
 		return sql.NullString{}
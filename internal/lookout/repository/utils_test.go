@@ -129,7 +129,36 @@ func (js *JobSimulator) CreateJobWithOpts(
 	time time.Time,
 	annotations map[string]string,
 ) *JobSimulator {
-	js.job = &api.Job{
+	return js.recordJob(newTestJob(queue, jobId, jobSetId, owner, time, annotations))
+}
+
+func (js *JobSimulator) CreateJobWithLabels(queue string, labels map[string]string) *JobSimulator {
+	job := newTestJob(queue, util.NewULID(), "job-set", "user", time.Now(), nil)
+	job.Labels = labels
+	return js.recordJob(job)
+}
+
+func (js *JobSimulator) CreateJobWithPriority(queue string, priority float64) *JobSimulator {
+	job := newTestJob(queue, util.NewULID(), "job-set", "user", time.Now(), nil)
+	job.Priority = priority
+	return js.recordJob(job)
+}
+
+func (js *JobSimulator) recordJob(job *api.Job) *JobSimulator {
+	js.job = job
+	assert.NoError(js.t, js.jobStore.RecordJob(js.job, job.Created))
+	return js
+}
+
+func newTestJob(
+	queue string,
+	jobId string,
+	jobSetId string,
+	owner string,
+	time time.Time,
+	annotations map[string]string,
+) *api.Job {
+	return &api.Job{
 		Id:          jobId,
 		JobSetId:    jobSetId,
 		Queue:       queue,
@@ -141,8 +170,6 @@ func (js *JobSimulator) CreateJobWithOpts(
 		PodSpec:     &v1.PodSpec{},
 		Created:     time,
 	}
-	assert.NoError(js.t, js.jobStore.RecordJob(js.job, time))
-	return js
 }
 
 func (js *JobSimulator) Pending(cluster string, k8sId string) *JobSimulator {
@@ -218,6 +245,23 @@ func (js *JobSimulator) FailedAtTime(cluster string, k8sId string, node string,
 	return js
 }
 
+func (js *JobSimulator) FailedWithCause(cluster string, k8sId string, node string, error string, cause api.Cause, containerStatuses []*api.ContainerStatus) *JobSimulator {
+	failedEvent := &api.JobFailedEvent{
+		JobId:             js.job.Id,
+		JobSetId:          js.job.JobSetId,
+		Queue:             js.job.Queue,
+		Created:           time.Now(),
+		ClusterId:         cluster,
+		Reason:            error,
+		KubernetesId:      k8sId,
+		NodeName:          node,
+		ContainerStatuses: containerStatuses,
+		Cause:             cause,
+	}
+	assert.NoError(js.t, js.jobStore.RecordJobFailed(failedEvent))
+	return js
+}
+
 func (js *JobSimulator) Cancelled() *JobSimulator {
 	return js.CancelledAtTime(time.Now())
 }
@@ -2,21 +2,34 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/api/lookout"
 )
 
 type LookoutServer struct {
 	jobRepository repository.JobRepository
+	permissions   authorization.PermissionChecker
+	queueCache    QueueCache
 }
 
-func NewLookoutServer(jobRepository repository.JobRepository) *LookoutServer {
-	return &LookoutServer{jobRepository: jobRepository}
+func NewLookoutServer(
+	jobRepository repository.JobRepository,
+	permissions authorization.PermissionChecker,
+	queueCache QueueCache) *LookoutServer {
+
+	return &LookoutServer{
+		jobRepository: jobRepository,
+		permissions:   permissions,
+		queueCache:    queueCache,
+	}
 }
 
 func (s *LookoutServer) Overview(ctx context.Context, _ *types.Empty) (*lookout.SystemOverview, error) {
@@ -24,10 +37,18 @@ func (s *LookoutServer) Overview(ctx context.Context, _ *types.Empty) (*lookout.
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to query queue stats: %s", err)
 	}
+
+	if visibleQueues := s.visibleQueues(ctx); visibleQueues != nil {
+		queues = filterQueueInfos(queues, visibleQueues)
+	}
 	return &lookout.SystemOverview{Queues: queues}, nil
 }
 
 func (s *LookoutServer) GetJobSets(ctx context.Context, opts *lookout.GetJobSetsRequest) (*lookout.GetJobSetsResponse, error) {
+	if err := s.checkCanViewQueue(ctx, opts.Queue); err != nil {
+		return nil, err
+	}
+
 	jobSets, err := s.jobRepository.GetJobSetInfos(ctx, opts)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to query queue stats: %s", err)
@@ -36,9 +57,100 @@ func (s *LookoutServer) GetJobSets(ctx context.Context, opts *lookout.GetJobSets
 }
 
 func (s *LookoutServer) GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) (*lookout.GetJobsResponse, error) {
-	jobInfos, err := s.jobRepository.GetJobs(ctx, opts)
+	jobInfos, nextCursor, err := s.jobRepository.GetJobsInQueues(ctx, opts, s.visibleQueues(ctx))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to query jobs in queue: %s", err)
 	}
-	return &lookout.GetJobsResponse{JobInfos: jobInfos}, nil
+	s.setEffectivePriorities(jobInfos, time.Now())
+	err = s.setThrottledReasons(ctx, jobInfos)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query running jobs: %s", err)
+	}
+	return &lookout.GetJobsResponse{JobInfos: jobInfos, NextCursor: nextCursor}, nil
+}
+
+// Queued jobs are checked against max running limits of their queue as known to the queue cache and of their job set,
+// pending and running jobs recorded by lookout are counted towards the limits
+func (s *LookoutServer) setThrottledReasons(ctx context.Context, jobInfos []*lookout.JobInfo) error {
+	queues := map[string]*api.Queue{}
+	for _, queue := range s.queueCache.GetAllQueues() {
+		queues[queue.Name] = queue
+	}
+
+	var queueRunning map[string]int
+	jobSetRunning := map[string]map[string]int{}
+	for _, jobInfo := range jobInfos {
+		if jobInfo.Job == nil || jobInfo.JobState != string(repository.JobQueued) {
+			continue
+		}
+		queue, ok := queues[jobInfo.Job.Queue]
+		if !ok || (queue.MaxRunning == 0 && jobInfo.Job.JobSetMaxRunning == 0) {
+			continue
+		}
+
+		if queueRunning == nil && queue.MaxRunning > 0 {
+			queueInfos, err := s.jobRepository.GetQueueInfos(ctx)
+			if err != nil {
+				return err
+			}
+			queueRunning = map[string]int{}
+			for _, queueInfo := range queueInfos {
+				queueRunning[queueInfo.Queue] = int(queueInfo.JobsPending + queueInfo.JobsRunning)
+			}
+		}
+		if _, ok := jobSetRunning[queue.Name]; !ok && jobInfo.Job.JobSetMaxRunning > 0 {
+			jobSetInfos, err := s.jobRepository.GetJobSetInfos(ctx, &lookout.GetJobSetsRequest{Queue: queue.Name, ActiveOnly: true})
+			if err != nil {
+				return err
+			}
+			jobSetRunning[queue.Name] = map[string]int{}
+			for _, jobSetInfo := range jobSetInfos {
+				jobSetRunning[queue.Name][jobSetInfo.JobSet] = int(jobSetInfo.JobsPending + jobSetInfo.JobsRunning)
+			}
+		}
+
+		jobInfo.ThrottledReason = queue.ThrottledReason(jobInfo.Job, queueRunning[queue.Name], jobSetRunning[queue.Name][jobInfo.Job.JobSetId])
+	}
+	return nil
+}
+
+// Queued jobs are aged with the priority aging of their queue as known to the queue cache, from when they were
+// submitted or their last run finished, jobs of queues missing from the cache keep their submit priority
+func (s *LookoutServer) setEffectivePriorities(jobInfos []*lookout.JobInfo, now time.Time) {
+	queues := map[string]*api.Queue{}
+	for _, queue := range s.queueCache.GetAllQueues() {
+		queues[queue.Name] = queue
+	}
+
+	for _, jobInfo := range jobInfos {
+		if jobInfo.Job == nil {
+			continue
+		}
+		jobInfo.EffectivePriority = jobInfo.Job.Priority
+		if queue, ok := queues[jobInfo.Job.Queue]; ok && jobInfo.JobState == string(repository.JobQueued) {
+			jobInfo.EffectivePriority = queue.EffectivePriority(jobInfo.Job, lastQueuedTime(jobInfo), now)
+		}
+	}
+}
+
+func lastQueuedTime(jobInfo *lookout.JobInfo) time.Time {
+	queued := jobInfo.Job.SubmitQueuedTime()
+	for _, run := range jobInfo.Runs {
+		if run.Finished != nil && run.Finished.After(queued) {
+			queued = *run.Finished
+		}
+	}
+	return queued
+}
+
+func (s *LookoutServer) GetJobStats(ctx context.Context, opts *lookout.GetJobStatsRequest) (*lookout.GetJobStatsResponse, error) {
+	if err := s.checkCanViewQueue(ctx, opts.Queue); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.jobRepository.GetJobStats(ctx, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query job stats: %s", err)
+	}
+	return &lookout.GetJobStatsResponse{Stats: stats}, nil
 }
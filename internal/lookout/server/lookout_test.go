@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestSetEffectivePriorities(t *testing.T) {
+	now := time.Now()
+	server := NewLookoutServer(nil, nil, &fakeQueueCache{queues: []*api.Queue{
+		{Name: "aged-queue", PriorityAgingRate: 2, PriorityAgingCap: 5},
+	}})
+
+	queued := &lookout.JobInfo{
+		Job:      &api.Job{Queue: "aged-queue", Priority: 10, Created: now.Add(-2 * time.Hour)},
+		JobState: string(repository.JobQueued),
+	}
+	cappedQueued := &lookout.JobInfo{
+		Job:      &api.Job{Queue: "aged-queue", Priority: 10, Created: now.Add(-10 * time.Hour)},
+		JobState: string(repository.JobQueued),
+	}
+	running := &lookout.JobInfo{
+		Job:      &api.Job{Queue: "aged-queue", Priority: 10, Created: now.Add(-2 * time.Hour)},
+		JobState: string(repository.JobRunning),
+	}
+	unknownQueue := &lookout.JobInfo{
+		Job:      &api.Job{Queue: "other-queue", Priority: 10, Created: now.Add(-2 * time.Hour)},
+		JobState: string(repository.JobQueued),
+	}
+
+	server.setEffectivePriorities([]*lookout.JobInfo{queued, cappedQueued, running, unknownQueue}, now)
+
+	assert.Equal(t, 6.0, queued.EffectivePriority)
+	assert.Equal(t, 5.0, cappedQueued.EffectivePriority)
+	assert.Equal(t, 10.0, running.EffectivePriority)
+	assert.Equal(t, 10.0, unknownQueue.EffectivePriority)
+}
+
+func TestSetThrottledReasons(t *testing.T) {
+	jobRepository := &fakeJobRepository{
+		queueInfos: []*lookout.QueueInfo{{Queue: "limited-queue", JobsPending: 1, JobsRunning: 1}},
+		jobSetInfos: map[string][]*lookout.JobSetInfo{
+			"queue": {{Queue: "queue", JobSet: "limited-set", JobsRunning: 2}},
+		},
+	}
+	server := NewLookoutServer(jobRepository, nil, &fakeQueueCache{queues: []*api.Queue{
+		{Name: "limited-queue", MaxRunning: 2},
+		{Name: "queue"},
+	}})
+
+	throttledByQueue := &lookout.JobInfo{
+		Job:      &api.Job{Queue: "limited-queue", JobSetId: "set"},
+		JobState: string(repository.JobQueued),
+	}
+	throttledByJobSet := &lookout.JobInfo{
+		Job:      &api.Job{Queue: "queue", JobSetId: "limited-set", JobSetMaxRunning: 2},
+		JobState: string(repository.JobQueued),
+	}
+	belowJobSetLimit := &lookout.JobInfo{
+		Job:      &api.Job{Queue: "queue", JobSetId: "other-set", JobSetMaxRunning: 2},
+		JobState: string(repository.JobQueued),
+	}
+	running := &lookout.JobInfo{
+		Job:      &api.Job{Queue: "limited-queue", JobSetId: "set"},
+		JobState: string(repository.JobRunning),
+	}
+
+	err := server.setThrottledReasons(context.Background(), []*lookout.JobInfo{throttledByQueue, throttledByJobSet, belowJobSetLimit, running})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "queue limited-queue reached its limit of 2 running jobs", throttledByQueue.ThrottledReason)
+	assert.Equal(t, "job set limited-set reached its limit of 2 running jobs", throttledByJobSet.ThrottledReason)
+	assert.Equal(t, "", belowJobSetLimit.ThrottledReason)
+	assert.Equal(t, "", running.ThrottledReason)
+}
+
+type fakeJobRepository struct {
+	repository.JobRepository
+	queueInfos  []*lookout.QueueInfo
+	jobSetInfos map[string][]*lookout.JobSetInfo
+}
+
+func (r *fakeJobRepository) GetQueueInfos(ctx context.Context) ([]*lookout.QueueInfo, error) {
+	return r.queueInfos, nil
+}
+
+func (r *fakeJobRepository) GetJobSetInfos(ctx context.Context, opts *lookout.GetJobSetsRequest) ([]*lookout.JobSetInfo, error) {
+	return r.jobSetInfos[opts.Queue], nil
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/lookout/permissions"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+type QueueCache interface {
+	GetAllQueues() []*api.Queue
+}
+
+// Names of the queues the user owns, either directly or through one of their groups.
+// Returns nil if the user is allowed to view jobs in all queues.
+func (s *LookoutServer) visibleQueues(ctx context.Context) []string {
+	if s.permissions.UserHasPermission(ctx, permissions.ViewAllJobs) {
+		return nil
+	}
+
+	visibleQueues := []string{}
+	for _, queue := range s.queueCache.GetAllQueues() {
+		if owned, _ := s.permissions.UserOwns(ctx, queue); owned {
+			visibleQueues = append(visibleQueues, queue.Name)
+		}
+	}
+	return visibleQueues
+}
+
+// An empty queue covers all queues, so requires permission to view all jobs
+func (s *LookoutServer) checkCanViewQueue(ctx context.Context, queue string) error {
+	visibleQueues := s.visibleQueues(ctx)
+	if visibleQueues == nil || (queue != "" && util.ContainsString(visibleQueues, queue)) {
+		return nil
+	}
+
+	principal := authorization.GetPrincipal(ctx)
+	if queue == "" {
+		return status.Errorf(codes.PermissionDenied, "User %q has no permission: %s", principal.GetName(), permissions.ViewAllJobs)
+	}
+	return status.Errorf(codes.PermissionDenied, "User %q has no permission to view jobs in queue %q", principal.GetName(), queue)
+}
+
+func filterQueueInfos(queueInfos []*lookout.QueueInfo, queues []string) []*lookout.QueueInfo {
+	queueSet := util.StringListToSet(queues)
+	result := []*lookout.QueueInfo{}
+	for _, queueInfo := range queueInfos {
+		if queueSet[queueInfo.Queue] {
+			result = append(result, queueInfo)
+		}
+	}
+	return result
+}
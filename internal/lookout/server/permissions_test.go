@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/auth/permission"
+	"github.com/G-Research/armada/internal/lookout/permissions"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+type fakeQueueCache struct {
+	queues []*api.Queue
+}
+
+func (c *fakeQueueCache) GetAllQueues() []*api.Queue {
+	return c.queues
+}
+
+func newTestServer() *LookoutServer {
+	permissionChecker := authorization.NewPrincipalPermissionChecker(
+		map[permission.Permission][]string{permissions.ViewAllJobs: {"admins"}},
+		map[permission.Permission][]string{},
+		map[permission.Permission][]string{})
+
+	queueCache := &fakeQueueCache{queues: []*api.Queue{
+		{Name: "user-queue", UserOwners: []string{"user"}},
+		{Name: "group-queue", GroupOwners: []string{"team"}},
+		{Name: "other-queue", UserOwners: []string{"other"}},
+	}}
+
+	return NewLookoutServer(nil, permissionChecker, queueCache)
+}
+
+func withUser(name string, groups ...string) context.Context {
+	return authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal(name, groups))
+}
+
+func TestVisibleQueues(t *testing.T) {
+	server := newTestServer()
+
+	assert.Nil(t, server.visibleQueues(withUser("admin", "admins")))
+	assert.Equal(t, []string{"user-queue", "group-queue"}, server.visibleQueues(withUser("user", "team")))
+	assert.Equal(t, []string{"group-queue"}, server.visibleQueues(withUser("someone", "team")))
+	assert.Equal(t, []string{}, server.visibleQueues(withUser("someone")))
+}
+
+func TestCheckCanViewQueue(t *testing.T) {
+	server := newTestServer()
+
+	assert.NoError(t, server.checkCanViewQueue(withUser("admin", "admins"), "other-queue"))
+	assert.NoError(t, server.checkCanViewQueue(withUser("admin", "admins"), ""))
+	assert.NoError(t, server.checkCanViewQueue(withUser("user"), "user-queue"))
+
+	err := server.checkCanViewQueue(withUser("user"), "other-queue")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = server.checkCanViewQueue(withUser("user"), "")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestFilterQueueInfos(t *testing.T) {
+	queueInfos := []*lookout.QueueInfo{
+		{Queue: "user-queue"},
+		{Queue: "group-queue"},
+		{Queue: "other-queue"},
+	}
+
+	filtered := filterQueueInfos(queueInfos, []string{"group-queue", "missing-queue"})
+
+	assert.Equal(t, []*lookout.QueueInfo{{Queue: "group-queue"}}, filtered)
+}
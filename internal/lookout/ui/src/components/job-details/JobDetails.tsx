@@ -31,6 +31,10 @@ export default function JobDetails(props: DetailsProps) {
             <DetailRow name="Job set" value={props.job.jobSet} />
             <DetailRow name="Job state" value={props.job.jobState} />
             <DetailRow name="Priority" value={props.job.priority.toString()} />
+            {props.job.jobState === "Queued" && props.job.effectivePriority !== props.job.priority && (
+              <DetailRow name="Effective priority" value={props.job.effectivePriority.toString()} />
+            )}
+            {props.job.throttledReason && <DetailRow name="Throttled" value={props.job.throttledReason} />}
             <DetailRow name="Submitted" value={props.job.submissionTime} />
             {props.job.cancelledTime && <DetailRow name="Cancelled" value={props.job.cancelledTime} />}
             {lastRun && <RunDetailsRows run={lastRun} />}
@@ -1,6 +1,6 @@
 import React, { Fragment } from "react"
 
-import { Run } from "../../services/JobService"
+import { ContainerStatus, Run } from "../../services/JobService"
 import DetailRow from "./DetailRow"
 
 import "./Details.css"
@@ -20,6 +20,20 @@ export function RunDetailsRows(props: RunDetailsRowsProps) {
       {props.run.podStartTime && <DetailRow name="Job started" value={props.run.podStartTime} />}
       {props.run.finishTime && <DetailRow name="Finished" value={props.run.finishTime} />}
       {props.run.error && <DetailRow name="Error" value={props.run.error} className="error-message" />}
+      {props.run.cause && <DetailRow name="Cause" value={props.run.cause} />}
+      {props.run.containerStatuses.map((c) => (
+        <DetailRow
+          key={"container-" + c.name}
+          name={"Container " + c.name}
+          value={describeContainer(c)}
+          className={c.exitCode !== 0 ? "error-message" : undefined}
+        />
+      ))}
     </Fragment>
   )
 }
+
+function describeContainer(container: ContainerStatus): string {
+  const details = [container.reason, "exit code " + container.exitCode, container.message].filter((d) => d)
+  return details.join(", ")
+}
@@ -1,6 +1,6 @@
 import React from "react"
 
-import { green, grey, orange, purple, red, yellow } from "@material-ui/core/colors"
+import { blue, green, grey, orange, purple, red, yellow } from "@material-ui/core/colors"
 import { TableCellProps } from "react-virtualized"
 
 import "./JobStateCell.css"
@@ -9,6 +9,8 @@ function colorForState(state: string): string {
   switch (state) {
     case "Queued":
       return yellow["A100"]
+    case "Held":
+      return blue["A100"]
     case "Pending":
       return orange["A100"]
     case "Running":
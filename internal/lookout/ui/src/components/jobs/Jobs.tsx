@@ -61,6 +61,7 @@ export default class Jobs extends React.Component<JobsProps, Record<string, neve
         jobId: "Loading",
         jobSet: "",
         priority: 0,
+        effectivePriority: 0,
         jobState: "",
         queue: "",
         submissionTime: "",
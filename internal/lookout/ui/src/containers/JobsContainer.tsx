@@ -72,8 +72,8 @@ export function isColumnSpec<T>(obj: any): obj is ColumnSpec<T> {
 
 const newPriorityRegex = new RegExp("^([0-9]+)$")
 const BATCH_SIZE = 100
-const CANCELLABLE_JOB_STATES = ["Queued", "Pending", "Running"]
-const REPRIORITIZEABLE_JOB_STATES = ["Queued", "Pending", "Running"]
+const CANCELLABLE_JOB_STATES = ["Queued", "Held", "Pending", "Running"]
+const REPRIORITIZEABLE_JOB_STATES = ["Queued", "Held", "Pending", "Running"]
 
 class JobsContainer extends React.Component<JobsContainerProps, JobsContainerState> {
   jobTableService: JobTableService
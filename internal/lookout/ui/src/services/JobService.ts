@@ -3,6 +3,7 @@ import yaml from "js-yaml"
 import { SubmitApi } from "../openapi/armada"
 import {
   LookoutApi,
+  LookoutContainerInfo,
   LookoutDurationStats,
   LookoutJobInfo,
   LookoutJobSetInfo,
@@ -75,6 +76,8 @@ export type Job = {
   owner: string
   jobSet: string
   priority: number
+  effectivePriority: number
+  throttledReason?: string
   submissionTime: string
   cancelledTime?: string
   jobState: string
@@ -90,11 +93,21 @@ export type Run = {
   node?: string
   succeeded: boolean
   error?: string
+  cause?: string
   podCreationTime?: string
   podStartTime?: string
   finishTime?: string
   podNumber: number
   containers: string[]
+  containerStatuses: ContainerStatus[]
+}
+
+export type ContainerStatus = {
+  name: string
+  exitCode: number
+  message?: string
+  reason?: string
+  cause?: string
 }
 
 export type CancelJobsResult = {
@@ -140,10 +153,11 @@ JOB_STATE_MAP.set("RUNNING", "Running")
 JOB_STATE_MAP.set("SUCCEEDED", "Succeeded")
 JOB_STATE_MAP.set("FAILED", "Failed")
 JOB_STATE_MAP.set("CANCELLED", "Cancelled")
+JOB_STATE_MAP.set("HELD", "Held")
 
 const INVERSE_JOB_STATE_MAP = reverseMap(JOB_STATE_MAP)
 
-export const JOB_STATES_FOR_DISPLAY = ["Queued", "Pending", "Running", "Succeeded", "Failed", "Cancelled"]
+export const JOB_STATES_FOR_DISPLAY = ["Queued", "Held", "Pending", "Running", "Succeeded", "Failed", "Cancelled"]
 
 export default class JobService {
   lookoutApi: LookoutApi
@@ -385,6 +399,8 @@ export default class JobService {
     const owner = jobInfo.job?.owner ?? "-"
     const jobSet = jobInfo.job?.jobSetId ?? "-"
     const priority = jobInfo.job?.priority ?? 0
+    const effectivePriority = jobInfo.effectivePriority ?? priority
+    const throttledReason = jobInfo.throttledReason || undefined
     const submissionTime = dateToString(jobInfo.job?.created ?? new Date())
     const cancelledTime = jobInfo.cancelled ? dateToString(jobInfo.cancelled) : undefined
     const jobState = JOB_STATE_MAP.get(jobInfo.jobState ?? "") ?? "Unknown"
@@ -400,6 +416,8 @@ export default class JobService {
       owner: owner,
       jobSet: jobSet,
       priority: priority,
+      effectivePriority: effectivePriority,
+      throttledReason: throttledReason,
       submissionTime: submissionTime,
       cancelledTime: cancelledTime,
       jobState: jobState,
@@ -527,11 +545,23 @@ function runInfoToViewModel(run: LookoutRunInfo, job: ApiJob | undefined): Run {
     node: run.node,
     succeeded: run.succeeded ?? false,
     error: run.error,
+    cause: run.cause,
     podCreationTime: run.created ? dateToString(run.created) : undefined,
     podStartTime: run.started ? dateToString(run.started) : undefined,
     finishTime: run.finished ? dateToString(run.finished) : undefined,
     podNumber: run.podNumber ?? 0,
     containers: containerNames,
+    containerStatuses: (run.containers ?? []).map(containerInfoToViewModel),
+  }
+}
+
+function containerInfoToViewModel(container: LookoutContainerInfo): ContainerStatus {
+  return {
+    name: container.name ?? "Unknown container",
+    exitCode: container.exitCode ?? 0,
+    message: container.message,
+    reason: container.reason,
+    cause: container.cause,
   }
 }
 
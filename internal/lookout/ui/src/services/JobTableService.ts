@@ -13,6 +13,7 @@ function createLoadingJob(): JobMetadata {
     jobId: "Loading",
     jobSet: "",
     priority: 0,
+    effectivePriority: 0,
     jobState: "",
     queue: "",
     submissionTime: "",
@@ -745,6 +745,8 @@ type JobIngressInfoEvent struct {
 	PodName          string           `protobuf:"bytes,10,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
 	PodNamespace     string           `protobuf:"bytes,11,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
 	IngressAddresses map[int32]string `protobuf:"bytes,9,rep,name=ingress_addresses,json=ingressAddresses,proto3" json:"ingressAddresses,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Hostname of the pod within the headless service of jobs with peer discovery
+	PeerHostname string `protobuf:"bytes,12,opt,name=peer_hostname,json=peerHostname,proto3" json:"peerHostname,omitempty"`
 }
 
 func (m *JobIngressInfoEvent) Reset()      { *m = JobIngressInfoEvent{} }
@@ -856,6 +858,13 @@ func (m *JobIngressInfoEvent) GetIngressAddresses() map[int32]string {
 	return nil
 }
 
+func (m *JobIngressInfoEvent) GetPeerHostname() string {
+	if m != nil {
+		return m.PeerHostname
+	}
+	return ""
+}
+
 type JobUnableToScheduleEvent struct {
 	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -1689,6 +1698,156 @@ func (m *JobCancelledEvent) GetRequestor() string {
 	return ""
 }
 
+type JobHeldEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
+}
+
+func (m *JobHeldEvent) Reset()      { *m = JobHeldEvent{} }
+func (*JobHeldEvent) ProtoMessage() {}
+func (*JobHeldEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{17}
+}
+func (m *JobHeldEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobHeldEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobHeldEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobHeldEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobHeldEvent.Merge(m, src)
+}
+func (m *JobHeldEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobHeldEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobHeldEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobHeldEvent proto.InternalMessageInfo
+
+func (m *JobHeldEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobHeldEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobHeldEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobHeldEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobHeldEvent) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
+}
+
+type JobReleasedEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
+}
+
+func (m *JobReleasedEvent) Reset()      { *m = JobReleasedEvent{} }
+func (*JobReleasedEvent) ProtoMessage() {}
+func (*JobReleasedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{18}
+}
+func (m *JobReleasedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobReleasedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobReleasedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobReleasedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReleasedEvent.Merge(m, src)
+}
+func (m *JobReleasedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobReleasedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReleasedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobReleasedEvent proto.InternalMessageInfo
+
+func (m *JobReleasedEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobReleasedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobReleasedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobReleasedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobReleasedEvent) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
+}
+
 type JobTerminatedEvent struct {
 	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -1705,7 +1864,7 @@ type JobTerminatedEvent struct {
 func (m *JobTerminatedEvent) Reset()      { *m = JobTerminatedEvent{} }
 func (*JobTerminatedEvent) ProtoMessage() {}
 func (*JobTerminatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{17}
+	return fileDescriptor_7758595c3bb8cf56, []int{19}
 }
 func (m *JobTerminatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1816,7 +1975,7 @@ type JobUpdatedEvent struct {
 func (m *JobUpdatedEvent) Reset()      { *m = JobUpdatedEvent{} }
 func (*JobUpdatedEvent) ProtoMessage() {}
 func (*JobUpdatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{18}
+	return fileDescriptor_7758595c3bb8cf56, []int{20}
 }
 func (m *JobUpdatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1908,13 +2067,17 @@ type EventMessage struct {
 	//	*EventMessage_IngressInfo
 	//	*EventMessage_Reprioritizing
 	//	*EventMessage_Updated
+	//	*EventMessage_Held
+	//	*EventMessage_Released
 	Events isEventMessage_Events `protobuf_oneof:"events"`
+	// Set by executors replaying events, events with a key that was already reported are ignored
+	DeduplicationKey string `protobuf:"bytes,20,opt,name=deduplication_key,json=deduplicationKey,proto3" json:"deduplicationKey,omitempty"`
 }
 
 func (m *EventMessage) Reset()      { *m = EventMessage{} }
 func (*EventMessage) ProtoMessage() {}
 func (*EventMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{19}
+	return fileDescriptor_7758595c3bb8cf56, []int{21}
 }
 func (m *EventMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2006,6 +2169,12 @@ type EventMessage_Reprioritizing struct {
 type EventMessage_Updated struct {
 	Updated *JobUpdatedEvent `protobuf:"bytes,19,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
 }
+type EventMessage_Held struct {
+	Held *JobHeldEvent `protobuf:"bytes,21,opt,name=held,proto3,oneof" json:"held,omitempty"`
+}
+type EventMessage_Released struct {
+	Released *JobReleasedEvent `protobuf:"bytes,22,opt,name=released,proto3,oneof" json:"released,omitempty"`
+}
 
 func (*EventMessage_Submitted) isEventMessage_Events()        {}
 func (*EventMessage_Queued) isEventMessage_Events()           {}
@@ -2026,6 +2195,8 @@ func (*EventMessage_Utilisation) isEventMessage_Events()      {}
 func (*EventMessage_IngressInfo) isEventMessage_Events()      {}
 func (*EventMessage_Reprioritizing) isEventMessage_Events()   {}
 func (*EventMessage_Updated) isEventMessage_Events()          {}
+func (*EventMessage_Held) isEventMessage_Events()             {}
+func (*EventMessage_Released) isEventMessage_Events()         {}
 
 func (m *EventMessage) GetEvents() isEventMessage_Events {
 	if m != nil {
@@ -2167,6 +2338,27 @@ func (m *EventMessage) GetUpdated() *JobUpdatedEvent {
 	return nil
 }
 
+func (m *EventMessage) GetHeld() *JobHeldEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Held); ok {
+		return x.Held
+	}
+	return nil
+}
+
+func (m *EventMessage) GetReleased() *JobReleasedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Released); ok {
+		return x.Released
+	}
+	return nil
+}
+
+func (m *EventMessage) GetDeduplicationKey() string {
+	if m != nil {
+		return m.DeduplicationKey
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2189,6 +2381,8 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessage_IngressInfo)(nil),
 		(*EventMessage_Reprioritizing)(nil),
 		(*EventMessage_Updated)(nil),
+		(*EventMessage_Held)(nil),
+		(*EventMessage_Released)(nil),
 	}
 }
 
@@ -2203,7 +2397,7 @@ type ContainerStatus struct {
 func (m *ContainerStatus) Reset()      { *m = ContainerStatus{} }
 func (*ContainerStatus) ProtoMessage() {}
 func (*ContainerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{20}
+	return fileDescriptor_7758595c3bb8cf56, []int{22}
 }
 func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2274,7 +2468,7 @@ type EventList struct {
 func (m *EventList) Reset()      { *m = EventList{} }
 func (*EventList) ProtoMessage() {}
 func (*EventList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{21}
+	return fileDescriptor_7758595c3bb8cf56, []int{23}
 }
 func (m *EventList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2319,7 +2513,7 @@ type EventStreamMessage struct {
 func (m *EventStreamMessage) Reset()      { *m = EventStreamMessage{} }
 func (*EventStreamMessage) ProtoMessage() {}
 func (*EventStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{22}
+	return fileDescriptor_7758595c3bb8cf56, []int{24}
 }
 func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2373,7 +2567,7 @@ type JobSetRequest struct {
 func (m *JobSetRequest) Reset()      { *m = JobSetRequest{} }
 func (*JobSetRequest) ProtoMessage() {}
 func (*JobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{23}
+	return fileDescriptor_7758595c3bb8cf56, []int{25}
 }
 func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2453,6 +2647,8 @@ func init() {
 	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
 	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
 	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
+	proto.RegisterType((*JobHeldEvent)(nil), "api.JobHeldEvent")
+	proto.RegisterType((*JobReleasedEvent)(nil), "api.JobReleasedEvent")
 	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
 	proto.RegisterType((*JobUpdatedEvent)(nil), "api.JobUpdatedEvent")
 	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
@@ -2465,124 +2661,130 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
 
 var fileDescriptor_7758595c3bb8cf56 = []byte{
-	// 1869 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xe7, 0x52, 0xe2, 0xd7, 0xa3, 0x44, 0x51, 0x63, 0x59, 0x59, 0xd3, 0xb6, 0xcc, 0x6e, 0x80,
-	0x40, 0x4d, 0x61, 0x32, 0xa5, 0x8b, 0xc0, 0x0d, 0xd2, 0xa0, 0x95, 0x4c, 0x97, 0x22, 0xa2, 0xc6,
-	0x5e, 0xd9, 0xa7, 0x1e, 0x88, 0xfd, 0x18, 0xd1, 0x23, 0x2d, 0x77, 0x36, 0xbb, 0xb3, 0xb2, 0xd4,
-	0x20, 0x40, 0x91, 0xbf, 0x20, 0x40, 0xd1, 0x53, 0x4f, 0xfd, 0x1f, 0x7a, 0x2a, 0x5a, 0xb4, 0xc7,
-	0x00, 0xbd, 0x04, 0xe8, 0x25, 0x2d, 0xd2, 0xa4, 0xb5, 0xf2, 0x6f, 0x14, 0x2d, 0x66, 0x66, 0x97,
-	0x9c, 0xa5, 0x28, 0x09, 0xfd, 0x42, 0x69, 0x21, 0x27, 0x71, 0xdf, 0xbc, 0x37, 0xf3, 0xde, 0x6f,
-	0xde, 0xc7, 0xbc, 0x27, 0xb8, 0x16, 0x1c, 0x0e, 0xdb, 0x56, 0x40, 0xda, 0xf8, 0x08, 0xfb, 0xac,
-	0x15, 0x84, 0x94, 0x51, 0xb4, 0x60, 0x05, 0xa4, 0x71, 0x67, 0x48, 0xe9, 0xd0, 0xc3, 0x6d, 0x41,
-	0xb2, 0xe3, 0xfd, 0x36, 0x23, 0x23, 0x1c, 0x31, 0x6b, 0x14, 0x48, 0xae, 0xc6, 0x58, 0xf4, 0xfd,
-	0x18, 0xc7, 0x38, 0x21, 0xde, 0x9c, 0x96, 0xc2, 0xa3, 0x80, 0x9d, 0x24, 0x8b, 0x77, 0x87, 0x84,
-	0x3d, 0x8b, 0xed, 0x96, 0x43, 0x47, 0xed, 0x21, 0x1d, 0xd2, 0x09, 0x17, 0xff, 0x12, 0x1f, 0xe2,
-	0x57, 0xc2, 0x7e, 0x2b, 0xd9, 0x8b, 0x9f, 0x61, 0xf9, 0x3e, 0x65, 0x16, 0x23, 0xd4, 0x8f, 0x92,
-	0xd5, 0xef, 0x1c, 0xde, 0x8f, 0x5a, 0x84, 0xf2, 0xd5, 0x91, 0xe5, 0x3c, 0x23, 0x3e, 0x0e, 0x4f,
-	0xda, 0xa9, 0x4a, 0x21, 0x8e, 0x68, 0x1c, 0x3a, 0xb8, 0x3d, 0xc4, 0x3e, 0x0e, 0x2d, 0x86, 0x5d,
-	0x29, 0x65, 0xfc, 0x5e, 0x83, 0xd5, 0x3e, 0xb5, 0xf7, 0x62, 0x7b, 0x44, 0x18, 0xc3, 0x6e, 0x97,
-	0x9b, 0x8d, 0xae, 0x43, 0xf1, 0x80, 0xda, 0x03, 0xe2, 0xea, 0x5a, 0x53, 0xdb, 0xac, 0x98, 0x85,
-	0x03, 0x6a, 0xef, 0xb8, 0xe8, 0x16, 0x00, 0x27, 0x47, 0x98, 0xf1, 0xa5, 0xbc, 0x58, 0x2a, 0x1f,
-	0x50, 0x7b, 0x0f, 0xb3, 0x1d, 0x17, 0xad, 0x41, 0x41, 0x58, 0xae, 0x2f, 0x48, 0x19, 0xf1, 0x81,
-	0xde, 0x81, 0x92, 0x13, 0x62, 0x7e, 0xa2, 0xbe, 0xd8, 0xd4, 0x36, 0xab, 0x9d, 0x46, 0x4b, 0x9a,
-	0xd1, 0x4a, 0x8d, 0x6d, 0x3d, 0x49, 0x81, 0xdc, 0x2a, 0x7f, 0xf2, 0xc5, 0x9d, 0xdc, 0xc7, 0x5f,
-	0xde, 0xd1, 0xcc, 0x54, 0x08, 0x35, 0x61, 0xe1, 0x80, 0xda, 0x7a, 0x41, 0xc8, 0x96, 0x5b, 0x56,
-	0x40, 0x5a, 0x7d, 0x6a, 0x6f, 0x2d, 0x72, 0x4e, 0x93, 0x2f, 0x19, 0xbf, 0xd0, 0xa0, 0xd6, 0xa7,
-	0xf6, 0x63, 0x7e, 0xdc, 0xdc, 0xe9, 0x6f, 0xfc, 0x41, 0x83, 0xf5, 0x3e, 0xb5, 0x1f, 0xc4, 0x81,
-	0x47, 0x1c, 0x8b, 0xe1, 0x87, 0x34, 0xf6, 0xe7, 0x0f, 0xe5, 0xd7, 0x60, 0x85, 0x86, 0x64, 0x48,
-	0x7c, 0xcb, 0x1b, 0x24, 0x3a, 0x15, 0xc4, 0xfe, 0xcb, 0x29, 0xb9, 0xcf, 0x75, 0x33, 0x7e, 0x2d,
-	0xb1, 0x7e, 0x17, 0x5b, 0xd1, 0x1c, 0xfa, 0xca, 0x6d, 0x00, 0xc7, 0x8b, 0x23, 0x86, 0xc3, 0x89,
-	0x01, 0x95, 0x84, 0xb2, 0xe3, 0x1a, 0x7f, 0xd2, 0xe0, 0x7a, 0xaa, 0xbc, 0x89, 0x59, 0x1c, 0xfa,
-	0x2f, 0x9d, 0x0d, 0x68, 0x1d, 0x8a, 0x21, 0xb6, 0x22, 0xea, 0xeb, 0x45, 0xb1, 0x94, 0x7c, 0x19,
-	0xbf, 0xd4, 0x60, 0x2d, 0xb5, 0xad, 0x7b, 0x1c, 0x90, 0x70, 0x0e, 0x43, 0xe1, 0x77, 0x79, 0x58,
-	0xe9, 0x53, 0xfb, 0x11, 0xf6, 0x5d, 0xe2, 0x0f, 0x5f, 0x36, 0xe4, 0x5f, 0x85, 0xe5, 0xc3, 0xd8,
-	0xc6, 0xa1, 0x8f, 0x19, 0x8e, 0x38, 0x87, 0xbc, 0x80, 0xa5, 0x09, 0x71, 0x47, 0xec, 0x11, 0x50,
-	0x77, 0xe0, 0xc7, 0x23, 0x1b, 0x87, 0x7a, 0xa9, 0xa9, 0x6d, 0x16, 0xcc, 0x4a, 0x40, 0xdd, 0x1f,
-	0x09, 0x02, 0xba, 0x01, 0x65, 0xb1, 0x6c, 0x8d, 0xb0, 0x5e, 0x16, 0xe2, 0x25, 0xbe, 0x68, 0x8d,
-	0x30, 0xdf, 0x3e, 0x5d, 0x8a, 0x02, 0xcb, 0xc1, 0x7a, 0x45, 0x6e, 0x9f, 0xac, 0x0b, 0x9a, 0xf1,
-	0xb9, 0x44, 0xd0, 0x8c, 0x7d, 0xff, 0xaa, 0x22, 0x78, 0x13, 0x2a, 0x3e, 0x75, 0xb1, 0xc4, 0xa8,
-	0x24, 0xd5, 0xe6, 0x04, 0x01, 0x52, 0x16, 0xde, 0xf2, 0x45, 0xf0, 0x56, 0x2e, 0x81, 0x17, 0x66,
-	0xc0, 0xfb, 0xd1, 0x22, 0x5c, 0xe3, 0x79, 0xce, 0x1f, 0x86, 0x38, 0x8a, 0x76, 0xfc, 0x7d, 0xfa,
-	0x35, 0xc4, 0x17, 0x40, 0x0c, 0x97, 0x40, 0x5c, 0x3d, 0x0b, 0x31, 0xfa, 0x31, 0xac, 0x12, 0x09,
-	0xef, 0xc0, 0x72, 0x5d, 0xfe, 0x17, 0x47, 0x7a, 0xa5, 0xb9, 0xb0, 0x59, 0xed, 0xb4, 0xd2, 0xe2,
-	0x3e, 0x8d, 0x7f, 0x2b, 0x21, 0xfc, 0x20, 0x15, 0xe8, 0xfa, 0x2c, 0x3c, 0x31, 0xeb, 0x64, 0x8a,
-	0xdc, 0xd8, 0x86, 0xeb, 0x33, 0x59, 0x51, 0x1d, 0x16, 0x0e, 0xf1, 0x89, 0xb8, 0xbd, 0x82, 0xc9,
-	0x7f, 0xf2, 0xdb, 0x39, 0xb2, 0xbc, 0x18, 0x27, 0xd7, 0x26, 0x3f, 0xde, 0xca, 0xdf, 0xd7, 0x8c,
-	0xbf, 0xe7, 0x41, 0xef, 0x53, 0xfb, 0xa9, 0x6f, 0xd9, 0x1e, 0x7e, 0x42, 0xf7, 0x9c, 0x67, 0xd8,
-	0x8d, 0x3d, 0x7c, 0x45, 0x0a, 0xc5, 0x59, 0x0f, 0x29, 0x5d, 0xe6, 0x21, 0xe5, 0x0b, 0x3d, 0xa4,
-	0xf2, 0x5f, 0xf6, 0x10, 0xe3, 0xcb, 0x45, 0xf1, 0xc4, 0x78, 0x68, 0x11, 0xef, 0xca, 0x94, 0x67,
-	0xd4, 0x05, 0xc0, 0xc7, 0x84, 0x0d, 0x1c, 0xea, 0xe2, 0x48, 0x2f, 0x09, 0x7f, 0x37, 0x52, 0x7f,
-	0x57, 0x4c, 0x6d, 0x75, 0x8f, 0x09, 0xdb, 0xe6, 0x4c, 0xc2, 0x71, 0xb7, 0xf2, 0xba, 0x66, 0x56,
-	0x70, 0x4a, 0x3b, 0x7b, 0x79, 0xe5, 0xcb, 0x2e, 0xaf, 0x72, 0xe1, 0xe5, 0xc1, 0x45, 0x97, 0xb7,
-	0x7c, 0xc9, 0xe5, 0xd5, 0x66, 0x84, 0xf7, 0x36, 0x20, 0x87, 0xfa, 0xcc, 0xe2, 0xdd, 0xc7, 0x20,
-	0x62, 0x16, 0x8b, 0x79, 0x7c, 0x57, 0x85, 0xbd, 0x6b, 0xc2, 0xde, 0xed, 0x74, 0x79, 0x4f, 0xac,
-	0x9a, 0xab, 0x4e, 0x96, 0x80, 0x23, 0xd4, 0x84, 0x82, 0x63, 0xc5, 0x11, 0xd6, 0x97, 0x9a, 0xda,
-	0x66, 0xad, 0x03, 0x52, 0x8e, 0x53, 0x4c, 0xb9, 0xd0, 0x78, 0x1b, 0x6a, 0x59, 0xa0, 0xd4, 0x08,
-	0xaf, 0xcc, 0x88, 0xf0, 0x82, 0x1a, 0xe1, 0x5f, 0xe4, 0x93, 0x9e, 0xc7, 0x71, 0x30, 0x76, 0x5f,
-	0x3e, 0x27, 0x9b, 0xfb, 0x3a, 0xfa, 0xab, 0xa2, 0xa8, 0xa3, 0x4f, 0x19, 0xf1, 0x48, 0x24, 0x9a,
-	0xd4, 0x2b, 0x09, 0x31, 0x85, 0xeb, 0xbb, 0xd6, 0xb1, 0x99, 0xb4, 0xd6, 0xd1, 0x43, 0x1a, 0x3e,
-	0xc2, 0x21, 0xa1, 0x6e, 0x12, 0xdf, 0xf7, 0xd2, 0xf8, 0x9e, 0xc6, 0xa1, 0x35, 0x53, 0x4a, 0x06,
-	0xbc, 0xec, 0x6b, 0x67, 0xef, 0xfb, 0xff, 0x4c, 0xcb, 0xc8, 0x87, 0x75, 0x46, 0x99, 0xe5, 0x0d,
-	0x9c, 0x78, 0x14, 0x7b, 0x16, 0x23, 0x47, 0x78, 0x10, 0x47, 0xd6, 0x90, 0x47, 0x29, 0xb7, 0xb6,
-	0x73, 0xae, 0xb5, 0x4f, 0xb8, 0xd8, 0xf6, 0x58, 0xea, 0x29, 0x17, 0x52, 0x8d, 0x5d, 0x63, 0x33,
-	0x18, 0x1a, 0xc7, 0xd0, 0x38, 0x1f, 0xa6, 0x19, 0xe1, 0xfe, 0x40, 0x0d, 0x77, 0xfe, 0x98, 0x90,
-	0xe3, 0x90, 0x96, 0x3a, 0x0e, 0x69, 0x05, 0x87, 0x43, 0xa1, 0x66, 0x3a, 0x0e, 0x69, 0x3d, 0x8e,
-	0x2d, 0x9f, 0x11, 0x76, 0xa2, 0xa4, 0x87, 0xc6, 0x73, 0xb8, 0x71, 0xae, 0xca, 0xff, 0xcb, 0x83,
-	0x8d, 0xaf, 0xe4, 0xa8, 0xc0, 0xc4, 0x41, 0x48, 0x68, 0x48, 0x18, 0xf9, 0xc9, 0x3c, 0x3e, 0xf2,
-	0xbf, 0x01, 0x4b, 0x3e, 0x7e, 0x3e, 0x48, 0x74, 0x3c, 0x11, 0xb1, 0xa3, 0x99, 0x55, 0x1f, 0x3f,
-	0x7f, 0x94, 0x90, 0xd0, 0x2d, 0xa8, 0x84, 0xf8, 0xfd, 0x18, 0x47, 0x8c, 0x86, 0x49, 0xe4, 0x4c,
-	0x08, 0xc6, 0xa9, 0x6c, 0xc3, 0x15, 0x33, 0xe7, 0x30, 0x05, 0xff, 0xc7, 0x56, 0xfe, 0x56, 0x03,
-	0xd4, 0xa7, 0xf6, 0xb6, 0xe5, 0x3b, 0xd8, 0xf3, 0xe6, 0xf1, 0x22, 0x33, 0xfa, 0x17, 0xa6, 0xf5,
-	0xff, 0x8d, 0x1c, 0x0c, 0x26, 0xfa, 0xcf, 0xe1, 0x0d, 0x5d, 0xac, 0xfe, 0x9f, 0xf3, 0x02, 0xfe,
-	0x27, 0x38, 0x1c, 0x11, 0xdf, 0x62, 0x57, 0xb4, 0xc8, 0xff, 0x0b, 0xe3, 0x86, 0x7f, 0xa3, 0x8e,
-	0x2b, 0xaf, 0xd9, 0x72, 0x66, 0xd8, 0xf4, 0xb9, 0x26, 0xc6, 0x10, 0x4f, 0x03, 0x77, 0x2e, 0x91,
-	0xbd, 0xd0, 0x33, 0xd2, 0x81, 0x72, 0xf1, 0xfc, 0x81, 0xf2, 0x3f, 0xca, 0xb0, 0x24, 0x8c, 0xda,
-	0xc5, 0x11, 0xcf, 0xfc, 0xe8, 0x4d, 0xa8, 0x44, 0xe9, 0x80, 0x5c, 0x98, 0x57, 0xed, 0xac, 0xa7,
-	0x82, 0xd9, 0xc9, 0x79, 0x2f, 0x67, 0x4e, 0x58, 0xd1, 0x5d, 0x28, 0x0a, 0x8b, 0xdc, 0xa4, 0x36,
-	0x5c, 0x4b, 0x85, 0x94, 0x59, 0x75, 0x2f, 0x67, 0x26, 0x4c, 0xe8, 0x21, 0xac, 0xb8, 0xe9, 0x98,
-	0x78, 0xb0, 0x4f, 0x63, 0xdf, 0xd5, 0xeb, 0x42, 0xee, 0x66, 0x2a, 0x37, 0x63, 0x8a, 0xdc, 0xcb,
-	0x99, 0x35, 0x37, 0x43, 0xe6, 0xc7, 0x7a, 0x62, 0x40, 0x2b, 0x60, 0x55, 0x8e, 0x55, 0xc6, 0xb6,
-	0xfc, 0x58, 0xc9, 0x84, 0xb6, 0xa1, 0x26, 0x7e, 0x0d, 0xc2, 0x64, 0x26, 0x3a, 0x46, 0x5d, 0x15,
-	0xcb, 0x0c, 0x4c, 0x7b, 0x39, 0x73, 0xd9, 0x53, 0xa9, 0xe8, 0xfb, 0x20, 0x09, 0x03, 0x2c, 0x87,
-	0x8f, 0xc9, 0xc0, 0xfe, 0x46, 0x66, 0x0f, 0x75, 0x30, 0xd9, 0xcb, 0x99, 0x4b, 0x9e, 0x42, 0x44,
-	0x6f, 0x40, 0x29, 0x90, 0x93, 0xc1, 0xe4, 0x6e, 0xd6, 0x52, 0x59, 0x75, 0x60, 0xd8, 0xcb, 0x99,
-	0x29, 0x1b, 0x97, 0x08, 0xe5, 0x24, 0x4c, 0xb8, 0xbe, 0x22, 0xa1, 0x0e, 0xc8, 0xb8, 0x44, 0xc2,
-	0x86, 0x76, 0x01, 0xc5, 0xa2, 0xaf, 0x1f, 0x30, 0x3a, 0x88, 0x92, 0xce, 0x5e, 0x38, 0x77, 0xb5,
-	0x73, 0x7b, 0xfc, 0x80, 0x99, 0xd5, 0xf9, 0xf7, 0x72, 0x66, 0x3d, 0x9e, 0x5a, 0xe0, 0x40, 0xef,
-	0x8b, 0xde, 0x4d, 0x44, 0x97, 0x02, 0xb4, 0xd2, 0xd1, 0x71, 0xa0, 0x25, 0x93, 0x74, 0xa3, 0xa4,
-	0xe7, 0x10, 0xf1, 0x96, 0x71, 0x23, 0xb5, 0x19, 0x91, 0x6e, 0x94, 0x50, 0xd0, 0x16, 0x2c, 0x87,
-	0x6a, 0xb1, 0x14, 0xef, 0x33, 0xe5, 0x7e, 0xce, 0x56, 0x52, 0x7e, 0x3f, 0x19, 0x11, 0xf4, 0x5d,
-	0x00, 0x67, 0x5c, 0x8a, 0x44, 0x63, 0x55, 0xed, 0xbc, 0x92, 0x6e, 0x30, 0x55, 0xa4, 0x7a, 0x39,
-	0x53, 0x61, 0xe6, 0x6a, 0x3b, 0x69, 0x15, 0x10, 0x4d, 0xa1, 0xa2, 0x76, 0xb6, 0x3c, 0x70, 0xb5,
-	0xc7, 0xac, 0xfc, 0x48, 0x36, 0x4e, 0xbf, 0xa2, 0x5b, 0x54, 0x8e, 0x9c, 0x4a, 0xcc, 0xfc, 0xc8,
-	0x09, 0x33, 0x7a, 0x1b, 0xaa, 0xf1, 0xe4, 0x19, 0xa9, 0xaf, 0x08, 0x59, 0xfd, 0xbc, 0x17, 0x66,
-	0x2f, 0x67, 0xaa, 0xec, 0xe8, 0x7b, 0xb0, 0x94, 0xce, 0x98, 0x88, 0xbf, 0x4f, 0xf5, 0xd5, 0xac,
-	0xf8, 0xf4, 0x78, 0x89, 0x8b, 0x93, 0x09, 0x0d, 0x75, 0xa1, 0x16, 0x66, 0x9e, 0x60, 0x3a, 0xca,
-	0x46, 0xe1, 0x8c, 0x07, 0x1a, 0x8f, 0xc2, 0xac, 0x10, 0xf7, 0xce, 0x58, 0x26, 0x48, 0xfd, 0x5a,
-	0xd6, 0x3b, 0xd5, 0xbc, 0xc9, 0xbd, 0x33, 0x61, 0xdb, 0x2a, 0x43, 0x51, 0xfc, 0xd7, 0x31, 0x32,
-	0x7e, 0xae, 0xc1, 0xca, 0x54, 0xa3, 0x8c, 0x10, 0x2c, 0x8a, 0x44, 0x2e, 0xd3, 0xab, 0xf8, 0x8d,
-	0x1a, 0x50, 0x4e, 0x87, 0x03, 0x49, 0x9b, 0x3b, 0xfe, 0x46, 0x3a, 0x94, 0x46, 0x32, 0x7f, 0x25,
-	0xd9, 0x35, 0xfd, 0x54, 0xd2, 0xfa, 0x62, 0x66, 0x48, 0x31, 0xee, 0xbb, 0x0b, 0xe7, 0xf4, 0xdd,
-	0xc6, 0x9b, 0x50, 0x11, 0x5a, 0xbf, 0x4b, 0x22, 0x86, 0xbe, 0x99, 0xaa, 0xab, 0x6b, 0xa2, 0x03,
-	0x58, 0x15, 0xfc, 0x6a, 0xe2, 0x34, 0x53, 0x7b, 0x1e, 0x03, 0x12, 0xf4, 0x3d, 0x16, 0x62, 0x6b,
-	0x94, 0xa6, 0xd5, 0x1a, 0xe4, 0xc7, 0xe5, 0x22, 0x4f, 0x5c, 0xf4, 0xad, 0x89, 0xc6, 0x32, 0x5f,
-	0xce, 0xd8, 0x31, 0xe5, 0x30, 0x22, 0x58, 0xee, 0x8b, 0x32, 0x62, 0xca, 0xcc, 0x7e, 0x66, 0xb7,
-	0x35, 0x28, 0x3c, 0xb7, 0x98, 0xf3, 0x4c, 0xec, 0x55, 0x36, 0xe5, 0x07, 0x7a, 0x0d, 0x56, 0xf6,
-	0x43, 0x3a, 0x1a, 0x24, 0xdb, 0xf0, 0xa2, 0x24, 0xd1, 0x59, 0xe6, 0xe4, 0xe4, 0x14, 0xb5, 0x32,
-	0x2d, 0x2a, 0x95, 0xe9, 0xf5, 0x77, 0xa0, 0x20, 0xf0, 0x40, 0x15, 0x28, 0x74, 0xc3, 0x90, 0x86,
-	0xf5, 0x1c, 0xaa, 0x42, 0xa9, 0x7b, 0x44, 0x1c, 0x86, 0xdd, 0xba, 0x86, 0x4a, 0xb0, 0xf0, 0xde,
-	0x7b, 0xbb, 0xf5, 0x3c, 0x5a, 0x83, 0xfa, 0x03, 0x6c, 0xb9, 0x1e, 0xf1, 0x71, 0xf7, 0x58, 0xc6,
-	0x71, 0x7d, 0xa1, 0xf3, 0x17, 0x0d, 0x0a, 0xb2, 0x5c, 0xde, 0x87, 0x9a, 0x89, 0x03, 0x1a, 0xb2,
-	0xdd, 0xd8, 0x63, 0x24, 0xf0, 0x30, 0xaa, 0x4d, 0x8c, 0xe5, 0xf0, 0x36, 0xd6, 0xcf, 0x14, 0xbd,
-	0xee, 0x28, 0x60, 0x27, 0xe8, 0x1e, 0x14, 0xa5, 0x24, 0x3a, 0x0b, 0xcf, 0xb9, 0x42, 0x18, 0x56,
-	0x7e, 0x88, 0x99, 0x04, 0x4c, 0x08, 0x44, 0x08, 0x8d, 0x53, 0xcf, 0x18, 0xc3, 0xc6, 0x2b, 0x93,
-	0x1d, 0x33, 0x57, 0x65, 0xbc, 0xfa, 0xd1, 0x1f, 0xbf, 0xfa, 0x59, 0xfe, 0xb6, 0xa1, 0xb7, 0x8f,
-	0xbe, 0xdd, 0x3e, 0xa0, 0xf6, 0xdd, 0x08, 0xb3, 0xf6, 0x07, 0x02, 0x94, 0x0f, 0xdb, 0x1f, 0x10,
-	0xf7, 0xc3, 0xb7, 0xb4, 0xd7, 0xdf, 0xd0, 0xb6, 0x9a, 0x9f, 0xfd, 0x6d, 0x23, 0xf7, 0xd3, 0x17,
-	0x1b, 0xda, 0x27, 0x2f, 0x36, 0xb4, 0x4f, 0x5f, 0x6c, 0x68, 0x7f, 0x7d, 0xb1, 0xa1, 0x7d, 0x7c,
-	0xba, 0x91, 0xfb, 0xf4, 0x74, 0x23, 0xf7, 0xd9, 0xe9, 0x46, 0xce, 0x2e, 0x0a, 0xc5, 0xee, 0xfd,
-	0x33, 0x00, 0x00, 0xff, 0xff, 0x52, 0x2d, 0xaf, 0x23, 0x68, 0x1f, 0x00, 0x00,
+	// 1960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcf, 0x6f, 0x23, 0x49,
+	0xf5, 0x77, 0x3b, 0x71, 0x6c, 0x3f, 0x27, 0x8e, 0x53, 0x93, 0x64, 0x7b, 0x3c, 0x33, 0x99, 0x7c,
+	0xbd, 0xd2, 0x97, 0x61, 0xd1, 0xd8, 0x4b, 0x06, 0xad, 0x86, 0xd5, 0xb2, 0x82, 0x64, 0x3c, 0x78,
+	0xcc, 0x86, 0x9d, 0xe9, 0xcc, 0x9c, 0x38, 0x58, 0xfd, 0xe3, 0xc5, 0xa9, 0xa4, 0xdd, 0xe5, 0xed,
+	0xae, 0xce, 0x24, 0xac, 0x56, 0x42, 0x1c, 0x39, 0xad, 0x84, 0x38, 0x71, 0xe2, 0xc4, 0x3f, 0x80,
+	0x84, 0xc4, 0x0f, 0xc1, 0x71, 0x25, 0x2e, 0x2b, 0x71, 0x59, 0xd0, 0xb2, 0x0b, 0x33, 0xfb, 0x6f,
+	0x20, 0xa1, 0xfa, 0xd1, 0x76, 0xb7, 0xe3, 0x24, 0x02, 0x81, 0x48, 0x22, 0x4e, 0x71, 0xbd, 0x7a,
+	0xaf, 0xea, 0xbd, 0x4f, 0xd5, 0xab, 0x57, 0xf5, 0xe9, 0xc0, 0xb5, 0xe1, 0x41, 0xbf, 0x65, 0x0f,
+	0x69, 0x0b, 0x0f, 0x31, 0xe0, 0xcd, 0x61, 0xc8, 0x38, 0x23, 0x33, 0xf6, 0x90, 0xd6, 0x6f, 0xf7,
+	0x19, 0xeb, 0xfb, 0xd8, 0x92, 0x22, 0x27, 0xde, 0x6d, 0x71, 0x3a, 0xc0, 0x88, 0xdb, 0x83, 0xa1,
+	0xd2, 0xaa, 0x8f, 0x4c, 0xdf, 0x8b, 0x31, 0x46, 0x2d, 0xbc, 0x31, 0x69, 0x85, 0x83, 0x21, 0x3f,
+	0xd6, 0x9d, 0x77, 0xfb, 0x94, 0xef, 0xc5, 0x4e, 0xd3, 0x65, 0x83, 0x56, 0x9f, 0xf5, 0xd9, 0x58,
+	0x4b, 0xb4, 0x64, 0x43, 0xfe, 0xd2, 0xea, 0x37, 0xf5, 0x58, 0x62, 0x0e, 0x3b, 0x08, 0x18, 0xb7,
+	0x39, 0x65, 0x41, 0xa4, 0x7b, 0xbf, 0x76, 0x70, 0x3f, 0x6a, 0x52, 0x26, 0x7a, 0x07, 0xb6, 0xbb,
+	0x47, 0x03, 0x0c, 0x8f, 0x5b, 0x89, 0x4b, 0x21, 0x46, 0x2c, 0x0e, 0x5d, 0x6c, 0xf5, 0x31, 0xc0,
+	0xd0, 0xe6, 0xe8, 0x29, 0xab, 0xc6, 0xef, 0x0d, 0x58, 0xea, 0x32, 0x67, 0x27, 0x76, 0x06, 0x94,
+	0x73, 0xf4, 0xda, 0x22, 0x6c, 0xb2, 0x02, 0x73, 0xfb, 0xcc, 0xe9, 0x51, 0xcf, 0x34, 0xd6, 0x8d,
+	0x3b, 0x65, 0xab, 0xb0, 0xcf, 0x9c, 0x47, 0x1e, 0xb9, 0x09, 0x20, 0xc4, 0x11, 0x72, 0xd1, 0x95,
+	0x97, 0x5d, 0xa5, 0x7d, 0xe6, 0xec, 0x20, 0x7f, 0xe4, 0x91, 0x65, 0x28, 0xc8, 0xc8, 0xcd, 0x19,
+	0x65, 0x23, 0x1b, 0xe4, 0x6d, 0x28, 0xba, 0x21, 0x8a, 0x19, 0xcd, 0xd9, 0x75, 0xe3, 0x4e, 0x65,
+	0xa3, 0xde, 0x54, 0x61, 0x34, 0x93, 0x60, 0x9b, 0x4f, 0x13, 0x20, 0x37, 0x4b, 0x1f, 0x7d, 0x76,
+	0x3b, 0xf7, 0xe1, 0xe7, 0xb7, 0x0d, 0x2b, 0x31, 0x22, 0xeb, 0x30, 0xb3, 0xcf, 0x1c, 0xb3, 0x20,
+	0x6d, 0x4b, 0x4d, 0x7b, 0x48, 0x9b, 0x5d, 0xe6, 0x6c, 0xce, 0x0a, 0x4d, 0x4b, 0x74, 0x35, 0x7e,
+	0x6a, 0x40, 0xb5, 0xcb, 0x9c, 0x27, 0x62, 0xba, 0x0b, 0xe7, 0x7f, 0xe3, 0x0f, 0x06, 0xac, 0x76,
+	0x99, 0xf3, 0x20, 0x1e, 0xfa, 0xd4, 0xb5, 0x39, 0x3e, 0x64, 0x71, 0x70, 0xf1, 0x50, 0xfe, 0x7f,
+	0x58, 0x64, 0x21, 0xed, 0xd3, 0xc0, 0xf6, 0x7b, 0xda, 0xa7, 0x82, 0x1c, 0x7f, 0x21, 0x11, 0x77,
+	0x85, 0x6f, 0x8d, 0x5f, 0x29, 0xac, 0xdf, 0x41, 0x3b, 0xba, 0x80, 0x7b, 0xe5, 0x16, 0x80, 0xeb,
+	0xc7, 0x11, 0xc7, 0x70, 0x1c, 0x40, 0x59, 0x4b, 0x1e, 0x79, 0x8d, 0x3f, 0x19, 0xb0, 0x92, 0x38,
+	0x6f, 0x21, 0x8f, 0xc3, 0xe0, 0xd2, 0xc5, 0x40, 0x56, 0x61, 0x2e, 0x44, 0x3b, 0x62, 0x81, 0x39,
+	0x27, 0xbb, 0x74, 0xab, 0xf1, 0x33, 0x03, 0x96, 0x93, 0xd8, 0xda, 0x47, 0x43, 0x1a, 0x5e, 0xc0,
+	0x54, 0xf8, 0x5d, 0x1e, 0x16, 0xbb, 0xcc, 0x79, 0x8c, 0x81, 0x47, 0x83, 0xfe, 0x65, 0x43, 0xfe,
+	0x55, 0x58, 0x38, 0x88, 0x1d, 0x0c, 0x03, 0xe4, 0x18, 0x09, 0x0d, 0xb5, 0x00, 0xf3, 0x63, 0xe1,
+	0x23, 0x39, 0xc6, 0x90, 0x79, 0xbd, 0x20, 0x1e, 0x38, 0x18, 0x9a, 0xc5, 0x75, 0xe3, 0x4e, 0xc1,
+	0x2a, 0x0f, 0x99, 0xf7, 0x5d, 0x29, 0x20, 0xd7, 0xa1, 0x24, 0xbb, 0xed, 0x01, 0x9a, 0x25, 0x69,
+	0x5e, 0x14, 0x9d, 0xf6, 0x00, 0xc5, 0xf0, 0x49, 0x57, 0x34, 0xb4, 0x5d, 0x34, 0xcb, 0x6a, 0x78,
+	0xdd, 0x2f, 0x65, 0x8d, 0x4f, 0x15, 0x82, 0x56, 0x1c, 0x04, 0x57, 0x15, 0xc1, 0x1b, 0x50, 0x0e,
+	0x98, 0x87, 0x0a, 0xa3, 0xa2, 0x72, 0x5b, 0x08, 0x24, 0x48, 0x59, 0x78, 0x4b, 0x67, 0xc1, 0x5b,
+	0x3e, 0x07, 0x5e, 0x98, 0x02, 0xef, 0xcf, 0x67, 0xe1, 0x9a, 0x38, 0xe7, 0x82, 0x7e, 0x88, 0x51,
+	0xf4, 0x28, 0xd8, 0x65, 0xff, 0x83, 0xf8, 0x0c, 0x88, 0xe1, 0x1c, 0x88, 0x2b, 0x27, 0x21, 0x26,
+	0xdf, 0x83, 0x25, 0xaa, 0xe0, 0xed, 0xd9, 0x9e, 0x27, 0xfe, 0x62, 0x64, 0x96, 0xd7, 0x67, 0xee,
+	0x54, 0x36, 0x9a, 0x49, 0x71, 0x9f, 0xc4, 0xbf, 0xa9, 0x05, 0xdf, 0x4a, 0x0c, 0xda, 0x01, 0x0f,
+	0x8f, 0xad, 0x1a, 0x9d, 0x10, 0x4b, 0x0f, 0x10, 0xc3, 0xde, 0x1e, 0x8b, 0xb8, 0xf4, 0x70, 0x5e,
+	0x7b, 0x80, 0x18, 0x76, 0xb4, 0xac, 0xbe, 0x05, 0x2b, 0x53, 0xc7, 0x23, 0x35, 0x98, 0x39, 0xc0,
+	0x63, 0xb9, 0xc4, 0x05, 0x4b, 0xfc, 0x14, 0x4b, 0x78, 0x68, 0xfb, 0x31, 0xea, 0xb5, 0x55, 0x8d,
+	0x37, 0xf3, 0xf7, 0x8d, 0xc6, 0xdf, 0xf3, 0x60, 0x76, 0x99, 0xf3, 0x2c, 0xb0, 0x1d, 0x1f, 0x9f,
+	0xb2, 0x1d, 0x77, 0x0f, 0xbd, 0xd8, 0xc7, 0x2b, 0x52, 0x4d, 0x4e, 0x6e, 0xa3, 0xe2, 0x79, 0xdb,
+	0xa8, 0x74, 0xe6, 0x36, 0x2a, 0xff, 0x9b, 0xb7, 0x51, 0xe3, 0xf3, 0x59, 0x79, 0x0f, 0x79, 0x68,
+	0x53, 0xff, 0xca, 0xd4, 0x70, 0xd2, 0x06, 0xc0, 0x23, 0xca, 0x7b, 0x2e, 0xf3, 0x30, 0x32, 0x8b,
+	0x32, 0x29, 0x1a, 0x49, 0x52, 0xa4, 0x42, 0x6d, 0xb6, 0x8f, 0x28, 0xdf, 0x62, 0x9e, 0xde, 0xb8,
+	0x9b, 0x79, 0xd3, 0xb0, 0xca, 0x98, 0xc8, 0x4e, 0x2e, 0x5e, 0xe9, 0xbc, 0xc5, 0x2b, 0x9f, 0xb9,
+	0x78, 0x70, 0xd6, 0xe2, 0x2d, 0x9c, 0xb3, 0x78, 0xd5, 0x29, 0x67, 0xc0, 0x16, 0x10, 0x97, 0x05,
+	0xdc, 0x16, 0x4f, 0x94, 0x5e, 0xc4, 0x6d, 0x1e, 0x8b, 0x43, 0xa0, 0x22, 0xe3, 0x5d, 0x96, 0xf1,
+	0x6e, 0x25, 0xdd, 0x3b, 0xb2, 0xd7, 0x5a, 0x72, 0xb3, 0x02, 0x8c, 0xc8, 0x3a, 0x14, 0x5c, 0x3b,
+	0x8e, 0x54, 0x8e, 0x57, 0x37, 0x40, 0xd9, 0x09, 0x89, 0xa5, 0x3a, 0xea, 0x6f, 0x41, 0x35, 0x0b,
+	0x54, 0x3a, 0xc3, 0xcb, 0x53, 0x32, 0xbc, 0x90, 0xce, 0xf0, 0xcf, 0xf2, 0xfa, 0x61, 0xe4, 0xba,
+	0x88, 0xde, 0xe5, 0xdb, 0x64, 0x17, 0xbe, 0xd8, 0xfe, 0x62, 0x4e, 0x16, 0xdb, 0x67, 0x9c, 0xfa,
+	0x34, 0x92, 0x2f, 0xd9, 0x2b, 0x09, 0x31, 0x83, 0x95, 0x6d, 0xfb, 0xc8, 0xd2, 0xef, 0xef, 0xe8,
+	0x21, 0x0b, 0x1f, 0x63, 0x48, 0x99, 0xa7, 0xf3, 0xfb, 0x5e, 0x92, 0xdf, 0x93, 0x38, 0x34, 0xa7,
+	0x5a, 0xa9, 0x84, 0x57, 0x8f, 0xdf, 0xe9, 0xe3, 0xfe, 0x37, 0x8f, 0x65, 0x12, 0xc0, 0x2a, 0x67,
+	0xdc, 0xf6, 0x7b, 0x6e, 0x3c, 0x88, 0x7d, 0x9b, 0xd3, 0x43, 0xec, 0xc5, 0x91, 0xdd, 0x17, 0x59,
+	0x2a, 0xa2, 0xdd, 0x38, 0x35, 0xda, 0xa7, 0xc2, 0x6c, 0x6b, 0x64, 0xf5, 0x4c, 0x18, 0xa5, 0x83,
+	0x5d, 0xe6, 0x53, 0x14, 0xea, 0x47, 0x50, 0x3f, 0x1d, 0xa6, 0x29, 0xe9, 0xfe, 0x20, 0x9d, 0xee,
+	0xe2, 0xc6, 0xa1, 0x38, 0x93, 0x66, 0x9a, 0x33, 0x69, 0x0e, 0x0f, 0xfa, 0xd2, 0xcd, 0x84, 0x33,
+	0x69, 0x3e, 0x89, 0xed, 0x80, 0x53, 0x7e, 0x9c, 0x3a, 0x1e, 0xea, 0xcf, 0xe1, 0xfa, 0xa9, 0x2e,
+	0xff, 0x27, 0x27, 0x6e, 0x7c, 0xa1, 0xf8, 0x04, 0x0b, 0x87, 0x21, 0x65, 0x21, 0xe5, 0xf4, 0xfb,
+	0x17, 0xf1, 0x25, 0xf0, 0x7f, 0x30, 0x1f, 0xe0, 0xf3, 0x9e, 0xf6, 0xf1, 0x58, 0xe6, 0x8e, 0x61,
+	0x55, 0x02, 0x7c, 0xfe, 0x58, 0x8b, 0xc8, 0x4d, 0x28, 0x87, 0xf8, 0x5e, 0x8c, 0x11, 0x67, 0xa1,
+	0xce, 0x9c, 0xb1, 0xa0, 0xf1, 0x52, 0xbd, 0xd5, 0x53, 0x61, 0xa2, 0x77, 0xf5, 0xa2, 0xfc, 0xad,
+	0x01, 0xa4, 0xcb, 0x9c, 0x2d, 0x3b, 0x70, 0xd1, 0xf7, 0x2f, 0xe2, 0x42, 0x66, 0xfc, 0x2f, 0x4c,
+	0xfa, 0xff, 0x1b, 0xc5, 0x1e, 0x6a, 0xff, 0xd1, 0xbb, 0x64, 0xee, 0xff, 0xd2, 0x80, 0xf9, 0x2e,
+	0x73, 0x3a, 0xe8, 0x5f, 0x36, 0xcf, 0x7f, 0x6d, 0x40, 0x4d, 0xa6, 0x87, 0x7f, 0x31, 0x99, 0xb8,
+	0xb3, 0xbd, 0xff, 0x73, 0x5e, 0x6e, 0xfb, 0xa7, 0x18, 0x0e, 0x68, 0x60, 0xf3, 0x2b, 0x7a, 0xb9,
+	0xfa, 0x27, 0xb8, 0xa0, 0x7f, 0xe1, 0xfe, 0x94, 0x7a, 0x45, 0x94, 0x32, 0x4c, 0xe0, 0xa7, 0x86,
+	0xe4, 0x88, 0x9e, 0x0d, 0x3d, 0x9b, 0x5f, 0xb6, 0x9d, 0x91, 0xb0, 0xfd, 0x73, 0xa7, 0xb3, 0xfd,
+	0x3f, 0x02, 0x98, 0x97, 0x41, 0x6d, 0x63, 0x24, 0x2a, 0x2e, 0x79, 0x03, 0xca, 0x51, 0xf2, 0xf5,
+	0x42, 0x86, 0x57, 0xd9, 0x58, 0x4d, 0x0c, 0xb3, 0x9f, 0x35, 0x3a, 0x39, 0x6b, 0xac, 0x4a, 0xee,
+	0xc2, 0x9c, 0x8c, 0xc8, 0xd3, 0x35, 0xf9, 0x5a, 0x62, 0x94, 0xfa, 0x90, 0xd0, 0xc9, 0x59, 0x5a,
+	0x89, 0x3c, 0x84, 0x45, 0x2f, 0xe1, 0xf0, 0x7b, 0xbb, 0x82, 0xc4, 0x37, 0x6b, 0xd2, 0xee, 0x46,
+	0x62, 0x37, 0x85, 0xe2, 0xef, 0xe4, 0xac, 0xaa, 0x97, 0x11, 0x8b, 0x69, 0x55, 0xce, 0x9a, 0x33,
+	0xd9, 0x69, 0x53, 0x9c, 0xba, 0x98, 0x56, 0x29, 0x91, 0x2d, 0xa8, 0xca, 0x5f, 0xbd, 0x50, 0x13,
+	0xd6, 0x23, 0xd4, 0xd3, 0x66, 0x19, 0x36, 0xbb, 0x93, 0xb3, 0x16, 0xfc, 0xb4, 0x94, 0x7c, 0x13,
+	0x94, 0xa0, 0x87, 0x8a, 0x19, 0xd6, 0x5f, 0x53, 0xae, 0x67, 0xc6, 0x48, 0xb3, 0xc6, 0x9d, 0x9c,
+	0x35, 0xef, 0xa7, 0x84, 0xe4, 0x75, 0x28, 0x0e, 0x15, 0x6d, 0xab, 0xd7, 0x66, 0x39, 0xb1, 0x4d,
+	0xb3, 0xb9, 0x9d, 0x9c, 0x95, 0xa8, 0x09, 0x8b, 0x50, 0xd1, 0x94, 0x66, 0x31, 0x6b, 0x91, 0x66,
+	0x2f, 0x85, 0x85, 0x56, 0x23, 0xdb, 0x40, 0x62, 0xc9, 0xa7, 0xf4, 0x38, 0xeb, 0x45, 0x9a, 0x51,
+	0x91, 0x9b, 0xbb, 0xb2, 0x71, 0x6b, 0x74, 0x71, 0x9c, 0xc6, 0xb8, 0x74, 0x72, 0x56, 0x2d, 0x9e,
+	0xe8, 0x10, 0x40, 0xef, 0xca, 0x37, 0xb3, 0x59, 0xce, 0x02, 0x9d, 0x7a, 0x49, 0x0b, 0xa0, 0x95,
+	0x92, 0xda, 0x46, 0xfa, 0xad, 0x67, 0xc2, 0xe4, 0x36, 0x4a, 0x3f, 0x02, 0xd5, 0x36, 0xd2, 0x12,
+	0xb2, 0x09, 0x0b, 0x61, 0xfa, 0x92, 0x62, 0x56, 0xb2, 0xeb, 0x73, 0xf2, 0x06, 0x23, 0xd6, 0x27,
+	0x63, 0x42, 0xbe, 0x0e, 0xe0, 0x8e, 0xae, 0x00, 0xf2, 0x41, 0x5b, 0xd9, 0x78, 0x25, 0x19, 0x60,
+	0xe2, 0x72, 0xd0, 0xc9, 0x59, 0x29, 0x65, 0xe1, 0xb6, 0x9b, 0x54, 0x5f, 0x73, 0x21, 0xeb, 0x76,
+	0xb6, 0x2c, 0x0b, 0xb7, 0x47, 0xaa, 0x62, 0x4a, 0x3e, 0x3a, 0x7e, 0xcd, 0x6a, 0x76, 0xca, 0x89,
+	0x83, 0x59, 0x4c, 0x39, 0x56, 0x26, 0x6f, 0x41, 0x25, 0x1e, 0x5f, 0xdf, 0xcd, 0x45, 0x69, 0x6b,
+	0x9e, 0x76, 0xb3, 0xef, 0xe4, 0xac, 0xb4, 0x3a, 0xf9, 0x06, 0xcc, 0x27, 0x04, 0x20, 0x0d, 0x76,
+	0x99, 0xb9, 0x94, 0x35, 0x9f, 0xe4, 0xfe, 0x84, 0x39, 0x1d, 0xcb, 0x48, 0x1b, 0xaa, 0x61, 0xe6,
+	0xea, 0x6b, 0x92, 0x6c, 0x16, 0x4e, 0xb9, 0x18, 0x8b, 0x2c, 0xcc, 0x1a, 0x89, 0xdd, 0x19, 0xab,
+	0x03, 0xd2, 0xbc, 0x96, 0xdd, 0x9d, 0xe9, 0x73, 0x53, 0xec, 0x4e, 0xad, 0x46, 0xbe, 0x04, 0xb3,
+	0x7b, 0xe8, 0x7b, 0xe6, 0x8a, 0x54, 0x5f, 0x4a, 0xd4, 0x47, 0x77, 0x87, 0x4e, 0xce, 0x92, 0x0a,
+	0xe4, 0x1e, 0x94, 0x42, 0x5d, 0x96, 0xcd, 0x55, 0xa9, 0xbc, 0x32, 0xf6, 0xcd, 0xcf, 0x24, 0xf9,
+	0x48, 0x91, 0x7c, 0x05, 0x96, 0x3c, 0x4c, 0x4e, 0x0a, 0xca, 0x82, 0x9e, 0x78, 0x3f, 0x2c, 0xcb,
+	0xd3, 0xb1, 0x96, 0xe9, 0xf8, 0x0e, 0x1e, 0x6f, 0x96, 0x60, 0x4e, 0x7e, 0x9d, 0x8e, 0x1a, 0x3f,
+	0x31, 0x60, 0x71, 0x82, 0x2b, 0x21, 0x04, 0x66, 0x65, 0x4d, 0x51, 0x27, 0xbd, 0xfc, 0x4d, 0xea,
+	0x50, 0x4a, 0xf8, 0x21, 0xcd, 0x74, 0x8c, 0xda, 0xc4, 0x84, 0xe2, 0x40, 0x1d, 0xa5, 0xfa, 0xa0,
+	0x4f, 0x9a, 0xa9, 0x0a, 0x33, 0x9b, 0xe1, 0xa9, 0x46, 0xd4, 0x4b, 0xe1, 0x14, 0xea, 0xa5, 0xf1,
+	0x06, 0x94, 0x65, 0x8c, 0xef, 0xd0, 0x88, 0x93, 0x2f, 0x27, 0xee, 0x9a, 0xc6, 0xfa, 0xcc, 0x08,
+	0xbb, 0xf4, 0x19, 0x6e, 0x25, 0xf1, 0x3c, 0x01, 0x22, 0xe5, 0x3b, 0x3c, 0x44, 0x7b, 0xa0, 0x7b,
+	0x49, 0x15, 0xf2, 0xa3, 0xca, 0x95, 0xa7, 0x02, 0xac, 0x91, 0xc7, 0xf9, 0xd4, 0x6a, 0x64, 0x46,
+	0x4c, 0x34, 0x1a, 0x11, 0x2c, 0x74, 0x65, 0x45, 0xb3, 0x54, 0x91, 0x39, 0x31, 0xda, 0x32, 0x14,
+	0x9e, 0xdb, 0xdc, 0xdd, 0x93, 0x63, 0x95, 0x2c, 0xd5, 0x10, 0x1f, 0x44, 0x77, 0x43, 0x36, 0xe8,
+	0xe9, 0x61, 0x44, 0x7d, 0x54, 0xe8, 0x2c, 0x08, 0xb1, 0x9e, 0x25, 0x5d, 0x24, 0x67, 0x53, 0x45,
+	0xf2, 0xb5, 0xb7, 0xa1, 0x20, 0xf1, 0x20, 0x65, 0x28, 0xb4, 0xc3, 0x90, 0x85, 0xb5, 0x1c, 0xa9,
+	0x40, 0xb1, 0x7d, 0x48, 0x5d, 0x8e, 0x5e, 0xcd, 0x20, 0x45, 0x98, 0x79, 0xf7, 0xdd, 0xed, 0x5a,
+	0x9e, 0x2c, 0x43, 0xed, 0x01, 0xda, 0x9e, 0x4f, 0x03, 0x6c, 0x1f, 0xa9, 0x23, 0xa5, 0x36, 0xb3,
+	0xf1, 0x17, 0x03, 0x0a, 0xaa, 0x72, 0xdf, 0x87, 0xaa, 0x85, 0x43, 0x16, 0xf2, 0xed, 0xd8, 0xe7,
+	0x74, 0xe8, 0x23, 0xa9, 0x8e, 0x83, 0x15, 0xf0, 0xd6, 0x57, 0x4f, 0xd4, 0xdf, 0xb6, 0xf8, 0x17,
+	0x03, 0x72, 0x0f, 0xe6, 0x94, 0x25, 0x39, 0x09, 0xcf, 0xa9, 0x46, 0x08, 0x8b, 0xdf, 0x46, 0xae,
+	0x00, 0x93, 0x06, 0x11, 0x21, 0xa3, 0x53, 0x70, 0x84, 0x61, 0xfd, 0x95, 0xf1, 0x88, 0x99, 0xa5,
+	0x6a, 0xbc, 0xfa, 0xc3, 0x3f, 0x7e, 0xf1, 0xe3, 0xfc, 0xad, 0x86, 0xd9, 0x3a, 0xfc, 0x6a, 0x6b,
+	0x9f, 0x39, 0x77, 0x23, 0xe4, 0xad, 0xf7, 0x25, 0x28, 0x1f, 0xb4, 0xde, 0xa7, 0xde, 0x07, 0x6f,
+	0x1a, 0xaf, 0xbd, 0x6e, 0x6c, 0xae, 0x7f, 0xf2, 0xb7, 0xb5, 0xdc, 0x0f, 0x5e, 0xac, 0x19, 0x1f,
+	0xbd, 0x58, 0x33, 0x3e, 0x7e, 0xb1, 0x66, 0xfc, 0xf5, 0xc5, 0x9a, 0xf1, 0xe1, 0xcb, 0xb5, 0xdc,
+	0xc7, 0x2f, 0xd7, 0x72, 0x9f, 0xbc, 0x5c, 0xcb, 0x39, 0x73, 0xd2, 0xb1, 0x7b, 0xff, 0x18, 0x00,
+	0x11, 0x55, 0xab, 0x18, 0x90, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3312,6 +3514,13 @@ func (m *JobIngressInfoEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PeerHostname) > 0 {
+		i -= len(m.PeerHostname)
+		copy(dAtA[i:], m.PeerHostname)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PeerHostname)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.PodNamespace) > 0 {
 		i -= len(m.PodNamespace)
 		copy(dAtA[i:], m.PodNamespace)
@@ -4115,7 +4324,7 @@ func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobHeldEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4125,53 +4334,20 @@ func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobHeldEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobHeldEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -4207,7 +4383,7 @@ func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobUpdatedEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobReleasedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4217,26 +4393,16 @@ func (m *JobUpdatedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobReleasedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobReleasedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
 	if len(m.Requestor) > 0 {
 		i -= len(m.Requestor)
 		copy(dAtA[i:], m.Requestor)
@@ -4244,12 +4410,12 @@ func (m *JobUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err23 != nil {
-		return 0, err23
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err22 != nil {
+		return 0, err22
 	}
-	i -= n23
-	i = encodeVarintEvent(dAtA, i, uint64(n23))
+	i -= n22
+	i = encodeVarintEvent(dAtA, i, uint64(n22))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4276,7 +4442,7 @@ func (m *JobUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMessage) Marshal() (dAtA []byte, err error) {
+func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4286,46 +4452,216 @@ func (m *EventMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Events != nil {
-		{
-			size := m.Events.Size()
-			i -= size
-			if _, err := m.Events.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventMessage_Submitted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Submitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Submitted != nil {
-		{
-			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x4a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintEvent(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintEvent(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Events != nil {
+		{
+			size := m.Events.Size()
+			i -= size
+			if _, err := m.Events.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.DeduplicationKey) > 0 {
+		i -= len(m.DeduplicationKey)
+		copy(dAtA[i:], m.DeduplicationKey)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DeduplicationKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMessage_Submitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Submitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Submitted != nil {
+		{
+			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -4715,6 +5051,52 @@ func (m *EventMessage_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessage_Held) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Held) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Held != nil {
+		{
+			size, err := m.Held.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Released) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Released) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Released != nil {
+		{
+			size, err := m.Released.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5208,6 +5590,10 @@ func (m *JobIngressInfoEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	l = len(m.PeerHostname)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -5552,7 +5938,7 @@ func (m *JobCancelledEvent) Size() (n int) {
 	return n
 }
 
-func (m *JobTerminatedEvent) Size() (n int) {
+func (m *JobHeldEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5572,33 +5958,41 @@ func (m *JobTerminatedEvent) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
 	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
+	l = len(m.Requestor)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = len(m.KubernetesId)
+	return n
+}
+
+func (m *JobReleasedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
-	}
-	l = len(m.Reason)
+	l = len(m.JobSetId)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = len(m.PodName)
+	l = len(m.Queue)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = len(m.PodNamespace)
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Requestor)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
 	return n
 }
 
-func (m *JobUpdatedEvent) Size() (n int) {
+func (m *JobTerminatedEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5618,24 +6012,74 @@ func (m *JobUpdatedEvent) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
 	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Requestor)
+	l = len(m.ClusterId)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = m.Job.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func (m *EventMessage) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
 	}
-	var l int
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Job.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Events != nil {
 		n += m.Events.Size()
 	}
+	l = len(m.DeduplicationKey)
+	if l > 0 {
+		n += 2 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -5867,6 +6311,30 @@ func (m *EventMessage_Updated) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessage_Held) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Held != nil {
+		l = m.Held.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Released) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Released != nil {
+		l = m.Released.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
 func (m *ContainerStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6102,6 +6570,7 @@ func (this *JobIngressInfoEvent) String() string {
 		`IngressAddresses:` + mapStringForIngressAddresses + `,`,
 		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
 		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`PeerHostname:` + fmt.Sprintf("%v", this.PeerHostname) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6282,6 +6751,34 @@ func (this *JobCancelledEvent) String() string {
 	}, "")
 	return s
 }
+func (this *JobHeldEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobHeldEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReleasedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReleasedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *JobTerminatedEvent) String() string {
 	if this == nil {
 		return "nil"
@@ -6322,6 +6819,7 @@ func (this *EventMessage) String() string {
 	}
 	s := strings.Join([]string{`&EventMessage{`,
 		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
+		`DeduplicationKey:` + fmt.Sprintf("%v", this.DeduplicationKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6516,6 +7014,26 @@ func (this *EventMessage_Updated) String() string {
 	}, "")
 	return s
 }
+func (this *EventMessage_Held) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Held{`,
+		`Held:` + strings.Replace(fmt.Sprintf("%v", this.Held), "JobHeldEvent", "JobHeldEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Released) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Released{`,
+		`Released:` + strings.Replace(fmt.Sprintf("%v", this.Released), "JobReleasedEvent", "JobReleasedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ContainerStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -8946,6 +9464,38 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -10732,16 +11282,557 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCumulativeUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalCumulativeUsage == nil {
+				m.TotalCumulativeUsage = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalCumulativeUsage[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCumulativeUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10751,120 +11842,23 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalCumulativeUsage == nil {
-				m.TotalCumulativeUsage = make(map[string]resource.Quantity)
-			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.TotalCumulativeUsage[mapkey] = *mapvalue
+			m.Requestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10887,7 +11881,7 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10910,10 +11904,10 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11046,17 +12040,6 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
@@ -11109,7 +12092,7 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11132,10 +12115,10 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11268,17 +12251,6 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
@@ -11331,7 +12303,7 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobHeldEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11354,10 +12326,10 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobHeldEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobHeldEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11542,7 +12514,7 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
+func (m *JobReleasedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11565,10 +12537,10 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReleasedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReleasedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13049,6 +14021,108 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Events = &EventMessage_Updated{v}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeduplicationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobHeldEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Held{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobReleasedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Released{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -71,6 +71,10 @@ func UnwrapEvent(message *EventMessage) (Event, error) {
 		return event.IngressInfo, nil
 	case *EventMessage_Updated:
 		return event.Updated, nil
+	case *EventMessage_Held:
+		return event.Held, nil
+	case *EventMessage_Released:
+		return event.Released, nil
 	}
 	return nil, fmt.Errorf("unknown event type: %s", reflect.TypeOf(message.Events))
 }
@@ -191,6 +195,18 @@ func Wrap(event Event) (*EventMessage, error) {
 				Updated: typed,
 			},
 		}, nil
+	case *JobHeldEvent:
+		return &EventMessage{
+			Events: &EventMessage_Held{
+				Held: typed,
+			},
+		}, nil
+	case *JobReleasedEvent:
+		return &EventMessage{
+			Events: &EventMessage_Released{
+				Released: typed,
+			},
+		}, nil
 	}
 	return nil, fmt.Errorf("unknown event type: %s", reflect.TypeOf(event))
 }
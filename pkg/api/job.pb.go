@@ -0,0 +1,1718 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/api/job.proto
+
+package api
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type JobState int32
+
+const (
+	// Not set, so a status without a state is not mistaken for a queued job
+	JobState_Unknown JobState = 0
+	JobState_Queued  JobState = 1
+	JobState_Leased  JobState = 2
+	JobState_Running JobState = 3
+	// Succeeded, failed or cancelled, finished jobs are kept for the job retention duration
+	JobState_Finished JobState = 4
+	JobState_Held     JobState = 5
+	// Waiting for its not_before time to be queued
+	JobState_Deferred JobState = 6
+)
+
+var JobState_name = map[int32]string{
+	0: "Unknown",
+	1: "Queued",
+	2: "Leased",
+	3: "Running",
+	4: "Finished",
+	5: "Held",
+	6: "Deferred",
+}
+
+var JobState_value = map[string]int32{
+	"Unknown":  0,
+	"Queued":   1,
+	"Leased":   2,
+	"Running":  3,
+	"Finished": 4,
+	"Held":     5,
+	"Deferred": 6,
+}
+
+func (x JobState) String() string {
+	return proto.EnumName(JobState_name, int32(x))
+}
+
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e45f6b75bfad87a4, []int{0}
+}
+
+type JobGetRequest struct {
+	JobIds []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+}
+
+func (m *JobGetRequest) Reset()      { *m = JobGetRequest{} }
+func (*JobGetRequest) ProtoMessage() {}
+func (*JobGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e45f6b75bfad87a4, []int{0}
+}
+func (m *JobGetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobGetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobGetRequest.Merge(m, src)
+}
+func (m *JobGetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobGetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobGetRequest proto.InternalMessageInfo
+
+func (m *JobGetRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
+	}
+	return nil
+}
+
+type JobGetResponse struct {
+	// Jobs which do not exist are omitted
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (m *JobGetResponse) Reset()      { *m = JobGetResponse{} }
+func (*JobGetResponse) ProtoMessage() {}
+func (*JobGetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e45f6b75bfad87a4, []int{1}
+}
+func (m *JobGetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobGetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobGetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobGetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobGetResponse.Merge(m, src)
+}
+func (m *JobGetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobGetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobGetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobGetResponse proto.InternalMessageInfo
+
+func (m *JobGetResponse) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+type JobStatusRequest struct {
+	JobIds []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+}
+
+func (m *JobStatusRequest) Reset()      { *m = JobStatusRequest{} }
+func (*JobStatusRequest) ProtoMessage() {}
+func (*JobStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e45f6b75bfad87a4, []int{2}
+}
+func (m *JobStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStatusRequest.Merge(m, src)
+}
+func (m *JobStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobStatusRequest proto.InternalMessageInfo
+
+func (m *JobStatusRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
+	}
+	return nil
+}
+
+type JobStatusResponse struct {
+	// Jobs which do not exist are omitted
+	Statuses []*JobStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (m *JobStatusResponse) Reset()      { *m = JobStatusResponse{} }
+func (*JobStatusResponse) ProtoMessage() {}
+func (*JobStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e45f6b75bfad87a4, []int{3}
+}
+func (m *JobStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStatusResponse.Merge(m, src)
+}
+func (m *JobStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobStatusResponse proto.InternalMessageInfo
+
+func (m *JobStatusResponse) GetStatuses() []*JobStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type JobStatus struct {
+	JobId    string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Queue    string   `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string   `protobuf:"bytes,3,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	State    JobState `protobuf:"varint,4,opt,name=state,proto3,enum=api.JobState" json:"state,omitempty"`
+	// Cluster the job is leased by, when leased or running
+	ClusterId     string     `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Started       *time.Time `protobuf:"bytes,6,opt,name=started,proto3,stdtime" json:"started,omitempty"`
+	RetryAttempts int32      `protobuf:"varint,7,opt,name=retry_attempts,json=retryAttempts,proto3" json:"retryAttempts,omitempty"`
+	// Reason the lease of the job was last returned, when retried
+	LastFailureReason string `protobuf:"bytes,8,opt,name=last_failure_reason,json=lastFailureReason,proto3" json:"lastFailureReason,omitempty"`
+	// Priority the job is ordered by within its queue including aging, when queued
+	EffectivePriority float64 `protobuf:"fixed64,9,opt,name=effective_priority,json=effectivePriority,proto3" json:"effectivePriority,omitempty"`
+	// Why the job is not leased because its queue or job set reached its max running jobs, when queued
+	ThrottledReason string `protobuf:"bytes,10,opt,name=throttled_reason,json=throttledReason,proto3" json:"throttledReason,omitempty"`
+}
+
+func (m *JobStatus) Reset()      { *m = JobStatus{} }
+func (*JobStatus) ProtoMessage() {}
+func (*JobStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e45f6b75bfad87a4, []int{4}
+}
+func (m *JobStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStatus.Merge(m, src)
+}
+func (m *JobStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobStatus proto.InternalMessageInfo
+
+func (m *JobStatus) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobStatus) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobStatus) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobStatus) GetState() JobState {
+	if m != nil {
+		return m.State
+	}
+	return JobState_Unknown
+}
+
+func (m *JobStatus) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *JobStatus) GetStarted() *time.Time {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *JobStatus) GetRetryAttempts() int32 {
+	if m != nil {
+		return m.RetryAttempts
+	}
+	return 0
+}
+
+func (m *JobStatus) GetLastFailureReason() string {
+	if m != nil {
+		return m.LastFailureReason
+	}
+	return ""
+}
+
+func (m *JobStatus) GetEffectivePriority() float64 {
+	if m != nil {
+		return m.EffectivePriority
+	}
+	return 0
+}
+
+func (m *JobStatus) GetThrottledReason() string {
+	if m != nil {
+		return m.ThrottledReason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("api.JobState", JobState_name, JobState_value)
+	proto.RegisterType((*JobGetRequest)(nil), "api.JobGetRequest")
+	proto.RegisterType((*JobGetResponse)(nil), "api.JobGetResponse")
+	proto.RegisterType((*JobStatusRequest)(nil), "api.JobStatusRequest")
+	proto.RegisterType((*JobStatusResponse)(nil), "api.JobStatusResponse")
+	proto.RegisterType((*JobStatus)(nil), "api.JobStatus")
+}
+
+func init() { proto.RegisterFile("pkg/api/job.proto", fileDescriptor_e45f6b75bfad87a4) }
+
+var fileDescriptor_e45f6b75bfad87a4 = []byte{
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcd, 0x4e, 0xdb, 0x4e,
+	0x14, 0xc5, 0x33, 0xe4, 0xcb, 0xb9, 0x40, 0x70, 0x86, 0xff, 0xbf, 0x58, 0x11, 0x35, 0x51, 0xaa,
+	0x4a, 0x29, 0x15, 0xb6, 0x9a, 0xee, 0x5b, 0x15, 0x21, 0x28, 0x51, 0x17, 0xad, 0x69, 0xbb, 0x8d,
+	0x6c, 0x7c, 0x63, 0x26, 0x24, 0x1e, 0xe3, 0x19, 0x53, 0xb1, 0xab, 0xba, 0xed, 0x06, 0xa9, 0xaf,
+	0xd0, 0x87, 0x61, 0x89, 0xd4, 0x0d, 0xab, 0x7e, 0x84, 0x3e, 0x48, 0xe5, 0xb1, 0x1d, 0x28, 0x9b,
+	0xee, 0x66, 0xce, 0x39, 0xf3, 0xbb, 0xe3, 0xb9, 0xd7, 0xd0, 0x8a, 0x8e, 0x03, 0xdb, 0x8d, 0x98,
+	0x3d, 0xe6, 0x9e, 0x15, 0xc5, 0x5c, 0x72, 0x5a, 0x76, 0x23, 0xd6, 0xde, 0x08, 0x38, 0x0f, 0x26,
+	0x68, 0x2b, 0xc9, 0x4b, 0x46, 0xb6, 0x64, 0x53, 0x14, 0xd2, 0x9d, 0x46, 0x59, 0xaa, 0xbd, 0x9e,
+	0x07, 0xd2, 0xb3, 0x6e, 0x18, 0x72, 0xe9, 0x4a, 0xc6, 0x43, 0x91, 0xbb, 0x5b, 0x01, 0x93, 0x47,
+	0x89, 0x67, 0x1d, 0xf2, 0xa9, 0x1d, 0xf0, 0x80, 0xdf, 0x70, 0xd2, 0x9d, 0xda, 0xa8, 0x55, 0x1e,
+	0x5f, 0x2d, 0x6e, 0x71, 0x92, 0x60, 0x82, 0x99, 0xd8, 0xed, 0xc1, 0xf2, 0x80, 0x7b, 0x7b, 0x28,
+	0x1d, 0x3c, 0x49, 0x50, 0x48, 0xba, 0x06, 0xf5, 0x31, 0xf7, 0x86, 0xcc, 0x17, 0x06, 0xe9, 0x94,
+	0x7b, 0x0d, 0xa7, 0x36, 0xe6, 0xde, 0xbe, 0x2f, 0xba, 0x16, 0x34, 0x8b, 0xa4, 0x88, 0x78, 0x28,
+	0x90, 0xae, 0x43, 0x65, 0xcc, 0xbd, 0x2c, 0xb7, 0xd8, 0xd7, 0x2c, 0x37, 0x62, 0xd6, 0x80, 0x7b,
+	0x8e, 0x52, 0xbb, 0x8f, 0x41, 0x1f, 0x70, 0xef, 0x40, 0xba, 0x32, 0x11, 0xff, 0x84, 0x3f, 0x87,
+	0xd6, 0xad, 0x70, 0xce, 0xdf, 0x04, 0x4d, 0x28, 0x05, 0x8b, 0x1a, 0xcd, 0xa2, 0x46, 0x9e, 0x9c,
+	0xfb, 0xdd, 0xcf, 0x65, 0x68, 0xcc, 0x75, 0xfa, 0x3f, 0xd4, 0xb2, 0x3a, 0x06, 0xe9, 0x90, 0x5e,
+	0xc3, 0xa9, 0xaa, 0x32, 0xf4, 0x3f, 0xa8, 0xaa, 0x6f, 0x37, 0x16, 0x32, 0x55, 0x6d, 0xe8, 0x3a,
+	0x40, 0x1a, 0x16, 0x28, 0xd3, 0x03, 0x65, 0x65, 0x69, 0x63, 0xee, 0x1d, 0xa0, 0xdc, 0xf7, 0xe9,
+	0x03, 0xa8, 0xa6, 0x45, 0xd0, 0xa8, 0x74, 0x48, 0xaf, 0xd9, 0x5f, 0xbe, 0x7d, 0x03, 0x74, 0x32,
+	0x8f, 0xde, 0x07, 0x38, 0x9c, 0x24, 0x42, 0x62, 0x9c, 0x22, 0xaa, 0x0a, 0xd1, 0xc8, 0x95, 0x7d,
+	0x9f, 0x3e, 0x83, 0xba, 0x90, 0x6e, 0x2c, 0xd1, 0x37, 0x6a, 0x1d, 0xd2, 0x5b, 0xec, 0xb7, 0xad,
+	0xac, 0xb1, 0x56, 0xd1, 0x31, 0xeb, 0x6d, 0xd1, 0xf9, 0x6d, 0xed, 0xe2, 0xfb, 0x06, 0x39, 0xff,
+	0xb1, 0x41, 0x9c, 0xe2, 0x10, 0x7d, 0x08, 0xcd, 0x18, 0x65, 0x7c, 0x36, 0x74, 0xa5, 0xc4, 0x69,
+	0x24, 0x85, 0x51, 0xef, 0x90, 0x5e, 0xd5, 0x59, 0x56, 0xea, 0x8b, 0x5c, 0xa4, 0x16, 0xac, 0x4e,
+	0x5c, 0x21, 0x87, 0x23, 0x97, 0x4d, 0x92, 0x18, 0x87, 0x31, 0xba, 0x82, 0x87, 0x86, 0xa6, 0xae,
+	0xd3, 0x4a, 0xad, 0xdd, 0xcc, 0x71, 0x94, 0x41, 0xb7, 0x80, 0xe2, 0x68, 0x84, 0x87, 0x92, 0x9d,
+	0xe2, 0x30, 0x8a, 0x19, 0x8f, 0x99, 0x3c, 0x33, 0x1a, 0x1d, 0xd2, 0x23, 0x4e, 0x6b, 0xee, 0xbc,
+	0xce, 0x0d, 0xfa, 0x08, 0x74, 0x79, 0x14, 0x73, 0x29, 0x27, 0xe8, 0x17, 0x6c, 0x50, 0xec, 0x95,
+	0xb9, 0x9e, 0x91, 0x37, 0x3d, 0xd0, 0x8a, 0x27, 0xa2, 0x8b, 0x50, 0x7f, 0x17, 0x1e, 0x87, 0xfc,
+	0x43, 0xa8, 0x97, 0x28, 0x40, 0xed, 0x4d, 0xfa, 0xe8, 0xbe, 0x4e, 0xd2, 0xf5, 0x2b, 0x74, 0x05,
+	0xfa, 0xfa, 0x42, 0x1a, 0x72, 0x92, 0x30, 0x64, 0x61, 0xa0, 0x97, 0xe9, 0x12, 0x68, 0xbb, 0x2c,
+	0x64, 0xe2, 0x08, 0x7d, 0xbd, 0x42, 0x35, 0xa8, 0xbc, 0xc4, 0x89, 0xaf, 0x57, 0x53, 0x7d, 0x07,
+	0x47, 0x18, 0xc7, 0xe8, 0xeb, 0xb5, 0xfe, 0x57, 0x02, 0x95, 0x01, 0xf7, 0x04, 0xdd, 0x81, 0xfa,
+	0x1e, 0x4a, 0xb5, 0xa4, 0x45, 0x77, 0x6e, 0x06, 0xba, 0xbd, 0xfa, 0x97, 0x96, 0x8d, 0x56, 0x57,
+	0xff, 0xf4, 0xed, 0xf7, 0x97, 0x05, 0xa0, 0x9a, 0x7d, 0xfa, 0x24, 0xfd, 0x2b, 0x05, 0x7d, 0x0f,
+	0x4b, 0x19, 0xa5, 0x18, 0xa1, 0x3b, 0xa3, 0x96, 0xd3, 0xee, 0xdd, 0x95, 0x73, 0xe0, 0x9a, 0x02,
+	0xb6, 0xe8, 0x4a, 0x01, 0xb4, 0xb3, 0xd1, 0xdc, 0xee, 0x5c, 0xfd, 0x32, 0x4b, 0x1f, 0x67, 0x26,
+	0xb9, 0x98, 0x99, 0xe4, 0x72, 0x66, 0x92, 0x9f, 0x33, 0x93, 0x9c, 0x5f, 0x9b, 0xa5, 0xcb, 0x6b,
+	0xb3, 0x74, 0x75, 0x6d, 0x96, 0xbc, 0x9a, 0x1a, 0x82, 0xa7, 0x7f, 0x06, 0x00, 0x1c, 0xf7, 0x1f,
+	0x49, 0x26, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// JobsClient is the client API for Jobs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type JobsClient interface {
+	GetJobs(ctx context.Context, in *JobGetRequest, opts ...grpc.CallOption) (*JobGetResponse, error)
+	GetJobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*JobStatusResponse, error)
+}
+
+type jobsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewJobsClient(cc *grpc.ClientConn) JobsClient {
+	return &jobsClient{cc}
+}
+
+func (c *jobsClient) GetJobs(ctx context.Context, in *JobGetRequest, opts ...grpc.CallOption) (*JobGetResponse, error) {
+	out := new(JobGetResponse)
+	err := c.cc.Invoke(ctx, "/api.Jobs/GetJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsClient) GetJobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*JobStatusResponse, error) {
+	out := new(JobStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.Jobs/GetJobStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobsServer is the server API for Jobs service.
+type JobsServer interface {
+	GetJobs(context.Context, *JobGetRequest) (*JobGetResponse, error)
+	GetJobStatus(context.Context, *JobStatusRequest) (*JobStatusResponse, error)
+}
+
+// UnimplementedJobsServer can be embedded to have forward compatible implementations.
+type UnimplementedJobsServer struct {
+}
+
+func (*UnimplementedJobsServer) GetJobs(ctx context.Context, req *JobGetRequest) (*JobGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (*UnimplementedJobsServer) GetJobStatus(ctx context.Context, req *JobStatusRequest) (*JobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+
+func RegisterJobsServer(s *grpc.Server, srv JobsServer) {
+	s.RegisterService(&_Jobs_serviceDesc, srv)
+}
+
+func _Jobs_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServer).GetJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Jobs/GetJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServer).GetJobs(ctx, req.(*JobGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jobs_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServer).GetJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Jobs/GetJobStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServer).GetJobStatus(ctx, req.(*JobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Jobs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Jobs",
+	HandlerType: (*JobsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetJobs",
+			Handler:    _Jobs_GetJobs_Handler,
+		},
+		{
+			MethodName: "GetJobStatus",
+			Handler:    _Jobs_GetJobStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/job.proto",
+}
+
+func (m *JobGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintJob(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobGetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobGetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobGetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJob(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintJob(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJob(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ThrottledReason) > 0 {
+		i -= len(m.ThrottledReason)
+		copy(dAtA[i:], m.ThrottledReason)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.ThrottledReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.EffectivePriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EffectivePriority))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if len(m.LastFailureReason) > 0 {
+		i -= len(m.LastFailureReason)
+		copy(dAtA[i:], m.LastFailureReason)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.LastFailureReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RetryAttempts != 0 {
+		i = encodeVarintJob(dAtA, i, uint64(m.RetryAttempts))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Started != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Started, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintJob(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = encodeVarintJob(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintJob(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJob(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovJob(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobGetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovJob(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovJob(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovJob(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovJob(uint64(m.State))
+	}
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.Started != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started)
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.RetryAttempts != 0 {
+		n += 1 + sovJob(uint64(m.RetryAttempts))
+	}
+	l = len(m.LastFailureReason)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.EffectivePriority != 0 {
+		n += 9
+	}
+	l = len(m.ThrottledReason)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	return n
+}
+
+func sovJob(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozJob(x uint64) (n int) {
+	return sovJob(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *JobGetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobGetRequest{`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobGetResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobs := "[]*Job{"
+	for _, f := range this.Jobs {
+		repeatedStringForJobs += strings.Replace(fmt.Sprintf("%v", f), "Job", "Job", 1) + ","
+	}
+	repeatedStringForJobs += "}"
+	s := strings.Join([]string{`&JobGetResponse{`,
+		`Jobs:` + repeatedStringForJobs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobStatusRequest{`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStatuses := "[]*JobStatus{"
+	for _, f := range this.Statuses {
+		repeatedStringForStatuses += strings.Replace(f.String(), "JobStatus", "JobStatus", 1) + ","
+	}
+	repeatedStringForStatuses += "}"
+	s := strings.Join([]string{`&JobStatusResponse{`,
+		`Statuses:` + repeatedStringForStatuses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobStatus{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Started:` + strings.Replace(fmt.Sprintf("%v", this.Started), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RetryAttempts:` + fmt.Sprintf("%v", this.RetryAttempts) + `,`,
+		`LastFailureReason:` + fmt.Sprintf("%v", this.LastFailureReason) + `,`,
+		`EffectivePriority:` + fmt.Sprintf("%v", this.EffectivePriority) + `,`,
+		`ThrottledReason:` + fmt.Sprintf("%v", this.ThrottledReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringJob(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JobGetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobGetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobGetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobGetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &Job{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &JobStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Started, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAttempts", wireType)
+			}
+			m.RetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAttempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastFailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectivePriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EffectivePriority = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThrottledReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipJob(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowJob
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthJob
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupJob
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthJob
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthJob        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowJob          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupJob = fmt.Errorf("proto: unexpected end of group")
+)
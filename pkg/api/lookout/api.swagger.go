@@ -86,6 +86,38 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/api/v1/lookout/jobstats\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Lookout\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobStats\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetJobStatsRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetJobStatsResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/api/v1/lookout/overview\": {\n" +
 		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
@@ -142,6 +174,20 @@ func SwaggerJsonTemplate() string {
 		"    \"apiJob\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"allowedClusters\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Clusters the job can run on, in order of preference, any cluster when empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"allowedPools\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Pools the job can run in, in order of preference, any pool when empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"annotations\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
@@ -167,6 +213,11 @@ func SwaggerJsonTemplate() string {
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"jobSetMaxRunning\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"title\": \"Maximum number of jobs of the job set leased or running at once, set from the submit request\"\n" +
+		"        },\n" +
 		"        \"labels\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
@@ -176,9 +227,16 @@ func SwaggerJsonTemplate() string {
 		"        \"namespace\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"notBefore\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
 		"        \"owner\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"peerDiscovery\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
 		"        \"podSpec\": {\n" +
 		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
 		"        },\n" +
@@ -233,6 +291,47 @@ func SwaggerJsonTemplate() string {
 		"      \"title\": \"Type represents the stored type of IntOrString.\",\n" +
 		"      \"x-go-package\": \"k8s.io/apimachinery/pkg/util/intstr\"\n" +
 		"    },\n" +
+		"    \"lookoutContainerInfo\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cause\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"exitCode\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutDurationPercentiles\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"max\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"p50\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"p90\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"p95\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"p99\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"lookoutDurationStats\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -281,12 +380,84 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"lookoutGetJobStatsRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"bucket\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"One of \\\"hour\\\" or \\\"day\\\", defaults to \\\"hour\\\"\"\n" +
+		"        },\n" +
+		"        \"from\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"Defaults to one day (hourly buckets) or 30 days (daily buckets) before to\"\n" +
+		"        },\n" +
+		"        \"groupBy\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"One of \\\"queue\\\", \\\"cluster\\\" or \\\"owner\\\", statistics cover all jobs when empty\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Only jobs in this queue when not empty\"\n" +
+		"        },\n" +
+		"        \"to\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"Defaults to now\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetJobStatsResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"stats\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutJobStats\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"lookoutGetJobsRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"cluster\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"cursor\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Opaque value returned as next_cursor by a previous call with the same filters and ordering, takes precedence over skip\"\n" +
+		"        },\n" +
+		"        \"descending\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"failureCauses\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Jobs with a failed run for any of the causes, see RunInfo.cause\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"failureReason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"finishedAfter\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"finishedBefore\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"jobLabels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"jobSetIds\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -302,6 +473,13 @@ func SwaggerJsonTemplate() string {
 		"        \"newestFirst\": {\n" +
 		"          \"type\": \"boolean\"\n" +
 		"        },\n" +
+		"        \"node\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"orderBy\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"One of \\\"priority\\\" or \\\"duration\\\", jobs are ordered by job id (see newest_first) when empty\"\n" +
+		"        },\n" +
 		"        \"owner\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -312,6 +490,22 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int64\"\n" +
 		"        },\n" +
+		"        \"startedAfter\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"startedBefore\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"submittedAfter\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"submittedBefore\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
 		"        \"take\": {\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int64\"\n" +
@@ -332,6 +526,9 @@ func SwaggerJsonTemplate() string {
 		"          \"items\": {\n" +
 		"            \"$ref\": \"#/definitions/lookoutJobInfo\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"nextCursor\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -342,6 +539,11 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"effectivePriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\",\n" +
+		"          \"title\": \"Priority the job is ordered by within its queue including aging, when queued\"\n" +
+		"        },\n" +
 		"        \"job\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJob\"\n" +
 		"        },\n" +
@@ -356,6 +558,10 @@ func SwaggerJsonTemplate() string {
 		"          \"items\": {\n" +
 		"            \"$ref\": \"#/definitions/lookoutRunInfo\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"throttledReason\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Why the job is not leased because its queue or job set reached its max running jobs, when queued\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -400,6 +606,42 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"lookoutJobStats\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Queue wait covers jobs which started running within the bucket,\\nrun time, failure rate and throughput cover jobs which finished within the bucket\",\n" +
+		"      \"properties\": {\n" +
+		"        \"bucketStart\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"failureRate\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"group\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Queue, cluster or owner the statistics are for, see GetJobStatsRequest.group_by\"\n" +
+		"        },\n" +
+		"        \"jobsFailed\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"jobsStarted\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"jobsSucceeded\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"queueWait\": {\n" +
+		"          \"$ref\": \"#/definitions/lookoutDurationPercentiles\"\n" +
+		"        },\n" +
+		"        \"runTime\": {\n" +
+		"          \"$ref\": \"#/definitions/lookoutDurationPercentiles\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"lookoutQueueInfo\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -435,9 +677,19 @@ func SwaggerJsonTemplate() string {
 		"    \"lookoutRunInfo\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"cause\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"One of \\\"Error\\\", \\\"Evicted\\\", \\\"OOM\\\" or \\\"DeadlineExceeded\\\" for failed runs\"\n" +
+		"        },\n" +
 		"        \"cluster\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"containers\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutContainerInfo\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
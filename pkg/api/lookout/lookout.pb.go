@@ -5,6 +5,7 @@ package lookout
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -87,6 +88,10 @@ type JobInfo struct {
 	Cancelled *time.Time `protobuf:"bytes,3,opt,name=cancelled,proto3,stdtime" json:"cancelled,omitempty"`
 	JobState  string     `protobuf:"bytes,4,opt,name=job_state,json=jobState,proto3" json:"jobState,omitempty"`
 	JobJson   string     `protobuf:"bytes,5,opt,name=job_json,json=jobJson,proto3" json:"jobJson,omitempty"`
+	// Priority the job is ordered by within its queue including aging, when queued
+	EffectivePriority float64 `protobuf:"fixed64,6,opt,name=effective_priority,json=effectivePriority,proto3" json:"effectivePriority,omitempty"`
+	// Why the job is not leased because its queue or job set reached its max running jobs, when queued
+	ThrottledReason string `protobuf:"bytes,7,opt,name=throttled_reason,json=throttledReason,proto3" json:"throttledReason,omitempty"`
 }
 
 func (m *JobInfo) Reset()      { *m = JobInfo{} }
@@ -156,6 +161,20 @@ func (m *JobInfo) GetJobJson() string {
 	return ""
 }
 
+func (m *JobInfo) GetEffectivePriority() float64 {
+	if m != nil {
+		return m.EffectivePriority
+	}
+	return 0
+}
+
+func (m *JobInfo) GetThrottledReason() string {
+	if m != nil {
+		return m.ThrottledReason
+	}
+	return ""
+}
+
 type RunInfo struct {
 	K8SId            string     `protobuf:"bytes,1,opt,name=k8s_id,json=k8sId,proto3" json:"k8sId,omitempty"`
 	Cluster          string     `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
@@ -168,6 +187,9 @@ type RunInfo struct {
 	PodNumber        int32      `protobuf:"varint,9,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
 	RunState         string     `protobuf:"bytes,10,opt,name=run_state,json=runState,proto3" json:"runState,omitempty"`
 	UnableToSchedule bool       `protobuf:"varint,11,opt,name=unable_to_schedule,json=unableToSchedule,proto3" json:"unableToSchedule,omitempty"`
+	// One of "Error", "Evicted", "OOM" or "DeadlineExceeded" for failed runs
+	Cause      string           `protobuf:"bytes,12,opt,name=cause,proto3" json:"cause,omitempty"`
+	Containers []*ContainerInfo `protobuf:"bytes,13,rep,name=containers,proto3" json:"containers,omitempty"`
 }
 
 func (m *RunInfo) Reset()      { *m = RunInfo{} }
@@ -279,6 +301,95 @@ func (m *RunInfo) GetUnableToSchedule() bool {
 	return false
 }
 
+func (m *RunInfo) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *RunInfo) GetContainers() []*ContainerInfo {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+type ContainerInfo struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExitCode int32  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exitCode,omitempty"`
+	Message  string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cause    string `protobuf:"bytes,5,opt,name=cause,proto3" json:"cause,omitempty"`
+}
+
+func (m *ContainerInfo) Reset()      { *m = ContainerInfo{} }
+func (*ContainerInfo) ProtoMessage() {}
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{3}
+}
+func (m *ContainerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContainerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerInfo.Merge(m, src)
+}
+func (m *ContainerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerInfo proto.InternalMessageInfo
+
+func (m *ContainerInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ContainerInfo) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
 type QueueInfo struct {
 	Queue                  string          `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 	JobsQueued             uint32          `protobuf:"varint,2,opt,name=jobs_queued,json=jobsQueued,proto3" json:"jobsQueued,omitempty"`
@@ -293,7 +404,7 @@ type QueueInfo struct {
 func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
 func (*QueueInfo) ProtoMessage() {}
 func (*QueueInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{3}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{4}
 }
 func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +505,7 @@ type JobSetInfo struct {
 func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
 func (*JobSetInfo) ProtoMessage() {}
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{4}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{5}
 }
 func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +616,7 @@ type DurationStats struct {
 func (m *DurationStats) Reset()      { *m = DurationStats{} }
 func (*DurationStats) ProtoMessage() {}
 func (*DurationStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{5}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{6}
 }
 func (m *DurationStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +696,7 @@ type GetJobSetsRequest struct {
 func (m *GetJobSetsRequest) Reset()      { *m = GetJobSetsRequest{} }
 func (*GetJobSetsRequest) ProtoMessage() {}
 func (*GetJobSetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{6}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{7}
 }
 func (m *GetJobSetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +753,7 @@ type GetJobSetsResponse struct {
 func (m *GetJobSetsResponse) Reset()      { *m = GetJobSetsResponse{} }
 func (*GetJobSetsResponse) ProtoMessage() {}
 func (*GetJobSetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{7}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{8}
 }
 func (m *GetJobSetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,12 +799,29 @@ type GetJobsRequest struct {
 	JobId           string            `protobuf:"bytes,7,opt,name=jobId,proto3" json:"jobId,omitempty"`
 	Owner           string            `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 	UserAnnotations map[string]string `protobuf:"bytes,9,rep,name=user_annotations,json=userAnnotations,proto3" json:"userAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	JobLabels       map[string]string `protobuf:"bytes,10,rep,name=job_labels,json=jobLabels,proto3" json:"jobLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cluster         string            `protobuf:"bytes,11,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Node            string            `protobuf:"bytes,12,opt,name=node,proto3" json:"node,omitempty"`
+	FailureReason   string            `protobuf:"bytes,13,opt,name=failure_reason,json=failureReason,proto3" json:"failureReason,omitempty"`
+	SubmittedAfter  *time.Time        `protobuf:"bytes,14,opt,name=submitted_after,json=submittedAfter,proto3,stdtime" json:"submittedAfter,omitempty"`
+	SubmittedBefore *time.Time        `protobuf:"bytes,15,opt,name=submitted_before,json=submittedBefore,proto3,stdtime" json:"submittedBefore,omitempty"`
+	StartedAfter    *time.Time        `protobuf:"bytes,16,opt,name=started_after,json=startedAfter,proto3,stdtime" json:"startedAfter,omitempty"`
+	StartedBefore   *time.Time        `protobuf:"bytes,17,opt,name=started_before,json=startedBefore,proto3,stdtime" json:"startedBefore,omitempty"`
+	FinishedAfter   *time.Time        `protobuf:"bytes,18,opt,name=finished_after,json=finishedAfter,proto3,stdtime" json:"finishedAfter,omitempty"`
+	FinishedBefore  *time.Time        `protobuf:"bytes,19,opt,name=finished_before,json=finishedBefore,proto3,stdtime" json:"finishedBefore,omitempty"`
+	// One of "priority" or "duration", jobs are ordered by job id (see newest_first) when empty
+	OrderBy    string `protobuf:"bytes,20,opt,name=order_by,json=orderBy,proto3" json:"orderBy,omitempty"`
+	Descending bool   `protobuf:"varint,21,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Opaque value returned as next_cursor by a previous call with the same filters and ordering, takes precedence over skip
+	Cursor string `protobuf:"bytes,22,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Jobs with a failed run for any of the causes, see RunInfo.cause
+	FailureCauses []string `protobuf:"bytes,23,rep,name=failure_causes,json=failureCauses,proto3" json:"failureCauses,omitempty"`
 }
 
 func (m *GetJobsRequest) Reset()      { *m = GetJobsRequest{} }
 func (*GetJobsRequest) ProtoMessage() {}
 func (*GetJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{8}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{9}
 }
 func (m *GetJobsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,14 +913,113 @@ func (m *GetJobsRequest) GetUserAnnotations() map[string]string {
 	return nil
 }
 
+func (m *GetJobsRequest) GetJobLabels() map[string]string {
+	if m != nil {
+		return m.JobLabels
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetSubmittedAfter() *time.Time {
+	if m != nil {
+		return m.SubmittedAfter
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetSubmittedBefore() *time.Time {
+	if m != nil {
+		return m.SubmittedBefore
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetStartedAfter() *time.Time {
+	if m != nil {
+		return m.StartedAfter
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetStartedBefore() *time.Time {
+	if m != nil {
+		return m.StartedBefore
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetFinishedAfter() *time.Time {
+	if m != nil {
+		return m.FinishedAfter
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetFinishedBefore() *time.Time {
+	if m != nil {
+		return m.FinishedBefore
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *GetJobsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetFailureCauses() []string {
+	if m != nil {
+		return m.FailureCauses
+	}
+	return nil
+}
+
 type GetJobsResponse struct {
-	JobInfos []*JobInfo `protobuf:"bytes,1,rep,name=job_infos,json=jobInfos,proto3" json:"jobInfos,omitempty"`
+	JobInfos   []*JobInfo `protobuf:"bytes,1,rep,name=job_infos,json=jobInfos,proto3" json:"jobInfos,omitempty"`
+	NextCursor string     `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"nextCursor,omitempty"`
 }
 
 func (m *GetJobsResponse) Reset()      { *m = GetJobsResponse{} }
 func (*GetJobsResponse) ProtoMessage() {}
 func (*GetJobsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{9}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{10}
 }
 func (m *GetJobsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,183 +1055,551 @@ func (m *GetJobsResponse) GetJobInfos() []*JobInfo {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*SystemOverview)(nil), "lookout.SystemOverview")
-	proto.RegisterType((*JobInfo)(nil), "lookout.JobInfo")
-	proto.RegisterType((*RunInfo)(nil), "lookout.RunInfo")
-	proto.RegisterType((*QueueInfo)(nil), "lookout.QueueInfo")
-	proto.RegisterType((*JobSetInfo)(nil), "lookout.JobSetInfo")
-	proto.RegisterType((*DurationStats)(nil), "lookout.DurationStats")
-	proto.RegisterType((*GetJobSetsRequest)(nil), "lookout.GetJobSetsRequest")
-	proto.RegisterType((*GetJobSetsResponse)(nil), "lookout.GetJobSetsResponse")
-	proto.RegisterType((*GetJobsRequest)(nil), "lookout.GetJobsRequest")
-	proto.RegisterMapType((map[string]string)(nil), "lookout.GetJobsRequest.UserAnnotationsEntry")
-	proto.RegisterType((*GetJobsResponse)(nil), "lookout.GetJobsResponse")
+func (m *GetJobsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("pkg/api/lookout/lookout.proto", fileDescriptor_6ee7620a6fb9cfb1) }
-
-var fileDescriptor_6ee7620a6fb9cfb1 = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
-	0x10, 0x36, 0xf5, 0xe6, 0x28, 0x4e, 0x9c, 0x8d, 0x6b, 0x33, 0x4a, 0x22, 0x2b, 0x44, 0x0b, 0xb8,
-	0x41, 0x22, 0xc3, 0x36, 0x8a, 0x1a, 0x46, 0x50, 0xb8, 0x46, 0x93, 0xc2, 0x46, 0x5b, 0xb7, 0x54,
-	0x8a, 0x9e, 0x02, 0x81, 0x14, 0x57, 0x32, 0x25, 0x6a, 0x57, 0xe6, 0x2e, 0x65, 0xe8, 0x56, 0xf4,
-	0x17, 0x04, 0xe8, 0x5f, 0xe8, 0xb9, 0x87, 0xde, 0xfa, 0x0b, 0x9a, 0x63, 0x80, 0x5e, 0x72, 0xea,
-	0xc3, 0xee, 0x9f, 0xe8, 0xad, 0xd8, 0x07, 0xa9, 0x87, 0x1d, 0x19, 0x46, 0x4f, 0xdc, 0x9d, 0xf9,
-	0xe6, 0xb1, 0xf3, 0xcd, 0x2c, 0x17, 0x1e, 0x0c, 0x7a, 0x9d, 0x0d, 0x77, 0x10, 0x6c, 0x84, 0x94,
-	0xf6, 0x68, 0xcc, 0x93, 0x6f, 0x7d, 0x10, 0x51, 0x4e, 0x51, 0x51, 0x6f, 0x2b, 0x6b, 0x1d, 0x4a,
-	0x3b, 0x21, 0xde, 0x90, 0x62, 0x2f, 0x6e, 0x6f, 0xf0, 0xa0, 0x8f, 0x19, 0x77, 0xfb, 0x03, 0x85,
-	0xac, 0x54, 0x67, 0x01, 0x7e, 0x1c, 0xb9, 0x3c, 0xa0, 0x44, 0xeb, 0xef, 0xcd, 0xea, 0x71, 0x7f,
-	0xc0, 0x47, 0x5a, 0x79, 0x5f, 0x2b, 0x45, 0x22, 0x2e, 0x21, 0x94, 0x4b, 0x4b, 0xa6, 0xb5, 0x4f,
-	0x3a, 0x01, 0x3f, 0x8e, 0xbd, 0x7a, 0x8b, 0xf6, 0x37, 0x3a, 0xb4, 0x43, 0xc7, 0x3e, 0xc4, 0x4e,
-	0x6e, 0xe4, 0x4a, 0xc3, 0xef, 0x24, 0x47, 0x3a, 0x89, 0x71, 0x8c, 0x95, 0xd0, 0x7e, 0x0a, 0x37,
-	0x1b, 0x23, 0xc6, 0x71, 0xff, 0x68, 0x88, 0xa3, 0x61, 0x80, 0x4f, 0xd1, 0x23, 0x28, 0x48, 0x00,
-	0xb3, 0x8c, 0x5a, 0x76, 0xbd, 0xbc, 0x85, 0xea, 0xc9, 0xd1, 0xbf, 0x11, 0xe2, 0x03, 0xd2, 0xa6,
-	0x8e, 0x46, 0xd8, 0xbf, 0x19, 0x50, 0x3c, 0xa4, 0x9e, 0x90, 0xa1, 0x0a, 0x64, 0xbb, 0xd4, 0xb3,
-	0x8c, 0x9a, 0xb1, 0x5e, 0xde, 0x2a, 0xd5, 0xdd, 0x41, 0x50, 0x3f, 0xa4, 0x9e, 0x23, 0x84, 0xe8,
-	0x7d, 0xc8, 0x45, 0x31, 0x61, 0x56, 0x46, 0x7a, 0x5c, 0x4a, 0x3d, 0x3a, 0x31, 0x91, 0xfe, 0xa4,
-	0x16, 0xed, 0x83, 0xd9, 0x72, 0x49, 0x0b, 0x87, 0x21, 0xf6, 0xad, 0xac, 0xf4, 0x53, 0xa9, 0xab,
-	0x0a, 0xd4, 0x93, 0xa3, 0xd5, 0x5f, 0x24, 0xf5, 0xdd, 0x2f, 0xbd, 0xfe, 0x63, 0xcd, 0x78, 0xf5,
-	0xe7, 0x9a, 0xe1, 0x8c, 0xcd, 0xd0, 0x3d, 0x30, 0xbb, 0xd4, 0x6b, 0x32, 0xee, 0x72, 0x6c, 0xe5,
-	0x6a, 0xc6, 0xba, 0xe9, 0x94, 0xba, 0xd4, 0x6b, 0x88, 0x3d, 0xba, 0x0b, 0x62, 0xdd, 0xec, 0x32,
-	0x4a, 0xac, 0xbc, 0xd4, 0x15, 0xbb, 0xd4, 0x3b, 0x64, 0x94, 0xd8, 0x3f, 0x67, 0xa1, 0xa8, 0xb3,
-	0x41, 0xef, 0x41, 0xa1, 0xb7, 0xc3, 0x9a, 0x81, 0x2f, 0x0f, 0x63, 0x3a, 0xf9, 0xde, 0x0e, 0x3b,
-	0xf0, 0x91, 0x05, 0xc5, 0x56, 0x18, 0x33, 0x8e, 0x23, 0x2b, 0xa3, 0x8c, 0xf5, 0x16, 0x21, 0xc8,
-	0x11, 0xea, 0x63, 0x99, 0xb3, 0xe9, 0xc8, 0x35, 0xba, 0x0f, 0x26, 0x8b, 0x5b, 0x2d, 0x8c, 0x7d,
-	0xec, 0xcb, 0x44, 0x4a, 0xce, 0x58, 0x80, 0x96, 0x21, 0x8f, 0xa3, 0x88, 0x46, 0x3a, 0x0d, 0xb5,
-	0x41, 0x9f, 0x40, 0xb1, 0x15, 0x61, 0x97, 0x63, 0xdf, 0x2a, 0x5c, 0xe3, 0xf8, 0x89, 0x91, 0xb0,
-	0x67, 0xdc, 0x8d, 0x84, 0x7d, 0xf1, 0x3a, 0xf6, 0xda, 0x08, 0xed, 0x41, 0xa9, 0x1d, 0x90, 0x80,
-	0x1d, 0x63, 0xdf, 0x2a, 0x5d, 0xc3, 0x41, 0x6a, 0x85, 0x1e, 0x00, 0x0c, 0xa8, 0xdf, 0x24, 0x71,
-	0xdf, 0xc3, 0x91, 0x65, 0xd6, 0x8c, 0xf5, 0xbc, 0x63, 0x0e, 0xa8, 0xff, 0x95, 0x14, 0x08, 0x76,
-	0xa2, 0x98, 0x68, 0x76, 0x40, 0xb1, 0x13, 0xc5, 0x44, 0xb1, 0xf3, 0x18, 0x50, 0x4c, 0x5c, 0x2f,
-	0xc4, 0x4d, 0x4e, 0x9b, 0xac, 0x75, 0x8c, 0xfd, 0x38, 0xc4, 0x56, 0x59, 0x96, 0x6e, 0x49, 0x69,
-	0x5e, 0xd0, 0x86, 0x96, 0x0b, 0xc2, 0xcc, 0xb4, 0x21, 0x45, 0x3d, 0x65, 0x4b, 0x26, 0x8c, 0xc9,
-	0x0d, 0x5a, 0x83, 0x72, 0x97, 0x7a, 0xac, 0x29, 0x77, 0xbe, 0x64, 0x6d, 0xd1, 0x01, 0x21, 0x92,
-	0x96, 0x3e, 0x7a, 0x08, 0x37, 0x24, 0x60, 0x80, 0x89, 0x1f, 0x90, 0x8e, 0x24, 0x70, 0xd1, 0x91,
-	0x46, 0x5f, 0x2b, 0x51, 0x0a, 0x89, 0x62, 0x42, 0x04, 0x24, 0x37, 0x86, 0x38, 0x4a, 0x84, 0x9e,
-	0xc2, 0x6d, 0x1a, 0xfa, 0x98, 0x71, 0x1d, 0xa8, 0x29, 0xe6, 0x20, 0x2f, 0xeb, 0x37, 0x6e, 0x75,
-	0x3d, 0x26, 0xce, 0x2d, 0x05, 0x55, 0x09, 0x1c, 0x52, 0x0f, 0xed, 0xc1, 0x9d, 0x90, 0x92, 0x8e,
-	0x30, 0xd7, 0x31, 0xa4, 0x7d, 0xe1, 0x1d, 0xf6, 0xb7, 0x35, 0x58, 0x07, 0x17, 0x1e, 0x8e, 0x60,
-	0x65, 0x3a, 0x7e, 0x72, 0xc5, 0xe8, 0x2e, 0xb8, 0x7b, 0x81, 0xc4, 0xcf, 0x34, 0xc0, 0x59, 0x9e,
-	0xcc, 0x26, 0x91, 0xa2, 0x06, 0x58, 0xb3, 0x29, 0xa5, 0x2e, 0x4b, 0x57, 0xb9, 0x5c, 0x99, 0x4e,
-	0x30, 0x91, 0xdb, 0x3f, 0x65, 0x01, 0x0e, 0xa9, 0xd7, 0xc0, 0x7c, 0x0e, 0x63, 0xab, 0x50, 0x94,
-	0xe3, 0x8b, 0xb9, 0x9e, 0xb1, 0x42, 0x57, 0x9a, 0xcc, 0x52, 0x99, 0xbd, 0x92, 0xca, 0xdc, 0xd5,
-	0x54, 0xe6, 0x2f, 0x52, 0xf9, 0x01, 0xdc, 0x94, 0x90, 0xf1, 0xe8, 0x16, 0x24, 0x68, 0x51, 0x48,
-	0x1b, 0xe9, 0xf8, 0x26, 0xd9, 0xb4, 0xdd, 0x20, 0xd4, 0xc3, 0xa6, 0xb3, 0x79, 0x2e, 0x25, 0x68,
-	0x17, 0x6e, 0xe8, 0x28, 0xa2, 0xb7, 0x99, 0xae, 0xda, 0x4a, 0xca, 0x66, 0x52, 0x15, 0xa9, 0x75,
-	0xa6, 0xb0, 0x68, 0x07, 0xca, 0xea, 0x94, 0xca, 0xd4, 0x9c, 0x6b, 0x3a, 0x09, 0x15, 0x17, 0x28,
-	0x8b, 0xbd, 0x7e, 0xc0, 0xc5, 0x0d, 0x00, 0xd7, 0xb9, 0x40, 0x53, 0x33, 0xfb, 0xd7, 0x0c, 0x2c,
-	0x4e, 0x85, 0x40, 0x1f, 0x41, 0x89, 0x1d, 0xd3, 0x88, 0x63, 0xc6, 0xf5, 0xed, 0x3e, 0x87, 0xfd,
-	0x14, 0x8a, 0xb6, 0xa1, 0xa8, 0x3b, 0x41, 0x52, 0x39, 0xd7, 0x2a, 0x41, 0x0a, 0x23, 0x77, 0x88,
-	0x23, 0xb7, 0x83, 0xf5, 0x0f, 0x60, 0x9e, 0x91, 0x46, 0xa2, 0x4d, 0x28, 0xf4, 0xb1, 0x1f, 0xb8,
-	0x44, 0x92, 0x3e, 0xd7, 0x46, 0x03, 0xd1, 0x87, 0x90, 0x39, 0xd9, 0xd4, 0x33, 0x3a, 0x07, 0x9e,
-	0x39, 0xd9, 0x94, 0xd0, 0x6d, 0x3d, 0x8e, 0x73, 0xa1, 0xdb, 0x76, 0x1f, 0x6e, 0x7f, 0x8e, 0xb9,
-	0x6a, 0x72, 0xe6, 0xe0, 0x93, 0x58, 0x1c, 0xe9, 0xf2, 0x46, 0x7f, 0x08, 0x37, 0x08, 0x3e, 0x15,
-	0x13, 0xd6, 0x0e, 0x22, 0x5d, 0xa2, 0x92, 0x53, 0x56, 0xb2, 0xe7, 0x42, 0x24, 0x9a, 0xcc, 0x6d,
-	0xf1, 0x60, 0x88, 0x9b, 0x94, 0x84, 0x23, 0x59, 0x8f, 0x92, 0x03, 0x4a, 0x74, 0x44, 0xc2, 0x91,
-	0xfd, 0x25, 0xa0, 0xc9, 0x70, 0x6c, 0x40, 0x09, 0xc3, 0xe8, 0x63, 0x58, 0xd4, 0x23, 0xd4, 0x0c,
-	0x48, 0x9b, 0x26, 0xbf, 0xf1, 0x3b, 0x93, 0x37, 0x89, 0x1e, 0x42, 0xd9, 0xfb, 0x7a, 0xcd, 0xec,
-	0x7f, 0x33, 0x70, 0x53, 0xf9, 0xfb, 0xff, 0xb9, 0x3f, 0x00, 0x48, 0x7f, 0xc3, 0xcc, 0xca, 0xd6,
-	0xb2, 0xeb, 0xa6, 0x63, 0x26, 0xff, 0x61, 0x86, 0xaa, 0x72, 0x7e, 0x54, 0x8e, 0x3e, 0xb3, 0x72,
-	0x63, 0x3d, 0xe6, 0x07, 0x3e, 0x13, 0x3f, 0x54, 0xee, 0xf6, 0xb0, 0x9e, 0x50, 0xb9, 0x16, 0x32,
-	0xd6, 0x0b, 0x06, 0x7a, 0x20, 0xe5, 0x5a, 0xe4, 0xd7, 0xa5, 0xde, 0x81, 0x9a, 0x40, 0xd3, 0x51,
-	0x1b, 0x21, 0xa5, 0xa7, 0x04, 0x47, 0x72, 0xea, 0x4c, 0x47, 0x6d, 0xd0, 0x77, 0xb0, 0x14, 0x33,
-	0x1c, 0x35, 0x27, 0xde, 0x51, 0x96, 0x29, 0x4b, 0xf3, 0x38, 0x2d, 0xcd, 0xf4, 0xf1, 0xeb, 0xdf,
-	0x32, 0x1c, 0x7d, 0x3a, 0x86, 0x3f, 0x23, 0x3c, 0x1a, 0x39, 0xb7, 0xe2, 0x69, 0x69, 0x65, 0x1f,
-	0x96, 0x2f, 0x03, 0xa2, 0x25, 0xc8, 0xf6, 0xf0, 0x48, 0x97, 0x4e, 0x2c, 0x45, 0x62, 0x43, 0x37,
-	0x8c, 0xb1, 0xbe, 0xdb, 0xd4, 0x66, 0x37, 0xb3, 0x63, 0xd8, 0x7b, 0x70, 0x2b, 0x8d, 0xad, 0x79,
-	0x7c, 0xa2, 0x5e, 0x32, 0x93, 0x1c, 0x5e, 0xfc, 0x1b, 0x88, 0xf7, 0x8c, 0x64, 0x6f, 0xeb, 0x97,
-	0x0c, 0x14, 0xbf, 0x50, 0x5a, 0xf4, 0x12, 0x4a, 0xe9, 0x73, 0x6e, 0xe5, 0x42, 0xcb, 0x3e, 0x13,
-	0x0f, 0xcc, 0xca, 0x6a, 0xea, 0x6b, 0xfa, 0xfd, 0x67, 0xd7, 0x7e, 0xf8, 0xfd, 0x9f, 0x1f, 0x33,
-	0x15, 0x64, 0xc9, 0xb7, 0xe2, 0x70, 0x33, 0x7d, 0x01, 0xd3, 0xc4, 0x65, 0x00, 0x30, 0xee, 0x3b,
-	0x54, 0x99, 0xa9, 0xde, 0x44, 0xef, 0x57, 0xee, 0x5d, 0xaa, 0x53, 0x07, 0xb4, 0x6d, 0x19, 0xe8,
-	0xbe, 0xbd, 0x3a, 0x1b, 0x48, 0xdc, 0xa3, 0x98, 0xb3, 0x5d, 0xe3, 0x11, 0x7a, 0x09, 0x45, 0x5d,
-	0x17, 0xb4, 0xfa, 0x0e, 0x96, 0x2a, 0xd6, 0x45, 0x85, 0x8e, 0xb0, 0x26, 0x23, 0xdc, 0xb5, 0x97,
-	0x2f, 0x8b, 0xb0, 0x6b, 0x3c, 0xda, 0xaf, 0xbd, 0xfd, 0xbb, 0xba, 0xf0, 0xfd, 0x59, 0xd5, 0x78,
-	0x7d, 0x56, 0x35, 0xde, 0x9c, 0x55, 0x8d, 0xbf, 0xce, 0xaa, 0xc6, 0xab, 0xf3, 0xea, 0xc2, 0x9b,
-	0xf3, 0xea, 0xc2, 0xdb, 0xf3, 0xea, 0x82, 0x57, 0x90, 0x65, 0xdb, 0xfe, 0x2f, 0x00, 0x00, 0xff,
-	0xff, 0x4f, 0xaf, 0x4a, 0x7b, 0x10, 0x0c, 0x00, 0x00,
+type GetJobStatsRequest struct {
+	// Defaults to one day (hourly buckets) or 30 days (daily buckets) before to
+	From *time.Time `protobuf:"bytes,1,opt,name=from,proto3,stdtime" json:"from,omitempty"`
+	// Defaults to now
+	To *time.Time `protobuf:"bytes,2,opt,name=to,proto3,stdtime" json:"to,omitempty"`
+	// One of "hour" or "day", defaults to "hour"
+	Bucket string `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// One of "queue", "cluster" or "owner", statistics cover all jobs when empty
+	GroupBy string `protobuf:"bytes,4,opt,name=group_by,json=groupBy,proto3" json:"groupBy,omitempty"`
+	// Only jobs in this queue when not empty
+	Queue string `protobuf:"bytes,5,opt,name=queue,proto3" json:"queue,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// LookoutClient is the client API for Lookout service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type LookoutClient interface {
-	Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error)
-	GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error)
-	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
+func (m *GetJobStatsRequest) Reset()      { *m = GetJobStatsRequest{} }
+func (*GetJobStatsRequest) ProtoMessage() {}
+func (*GetJobStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{11}
 }
-
-type lookoutClient struct {
-	cc *grpc.ClientConn
+func (m *GetJobStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewLookoutClient(cc *grpc.ClientConn) LookoutClient {
-	return &lookoutClient{cc}
+func (m *GetJobStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJobStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetJobStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobStatsRequest.Merge(m, src)
+}
+func (m *GetJobStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJobStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobStatsRequest.DiscardUnknown(m)
 }
 
-func (c *lookoutClient) Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error) {
-	out := new(SystemOverview)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/Overview", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetJobStatsRequest proto.InternalMessageInfo
+
+func (m *GetJobStatsRequest) GetFrom() *time.Time {
+	if m != nil {
+		return m.From
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lookoutClient) GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error) {
-	out := new(GetJobSetsResponse)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobSets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetJobStatsRequest) GetTo() *time.Time {
+	if m != nil {
+		return m.To
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lookoutClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
-	out := new(GetJobsResponse)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetJobStatsRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
 	}
-	return out, nil
+	return ""
 }
 
-// LookoutServer is the server API for Lookout service.
-type LookoutServer interface {
-	Overview(context.Context, *types.Empty) (*SystemOverview, error)
-	GetJobSets(context.Context, *GetJobSetsRequest) (*GetJobSetsResponse, error)
-	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
+func (m *GetJobStatsRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
 }
 
-// UnimplementedLookoutServer can be embedded to have forward compatible implementations.
-type UnimplementedLookoutServer struct {
+func (m *GetJobStatsRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
 
-func (*UnimplementedLookoutServer) Overview(ctx context.Context, req *types.Empty) (*SystemOverview, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Overview not implemented")
-}
-func (*UnimplementedLookoutServer) GetJobSets(ctx context.Context, req *GetJobSetsRequest) (*GetJobSetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobSets not implemented")
-}
-func (*UnimplementedLookoutServer) GetJobs(ctx context.Context, req *GetJobsRequest) (*GetJobsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+type GetJobStatsResponse struct {
+	Stats []*JobStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func RegisterLookoutServer(s *grpc.Server, srv LookoutServer) {
-	s.RegisterService(&_Lookout_serviceDesc, srv)
+func (m *GetJobStatsResponse) Reset()      { *m = GetJobStatsResponse{} }
+func (*GetJobStatsResponse) ProtoMessage() {}
+func (*GetJobStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{12}
 }
-
-func _Lookout_Overview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func (m *GetJobStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetJobStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJobStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetJobStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobStatsResponse.Merge(m, src)
+}
+func (m *GetJobStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJobStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobStatsResponse proto.InternalMessageInfo
+
+func (m *GetJobStatsResponse) GetStats() []*JobStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// Queue wait covers jobs which started running within the bucket,
+// run time, failure rate and throughput cover jobs which finished within the bucket
+type JobStats struct {
+	BucketStart time.Time `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3,stdtime" json:"bucket_start"`
+	// Queue, cluster or owner the statistics are for, see GetJobStatsRequest.group_by
+	Group         string               `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	JobsStarted   uint32               `protobuf:"varint,3,opt,name=jobs_started,json=jobsStarted,proto3" json:"jobsStarted,omitempty"`
+	JobsSucceeded uint32               `protobuf:"varint,4,opt,name=jobs_succeeded,json=jobsSucceeded,proto3" json:"jobsSucceeded,omitempty"`
+	JobsFailed    uint32               `protobuf:"varint,5,opt,name=jobs_failed,json=jobsFailed,proto3" json:"jobsFailed,omitempty"`
+	FailureRate   float64              `protobuf:"fixed64,6,opt,name=failure_rate,json=failureRate,proto3" json:"failureRate,omitempty"`
+	QueueWait     *DurationPercentiles `protobuf:"bytes,7,opt,name=queue_wait,json=queueWait,proto3" json:"queueWait,omitempty"`
+	RunTime       *DurationPercentiles `protobuf:"bytes,8,opt,name=run_time,json=runTime,proto3" json:"runTime,omitempty"`
+}
+
+func (m *JobStats) Reset()      { *m = JobStats{} }
+func (*JobStats) ProtoMessage() {}
+func (*JobStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{13}
+}
+func (m *JobStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStats.Merge(m, src)
+}
+func (m *JobStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobStats proto.InternalMessageInfo
+
+func (m *JobStats) GetBucketStart() time.Time {
+	if m != nil {
+		return m.BucketStart
+	}
+	return time.Time{}
+}
+
+func (m *JobStats) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *JobStats) GetJobsStarted() uint32 {
+	if m != nil {
+		return m.JobsStarted
+	}
+	return 0
+}
+
+func (m *JobStats) GetJobsSucceeded() uint32 {
+	if m != nil {
+		return m.JobsSucceeded
+	}
+	return 0
+}
+
+func (m *JobStats) GetJobsFailed() uint32 {
+	if m != nil {
+		return m.JobsFailed
+	}
+	return 0
+}
+
+func (m *JobStats) GetFailureRate() float64 {
+	if m != nil {
+		return m.FailureRate
+	}
+	return 0
+}
+
+func (m *JobStats) GetQueueWait() *DurationPercentiles {
+	if m != nil {
+		return m.QueueWait
+	}
+	return nil
+}
+
+func (m *JobStats) GetRunTime() *DurationPercentiles {
+	if m != nil {
+		return m.RunTime
+	}
+	return nil
+}
+
+type DurationPercentiles struct {
+	P50 *types.Duration `protobuf:"bytes,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90 *types.Duration `protobuf:"bytes,2,opt,name=p90,proto3" json:"p90,omitempty"`
+	P95 *types.Duration `protobuf:"bytes,3,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99 *types.Duration `protobuf:"bytes,4,opt,name=p99,proto3" json:"p99,omitempty"`
+	Max *types.Duration `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *DurationPercentiles) Reset()      { *m = DurationPercentiles{} }
+func (*DurationPercentiles) ProtoMessage() {}
+func (*DurationPercentiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{14}
+}
+func (m *DurationPercentiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DurationPercentiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DurationPercentiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DurationPercentiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationPercentiles.Merge(m, src)
+}
+func (m *DurationPercentiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *DurationPercentiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationPercentiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationPercentiles proto.InternalMessageInfo
+
+func (m *DurationPercentiles) GetP50() *types.Duration {
+	if m != nil {
+		return m.P50
+	}
+	return nil
+}
+
+func (m *DurationPercentiles) GetP90() *types.Duration {
+	if m != nil {
+		return m.P90
+	}
+	return nil
+}
+
+func (m *DurationPercentiles) GetP95() *types.Duration {
+	if m != nil {
+		return m.P95
+	}
+	return nil
+}
+
+func (m *DurationPercentiles) GetP99() *types.Duration {
+	if m != nil {
+		return m.P99
+	}
+	return nil
+}
+
+func (m *DurationPercentiles) GetMax() *types.Duration {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SystemOverview)(nil), "lookout.SystemOverview")
+	proto.RegisterType((*JobInfo)(nil), "lookout.JobInfo")
+	proto.RegisterType((*RunInfo)(nil), "lookout.RunInfo")
+	proto.RegisterType((*ContainerInfo)(nil), "lookout.ContainerInfo")
+	proto.RegisterType((*QueueInfo)(nil), "lookout.QueueInfo")
+	proto.RegisterType((*JobSetInfo)(nil), "lookout.JobSetInfo")
+	proto.RegisterType((*DurationStats)(nil), "lookout.DurationStats")
+	proto.RegisterType((*GetJobSetsRequest)(nil), "lookout.GetJobSetsRequest")
+	proto.RegisterType((*GetJobSetsResponse)(nil), "lookout.GetJobSetsResponse")
+	proto.RegisterType((*GetJobsRequest)(nil), "lookout.GetJobsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "lookout.GetJobsRequest.JobLabelsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "lookout.GetJobsRequest.UserAnnotationsEntry")
+	proto.RegisterType((*GetJobsResponse)(nil), "lookout.GetJobsResponse")
+	proto.RegisterType((*GetJobStatsRequest)(nil), "lookout.GetJobStatsRequest")
+	proto.RegisterType((*GetJobStatsResponse)(nil), "lookout.GetJobStatsResponse")
+	proto.RegisterType((*JobStats)(nil), "lookout.JobStats")
+	proto.RegisterType((*DurationPercentiles)(nil), "lookout.DurationPercentiles")
+}
+
+func init() { proto.RegisterFile("pkg/api/lookout/lookout.proto", fileDescriptor_6ee7620a6fb9cfb1) }
+
+var fileDescriptor_6ee7620a6fb9cfb1 = []byte{
+	// 1909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4b, 0x73, 0x1b, 0xc7,
+	0xf1, 0xe7, 0x02, 0xc4, 0xab, 0x41, 0xf0, 0x31, 0xa4, 0xc9, 0x15, 0x44, 0x81, 0xd0, 0xfe, 0xff,
+	0x4e, 0x68, 0xc5, 0x22, 0x2d, 0x29, 0x8a, 0x69, 0x45, 0xe5, 0xb2, 0xa9, 0xc8, 0x2e, 0xd2, 0x56,
+	0xa4, 0x2c, 0x9d, 0xf2, 0xc9, 0xb5, 0xb5, 0x8b, 0x1d, 0x80, 0x0b, 0x02, 0x3b, 0xd0, 0xcc, 0x2c,
+	0x25, 0xdc, 0x52, 0x39, 0xe5, 0xe8, 0xaa, 0x7c, 0x02, 0x55, 0xe5, 0x9c, 0x7b, 0xbe, 0x81, 0x2f,
+	0xa9, 0x72, 0x55, 0x2e, 0x3e, 0xe5, 0x21, 0xe5, 0x9a, 0x6b, 0x4e, 0x39, 0xa4, 0xa6, 0x67, 0x76,
+	0x01, 0x90, 0x90, 0x20, 0x54, 0x4e, 0x98, 0xee, 0xfe, 0x75, 0x4f, 0x4f, 0x77, 0x4f, 0x4f, 0x63,
+	0xe1, 0xda, 0xe0, 0xac, 0xb3, 0xef, 0x0f, 0xa2, 0xfd, 0x1e, 0x63, 0x67, 0x2c, 0x91, 0xe9, 0xef,
+	0xde, 0x80, 0x33, 0xc9, 0x48, 0xc9, 0x90, 0xf5, 0x9d, 0x0e, 0x63, 0x9d, 0x1e, 0xdd, 0x47, 0x76,
+	0x90, 0xb4, 0xf7, 0x65, 0xd4, 0xa7, 0x42, 0xfa, 0xfd, 0x81, 0x46, 0xd6, 0x1b, 0x17, 0x01, 0x61,
+	0xc2, 0x7d, 0x19, 0xb1, 0xd8, 0xc8, 0xaf, 0x5e, 0x94, 0xd3, 0xfe, 0x40, 0x0e, 0x8d, 0x70, 0xdb,
+	0x08, 0x95, 0x23, 0x7e, 0x1c, 0x33, 0x89, 0x9a, 0xc2, 0x48, 0x6f, 0x76, 0x22, 0x79, 0x9a, 0x04,
+	0x7b, 0x2d, 0xd6, 0xdf, 0xef, 0xb0, 0x0e, 0x1b, 0xd9, 0x50, 0x14, 0x12, 0xb8, 0x32, 0xf0, 0xf5,
+	0xf4, 0x48, 0x4f, 0x13, 0x9a, 0x50, 0xcd, 0x74, 0xee, 0xc3, 0xf2, 0xc9, 0x50, 0x48, 0xda, 0x7f,
+	0x7c, 0x4e, 0xf9, 0x79, 0x44, 0x9f, 0x91, 0x1b, 0x50, 0x44, 0x80, 0xb0, 0xad, 0x66, 0x7e, 0xb7,
+	0x7a, 0x9b, 0xec, 0xa5, 0x47, 0xff, 0x95, 0x62, 0x1f, 0xc5, 0x6d, 0xe6, 0x1a, 0x84, 0xf3, 0x22,
+	0x07, 0xa5, 0x63, 0x16, 0x28, 0x1e, 0xa9, 0x43, 0xbe, 0xcb, 0x02, 0xdb, 0x6a, 0x5a, 0xbb, 0xd5,
+	0xdb, 0xe5, 0x3d, 0x7f, 0x10, 0xed, 0x1d, 0xb3, 0xc0, 0x55, 0x4c, 0xf2, 0xff, 0xb0, 0xc8, 0x93,
+	0x58, 0xd8, 0x39, 0xb4, 0xb8, 0x9a, 0x59, 0x74, 0x93, 0x18, 0xed, 0xa1, 0x94, 0x1c, 0x42, 0xa5,
+	0xe5, 0xc7, 0x2d, 0xda, 0xeb, 0xd1, 0xd0, 0xce, 0xa3, 0x9d, 0xfa, 0x9e, 0x8e, 0xc0, 0x5e, 0x7a,
+	0xb4, 0xbd, 0xaf, 0xd2, 0xf8, 0x1e, 0x96, 0xbf, 0xfb, 0xeb, 0x8e, 0xf5, 0xed, 0xdf, 0x76, 0x2c,
+	0x77, 0xa4, 0x46, 0xae, 0x42, 0xa5, 0xcb, 0x02, 0x4f, 0x48, 0x5f, 0x52, 0x7b, 0xb1, 0x69, 0xed,
+	0x56, 0xdc, 0x72, 0x97, 0x05, 0x27, 0x8a, 0x26, 0x57, 0x40, 0xad, 0xbd, 0xae, 0x60, 0xb1, 0x5d,
+	0x40, 0x59, 0xa9, 0xcb, 0x82, 0x63, 0xc1, 0x62, 0x72, 0x13, 0x08, 0x6d, 0xb7, 0x69, 0x4b, 0x46,
+	0xe7, 0xd4, 0x1b, 0xf0, 0x88, 0xf1, 0x48, 0x0e, 0xed, 0x62, 0xd3, 0xda, 0xb5, 0xdc, 0xb5, 0x4c,
+	0xf2, 0xc4, 0x08, 0xc8, 0x7b, 0xb0, 0x2a, 0x4f, 0x39, 0x93, 0xb2, 0x47, 0x43, 0x8f, 0x53, 0x5f,
+	0x59, 0x2c, 0xa1, 0xc5, 0x95, 0x8c, 0xef, 0x22, 0xdb, 0xf9, 0x4f, 0x1e, 0x4a, 0xe6, 0x9c, 0xe4,
+	0x1d, 0x28, 0x9e, 0x1d, 0x08, 0x2f, 0x0a, 0x31, 0x4c, 0x15, 0xb7, 0x70, 0x76, 0x20, 0x8e, 0x42,
+	0x62, 0x43, 0xa9, 0xd5, 0x4b, 0x84, 0xa4, 0xdc, 0xce, 0x69, 0xb7, 0x0c, 0x49, 0x08, 0x2c, 0xc6,
+	0x2c, 0xa4, 0x18, 0x8d, 0x8a, 0x8b, 0x6b, 0xb2, 0x0d, 0x15, 0x91, 0xb4, 0x5a, 0x94, 0x86, 0x34,
+	0xc4, 0x23, 0x96, 0xdd, 0x11, 0x83, 0x6c, 0x40, 0x81, 0x72, 0xce, 0xb8, 0x39, 0xa0, 0x26, 0xc8,
+	0xc7, 0x50, 0x6a, 0x71, 0xea, 0x4b, 0x1a, 0xda, 0xc5, 0x39, 0x02, 0x9b, 0x2a, 0x29, 0x7d, 0x21,
+	0x7d, 0xae, 0xf4, 0x4b, 0xf3, 0xe8, 0x1b, 0x25, 0xf2, 0x09, 0x94, 0xdb, 0x51, 0x1c, 0x89, 0x53,
+	0x1a, 0xda, 0xe5, 0x39, 0x0c, 0x64, 0x5a, 0xe4, 0x1a, 0xc0, 0x80, 0x85, 0x5e, 0x9c, 0xf4, 0x03,
+	0xca, 0xed, 0x4a, 0xd3, 0xda, 0x2d, 0xb8, 0x95, 0x01, 0x0b, 0x7f, 0x89, 0x0c, 0x95, 0x77, 0x9e,
+	0xc4, 0x26, 0xef, 0xa0, 0xf3, 0xce, 0x93, 0x58, 0xe7, 0xfd, 0x7d, 0x20, 0x49, 0xec, 0x07, 0x3d,
+	0xea, 0x49, 0xe6, 0x89, 0xd6, 0x29, 0x0d, 0x93, 0x1e, 0xb5, 0xab, 0x18, 0xba, 0x55, 0x2d, 0xf9,
+	0x8a, 0x9d, 0x18, 0xbe, 0x8a, 0x60, 0xcb, 0x4f, 0x04, 0xb5, 0x97, 0x74, 0x04, 0x91, 0x20, 0x3f,
+	0x03, 0x68, 0xb1, 0x58, 0xfa, 0x51, 0x4c, 0xb9, 0xb0, 0x6b, 0x58, 0xc8, 0x9b, 0x59, 0x21, 0x3f,
+	0x48, 0x45, 0x58, 0xce, 0x63, 0x48, 0xe7, 0x77, 0x16, 0xd4, 0x26, 0xa4, 0x98, 0x53, 0xbf, 0x4f,
+	0x4d, 0x09, 0xe0, 0x5a, 0xb9, 0x4f, 0x9f, 0x47, 0xd2, 0x6b, 0xa9, 0x64, 0xe7, 0xf0, 0x70, 0x65,
+	0xc5, 0x78, 0xa0, 0x12, 0x6e, 0x43, 0xa9, 0x4f, 0x85, 0xf0, 0x3b, 0x69, 0x1d, 0xa4, 0x24, 0xd9,
+	0x84, 0xa2, 0x29, 0x3e, 0x5d, 0xea, 0x86, 0x1a, 0x1d, 0xa1, 0x30, 0x76, 0x04, 0xe7, 0x8f, 0x79,
+	0xa8, 0x64, 0x77, 0x58, 0x61, 0xf0, 0x16, 0xa7, 0xa5, 0x88, 0x04, 0xd9, 0x81, 0x6a, 0x97, 0x05,
+	0xc2, 0x43, 0x2a, 0x44, 0x57, 0x6a, 0x2e, 0x28, 0x16, 0x6a, 0x86, 0xe4, 0x3a, 0x2c, 0x21, 0x60,
+	0x40, 0xe3, 0x30, 0x8a, 0x3b, 0xe8, 0x51, 0xcd, 0x45, 0xa5, 0x27, 0x9a, 0x95, 0x41, 0x78, 0x12,
+	0xc7, 0x0a, 0xb2, 0x38, 0x82, 0xb8, 0x9a, 0x45, 0xee, 0xc3, 0x1a, 0xeb, 0x85, 0x54, 0x48, 0xb3,
+	0x91, 0xa7, 0x5a, 0x47, 0xa1, 0x69, 0x4d, 0x74, 0x07, 0xd3, 0x59, 0xdc, 0x15, 0x0d, 0xd5, 0x0e,
+	0x1c, 0xb3, 0x80, 0x7c, 0x02, 0xeb, 0x3d, 0x16, 0x77, 0x94, 0xba, 0xd9, 0x03, 0xf5, 0x8b, 0xaf,
+	0xd1, 0x5f, 0x33, 0x60, 0xb3, 0xb9, 0xb2, 0xf0, 0x18, 0x36, 0x27, 0xf7, 0x4f, 0xbb, 0xb2, 0x29,
+	0xef, 0x2b, 0x97, 0xaa, 0xf3, 0x17, 0x06, 0xe0, 0x6e, 0x8c, 0x7b, 0x93, 0x72, 0xc9, 0x09, 0xd8,
+	0x17, 0x5d, 0xca, 0x4c, 0x96, 0x67, 0x99, 0xdc, 0x9c, 0x74, 0x30, 0xe5, 0x3b, 0x7f, 0xc8, 0x03,
+	0x1c, 0xb3, 0xe0, 0x84, 0xca, 0x37, 0x64, 0x6c, 0x0b, 0x4a, 0xd8, 0xf1, 0xa8, 0x34, 0xcd, 0xa3,
+	0xd8, 0x45, 0x95, 0x8b, 0xa9, 0xcc, 0xcf, 0x4c, 0xe5, 0xe2, 0xec, 0x54, 0x16, 0x2e, 0xa7, 0xf2,
+	0x5d, 0x58, 0x46, 0xc8, 0xa8, 0x27, 0x15, 0x11, 0x54, 0x53, 0xdc, 0x93, 0x94, 0x99, 0x79, 0xd3,
+	0xf6, 0xa3, 0x9e, 0xe9, 0x22, 0xc6, 0x9b, 0xcf, 0x90, 0x43, 0xee, 0xc1, 0x92, 0xd9, 0x45, 0x5d,
+	0x5a, 0x61, 0xa2, 0x36, 0xba, 0x62, 0x69, 0x54, 0x50, 0xea, 0x4e, 0x60, 0xc9, 0x01, 0x54, 0xf5,
+	0x29, 0xb5, 0x6a, 0xe5, 0x8d, 0xaa, 0xe3, 0x50, 0xf5, 0xe6, 0x88, 0x24, 0xe8, 0x47, 0x52, 0xb5,
+	0x36, 0x98, 0xe7, 0xcd, 0xc9, 0xd4, 0x9c, 0x3f, 0xe5, 0xa0, 0x36, 0xb1, 0x05, 0xb9, 0x0b, 0x65,
+	0x71, 0xca, 0xb8, 0xa4, 0x42, 0xda, 0xd6, 0xac, 0xec, 0x67, 0x50, 0x72, 0x07, 0x4a, 0xa6, 0x12,
+	0xec, 0xdc, 0x2c, 0xad, 0x14, 0xa9, 0x94, 0xfc, 0x73, 0xca, 0xd3, 0xee, 0xf0, 0x66, 0x25, 0x83,
+	0x24, 0xb7, 0xa0, 0xd8, 0xa7, 0x61, 0xe4, 0xeb, 0xc6, 0xf1, 0x46, 0x1d, 0x03, 0x24, 0xef, 0x41,
+	0xee, 0xe9, 0x2d, 0xbb, 0x30, 0x0b, 0x9e, 0x7b, 0x7a, 0x0b, 0xa1, 0x77, 0xec, 0xe2, 0x6c, 0xe8,
+	0x1d, 0xa7, 0x0f, 0x6b, 0x9f, 0x53, 0xa9, 0x8b, 0x5c, 0xb8, 0xf4, 0x69, 0xa2, 0x8e, 0x34, 0xbd,
+	0xd0, 0xaf, 0xc3, 0x52, 0x4c, 0x9f, 0xa9, 0x1b, 0xd6, 0x8e, 0xb8, 0x09, 0x51, 0xd9, 0xad, 0x6a,
+	0xde, 0x67, 0x8a, 0xa5, 0x8a, 0xcc, 0xd7, 0x4f, 0x38, 0x8b, 0x7b, 0x43, 0x8c, 0x47, 0xd9, 0x05,
+	0xcd, 0x7a, 0x1c, 0xf7, 0x86, 0xce, 0x23, 0x20, 0xe3, 0xdb, 0x89, 0x01, 0x8b, 0x05, 0x25, 0x1f,
+	0x42, 0xcd, 0x5c, 0x21, 0x2f, 0x8a, 0xdb, 0x2c, 0x9d, 0x7c, 0xd6, 0xc7, 0x3b, 0x89, 0xb9, 0x84,
+	0x58, 0xfb, 0x66, 0x2d, 0x9c, 0x17, 0x15, 0x58, 0xd6, 0xf6, 0xfe, 0x77, 0xdf, 0xaf, 0x01, 0x64,
+	0x93, 0x8b, 0xb0, 0xf3, 0xcd, 0xfc, 0x6e, 0xc5, 0xad, 0xa4, 0xa3, 0x8b, 0x20, 0x0d, 0xa8, 0x66,
+	0x3e, 0x86, 0xc2, 0x5e, 0x1c, 0xc9, 0xa9, 0x3c, 0x0a, 0x85, 0x7a, 0x55, 0xa4, 0x7f, 0x46, 0xcd,
+	0x0d, 0xc5, 0xb5, 0xe2, 0x89, 0xb3, 0x68, 0x60, 0x2e, 0x24, 0xae, 0x95, 0x7f, 0x5d, 0x16, 0x1c,
+	0x85, 0x66, 0x5c, 0xd1, 0x84, 0xe2, 0xb2, 0x67, 0x31, 0xe5, 0x78, 0xeb, 0x2a, 0xae, 0x26, 0xc8,
+	0xd7, 0xb0, 0x9a, 0x08, 0xca, 0xbd, 0xb1, 0xd1, 0xd3, 0xae, 0x60, 0x68, 0xde, 0xcf, 0x42, 0x33,
+	0x79, 0xfc, 0xbd, 0x5f, 0x0b, 0xca, 0x3f, 0x1d, 0xc1, 0x1f, 0xc6, 0x92, 0x0f, 0xdd, 0x95, 0x64,
+	0x92, 0x4b, 0x1e, 0xea, 0xb3, 0xf6, 0xfc, 0x80, 0xf6, 0x84, 0x0d, 0x68, 0xf2, 0x47, 0xaf, 0x33,
+	0x79, 0xcc, 0x82, 0x2f, 0x11, 0xa8, 0x8d, 0x55, 0xba, 0x29, 0x3d, 0x3e, 0x37, 0x55, 0xa7, 0xcf,
+	0x4d, 0x4b, 0x63, 0x73, 0xd3, 0xbb, 0xb0, 0xac, 0x9a, 0x4f, 0xc2, 0x69, 0x3a, 0xb1, 0xd5, 0x50,
+	0x5a, 0x33, 0x5c, 0x3d, 0xaf, 0x91, 0x47, 0xb0, 0x92, 0x5d, 0x6d, 0xcf, 0x6f, 0x2b, 0xe3, 0xcb,
+	0x73, 0xf4, 0x85, 0xe5, 0x4c, 0xf9, 0x53, 0xa5, 0x4b, 0x1e, 0xc3, 0xea, 0xc8, 0x5c, 0x40, 0xdb,
+	0x8c, 0x53, 0x7b, 0x65, 0x0e, 0x7b, 0x23, 0x67, 0x0e, 0x51, 0x99, 0x1c, 0x41, 0xcd, 0x4c, 0x55,
+	0xc6, 0xbb, 0xd5, 0x39, 0xac, 0x2d, 0x19, 0x55, 0xed, 0xdb, 0x17, 0xb0, 0x9c, 0x9a, 0x32, 0x9e,
+	0xad, 0xcd, 0x61, 0x2b, 0x75, 0xc3, 0xf8, 0xf5, 0x05, 0x2c, 0xa7, 0xc3, 0x9a, 0x71, 0x8c, 0xcc,
+	0x63, 0x2c, 0xd5, 0xd5, 0x9e, 0x3d, 0x82, 0x95, 0xcc, 0x98, 0x71, 0x6d, 0x7d, 0x9e, 0x24, 0xa4,
+	0xca, 0xc6, 0xb7, 0x2b, 0x50, 0x66, 0x3c, 0xa4, 0xdc, 0x0b, 0x86, 0xf6, 0x86, 0xae, 0x14, 0xa4,
+	0x0f, 0x87, 0xa4, 0x01, 0x10, 0x52, 0xd1, 0x32, 0x4f, 0xe0, 0x3b, 0xba, 0x63, 0x8c, 0x38, 0x6a,
+	0xc4, 0x6a, 0x25, 0x5c, 0x30, 0x6e, 0x6f, 0xea, 0xd7, 0x55, 0x53, 0xe3, 0xd5, 0x84, 0xd3, 0x95,
+	0xb0, 0xb7, 0x9a, 0xf9, 0xb1, 0x6a, 0x7a, 0x80, 0xcc, 0xfa, 0x21, 0x6c, 0x4c, 0xbb, 0x12, 0x64,
+	0x15, 0xf2, 0x67, 0x74, 0x68, 0x9a, 0x84, 0x5a, 0xaa, 0x2b, 0x78, 0xee, 0xf7, 0x12, 0x6a, 0x5e,
+	0x71, 0x4d, 0xdc, 0xcb, 0x1d, 0x58, 0xf5, 0xfb, 0xb0, 0x3c, 0x79, 0x07, 0xe6, 0xd1, 0x76, 0x7c,
+	0x58, 0xc9, 0x2e, 0x94, 0xe9, 0x77, 0x37, 0xf5, 0x9f, 0xa4, 0xf1, 0x5e, 0x77, 0x79, 0x6a, 0x2a,
+	0x77, 0xf5, 0x42, 0xa8, 0xae, 0x1a, 0xd3, 0xe7, 0xd2, 0x33, 0x71, 0xd0, 0x3b, 0x80, 0x62, 0x3d,
+	0x40, 0x8e, 0xf3, 0x67, 0x2b, 0x6b, 0xab, 0xf8, 0xc2, 0x9a, 0x56, 0x78, 0x00, 0x8b, 0x6d, 0xce,
+	0xfa, 0xb6, 0x35, 0x47, 0xe6, 0x50, 0x83, 0xfc, 0x14, 0x72, 0x92, 0xd9, 0xb9, 0x39, 0xf4, 0x72,
+	0x92, 0xa9, 0x54, 0x05, 0x49, 0xeb, 0x8c, 0x4a, 0x33, 0x26, 0x1b, 0x4a, 0x65, 0xbf, 0xc3, 0x59,
+	0x32, 0x50, 0xd9, 0xd7, 0x73, 0x72, 0x09, 0xe9, 0xc3, 0xe1, 0xa8, 0x5b, 0x17, 0xc6, 0xba, 0xb5,
+	0xf3, 0x31, 0xac, 0x4f, 0x1c, 0xc7, 0x84, 0xed, 0xc7, 0x50, 0x50, 0xdd, 0x39, 0x0d, 0xd9, 0xda,
+	0xc4, 0xf3, 0x80, 0x48, 0x2d, 0x77, 0xfe, 0x95, 0x83, 0x72, 0xca, 0x23, 0x9f, 0xc3, 0x92, 0xf6,
+	0xc3, 0xc3, 0xfb, 0xf2, 0x96, 0xd1, 0x58, 0xc0, 0x53, 0x55, 0xb5, 0xe6, 0x89, 0x52, 0x54, 0xbe,
+	0xa2, 0xdb, 0x69, 0x8a, 0x91, 0xc8, 0x26, 0xb4, 0xf4, 0xef, 0xd9, 0xd8, 0x3c, 0x7e, 0xa2, 0x59,
+	0x53, 0x26, 0xb4, 0xc5, 0xb7, 0x98, 0xd0, 0x0a, 0x97, 0x26, 0xb4, 0xeb, 0xb0, 0x94, 0x35, 0x50,
+	0xf5, 0x37, 0x4b, 0xff, 0x3b, 0xae, 0x1a, 0x9e, 0xab, 0xfe, 0x69, 0xfd, 0x1c, 0x00, 0x43, 0xe8,
+	0x3d, 0xf3, 0x23, 0x69, 0x66, 0xe9, 0xed, 0x4b, 0x73, 0xd8, 0x13, 0xca, 0x5b, 0x34, 0x96, 0x51,
+	0x8f, 0x0a, 0xb7, 0x82, 0xf8, 0xaf, 0xfd, 0x48, 0x92, 0x0f, 0x41, 0xfd, 0x65, 0xf3, 0xd4, 0x17,
+	0x14, 0xbb, 0xfc, 0x16, 0xaa, 0x25, 0x9e, 0xc4, 0x2a, 0x6a, 0xce, 0xbf, 0x2d, 0x58, 0x9f, 0x02,
+	0x20, 0x3f, 0x81, 0xfc, 0xe0, 0xee, 0x07, 0xb3, 0x27, 0x30, 0x85, 0x42, 0xf0, 0x47, 0x1f, 0xcc,
+	0x1e, 0xbc, 0x14, 0x4a, 0x83, 0xef, 0xce, 0x1e, 0xb8, 0x14, 0x4a, 0x83, 0x3f, 0x9a, 0x3d, 0x69,
+	0x29, 0x94, 0x02, 0xf7, 0xfd, 0xe7, 0xb3, 0xe7, 0x2c, 0x85, 0xba, 0xfd, 0x22, 0x0f, 0xa5, 0x2f,
+	0x75, 0x84, 0xc8, 0x37, 0x50, 0xce, 0xbe, 0xe1, 0x6c, 0x5e, 0xd2, 0x7b, 0xa8, 0xbe, 0x2a, 0xd5,
+	0xb7, 0xb2, 0x78, 0x4e, 0x7e, 0xf4, 0x71, 0x9a, 0xbf, 0xfd, 0xcb, 0x3f, 0x7f, 0x9f, 0xab, 0x13,
+	0x1b, 0x3f, 0x10, 0x9d, 0xdf, 0xca, 0x3e, 0x7b, 0xb1, 0xd4, 0x64, 0x04, 0x30, 0x9a, 0x9c, 0x48,
+	0xfd, 0xc2, 0x63, 0x3d, 0x36, 0xbd, 0xd5, 0xaf, 0x4e, 0x95, 0xe9, 0x3b, 0xe4, 0x38, 0xb8, 0xd1,
+	0xb6, 0xb3, 0x75, 0x71, 0x23, 0x55, 0x67, 0x54, 0x8a, 0x7b, 0xd6, 0x0d, 0xf2, 0x0d, 0x94, 0xb4,
+	0xa6, 0x20, 0x5b, 0xaf, 0x19, 0x0a, 0xea, 0xf6, 0x65, 0x81, 0xd9, 0x61, 0x07, 0x77, 0xb8, 0xe2,
+	0x6c, 0x4c, 0xdb, 0x41, 0x99, 0xef, 0x43, 0x75, 0xec, 0x76, 0x93, 0x4b, 0xee, 0x8e, 0xb5, 0xb0,
+	0xfa, 0xf6, 0x74, 0xa1, 0xd9, 0xea, 0xff, 0x70, 0xab, 0x6b, 0x8e, 0x3d, 0x6d, 0x2b, 0x85, 0xbc,
+	0x67, 0xdd, 0x38, 0x6c, 0xfe, 0xf0, 0x8f, 0xc6, 0xc2, 0x6f, 0x5e, 0x36, 0xac, 0xef, 0x5e, 0x36,
+	0xac, 0xef, 0x5f, 0x36, 0xac, 0xbf, 0xbf, 0x6c, 0x58, 0xdf, 0xbe, 0x6a, 0x2c, 0x7c, 0xff, 0xaa,
+	0xb1, 0xf0, 0xc3, 0xab, 0xc6, 0x42, 0x50, 0xc4, 0x2c, 0xdd, 0xf9, 0xef, 0x00, 0x44, 0xbd, 0xc6,
+	0xfc, 0x74, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LookoutClient is the client API for Lookout service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LookoutClient interface {
+	Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error)
+	GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error)
+	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
+	GetJobStats(ctx context.Context, in *GetJobStatsRequest, opts ...grpc.CallOption) (*GetJobStatsResponse, error)
+}
+
+type lookoutClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLookoutClient(cc *grpc.ClientConn) LookoutClient {
+	return &lookoutClient{cc}
+}
+
+func (c *lookoutClient) Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error) {
+	out := new(SystemOverview)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/Overview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookoutClient) GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error) {
+	out := new(GetJobSetsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobSets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookoutClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
+	out := new(GetJobsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookoutClient) GetJobStats(ctx context.Context, in *GetJobStatsRequest, opts ...grpc.CallOption) (*GetJobStatsResponse, error) {
+	out := new(GetJobStatsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LookoutServer is the server API for Lookout service.
+type LookoutServer interface {
+	Overview(context.Context, *types.Empty) (*SystemOverview, error)
+	GetJobSets(context.Context, *GetJobSetsRequest) (*GetJobSetsResponse, error)
+	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
+	GetJobStats(context.Context, *GetJobStatsRequest) (*GetJobStatsResponse, error)
+}
+
+// UnimplementedLookoutServer can be embedded to have forward compatible implementations.
+type UnimplementedLookoutServer struct {
+}
+
+func (*UnimplementedLookoutServer) Overview(ctx context.Context, req *types.Empty) (*SystemOverview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Overview not implemented")
+}
+func (*UnimplementedLookoutServer) GetJobSets(ctx context.Context, req *GetJobSetsRequest) (*GetJobSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSets not implemented")
+}
+func (*UnimplementedLookoutServer) GetJobs(ctx context.Context, req *GetJobsRequest) (*GetJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (*UnimplementedLookoutServer) GetJobStats(ctx context.Context, req *GetJobStatsRequest) (*GetJobStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStats not implemented")
+}
+
+func RegisterLookoutServer(s *grpc.Server, srv LookoutServer) {
+	s.RegisterService(&_Lookout_serviceDesc, srv)
+}
+
+func _Lookout_Overview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -1058,6 +1653,24 @@ func _Lookout_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lookout_GetJobStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookoutServer).GetJobStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetJobStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetJobStats(ctx, req.(*GetJobStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Lookout_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lookout.Lookout",
 	HandlerType: (*LookoutServer)(nil),
@@ -1074,6 +1687,10 @@ var _Lookout_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetJobs",
 			Handler:    _Lookout_GetJobs_Handler,
 		},
+		{
+			MethodName: "GetJobStats",
+			Handler:    _Lookout_GetJobStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/lookout/lookout.proto",
@@ -1136,6 +1753,19 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ThrottledReason) > 0 {
+		i -= len(m.ThrottledReason)
+		copy(dAtA[i:], m.ThrottledReason)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.ThrottledReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EffectivePriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EffectivePriority))))
+		i--
+		dAtA[i] = 0x31
+	}
 	if len(m.JobJson) > 0 {
 		i -= len(m.JobJson)
 		copy(dAtA[i:], m.JobJson)
@@ -1209,7 +1839,28 @@ func (m *RunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.UnableToSchedule {
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Cause) > 0 {
+		i -= len(m.Cause)
+		copy(dAtA[i:], m.Cause)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cause)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UnableToSchedule {
 		i--
 		if m.UnableToSchedule {
 			dAtA[i] = 1
@@ -1302,6 +1953,62 @@ func (m *RunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cause) > 0 {
+		i -= len(m.Cause)
+		copy(dAtA[i:], m.Cause)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cause)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1693,6 +2400,155 @@ func (m *GetJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailureCauses) > 0 {
+		for iNdEx := len(m.FailureCauses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailureCauses[iNdEx])
+			copy(dAtA[i:], m.FailureCauses[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.FailureCauses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Descending {
+		i--
+		if m.Descending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.FinishedBefore != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FinishedBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedBefore):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintLookout(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.FinishedAfter != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FinishedAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedAfter):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintLookout(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.StartedBefore != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedBefore):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintLookout(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.StartedAfter != nil {
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedAfter):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintLookout(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.SubmittedBefore != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmittedBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmittedBefore):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintLookout(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SubmittedAfter != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmittedAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmittedAfter):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintLookout(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.JobLabels) > 0 {
+		for k := range m.JobLabels {
+			v := m.JobLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLookout(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.UserAnnotations) > 0 {
 		for k := range m.UserAnnotations {
 			v := m.UserAnnotations[k]
@@ -1794,6 +2650,13 @@ func (m *GetJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.JobInfos) > 0 {
 		for iNdEx := len(m.JobInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1811,80 +2674,354 @@ func (m *GetJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLookout(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLookout(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetJobStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SystemOverview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Queues) > 0 {
-		for _, e := range m.Queues {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
-		}
-	}
-	return n
+
+func (m *GetJobStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetJobStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Runs) > 0 {
-		for _, e := range m.Runs {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
-		}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Cancelled != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled)
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Bucket)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.JobState)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.To != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.To):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintLookout(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.JobJson)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if m.From != nil {
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.From):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintLookout(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RunInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetJobStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.K8SId)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *JobStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *JobStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RunTime != nil {
+		{
+			size, err := m.RunTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.QueueWait != nil {
+		{
+			size, err := m.QueueWait.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FailureRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FailureRate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.JobsFailed != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsFailed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JobsSucceeded != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsSucceeded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JobsStarted != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsStarted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BucketStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BucketStart):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintLookout(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DurationPercentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DurationPercentiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DurationPercentiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.P99 != nil {
+		{
+			size, err := m.P99.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.P95 != nil {
+		{
+			size, err := m.P95.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.P90 != nil {
+		{
+			size, err := m.P90.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.P50 != nil {
+		{
+			size, err := m.P50.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLookout(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLookout(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SystemOverview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Runs) > 0 {
+		for _, e := range m.Runs {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if m.Cancelled != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobState)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobJson)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.EffectivePriority != 0 {
+		n += 9
+	}
+	l = len(m.ThrottledReason)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *RunInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.K8SId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
 	}
 	if m.Succeeded {
 		n += 2
@@ -1915,6 +3052,44 @@ func (m *RunInfo) Size() (n int) {
 	if m.UnableToSchedule {
 		n += 2
 	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContainerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovLookout(uint64(m.ExitCode))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
 	return n
 }
 
@@ -2114,6 +3289,67 @@ func (m *GetJobsRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
 		}
 	}
+	if len(m.JobLabels) > 0 {
+		for k, v := range m.JobLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + len(v) + sovLookout(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.SubmittedAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmittedAfter)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.SubmittedBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmittedBefore)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.StartedAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedAfter)
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	if m.StartedBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedBefore)
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	if m.FinishedAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedAfter)
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	if m.FinishedBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FinishedBefore)
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	if m.Descending {
+		n += 3
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	if len(m.FailureCauses) > 0 {
+		for _, s := range m.FailureCauses {
+			l = len(s)
+			n += 2 + l + sovLookout(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2129,18 +3365,130 @@ func (m *GetJobsResponse) Size() (n int) {
 			n += 1 + l + sovLookout(uint64(l))
 		}
 	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
 	return n
 }
 
-func sovLookout(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLookout(x uint64) (n int) {
-	return sovLookout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *SystemOverview) String() string {
-	if this == nil {
-		return "nil"
+func (m *GetJobStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.From)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.To != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.To)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetJobStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BucketStart)
+	n += 1 + l + sovLookout(uint64(l))
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.JobsStarted != 0 {
+		n += 1 + sovLookout(uint64(m.JobsStarted))
+	}
+	if m.JobsSucceeded != 0 {
+		n += 1 + sovLookout(uint64(m.JobsSucceeded))
+	}
+	if m.JobsFailed != 0 {
+		n += 1 + sovLookout(uint64(m.JobsFailed))
+	}
+	if m.FailureRate != 0 {
+		n += 9
+	}
+	if m.QueueWait != nil {
+		l = m.QueueWait.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.RunTime != nil {
+		l = m.RunTime.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *DurationPercentiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.P50 != nil {
+		l = m.P50.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.P90 != nil {
+		l = m.P90.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.P95 != nil {
+		l = m.P95.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.P99 != nil {
+		l = m.P99.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func sovLookout(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLookout(x uint64) (n int) {
+	return sovLookout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SystemOverview) String() string {
+	if this == nil {
+		return "nil"
 	}
 	repeatedStringForQueues := "[]*QueueInfo{"
 	for _, f := range this.Queues {
@@ -2168,6 +3516,8 @@ func (this *JobInfo) String() string {
 		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "Timestamp", "types.Timestamp", 1) + `,`,
 		`JobState:` + fmt.Sprintf("%v", this.JobState) + `,`,
 		`JobJson:` + fmt.Sprintf("%v", this.JobJson) + `,`,
+		`EffectivePriority:` + fmt.Sprintf("%v", this.EffectivePriority) + `,`,
+		`ThrottledReason:` + fmt.Sprintf("%v", this.ThrottledReason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2176,6 +3526,11 @@ func (this *RunInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForContainers := "[]*ContainerInfo{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(f.String(), "ContainerInfo", "ContainerInfo", 1) + ","
+	}
+	repeatedStringForContainers += "}"
 	s := strings.Join([]string{`&RunInfo{`,
 		`K8SId:` + fmt.Sprintf("%v", this.K8SId) + `,`,
 		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
@@ -2188,6 +3543,22 @@ func (this *RunInfo) String() string {
 		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
 		`RunState:` + fmt.Sprintf("%v", this.RunState) + `,`,
 		`UnableToSchedule:` + fmt.Sprintf("%v", this.UnableToSchedule) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2284,6 +3655,16 @@ func (this *GetJobsRequest) String() string {
 		mapStringForUserAnnotations += fmt.Sprintf("%v: %v,", k, this.UserAnnotations[k])
 	}
 	mapStringForUserAnnotations += "}"
+	keysForJobLabels := make([]string, 0, len(this.JobLabels))
+	for k, _ := range this.JobLabels {
+		keysForJobLabels = append(keysForJobLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForJobLabels)
+	mapStringForJobLabels := "map[string]string{"
+	for _, k := range keysForJobLabels {
+		mapStringForJobLabels += fmt.Sprintf("%v: %v,", k, this.JobLabels[k])
+	}
+	mapStringForJobLabels += "}"
 	s := strings.Join([]string{`&GetJobsRequest{`,
 		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
 		`NewestFirst:` + fmt.Sprintf("%v", this.NewestFirst) + `,`,
@@ -2294,6 +3675,20 @@ func (this *GetJobsRequest) String() string {
 		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
 		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
 		`UserAnnotations:` + mapStringForUserAnnotations + `,`,
+		`JobLabels:` + mapStringForJobLabels + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`SubmittedAfter:` + strings.Replace(fmt.Sprintf("%v", this.SubmittedAfter), "Timestamp", "types.Timestamp", 1) + `,`,
+		`SubmittedBefore:` + strings.Replace(fmt.Sprintf("%v", this.SubmittedBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedAfter:` + strings.Replace(fmt.Sprintf("%v", this.StartedAfter), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedBefore:` + strings.Replace(fmt.Sprintf("%v", this.StartedBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`FinishedAfter:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAfter), "Timestamp", "types.Timestamp", 1) + `,`,
+		`FinishedBefore:` + strings.Replace(fmt.Sprintf("%v", this.FinishedBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`OrderBy:` + fmt.Sprintf("%v", this.OrderBy) + `,`,
+		`Descending:` + fmt.Sprintf("%v", this.Descending) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`FailureCauses:` + fmt.Sprintf("%v", this.FailureCauses) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2309,6 +3704,67 @@ func (this *GetJobsResponse) String() string {
 	repeatedStringForJobInfos += "}"
 	s := strings.Join([]string{`&GetJobsResponse{`,
 		`JobInfos:` + repeatedStringForJobInfos + `,`,
+		`NextCursor:` + fmt.Sprintf("%v", this.NextCursor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetJobStatsRequest{`,
+		`From:` + strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1) + `,`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`GroupBy:` + fmt.Sprintf("%v", this.GroupBy) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobStatsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStats := "[]*JobStats{"
+	for _, f := range this.Stats {
+		repeatedStringForStats += strings.Replace(f.String(), "JobStats", "JobStats", 1) + ","
+	}
+	repeatedStringForStats += "}"
+	s := strings.Join([]string{`&GetJobStatsResponse{`,
+		`Stats:` + repeatedStringForStats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobStats{`,
+		`BucketStart:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.BucketStart), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`JobsStarted:` + fmt.Sprintf("%v", this.JobsStarted) + `,`,
+		`JobsSucceeded:` + fmt.Sprintf("%v", this.JobsSucceeded) + `,`,
+		`JobsFailed:` + fmt.Sprintf("%v", this.JobsFailed) + `,`,
+		`FailureRate:` + fmt.Sprintf("%v", this.FailureRate) + `,`,
+		`QueueWait:` + strings.Replace(this.QueueWait.String(), "DurationPercentiles", "DurationPercentiles", 1) + `,`,
+		`RunTime:` + strings.Replace(this.RunTime.String(), "DurationPercentiles", "DurationPercentiles", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DurationPercentiles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DurationPercentiles{`,
+		`P50:` + strings.Replace(fmt.Sprintf("%v", this.P50), "Duration", "types.Duration", 1) + `,`,
+		`P90:` + strings.Replace(fmt.Sprintf("%v", this.P90), "Duration", "types.Duration", 1) + `,`,
+		`P95:` + strings.Replace(fmt.Sprintf("%v", this.P95), "Duration", "types.Duration", 1) + `,`,
+		`P99:` + strings.Replace(fmt.Sprintf("%v", this.P99), "Duration", "types.Duration", 1) + `,`,
+		`Max:` + strings.Replace(fmt.Sprintf("%v", this.Max), "Duration", "types.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2604,6 +4060,49 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.JobJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectivePriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EffectivePriority = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThrottledReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLookout(dAtA[iNdEx:])
@@ -2981,6 +4480,72 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UnableToSchedule = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, &ContainerInfo{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLookout(dAtA[iNdEx:])
@@ -3002,7 +4567,7 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3025,15 +4590,15 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3061,13 +4626,13 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			m.JobsQueued = 0
+			m.ExitCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3077,16 +4642,16 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsQueued |= uint32(b&0x7F) << shift
+				m.ExitCode |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.JobsPending = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3096,16 +4661,29 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsPending |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.JobsRunning = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3115,13 +4693,197 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsRunning |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
+			}
+			m.JobsQueued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsQueued |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
+			}
+			m.JobsPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsPending |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
+			}
+			m.JobsRunning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsRunning |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedJob", wireType)
 			}
 			var msglen int
@@ -4448,59 +6210,9 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.UserAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLookout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLookout
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobLabels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4527,8 +6239,1432 @@ func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobInfos = append(m.JobInfos, &JobInfo{})
-			if err := m.JobInfos[len(m.JobInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.JobLabels == nil {
+				m.JobLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLookout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLookout(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.JobLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmittedAfter == nil {
+				m.SubmittedAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SubmittedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmittedBefore == nil {
+				m.SubmittedBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SubmittedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAfter == nil {
+				m.StartedAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedBefore == nil {
+				m.StartedBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAfter == nil {
+				m.FinishedAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FinishedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedBefore == nil {
+				m.FinishedBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FinishedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Descending = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCauses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureCauses = append(m.FailureCauses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobInfos = append(m.JobInfos, &JobInfo{})
+			if err := m.JobInfos[len(m.JobInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.To, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &JobStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BucketStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsStarted", wireType)
+			}
+			m.JobsStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsStarted |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsSucceeded", wireType)
+			}
+			m.JobsSucceeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsSucceeded |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsFailed", wireType)
+			}
+			m.JobsFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsFailed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FailureRate = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueWait", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueueWait == nil {
+				m.QueueWait = &DurationPercentiles{}
+			}
+			if err := m.QueueWait.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunTime == nil {
+				m.RunTime = &DurationPercentiles{}
+			}
+			if err := m.RunTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DurationPercentiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DurationPercentiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DurationPercentiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.P50 == nil {
+				m.P50 = &types.Duration{}
+			}
+			if err := m.P50.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.P90 == nil {
+				m.P90 = &types.Duration{}
+			}
+			if err := m.P90.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P95", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.P95 == nil {
+				m.P95 = &types.Duration{}
+			}
+			if err := m.P95.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.P99 == nil {
+				m.P99 = &types.Duration{}
+			}
+			if err := m.P99.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Max == nil {
+				m.Max = &types.Duration{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
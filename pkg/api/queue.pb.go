@@ -54,6 +54,14 @@ type Job struct {
 	PodSpecs                 []*v1.PodSpec     `protobuf:"bytes,12,rep,name=pod_specs,json=podSpecs,proto3" json:"podSpecs,omitempty"`
 	Created                  time.Time         `protobuf:"bytes,6,opt,name=created,proto3,stdtime" json:"created"`
 	Ingress                  []*IngressConfig  `protobuf:"bytes,14,rep,name=ingress,proto3" json:"ingress,omitempty"`
+	PeerDiscovery            bool              `protobuf:"varint,16,opt,name=peer_discovery,json=peerDiscovery,proto3" json:"peerDiscovery,omitempty"`
+	NotBefore                *time.Time        `protobuf:"bytes,17,opt,name=not_before,json=notBefore,proto3,stdtime" json:"notBefore,omitempty"`
+	// Maximum number of jobs of the job set leased or running at once, set from the submit request
+	JobSetMaxRunning uint32 `protobuf:"varint,18,opt,name=job_set_max_running,json=jobSetMaxRunning,proto3" json:"jobSetMaxRunning,omitempty"`
+	// Pools the job can run in, in order of preference, any pool when empty
+	AllowedPools []string `protobuf:"bytes,19,rep,name=allowed_pools,json=allowedPools,proto3" json:"allowedPools,omitempty"`
+	// Clusters the job can run on, in order of preference, any cluster when empty
+	AllowedClusters []string `protobuf:"bytes,20,rep,name=allowed_clusters,json=allowedClusters,proto3" json:"allowedClusters,omitempty"`
 }
 
 func (m *Job) Reset()      { *m = Job{} }
@@ -195,6 +203,41 @@ func (m *Job) GetIngress() []*IngressConfig {
 	return nil
 }
 
+func (m *Job) GetPeerDiscovery() bool {
+	if m != nil {
+		return m.PeerDiscovery
+	}
+	return false
+}
+
+func (m *Job) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *Job) GetJobSetMaxRunning() uint32 {
+	if m != nil {
+		return m.JobSetMaxRunning
+	}
+	return 0
+}
+
+func (m *Job) GetAllowedPools() []string {
+	if m != nil {
+		return m.AllowedPools
+	}
+	return nil
+}
+
+func (m *Job) GetAllowedClusters() []string {
+	if m != nil {
+		return m.AllowedClusters
+	}
+	return nil
+}
+
 type LeaseRequest struct {
 	ClusterId           string                       `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
 	Pool                string                       `protobuf:"bytes,8,opt,name=pool,proto3" json:"pool,omitempty"`
@@ -357,6 +400,8 @@ type NodeType struct {
 	Taints               []v1.Taint                   `protobuf:"bytes,1,rep,name=taints,proto3" json:"taints"`
 	Labels               map[string]string            `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	AllocatableResources map[string]resource.Quantity `protobuf:"bytes,3,rep,name=allocatable_resources,json=allocatableResources,proto3" json:"allocatableResources,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 0 in reports stored before node counts were tracked
+	NodeCount int32 `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"nodeCount,omitempty"`
 }
 
 func (m *NodeType) Reset()      { *m = NodeType{} }
@@ -412,6 +457,13 @@ func (m *NodeType) GetAllocatableResources() map[string]resource.Quantity {
 	return nil
 }
 
+func (m *NodeType) GetNodeCount() int32 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
 // Used to store last info in Redis
 type ClusterSchedulingInfoReport struct {
 	ClusterId      string                       `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
@@ -1009,97 +1061,105 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/queue.proto", fileDescriptor_d92c0c680df9617a) }
 
 var fileDescriptor_d92c0c680df9617a = []byte{
-	// 1429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xdd, 0x6e, 0xd4, 0xc6,
-	0x17, 0x8f, 0x77, 0x93, 0xfd, 0x38, 0x4b, 0xbe, 0x26, 0x09, 0x31, 0x1b, 0x58, 0x56, 0xfb, 0xd7,
-	0x9f, 0xa6, 0x2a, 0x78, 0x95, 0x94, 0xaa, 0x94, 0xb6, 0x48, 0x81, 0x44, 0x28, 0x29, 0x14, 0x70,
-	0x80, 0x2b, 0xa4, 0x95, 0xbd, 0x1e, 0x9c, 0x49, 0xbc, 0x1e, 0x33, 0x1e, 0x07, 0x2d, 0x57, 0xbc,
-	0x40, 0x2b, 0x9e, 0xa0, 0x2f, 0xd0, 0x77, 0xe8, 0x35, 0x97, 0x5c, 0x22, 0x55, 0xa2, 0x6d, 0x78,
-	0x88, 0xaa, 0x77, 0xd5, 0xcc, 0xd8, 0xbb, 0xde, 0x5d, 0xa7, 0x24, 0xd0, 0xb4, 0xea, 0x9d, 0x67,
-	0xce, 0xef, 0x9c, 0x33, 0xe7, 0xcc, 0xef, 0x9c, 0x39, 0x86, 0xb9, 0x60, 0xcf, 0x6d, 0x5a, 0x01,
-	0x69, 0x3e, 0x89, 0x70, 0x84, 0x8d, 0x80, 0x51, 0x4e, 0x51, 0xde, 0x0a, 0x48, 0xf5, 0xbc, 0x4b,
-	0xa9, 0xeb, 0xe1, 0xa6, 0xdc, 0xb2, 0xa3, 0xc7, 0x4d, 0x4e, 0x3a, 0x38, 0xe4, 0x56, 0x27, 0x50,
-	0xa8, 0x6a, 0x63, 0xef, 0x4a, 0x68, 0x10, 0x2a, 0xb5, 0xdb, 0x94, 0xe1, 0xe6, 0xfe, 0x4a, 0xd3,
-	0xc5, 0x3e, 0x66, 0x16, 0xc7, 0x4e, 0x8c, 0xb9, 0xdc, 0xc7, 0x74, 0xac, 0xf6, 0x0e, 0xf1, 0x31,
-	0xeb, 0x36, 0x13, 0x97, 0x0c, 0x87, 0x34, 0x62, 0x6d, 0x3c, 0xa2, 0x75, 0xc9, 0x25, 0x7c, 0x27,
-	0xb2, 0x8d, 0x36, 0xed, 0x34, 0x5d, 0xea, 0xd2, 0xfe, 0x19, 0xc4, 0x4a, 0x2e, 0xe4, 0x57, 0x0c,
-	0x5f, 0x1a, 0x3e, 0x29, 0xee, 0x04, 0xbc, 0x1b, 0x0b, 0xe7, 0x13, 0x6f, 0x61, 0x64, 0x77, 0x08,
-	0x57, 0xbb, 0x8d, 0x37, 0x05, 0xc8, 0x6f, 0x51, 0x1b, 0x4d, 0x41, 0x8e, 0x38, 0xba, 0x56, 0xd7,
-	0x96, 0xcb, 0x66, 0x8e, 0x38, 0x68, 0x09, 0xca, 0x6d, 0x8f, 0x60, 0x9f, 0xb7, 0x88, 0xa3, 0x4f,
-	0xca, 0xed, 0x92, 0xda, 0xd8, 0x74, 0xd0, 0x59, 0x80, 0x5d, 0x6a, 0xb7, 0x42, 0x2c, 0xa5, 0x39,
-	0x25, 0xdd, 0xa5, 0xf6, 0x36, 0x16, 0xd2, 0x79, 0x98, 0x90, 0x39, 0xd4, 0xf3, 0x52, 0xa0, 0x16,
-	0xe8, 0x2c, 0x94, 0x7d, 0xab, 0x83, 0xc3, 0xc0, 0x6a, 0x63, 0xbd, 0x28, 0x25, 0xfd, 0x0d, 0x74,
-	0x11, 0x0a, 0x9e, 0x65, 0x63, 0x2f, 0xd4, 0xcb, 0xf5, 0xfc, 0x72, 0x65, 0x75, 0xde, 0xb0, 0x02,
-	0x62, 0x6c, 0x51, 0xdb, 0xb8, 0x25, 0xb7, 0x37, 0x7c, 0xce, 0xba, 0x66, 0x8c, 0x41, 0x5f, 0x42,
-	0xc5, 0xf2, 0x7d, 0xca, 0x2d, 0x4e, 0xa8, 0x1f, 0xea, 0x20, 0x55, 0xce, 0xf4, 0x54, 0xd6, 0xfa,
-	0x32, 0xa5, 0x97, 0x46, 0xa3, 0x87, 0x30, 0xcf, 0xf0, 0x93, 0x88, 0x30, 0xec, 0xb4, 0x7c, 0xea,
-	0xe0, 0x56, 0xec, 0xb8, 0x22, 0xad, 0xd4, 0x7b, 0x56, 0xcc, 0x18, 0xf4, 0x2d, 0x75, 0x70, 0xea,
-	0x10, 0xd7, 0x73, 0xba, 0x66, 0x22, 0x36, 0x22, 0x14, 0x61, 0xd3, 0xa7, 0x3e, 0x66, 0x7a, 0x49,
-	0x85, 0x2d, 0x17, 0xe8, 0x6b, 0x58, 0x92, 0xf1, 0xb7, 0xe4, 0x32, 0xdc, 0x21, 0x41, 0x2b, 0x0a,
-	0x31, 0x6b, 0xb9, 0x8c, 0x46, 0x41, 0xa8, 0x4f, 0xd7, 0xf3, 0xcb, 0x65, 0x53, 0x97, 0x90, 0x3b,
-	0x09, 0xe2, 0x41, 0x88, 0xd9, 0x4d, 0x29, 0x47, 0x55, 0x28, 0x05, 0x8c, 0x50, 0x46, 0x78, 0x57,
-	0x1f, 0xaf, 0x6b, 0xcb, 0x9a, 0xd9, 0x5b, 0xa3, 0xab, 0x50, 0x0a, 0xa8, 0xd3, 0x0a, 0x03, 0xdc,
-	0xd6, 0x27, 0xea, 0xda, 0x72, 0x65, 0x75, 0xc9, 0x50, 0x2c, 0x93, 0x31, 0x08, 0x26, 0x1a, 0xfb,
-	0x2b, 0xc6, 0x5d, 0xea, 0x6c, 0x07, 0xb8, 0x2d, 0xcf, 0x5d, 0x0c, 0xd4, 0x02, 0x5d, 0x81, 0x72,
-	0xa2, 0x1b, 0xea, 0xa7, 0x64, 0xe4, 0x7f, 0xa5, 0x6c, 0x96, 0x62, 0xc5, 0x10, 0x5d, 0x83, 0x62,
-	0x9b, 0x61, 0xc1, 0x51, 0xbd, 0x20, 0x9d, 0x56, 0x0d, 0xc5, 0x3a, 0x23, 0x61, 0x9d, 0x71, 0x3f,
-	0xa9, 0x8f, 0xeb, 0xa5, 0x97, 0x6f, 0xce, 0x8f, 0xbd, 0xf8, 0xe5, 0xbc, 0x66, 0x26, 0x4a, 0xe8,
-	0x22, 0x14, 0x89, 0xef, 0x32, 0x1c, 0x86, 0xfa, 0x94, 0xf4, 0x8b, 0xa4, 0xc3, 0x4d, 0xb5, 0x77,
-	0x83, 0xfa, 0x8f, 0x89, 0x6b, 0x26, 0x90, 0xea, 0x17, 0x50, 0x49, 0xe5, 0x1e, 0xcd, 0x40, 0x7e,
-	0x0f, 0x77, 0x63, 0x9a, 0x8a, 0x4f, 0x91, 0xf5, 0x7d, 0xcb, 0x8b, 0x70, 0xcc, 0x42, 0xb5, 0xb8,
-	0x9a, 0xbb, 0xa2, 0x55, 0xaf, 0xc1, 0xcc, 0x30, 0x11, 0x8e, 0xa5, 0xbf, 0x01, 0x8b, 0x87, 0x50,
-	0xe0, 0x38, 0x66, 0x1a, 0x3f, 0x8d, 0xc3, 0xa9, 0x5b, 0xd8, 0x0a, 0xb1, 0x30, 0x86, 0x43, 0x8e,
-	0xce, 0x01, 0xb4, 0xbd, 0x28, 0xe4, 0x98, 0xb5, 0x7a, 0x15, 0x57, 0x8e, 0x77, 0x36, 0x1d, 0x84,
-	0x60, 0x3c, 0xa0, 0xd4, 0x8b, 0x59, 0x24, 0xbf, 0xd1, 0x3a, 0x94, 0x93, 0x16, 0x11, 0xea, 0xb9,
-	0x14, 0x4f, 0xd3, 0x86, 0x0d, 0x33, 0x81, 0x28, 0x9e, 0x8e, 0x8b, 0xdc, 0x9b, 0x7d, 0x45, 0x64,
-	0xc2, 0x42, 0xe2, 0xd8, 0x13, 0x7a, 0x4e, 0x8b, 0xe1, 0x80, 0x32, 0x2e, 0x89, 0x55, 0x59, 0xd5,
-	0xa5, 0xc5, 0x1b, 0x0a, 0x21, 0x0d, 0x3b, 0xa6, 0x94, 0xc7, 0x96, 0xe6, 0xda, 0xa3, 0x22, 0xf4,
-	0x00, 0x66, 0x3a, 0xc4, 0x27, 0x9d, 0xa8, 0xd3, 0x92, 0x1d, 0x81, 0x3c, 0xc3, 0x7a, 0x41, 0x1e,
-	0xf0, 0xff, 0xa3, 0x07, 0xbc, 0xad, 0x90, 0x5b, 0xd4, 0xde, 0x26, 0xcf, 0x70, 0xfa, 0x94, 0x53,
-	0x9d, 0x01, 0x11, 0xfa, 0x18, 0x26, 0x44, 0x69, 0x86, 0x7a, 0x51, 0xda, 0x9a, 0x94, 0xb6, 0xc4,
-	0x2d, 0x6c, 0xfa, 0x8f, 0x69, 0xac, 0xa3, 0x10, 0x55, 0x0f, 0xa6, 0x06, 0x03, 0xcf, 0xb8, 0x9d,
-	0xf5, 0xf4, 0xed, 0x54, 0x56, 0x8d, 0x14, 0xd3, 0x7b, 0xcd, 0xd8, 0x08, 0xf6, 0x5c, 0xe9, 0x26,
-	0x49, 0x98, 0x71, 0x2f, 0xb2, 0x7c, 0x4e, 0x78, 0x37, 0x4d, 0x8a, 0x27, 0x30, 0x97, 0x11, 0xc5,
-	0x49, 0xba, 0x6c, 0xfc, 0x3e, 0x0e, 0xa5, 0x24, 0x74, 0xc1, 0x0e, 0xd1, 0x34, 0x63, 0x4f, 0xf2,
-	0x1b, 0x7d, 0x0e, 0x05, 0x6e, 0x11, 0x9f, 0x27, 0xd4, 0x38, 0x93, 0x55, 0xc8, 0xf7, 0x05, 0x22,
-	0xce, 0x5c, 0x0c, 0x47, 0x2b, 0xbd, 0xa6, 0x9b, 0x4f, 0x75, 0xd0, 0xc4, 0x57, 0x66, 0xe7, 0xb5,
-	0x61, 0xc1, 0xf2, 0x3c, 0xda, 0xb6, 0xb8, 0x65, 0x7b, 0xb8, 0xd5, 0x67, 0xe5, 0xb8, 0xb4, 0xf0,
-	0xd1, 0xa0, 0x85, 0xb5, 0x3e, 0x34, 0x93, 0x9c, 0xf3, 0x56, 0x06, 0x00, 0x3d, 0x82, 0x39, 0x6b,
-	0xdf, 0x22, 0xde, 0x90, 0x87, 0x89, 0x14, 0xad, 0xfa, 0x1e, 0x12, 0x60, 0xa6, 0x7d, 0x64, 0x8d,
-	0x88, 0x3f, 0xa4, 0xa3, 0x3c, 0x85, 0x33, 0x87, 0x46, 0x74, 0xa2, 0xac, 0x8b, 0x60, 0xf1, 0x90,
-	0x40, 0x4f, 0x94, 0x79, 0xdf, 0xe7, 0x15, 0xf3, 0xee, 0x77, 0x83, 0x34, 0xcb, 0xb4, 0xf7, 0x65,
-	0x59, 0x6e, 0x88, 0x65, 0xc2, 0xee, 0xf1, 0x58, 0x96, 0x1f, 0x62, 0x99, 0xb4, 0xf0, 0x5e, 0x2c,
-	0xfb, 0x2f, 0xf2, 0xa0, 0xf1, 0x43, 0x1e, 0x96, 0xe2, 0x06, 0xbd, 0xdd, 0xde, 0xc1, 0x4e, 0xe4,
-	0x11, 0xdf, 0x15, 0x75, 0x10, 0x77, 0xe3, 0x23, 0x3e, 0x2d, 0xc5, 0xd4, 0xd3, 0xb2, 0x01, 0x15,
-	0xf5, 0x0a, 0xb4, 0xc4, 0x54, 0x1b, 0x1f, 0xf1, 0x68, 0x4f, 0x3a, 0x28, 0x45, 0x21, 0x42, 0x17,
-	0x01, 0xe4, 0x2c, 0xc5, 0xbb, 0x41, 0xaf, 0x54, 0x27, 0x07, 0xae, 0xc9, 0x2c, 0xfb, 0xf1, 0x57,
-	0x88, 0x9c, 0x43, 0x5f, 0x8d, 0xcb, 0xe9, 0x47, 0x28, 0x2b, 0xc6, 0xa3, 0x3f, 0x22, 0xff, 0x46,
-	0xaf, 0xfe, 0x43, 0x83, 0xd9, 0x7b, 0x62, 0x96, 0x1b, 0x78, 0x24, 0xb3, 0x9a, 0xf6, 0x23, 0x98,
-	0xe9, 0xd1, 0x3a, 0x7e, 0x8e, 0xe3, 0xfa, 0xf8, 0x44, 0xba, 0x19, 0xb1, 0xd2, 0x7f, 0xde, 0xd5,
-	0x6e, 0x3a, 0xf2, 0x69, 0x36, 0x28, 0xab, 0x32, 0x98, 0xcf, 0x82, 0x9f, 0x68, 0xec, 0x3f, 0x6a,
-	0x30, 0x97, 0x31, 0x3d, 0xbc, 0x8b, 0x94, 0x7f, 0x13, 0x01, 0x0d, 0x28, 0xc8, 0x21, 0x3a, 0xe9,
-	0x11, 0xa7, 0xb3, 0xb3, 0x68, 0xc6, 0xa8, 0xc6, 0x4b, 0x0d, 0xa6, 0x6f, 0xd0, 0x4e, 0x10, 0xf1,
-	0x5e, 0x01, 0xa3, 0x9b, 0xe9, 0x31, 0x4b, 0x75, 0xb9, 0xff, 0x29, 0x3e, 0x0e, 0x02, 0xdf, 0x35,
-	0x69, 0xfd, 0xb3, 0x33, 0x49, 0xe3, 0xb9, 0x06, 0xa7, 0x7a, 0x13, 0x2a, 0xf1, 0x5d, 0xf4, 0xd9,
-	0xd0, 0xbb, 0x7e, 0xae, 0x57, 0x88, 0x09, 0x24, 0xab, 0xeb, 0x7e, 0x40, 0x47, 0x6c, 0x5c, 0x80,
-	0xd2, 0x16, 0xb5, 0x65, 0xa2, 0x51, 0x15, 0xf2, 0xbb, 0xd4, 0x8e, 0xf3, 0x57, 0x4a, 0x7e, 0xa7,
-	0x4c, 0xb1, 0xd9, 0xa8, 0x42, 0x61, 0xd3, 0xb9, 0x45, 0x42, 0x2e, 0xac, 0x13, 0x47, 0x65, 0xb9,
-	0x6c, 0x8a, 0xcf, 0xc6, 0x3a, 0xcc, 0x9a, 0xd8, 0xc7, 0x4f, 0x8f, 0x33, 0x2c, 0xc7, 0x56, 0x72,
-	0x7d, 0x2b, 0xdf, 0x69, 0x80, 0x4c, 0xcc, 0x23, 0xe6, 0x1f, 0xc7, 0xce, 0x02, 0x14, 0x44, 0x23,
-	0xea, 0xfd, 0xcc, 0x4e, 0xec, 0x52, 0x7b, 0xd3, 0x41, 0x6b, 0x30, 0x6b, 0xed, 0x53, 0x32, 0xf8,
-	0x9f, 0xa8, 0xa6, 0xe5, 0x05, 0x19, 0xd8, 0x1d, 0xe6, 0x60, 0x86, 0x9d, 0x6d, 0xce, 0x88, 0xef,
-	0xde, 0xb6, 0x02, 0x73, 0x5a, 0xe2, 0xfb, 0xff, 0x0b, 0x8d, 0xaf, 0x00, 0x29, 0xe9, 0x37, 0xb8,
-	0xfb, 0x50, 0xe4, 0xeb, 0xae, 0x45, 0xd8, 0x51, 0x73, 0xdb, 0xd8, 0x80, 0x99, 0x61, 0x17, 0x68,
-	0x05, 0x8a, 0xd8, 0xe7, 0x8c, 0xf4, 0x38, 0xba, 0x28, 0x8f, 0x32, 0xea, 0xc5, 0x4c, 0x70, 0xab,
-	0x3f, 0x6b, 0x30, 0xbd, 0xe6, 0xba, 0x0c, 0xbb, 0xe2, 0x17, 0x4c, 0x16, 0x05, 0xba, 0x04, 0x65,
-	0x99, 0xa1, 0x2d, 0x6a, 0x87, 0x68, 0x76, 0x64, 0x58, 0xaf, 0x4e, 0x26, 0x37, 0xa7, 0x6e, 0x75,
-	0x05, 0xa0, 0x7f, 0x3b, 0x48, 0x55, 0xd7, 0xc8, 0x75, 0x55, 0x2b, 0xea, 0x5f, 0x4e, 0x5d, 0xf1,
-	0x35, 0xa8, 0xa4, 0x6e, 0x02, 0x2d, 0xc6, 0x3a, 0xc3, 0x77, 0x53, 0x3d, 0x3d, 0x52, 0xec, 0x1b,
-	0x9d, 0x80, 0x77, 0xd1, 0x05, 0xe1, 0x52, 0x14, 0xed, 0x3a, 0xf5, 0x31, 0x4a, 0x9b, 0x1e, 0xf0,
-	0x73, 0xbd, 0xfe, 0xfa, 0xb7, 0xda, 0xd8, 0xf3, 0x83, 0x9a, 0xf6, 0xf2, 0xa0, 0xa6, 0xbd, 0x3a,
-	0xa8, 0x69, 0xbf, 0x1e, 0xd4, 0xb4, 0x17, 0x6f, 0x6b, 0x63, 0xaf, 0xde, 0xd6, 0xc6, 0x5e, 0xbf,
-	0xad, 0x8d, 0xd9, 0x05, 0x69, 0xf9, 0xd3, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xad, 0xb6,
-	0x61, 0xe4, 0x11, 0x00, 0x00,
+	// 1558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5b, 0x6f, 0x13, 0x49,
+	0x16, 0x4e, 0xdb, 0x89, 0x63, 0x1f, 0xe7, 0xe2, 0x54, 0x12, 0xd2, 0x38, 0x60, 0x2c, 0x23, 0xd8,
+	0xa0, 0x85, 0xb6, 0x92, 0x65, 0xb5, 0x2c, 0xbb, 0x8b, 0x94, 0x9b, 0x50, 0xb2, 0xb0, 0x40, 0x07,
+	0x78, 0x42, 0x6a, 0x75, 0xbb, 0x2b, 0x9d, 0x4a, 0xec, 0xae, 0xa6, 0xba, 0x3b, 0xc1, 0x3c, 0xf1,
+	0x07, 0x56, 0xe2, 0x17, 0xec, 0x1f, 0x58, 0xcd, 0x5f, 0x98, 0x67, 0xe6, 0x8d, 0x47, 0xa4, 0x91,
+	0xe6, 0x12, 0x7e, 0xc4, 0x68, 0xde, 0x46, 0x75, 0x69, 0xbb, 0x7d, 0x89, 0x48, 0x60, 0x32, 0xa3,
+	0x79, 0xeb, 0x3a, 0xd7, 0x3a, 0xa7, 0xbe, 0x73, 0x4e, 0x55, 0xc3, 0x6c, 0x70, 0xe0, 0xd5, 0xed,
+	0x80, 0xd4, 0x5f, 0xc6, 0x38, 0xc6, 0x46, 0xc0, 0x68, 0x44, 0x51, 0xd6, 0x0e, 0x48, 0xf9, 0x8a,
+	0x47, 0xa9, 0xd7, 0xc4, 0x75, 0x41, 0x72, 0xe2, 0xdd, 0x7a, 0x44, 0x5a, 0x38, 0x8c, 0xec, 0x56,
+	0x20, 0xa5, 0xca, 0xb5, 0x83, 0x3b, 0xa1, 0x41, 0xa8, 0xd0, 0x6e, 0x50, 0x86, 0xeb, 0x87, 0xcb,
+	0x75, 0x0f, 0xfb, 0x98, 0xd9, 0x11, 0x76, 0x95, 0xcc, 0xed, 0xae, 0x4c, 0xcb, 0x6e, 0xec, 0x11,
+	0x1f, 0xb3, 0x76, 0x3d, 0x71, 0xc9, 0x70, 0x48, 0x63, 0xd6, 0xc0, 0x03, 0x5a, 0xb7, 0x3c, 0x12,
+	0xed, 0xc5, 0x8e, 0xd1, 0xa0, 0xad, 0xba, 0x47, 0x3d, 0xda, 0xdd, 0x03, 0x5f, 0x89, 0x85, 0xf8,
+	0x52, 0xe2, 0x8b, 0xfd, 0x3b, 0xc5, 0xad, 0x20, 0x6a, 0x2b, 0xe6, 0x5c, 0xe2, 0x2d, 0x8c, 0x9d,
+	0x16, 0x89, 0x24, 0xb5, 0xf6, 0x4d, 0x1e, 0xb2, 0xdb, 0xd4, 0x41, 0x53, 0x90, 0x21, 0xae, 0xae,
+	0x55, 0xb5, 0xa5, 0x82, 0x99, 0x21, 0x2e, 0x5a, 0x84, 0x42, 0xa3, 0x49, 0xb0, 0x1f, 0x59, 0xc4,
+	0xd5, 0x27, 0x05, 0x39, 0x2f, 0x09, 0x5b, 0x2e, 0xba, 0x04, 0xb0, 0x4f, 0x1d, 0x2b, 0xc4, 0x82,
+	0x9b, 0x91, 0xdc, 0x7d, 0xea, 0xec, 0x60, 0xce, 0x9d, 0x83, 0x31, 0x91, 0x43, 0x3d, 0x2b, 0x18,
+	0x72, 0x81, 0x2e, 0x41, 0xc1, 0xb7, 0x5b, 0x38, 0x0c, 0xec, 0x06, 0xd6, 0xc7, 0x05, 0xa7, 0x4b,
+	0x40, 0x37, 0x21, 0xd7, 0xb4, 0x1d, 0xdc, 0x0c, 0xf5, 0x42, 0x35, 0xbb, 0x54, 0x5c, 0x99, 0x33,
+	0xec, 0x80, 0x18, 0xdb, 0xd4, 0x31, 0x1e, 0x08, 0xf2, 0xa6, 0x1f, 0xb1, 0xb6, 0xa9, 0x64, 0xd0,
+	0x3f, 0xa0, 0x68, 0xfb, 0x3e, 0x8d, 0xec, 0x88, 0x50, 0x3f, 0xd4, 0x41, 0xa8, 0x5c, 0xec, 0xa8,
+	0xac, 0x76, 0x79, 0x52, 0x2f, 0x2d, 0x8d, 0x9e, 0xc3, 0x1c, 0xc3, 0x2f, 0x63, 0xc2, 0xb0, 0x6b,
+	0xf9, 0xd4, 0xc5, 0x96, 0x72, 0x5c, 0x14, 0x56, 0xaa, 0x1d, 0x2b, 0xa6, 0x12, 0xfa, 0x0f, 0x75,
+	0x71, 0x6a, 0x13, 0x6b, 0x19, 0x5d, 0x33, 0x11, 0x1b, 0x60, 0xf2, 0xb0, 0xe9, 0x91, 0x8f, 0x99,
+	0x9e, 0x97, 0x61, 0x8b, 0x05, 0xfa, 0x17, 0x2c, 0x8a, 0xf8, 0x2d, 0xb1, 0x0c, 0xf7, 0x48, 0x60,
+	0xc5, 0x21, 0x66, 0x96, 0xc7, 0x68, 0x1c, 0x84, 0xfa, 0x74, 0x35, 0xbb, 0x54, 0x30, 0x75, 0x21,
+	0xf2, 0x28, 0x91, 0x78, 0x16, 0x62, 0x76, 0x5f, 0xf0, 0x51, 0x19, 0xf2, 0x01, 0x23, 0x94, 0x91,
+	0xa8, 0xad, 0x8f, 0x56, 0xb5, 0x25, 0xcd, 0xec, 0xac, 0xd1, 0x5d, 0xc8, 0x07, 0xd4, 0xb5, 0xc2,
+	0x00, 0x37, 0xf4, 0xb1, 0xaa, 0xb6, 0x54, 0x5c, 0x59, 0x34, 0x24, 0xca, 0x44, 0x0c, 0x1c, 0x89,
+	0xc6, 0xe1, 0xb2, 0xf1, 0x98, 0xba, 0x3b, 0x01, 0x6e, 0x88, 0x7d, 0x8f, 0x07, 0x72, 0x81, 0xee,
+	0x40, 0x21, 0xd1, 0x0d, 0xf5, 0x89, 0x6a, 0xf6, 0x13, 0xca, 0x66, 0x5e, 0x29, 0x86, 0xe8, 0x1e,
+	0x8c, 0x37, 0x18, 0xe6, 0x18, 0xd5, 0x73, 0xc2, 0x69, 0xd9, 0x90, 0xa8, 0x33, 0x12, 0xd4, 0x19,
+	0x4f, 0x93, 0xfa, 0x58, 0xcb, 0xbf, 0xfb, 0xee, 0xca, 0xc8, 0xdb, 0xef, 0xaf, 0x68, 0x66, 0xa2,
+	0x84, 0x6e, 0xc2, 0x38, 0xf1, 0x3d, 0x86, 0xc3, 0x50, 0x9f, 0x12, 0x7e, 0x91, 0x70, 0xb8, 0x25,
+	0x69, 0xeb, 0xd4, 0xdf, 0x25, 0x9e, 0x99, 0x88, 0xa0, 0x6b, 0x30, 0x15, 0x60, 0xcc, 0x2c, 0x97,
+	0x84, 0x0d, 0x7a, 0x88, 0x59, 0x5b, 0x2f, 0x55, 0xb5, 0xa5, 0xbc, 0x39, 0xc9, 0xa9, 0x1b, 0x09,
+	0x11, 0xad, 0x03, 0xf8, 0x34, 0xb2, 0x1c, 0xbc, 0x4b, 0x19, 0xd6, 0x67, 0x4e, 0xb5, 0x2f, 0x4d,
+	0xec, 0xab, 0xe0, 0xd3, 0x68, 0x4d, 0xa8, 0xa1, 0x5b, 0x30, 0x9b, 0xa0, 0xba, 0x65, 0xbf, 0xb2,
+	0x58, 0xec, 0xfb, 0xc4, 0xf7, 0x74, 0x54, 0xd5, 0x96, 0x26, 0xcd, 0x92, 0x84, 0xf7, 0x43, 0xfb,
+	0x95, 0x29, 0xe9, 0xe8, 0x2a, 0x4c, 0xda, 0xcd, 0x26, 0x3d, 0xc2, 0xae, 0x15, 0x50, 0xda, 0x0c,
+	0xf5, 0x59, 0x71, 0x96, 0x13, 0x8a, 0xf8, 0x98, 0xd3, 0xd0, 0x0d, 0x28, 0x25, 0x42, 0x8d, 0x66,
+	0x1c, 0x46, 0x98, 0x85, 0xfa, 0x9c, 0x90, 0x9b, 0x56, 0xf4, 0x75, 0x45, 0x2e, 0xff, 0x1d, 0x8a,
+	0x29, 0x98, 0xa1, 0x12, 0x64, 0x0f, 0x70, 0x5b, 0x55, 0x24, 0xff, 0xe4, 0x00, 0x3b, 0xb4, 0x9b,
+	0x31, 0x56, 0x05, 0x27, 0x17, 0x77, 0x33, 0x77, 0xb4, 0xf2, 0x3d, 0x28, 0xf5, 0x63, 0xfe, 0x4c,
+	0xfa, 0x9b, 0xb0, 0x70, 0x02, 0xda, 0xcf, 0x62, 0xa6, 0xf6, 0xf5, 0x28, 0x4c, 0x3c, 0xc0, 0x76,
+	0x88, 0xb9, 0x31, 0x1c, 0x46, 0xe8, 0x32, 0x80, 0x8a, 0xda, 0xea, 0x34, 0x97, 0x82, 0xa2, 0x6c,
+	0xb9, 0x08, 0xc1, 0x28, 0xcf, 0x9c, 0x2a, 0x18, 0xf1, 0x8d, 0x36, 0xa0, 0x90, 0x74, 0xc3, 0x50,
+	0xcf, 0xa4, 0x4a, 0x32, 0x6d, 0xd8, 0x30, 0x13, 0x11, 0x59, 0x92, 0xa3, 0x1c, 0x66, 0x66, 0x57,
+	0x11, 0x99, 0x30, 0x9f, 0x38, 0x6e, 0x72, 0x3d, 0xd7, 0x62, 0x38, 0xa0, 0x2c, 0x12, 0x35, 0x54,
+	0x5c, 0xd1, 0x85, 0x45, 0x95, 0x79, 0x61, 0xd8, 0x35, 0x05, 0x5f, 0x59, 0x9a, 0x6d, 0x0c, 0xb2,
+	0xd0, 0x33, 0x28, 0xb5, 0x88, 0x4f, 0x5a, 0x71, 0xcb, 0x12, 0x30, 0x21, 0xaf, 0xb1, 0x9e, 0x13,
+	0x1b, 0xbc, 0x36, 0xb8, 0xc1, 0x87, 0x52, 0x72, 0x9b, 0x3a, 0x3b, 0xe4, 0x35, 0x4e, 0xef, 0x72,
+	0xaa, 0xd5, 0xc3, 0x42, 0x37, 0x60, 0x8c, 0x77, 0xa1, 0x50, 0x1f, 0x17, 0xb6, 0x26, 0x85, 0x2d,
+	0x7e, 0x0a, 0x5b, 0xfe, 0x2e, 0x55, 0x3a, 0x52, 0xa2, 0xdc, 0x84, 0xa9, 0xde, 0xc0, 0x87, 0x9c,
+	0xce, 0x46, 0xfa, 0x74, 0x8a, 0x2b, 0x46, 0xaa, 0xa8, 0x3b, 0x73, 0xc7, 0x08, 0x0e, 0x3c, 0xe1,
+	0x26, 0x49, 0x98, 0xf1, 0x24, 0xb6, 0xfd, 0x88, 0x44, 0xed, 0x34, 0x28, 0x5e, 0xc2, 0xec, 0x90,
+	0x28, 0xce, 0xd3, 0x65, 0xed, 0xa7, 0x51, 0xc8, 0x27, 0xa1, 0x73, 0x74, 0xf0, 0xf9, 0xa0, 0x3c,
+	0x89, 0x6f, 0xf4, 0x37, 0xc8, 0x45, 0x36, 0xf1, 0xa3, 0x04, 0x1a, 0x17, 0x87, 0xf5, 0xac, 0xa7,
+	0x5c, 0x42, 0x65, 0x4e, 0x89, 0xa3, 0xe5, 0xce, 0x7c, 0xc9, 0xa6, 0x86, 0x45, 0xe2, 0x6b, 0xe8,
+	0x90, 0x71, 0x60, 0x9e, 0x97, 0x68, 0xc3, 0x8e, 0x6c, 0xa7, 0x89, 0xad, 0x2e, 0x2a, 0x47, 0x85,
+	0x85, 0x3f, 0xf5, 0x5a, 0x58, 0xed, 0x8a, 0x0e, 0x05, 0xe7, 0x9c, 0x3d, 0x44, 0x00, 0xbd, 0x80,
+	0x59, 0xfb, 0xd0, 0x26, 0xcd, 0x3e, 0x0f, 0x63, 0x29, 0x58, 0x75, 0x3d, 0x24, 0x82, 0x43, 0xed,
+	0x23, 0x7b, 0x80, 0xfd, 0x25, 0x1d, 0xe5, 0x08, 0x2e, 0x9e, 0x18, 0xd1, 0xb9, 0xa2, 0x2e, 0x86,
+	0x85, 0x13, 0x02, 0x3d, 0x57, 0xe4, 0x7d, 0x95, 0x95, 0xc8, 0x7b, 0xda, 0x0e, 0xd2, 0x28, 0xd3,
+	0x3e, 0x17, 0x65, 0x99, 0x3e, 0x94, 0x71, 0xbb, 0x67, 0x43, 0x59, 0xb6, 0x0f, 0x65, 0xc2, 0xc2,
+	0xe7, 0xa1, 0xec, 0x32, 0x9f, 0x8e, 0x2e, 0xb6, 0x1a, 0x34, 0xf6, 0x65, 0x0b, 0x1c, 0xe3, 0x73,
+	0xcf, 0xc5, 0xeb, 0x9c, 0xf0, 0x47, 0x84, 0x49, 0xed, 0x7f, 0x59, 0x58, 0x54, 0xfd, 0x7b, 0xa7,
+	0xb1, 0x87, 0xdd, 0xb8, 0x49, 0x7c, 0x8f, 0x97, 0x89, 0x6a, 0xd6, 0xa7, 0x9c, 0x3c, 0xe3, 0xa9,
+	0xc9, 0xb3, 0x09, 0x45, 0x39, 0x24, 0x2c, 0x7e, 0xbf, 0xd7, 0x33, 0xa7, 0xba, 0x44, 0xc8, 0xcb,
+	0x0d, 0x48, 0x45, 0xce, 0x42, 0x37, 0x55, 0xb2, 0xa3, 0x76, 0xd0, 0xa9, 0xe4, 0xc9, 0x9e, 0x53,
+	0x94, 0xb9, 0xe7, 0x5f, 0x21, 0x72, 0x4f, 0x1c, 0x2a, 0xb7, 0xd3, 0x33, 0x6a, 0x58, 0x8c, 0xa7,
+	0x9f, 0x31, 0xbf, 0x47, 0x2b, 0xff, 0x59, 0x83, 0x99, 0x27, 0x31, 0x8e, 0x71, 0xcf, 0x0c, 0x1d,
+	0xd6, 0xd3, 0x5f, 0x40, 0xa9, 0x83, 0x7a, 0x35, 0xad, 0x55, 0xf9, 0xfc, 0x59, 0xb8, 0x19, 0xb0,
+	0xd2, 0x9d, 0xfe, 0x92, 0x9a, 0x8e, 0x7c, 0x9a, 0xf5, 0xf2, 0xca, 0x0c, 0xe6, 0x86, 0x89, 0x9f,
+	0x6b, 0xec, 0xff, 0xd7, 0x60, 0x76, 0xc8, 0xe5, 0xe2, 0x53, 0xa0, 0xfc, 0x95, 0x00, 0x68, 0x40,
+	0x4e, 0x3c, 0x27, 0x92, 0x16, 0x72, 0x61, 0x78, 0x16, 0x4d, 0x25, 0x55, 0x7b, 0xa7, 0xc1, 0xf4,
+	0x3a, 0x6d, 0x05, 0x71, 0xd4, 0x29, 0x60, 0x74, 0x3f, 0x7d, 0x0b, 0x93, 0x4d, 0xf0, 0xaa, 0xc4,
+	0x63, 0xaf, 0xe0, 0xa7, 0x2e, 0x62, 0xbf, 0xed, 0x95, 0xa5, 0xf6, 0x46, 0x83, 0x89, 0xce, 0x05,
+	0x96, 0xdf, 0xd1, 0xff, 0xda, 0x37, 0xf6, 0x2f, 0x77, 0x0a, 0x31, 0x11, 0x19, 0xd6, 0x94, 0xbf,
+	0xa0, 0x23, 0xd6, 0xae, 0x43, 0x7e, 0x9b, 0x3a, 0x22, 0xd1, 0xa8, 0x0c, 0xd9, 0x7d, 0xea, 0xa8,
+	0xfc, 0xe5, 0x93, 0x87, 0xa5, 0xc9, 0x89, 0xb5, 0x32, 0xe4, 0xb6, 0xdc, 0x07, 0x24, 0x8c, 0xb8,
+	0x75, 0xe2, 0xca, 0x2c, 0x17, 0x4c, 0xfe, 0x59, 0xdb, 0x80, 0x19, 0x13, 0xfb, 0xf8, 0xe8, 0x2c,
+	0x77, 0x69, 0x65, 0x25, 0xd3, 0xb5, 0xf2, 0x5f, 0x0d, 0x90, 0x89, 0xa3, 0x98, 0xf9, 0x67, 0xb1,
+	0x33, 0x0f, 0x39, 0xde, 0x88, 0x3a, 0xcf, 0xfa, 0xb1, 0x7d, 0xea, 0x6c, 0xb9, 0x68, 0x15, 0x66,
+	0xec, 0x43, 0x4a, 0x7a, 0x5f, 0xcc, 0xf2, 0x32, 0x3d, 0x2f, 0x02, 0x7b, 0xc4, 0x5c, 0xcc, 0xb0,
+	0xbb, 0x13, 0x31, 0xe2, 0x7b, 0x0f, 0xed, 0xc0, 0x9c, 0x16, 0xf2, 0xdd, 0xe7, 0x44, 0xed, 0x9f,
+	0x80, 0x24, 0xf7, 0xdf, 0xb8, 0xfd, 0x9c, 0xe7, 0xeb, 0xb1, 0x4d, 0xd8, 0x69, 0x73, 0x5b, 0xdb,
+	0x84, 0x52, 0xbf, 0x0b, 0xb4, 0x0c, 0xe3, 0xd8, 0x8f, 0x18, 0xe9, 0x60, 0x74, 0x41, 0x6c, 0x65,
+	0xd0, 0x8b, 0x99, 0xc8, 0xad, 0x7c, 0xab, 0xc1, 0xf4, 0xaa, 0xe7, 0x31, 0xec, 0xf1, 0xc7, 0xa8,
+	0x28, 0x0a, 0x74, 0x0b, 0x0a, 0x22, 0x43, 0xdb, 0xd4, 0x09, 0xd1, 0xcc, 0xc0, 0x5d, 0xbe, 0x3c,
+	0x99, 0x9c, 0x9c, 0x3c, 0xd5, 0x65, 0x80, 0xee, 0xe9, 0x20, 0x59, 0x5d, 0x03, 0xc7, 0x55, 0x2e,
+	0xca, 0x57, 0xad, 0x3c, 0xe2, 0x7b, 0x50, 0x4c, 0x9d, 0x04, 0x5a, 0x50, 0x3a, 0xfd, 0x67, 0x53,
+	0xbe, 0x30, 0x50, 0xec, 0x9b, 0xfc, 0x07, 0x0e, 0xba, 0x0e, 0x20, 0x8b, 0x76, 0x83, 0xfa, 0x18,
+	0xa5, 0x4d, 0xf7, 0xf8, 0x59, 0xab, 0x7e, 0xf8, 0xb1, 0x32, 0xf2, 0xe6, 0xb8, 0xa2, 0xbd, 0x3b,
+	0xae, 0x68, 0xef, 0x8f, 0x2b, 0xda, 0x0f, 0xc7, 0x15, 0xed, 0xed, 0xc7, 0xca, 0xc8, 0xfb, 0x8f,
+	0x95, 0x91, 0x0f, 0x1f, 0x2b, 0x23, 0x4e, 0x4e, 0x58, 0xfe, 0xcb, 0x2f, 0x03, 0x00, 0x8e, 0xad,
+	0x22, 0xf4, 0xee, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1310,6 +1370,59 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedClusters) > 0 {
+		for iNdEx := len(m.AllowedClusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedClusters[iNdEx])
+			copy(dAtA[i:], m.AllowedClusters[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.AllowedClusters[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.AllowedPools) > 0 {
+		for iNdEx := len(m.AllowedPools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPools[iNdEx])
+			copy(dAtA[i:], m.AllowedPools[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.AllowedPools[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.JobSetMaxRunning != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.JobSetMaxRunning))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.NotBefore != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQueue(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.PeerDiscovery {
+		i--
+		if m.PeerDiscovery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.QueueOwnershipUserGroups) > 0 {
 		for iNdEx := len(m.QueueOwnershipUserGroups) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.QueueOwnershipUserGroups[iNdEx])
@@ -1425,12 +1538,12 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintQueue(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintQueue(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x32
 	if m.PodSpec != nil {
@@ -1715,6 +1828,11 @@ func (m *NodeType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NodeCount != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.NodeCount))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AllocatableResources) > 0 {
 		for k := range m.AllocatableResources {
 			v := m.AllocatableResources[k]
@@ -1840,12 +1958,12 @@ func (m *ClusterSchedulingInfoReport) MarshalToSizedBuffer(dAtA []byte) (int, er
 			dAtA[i] = 0x2a
 		}
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintQueue(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintQueue(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ClusterId) > 0 {
@@ -1946,12 +2064,12 @@ func (m *ClusterLeasedReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintQueue(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintQueue(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ClusterId) > 0 {
@@ -2376,6 +2494,28 @@ func (m *Job) Size() (n int) {
 			n += 1 + l + sovQueue(uint64(l))
 		}
 	}
+	if m.PeerDiscovery {
+		n += 3
+	}
+	if m.NotBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore)
+		n += 2 + l + sovQueue(uint64(l))
+	}
+	if m.JobSetMaxRunning != 0 {
+		n += 2 + sovQueue(uint64(m.JobSetMaxRunning))
+	}
+	if len(m.AllowedPools) > 0 {
+		for _, s := range m.AllowedPools {
+			l = len(s)
+			n += 2 + l + sovQueue(uint64(l))
+		}
+	}
+	if len(m.AllowedClusters) > 0 {
+		for _, s := range m.AllowedClusters {
+			l = len(s)
+			n += 2 + l + sovQueue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2496,6 +2636,9 @@ func (m *NodeType) Size() (n int) {
 			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
 		}
 	}
+	if m.NodeCount != 0 {
+		n += 1 + sovQueue(uint64(m.NodeCount))
+	}
 	return n
 }
 
@@ -2779,6 +2922,11 @@ func (this *Job) String() string {
 		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
 		`Ingress:` + repeatedStringForIngress + `,`,
 		`QueueOwnershipUserGroups:` + fmt.Sprintf("%v", this.QueueOwnershipUserGroups) + `,`,
+		`PeerDiscovery:` + fmt.Sprintf("%v", this.PeerDiscovery) + `,`,
+		`NotBefore:` + strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`JobSetMaxRunning:` + fmt.Sprintf("%v", this.JobSetMaxRunning) + `,`,
+		`AllowedPools:` + fmt.Sprintf("%v", this.AllowedPools) + `,`,
+		`AllowedClusters:` + fmt.Sprintf("%v", this.AllowedClusters) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2905,6 +3053,7 @@ func (this *NodeType) String() string {
 		`Taints:` + repeatedStringForTaints + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`AllocatableResources:` + mapStringForAllocatableResources + `,`,
+		`NodeCount:` + fmt.Sprintf("%v", this.NodeCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3880,6 +4029,145 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			}
 			m.QueueOwnershipUserGroups = append(m.QueueOwnershipUserGroups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerDiscovery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PeerDiscovery = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetMaxRunning", wireType)
+			}
+			m.JobSetMaxRunning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobSetMaxRunning |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPools = append(m.AllowedPools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedClusters = append(m.AllowedClusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
@@ -5160,6 +5448,25 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 			}
 			m.AllocatableResources[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCount", wireType)
+			}
+			m.NodeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
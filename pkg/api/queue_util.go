@@ -1,6 +1,11 @@
 package api
 
-import v1 "k8s.io/api/core/v1"
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
 
 func (m *Job) GetAllPodSpecs() []*v1.PodSpec {
 	if len(m.PodSpecs) != 0 {
@@ -15,3 +20,81 @@ func (m *JobSubmitRequestItem) GetAllPodSpecs() []*v1.PodSpec {
 	}
 	return []*v1.PodSpec{m.PodSpec}
 }
+
+// AllowsCluster checks the cluster and its pool are among the allowed clusters and pools of the job
+func (m *Job) AllowsCluster(clusterId string, pool string) bool {
+	return m.PoolRank(pool) >= 0 && m.ClusterRank(clusterId) >= 0
+}
+
+// PoolRank returns the position of the pool in the allowed pools of the job, lower is preferred.
+// All pools rank 0 when the job has no allowed pools, and pools it is not allowed in rank -1.
+func (m *Job) PoolRank(pool string) int {
+	return allowedRank(m.AllowedPools, pool)
+}
+
+// ClusterRank returns the position of the cluster in the allowed clusters of the job, ranked like PoolRank
+func (m *Job) ClusterRank(clusterId string) int {
+	return allowedRank(m.AllowedClusters, clusterId)
+}
+
+func allowedRank(allowed []string, value string) int {
+	if len(allowed) == 0 {
+		return 0
+	}
+	for i, a := range allowed {
+		if a == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// SubmitQueuedTime returns when the job was first queued, its creation or its not before time when deferred
+func (m *Job) SubmitQueuedTime() time.Time {
+	if m.NotBefore != nil && m.NotBefore.After(m.Created) {
+		return *m.NotBefore
+	}
+	return m.Created
+}
+
+// Priority of the job within this queue, improved by the aging of the queue for the time spent queued since it was
+// last queued
+func (m *Queue) EffectivePriority(job *Job, queued time.Time, now time.Time) float64 {
+	aging := m.PriorityAgingRate * now.Sub(queued).Hours()
+	if aging < 0 {
+		return job.Priority
+	}
+	if m.PriorityAgingCap > 0 && aging > m.PriorityAgingCap {
+		aging = m.PriorityAgingCap
+	}
+	return job.Priority - aging
+}
+
+// Max running limits which can hold back a queued job from being leased
+const (
+	QueueRunningLimit  = "queue"
+	JobSetRunningLimit = "jobSet"
+)
+
+// ThrottledBy returns the max running limit holding back the queued job, given the number of leased or running jobs
+// of this queue and of the job set of the job, or "" when the job is not throttled
+func (m *Queue) ThrottledBy(job *Job, queueRunning int, jobSetRunning int) string {
+	if m.MaxRunning > 0 && queueRunning >= int(m.MaxRunning) {
+		return QueueRunningLimit
+	}
+	if job.JobSetMaxRunning > 0 && jobSetRunning >= int(job.JobSetMaxRunning) {
+		return JobSetRunningLimit
+	}
+	return ""
+}
+
+// ThrottledReason describes why the queued job is held back, or returns "" when it is not throttled
+func (m *Queue) ThrottledReason(job *Job, queueRunning int, jobSetRunning int) string {
+	switch m.ThrottledBy(job, queueRunning, jobSetRunning) {
+	case QueueRunningLimit:
+		return fmt.Sprintf("queue %s reached its limit of %d running jobs", m.Name, m.MaxRunning)
+	case JobSetRunningLimit:
+		return fmt.Sprintf("job set %s reached its limit of %d running jobs", job.JobSetId, job.JobSetMaxRunning)
+	}
+	return ""
+}
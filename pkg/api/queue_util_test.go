@@ -0,0 +1,29 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectivePriority(t *testing.T) {
+	now := time.Now()
+	job := &Job{Priority: 10, Created: now.Add(-5 * time.Hour)}
+	queued := now.Add(-3 * time.Hour)
+
+	assert.Equal(t, 10.0, (&Queue{}).EffectivePriority(job, queued, now))
+	assert.Equal(t, 4.0, (&Queue{PriorityAgingRate: 2}).EffectivePriority(job, queued, now))
+	assert.Equal(t, 5.0, (&Queue{PriorityAgingRate: 2, PriorityAgingCap: 5}).EffectivePriority(job, queued, now))
+	assert.Equal(t, 10.0, (&Queue{PriorityAgingRate: 2}).EffectivePriority(job, queued, now.Add(-4*time.Hour)))
+}
+
+func TestSubmitQueuedTime(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	assert.Equal(t, now, (&Job{Created: now}).SubmitQueuedTime())
+	assert.Equal(t, later, (&Job{Created: now, NotBefore: &later}).SubmitQueuedTime())
+	assert.Equal(t, now, (&Job{Created: now, NotBefore: &earlier}).SubmitQueuedTime())
+}
@@ -12,22 +12,26 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -60,6 +64,38 @@ func (IngressType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e998bacb27df16c1, []int{0}
 }
 
+// What to do when a schedule fires while jobs submitted by its previous firing are still active
+type ConcurrencyPolicy int32
+
+const (
+	// Submit new jobs alongside the active ones
+	ConcurrencyPolicy_Allow ConcurrencyPolicy = 0
+	// Skip the firing
+	ConcurrencyPolicy_Forbid ConcurrencyPolicy = 1
+	// Cancel the active jobs and submit new ones
+	ConcurrencyPolicy_Replace ConcurrencyPolicy = 2
+)
+
+var ConcurrencyPolicy_name = map[int32]string{
+	0: "Allow",
+	1: "Forbid",
+	2: "Replace",
+}
+
+var ConcurrencyPolicy_value = map[string]int32{
+	"Allow":   0,
+	"Forbid":  1,
+	"Replace": 2,
+}
+
+func (x ConcurrencyPolicy) String() string {
+	return proto.EnumName(ConcurrencyPolicy_name, int32(x))
+}
+
+func (ConcurrencyPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
+
 type JobSubmitRequestItem struct {
 	Priority           float64           `protobuf:"fixed64,1,opt,name=priority,proto3" json:"priority,omitempty"`
 	Namespace          string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -70,6 +106,14 @@ type JobSubmitRequestItem struct {
 	PodSpec            *v1.PodSpec       `protobuf:"bytes,2,opt,name=pod_spec,json=podSpec,proto3" json:"podSpec,omitempty"`                                                                                                                           // Deprecated: Do not use.
 	PodSpecs           []*v1.PodSpec     `protobuf:"bytes,7,rep,name=pod_specs,json=podSpecs,proto3" json:"podSpecs,omitempty"`
 	Ingress            []*IngressConfig  `protobuf:"bytes,9,rep,name=ingress,proto3" json:"ingress,omitempty"`
+	// Creates a headless service so pods of the job can reach each other by hostname
+	PeerDiscovery bool `protobuf:"varint,10,opt,name=peer_discovery,json=peerDiscovery,proto3" json:"peerDiscovery,omitempty"`
+	// The job is kept out of its queue until this time, when set
+	NotBefore *time.Time `protobuf:"bytes,11,opt,name=not_before,json=notBefore,proto3,stdtime" json:"notBefore,omitempty"`
+	// Pools the job can run in, in order of preference, any pool when empty
+	AllowedPools []string `protobuf:"bytes,12,rep,name=allowed_pools,json=allowedPools,proto3" json:"allowedPools,omitempty"`
+	// Clusters the job can run on, in order of preference, any cluster when empty
+	AllowedClusters []string `protobuf:"bytes,13,rep,name=allowed_clusters,json=allowedClusters,proto3" json:"allowedClusters,omitempty"`
 }
 
 func (m *JobSubmitRequestItem) Reset()      { *m = JobSubmitRequestItem{} }
@@ -169,6 +213,34 @@ func (m *JobSubmitRequestItem) GetIngress() []*IngressConfig {
 	return nil
 }
 
+func (m *JobSubmitRequestItem) GetPeerDiscovery() bool {
+	if m != nil {
+		return m.PeerDiscovery
+	}
+	return false
+}
+
+func (m *JobSubmitRequestItem) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetAllowedPools() []string {
+	if m != nil {
+		return m.AllowedPools
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetAllowedClusters() []string {
+	if m != nil {
+		return m.AllowedClusters
+	}
+	return nil
+}
+
 type IngressConfig struct {
 	Type        IngressType       `protobuf:"varint,1,opt,name=type,proto3,enum=api.IngressType" json:"type,omitempty"`
 	Ports       []uint32          `protobuf:"varint,2,rep,packed,name=ports,proto3" json:"ports,omitempty"`
@@ -233,6 +305,8 @@ type JobSubmitRequest struct {
 	Queue           string                  `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 	JobSetId        string                  `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	JobRequestItems []*JobSubmitRequestItem `protobuf:"bytes,3,rep,name=job_request_items,json=jobRequestItems,proto3" json:"jobRequestItems,omitempty"`
+	// Maximum number of jobs of the job set leased or running at once, applies to the jobs of this request, unlimited when 0
+	MaxRunning uint32 `protobuf:"varint,4,opt,name=max_running,json=maxRunning,proto3" json:"maxRunning,omitempty"`
 }
 
 func (m *JobSubmitRequest) Reset()      { *m = JobSubmitRequest{} }
@@ -288,6 +362,13 @@ func (m *JobSubmitRequest) GetJobRequestItems() []*JobSubmitRequestItem {
 	return nil
 }
 
+func (m *JobSubmitRequest) GetMaxRunning() uint32 {
+	if m != nil {
+		return m.MaxRunning
+	}
+	return 0
+}
+
 // swagger:model
 type JobCancelRequest struct {
 	JobId    string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
@@ -460,6 +541,236 @@ func (m *JobReprioritizeResponse) GetReprioritizationResults() map[string]string
 	return nil
 }
 
+// Jobs are selected by id, or by queue with job set id and/or labels
+// swagger:model
+type JobHoldRequest struct {
+	JobIds   []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+	JobSetId string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Only jobs with all of these labels are selected
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobHoldRequest) Reset()      { *m = JobHoldRequest{} }
+func (*JobHoldRequest) ProtoMessage() {}
+func (*JobHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{6}
+}
+func (m *JobHoldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobHoldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobHoldRequest.Merge(m, src)
+}
+func (m *JobHoldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobHoldRequest proto.InternalMessageInfo
+
+func (m *JobHoldRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
+	}
+	return nil
+}
+
+func (m *JobHoldRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobHoldRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobHoldRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// swagger:model
+type JobHoldResponse struct {
+	// Job id to error, empty when the job was held
+	HoldResults map[string]string `protobuf:"bytes,1,rep,name=hold_results,json=holdResults,proto3" json:"holdResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobHoldResponse) Reset()      { *m = JobHoldResponse{} }
+func (*JobHoldResponse) ProtoMessage() {}
+func (*JobHoldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{7}
+}
+func (m *JobHoldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobHoldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobHoldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobHoldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobHoldResponse.Merge(m, src)
+}
+func (m *JobHoldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobHoldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobHoldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobHoldResponse proto.InternalMessageInfo
+
+func (m *JobHoldResponse) GetHoldResults() map[string]string {
+	if m != nil {
+		return m.HoldResults
+	}
+	return nil
+}
+
+// Jobs are selected by id, or by queue with job set id and/or labels
+// swagger:model
+type JobReleaseRequest struct {
+	JobIds   []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+	JobSetId string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Only jobs with all of these labels are selected
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobReleaseRequest) Reset()      { *m = JobReleaseRequest{} }
+func (*JobReleaseRequest) ProtoMessage() {}
+func (*JobReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{8}
+}
+func (m *JobReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobReleaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReleaseRequest.Merge(m, src)
+}
+func (m *JobReleaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReleaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobReleaseRequest proto.InternalMessageInfo
+
+func (m *JobReleaseRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
+	}
+	return nil
+}
+
+func (m *JobReleaseRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobReleaseRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobReleaseRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// swagger:model
+type JobReleaseResponse struct {
+	// Job id to error, empty when the job was released
+	ReleaseResults map[string]string `protobuf:"bytes,1,rep,name=release_results,json=releaseResults,proto3" json:"releaseResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobReleaseResponse) Reset()      { *m = JobReleaseResponse{} }
+func (*JobReleaseResponse) ProtoMessage() {}
+func (*JobReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{9}
+}
+func (m *JobReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobReleaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReleaseResponse.Merge(m, src)
+}
+func (m *JobReleaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReleaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobReleaseResponse proto.InternalMessageInfo
+
+func (m *JobReleaseResponse) GetReleaseResults() map[string]string {
+	if m != nil {
+		return m.ReleaseResults
+	}
+	return nil
+}
+
 type JobSubmitResponseItem struct {
 	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -468,7 +779,7 @@ type JobSubmitResponseItem struct {
 func (m *JobSubmitResponseItem) Reset()      { *m = JobSubmitResponseItem{} }
 func (*JobSubmitResponseItem) ProtoMessage() {}
 func (*JobSubmitResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{6}
+	return fileDescriptor_e998bacb27df16c1, []int{10}
 }
 func (m *JobSubmitResponseItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +830,7 @@ type JobSubmitResponse struct {
 func (m *JobSubmitResponse) Reset()      { *m = JobSubmitResponse{} }
 func (*JobSubmitResponse) ProtoMessage() {}
 func (*JobSubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{7}
+	return fileDescriptor_e998bacb27df16c1, []int{11}
 }
 func (m *JobSubmitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,12 +873,22 @@ type Queue struct {
 	UserOwners     []string           `protobuf:"bytes,3,rep,name=user_owners,json=userOwners,proto3" json:"userOwners,omitempty"`
 	GroupOwners    []string           `protobuf:"bytes,4,rep,name=group_owners,json=groupOwners,proto3" json:"groupOwners,omitempty"`
 	ResourceLimits map[string]float64 `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Jobs of a paused queue are not leased, only changed by PauseQueueScheduling and ResumeQueueScheduling
+	SchedulingPaused bool `protobuf:"varint,6,opt,name=scheduling_paused,json=schedulingPaused,proto3" json:"schedulingPaused,omitempty"`
+	// Improvement of priority per hour a job spends queued, lower values are scheduled first, so aging lowers the priority
+	PriorityAgingRate float64 `protobuf:"fixed64,7,opt,name=priority_aging_rate,json=priorityAgingRate,proto3" json:"priorityAgingRate,omitempty"`
+	// Maximum improvement of priority through aging, aging is not capped when 0
+	PriorityAgingCap float64 `protobuf:"fixed64,8,opt,name=priority_aging_cap,json=priorityAgingCap,proto3" json:"priorityAgingCap,omitempty"`
+	// Maximum number of jobs of the queue leased or running at once, unlimited when 0
+	MaxRunning uint32 `protobuf:"varint,9,opt,name=max_running,json=maxRunning,proto3" json:"maxRunning,omitempty"`
+	// Maximum ratio of the resource limits of a pod to its resource requests, for each resource. Not capped when 0
+	MaxOvercommitRatio float64 `protobuf:"fixed64,10,opt,name=max_overcommit_ratio,json=maxOvercommitRatio,proto3" json:"maxOvercommitRatio,omitempty"`
 }
 
 func (m *Queue) Reset()      { *m = Queue{} }
 func (*Queue) ProtoMessage() {}
 func (*Queue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{8}
+	return fileDescriptor_e998bacb27df16c1, []int{12}
 }
 func (m *Queue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,6 +952,41 @@ func (m *Queue) GetResourceLimits() map[string]float64 {
 	return nil
 }
 
+func (m *Queue) GetSchedulingPaused() bool {
+	if m != nil {
+		return m.SchedulingPaused
+	}
+	return false
+}
+
+func (m *Queue) GetPriorityAgingRate() float64 {
+	if m != nil {
+		return m.PriorityAgingRate
+	}
+	return 0
+}
+
+func (m *Queue) GetPriorityAgingCap() float64 {
+	if m != nil {
+		return m.PriorityAgingCap
+	}
+	return 0
+}
+
+func (m *Queue) GetMaxRunning() uint32 {
+	if m != nil {
+		return m.MaxRunning
+	}
+	return 0
+}
+
+func (m *Queue) GetMaxOvercommitRatio() float64 {
+	if m != nil {
+		return m.MaxOvercommitRatio
+	}
+	return 0
+}
+
 // swagger:model
 type CancellationResult struct {
 	CancelledIds []string `protobuf:"bytes,1,rep,name=cancelled_ids,json=cancelledIds,proto3" json:"cancelledIds"`
@@ -639,7 +995,7 @@ type CancellationResult struct {
 func (m *CancellationResult) Reset()      { *m = CancellationResult{} }
 func (*CancellationResult) ProtoMessage() {}
 func (*CancellationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{9}
+	return fileDescriptor_e998bacb27df16c1, []int{13}
 }
 func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +1039,7 @@ type QueueGetRequest struct {
 func (m *QueueGetRequest) Reset()      { *m = QueueGetRequest{} }
 func (*QueueGetRequest) ProtoMessage() {}
 func (*QueueGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{10}
+	return fileDescriptor_e998bacb27df16c1, []int{14}
 }
 func (m *QueueGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,7 +1083,7 @@ type QueueInfoRequest struct {
 func (m *QueueInfoRequest) Reset()      { *m = QueueInfoRequest{} }
 func (*QueueInfoRequest) ProtoMessage() {}
 func (*QueueInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{11}
+	return fileDescriptor_e998bacb27df16c1, []int{15}
 }
 func (m *QueueInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -764,21 +1120,21 @@ func (m *QueueInfoRequest) GetName() string {
 }
 
 //swagger:model
-type QueueDeleteRequest struct {
+type QueueSchedulingRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueueDeleteRequest) Reset()      { *m = QueueDeleteRequest{} }
-func (*QueueDeleteRequest) ProtoMessage() {}
-func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{12}
+func (m *QueueSchedulingRequest) Reset()      { *m = QueueSchedulingRequest{} }
+func (*QueueSchedulingRequest) ProtoMessage() {}
+func (*QueueSchedulingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{16}
 }
-func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueueSchedulingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueueSchedulingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueueSchedulingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -788,42 +1144,46 @@ func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
+func (m *QueueSchedulingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSchedulingRequest.Merge(m, src)
 }
-func (m *QueueDeleteRequest) XXX_Size() int {
+func (m *QueueSchedulingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
+func (m *QueueSchedulingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSchedulingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueueSchedulingRequest proto.InternalMessageInfo
 
-func (m *QueueDeleteRequest) GetName() string {
+func (m *QueueSchedulingRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-//swagger:model
-type QueueInfo struct {
-	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ActiveJobSets []*JobSetInfo `protobuf:"bytes,2,rep,name=active_job_sets,json=activeJobSets,proto3" json:"activeJobSets,omitempty"`
+// Cordoned clusters don't lease any jobs. Either a single cluster or all clusters of a pool are cordoned.
+// swagger:model
+type ClusterCordon struct {
+	ClusterId string    `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Pool      string    `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	Reason    string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Requestor string    `protobuf:"bytes,4,opt,name=requestor,proto3" json:"requestor,omitempty"`
+	Created   time.Time `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created"`
 }
 
-func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
-func (*QueueInfo) ProtoMessage() {}
-func (*QueueInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13}
+func (m *ClusterCordon) Reset()      { *m = ClusterCordon{} }
+func (*ClusterCordon) ProtoMessage() {}
+func (*ClusterCordon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{17}
 }
-func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
+func (m *ClusterCordon) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClusterCordon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClusterCordon.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -833,49 +1193,73 @@ func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueueInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueInfo.Merge(m, src)
+func (m *ClusterCordon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCordon.Merge(m, src)
 }
-func (m *QueueInfo) XXX_Size() int {
+func (m *ClusterCordon) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueInfo.DiscardUnknown(m)
+func (m *ClusterCordon) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCordon.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueInfo proto.InternalMessageInfo
+var xxx_messageInfo_ClusterCordon proto.InternalMessageInfo
 
-func (m *QueueInfo) GetName() string {
+func (m *ClusterCordon) GetClusterId() string {
 	if m != nil {
-		return m.Name
+		return m.ClusterId
 	}
 	return ""
 }
 
-func (m *QueueInfo) GetActiveJobSets() []*JobSetInfo {
+func (m *ClusterCordon) GetPool() string {
 	if m != nil {
-		return m.ActiveJobSets
+		return m.Pool
 	}
-	return nil
+	return ""
 }
 
-type JobSetInfo struct {
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
-	LeasedJobs int32  `protobuf:"varint,3,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
+func (m *ClusterCordon) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
-func (*JobSetInfo) ProtoMessage() {}
-func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{14}
+func (m *ClusterCordon) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
 }
-func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *ClusterCordon) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+// swagger:model
+type ClusterCordonRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Pool      string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Return the leases of jobs which are leased by the cordoned clusters but not running yet
+	Drain bool `protobuf:"varint,4,opt,name=drain,proto3" json:"drain,omitempty"`
+}
+
+func (m *ClusterCordonRequest) Reset()      { *m = ClusterCordonRequest{} }
+func (*ClusterCordonRequest) ProtoMessage() {}
+func (*ClusterCordonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{18}
+}
+func (m *ClusterCordonRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClusterCordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClusterCordonRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -885,1849 +1269,7524 @@ func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobSetInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSetInfo.Merge(m, src)
+func (m *ClusterCordonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCordonRequest.Merge(m, src)
 }
-func (m *JobSetInfo) XXX_Size() int {
+func (m *ClusterCordonRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSetInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
+func (m *ClusterCordonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCordonRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
+var xxx_messageInfo_ClusterCordonRequest proto.InternalMessageInfo
 
-func (m *JobSetInfo) GetName() string {
+func (m *ClusterCordonRequest) GetClusterId() string {
 	if m != nil {
-		return m.Name
+		return m.ClusterId
 	}
 	return ""
 }
 
-func (m *JobSetInfo) GetQueuedJobs() int32 {
+func (m *ClusterCordonRequest) GetPool() string {
 	if m != nil {
-		return m.QueuedJobs
+		return m.Pool
 	}
-	return 0
+	return ""
 }
 
-func (m *JobSetInfo) GetLeasedJobs() int32 {
+func (m *ClusterCordonRequest) GetReason() string {
 	if m != nil {
-		return m.LeasedJobs
+		return m.Reason
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("api.IngressType", IngressType_name, IngressType_value)
-	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
-	proto.RegisterType((*IngressConfig)(nil), "api.IngressConfig")
-	proto.RegisterMapType((map[string]string)(nil), "api.IngressConfig.AnnotationsEntry")
-	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
-	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
-	proto.RegisterType((*JobReprioritizeRequest)(nil), "api.JobReprioritizeRequest")
-	proto.RegisterType((*JobReprioritizeResponse)(nil), "api.JobReprioritizeResponse")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobReprioritizeResponse.ReprioritizationResultsEntry")
-	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
-	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
-	proto.RegisterType((*Queue)(nil), "api.Queue")
-	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
-	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
-	proto.RegisterType((*QueueGetRequest)(nil), "api.QueueGetRequest")
-	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
-	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
-	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
-	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
+func (m *ClusterCordonRequest) GetDrain() bool {
+	if m != nil {
+		return m.Drain
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
-
-var fileDescriptor_e998bacb27df16c1 = []byte{
-	// 1291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xce, 0xc6, 0xf9, 0xb0, 0xcf, 0xe6, 0xc3, 0x9d, 0x3a, 0xb5, 0xbb, 0xc9, 0xeb, 0xf8, 0x5d,
-	0x28, 0x58, 0x11, 0xac, 0xd5, 0x20, 0x44, 0x5b, 0x09, 0xa4, 0x7e, 0xa4, 0x21, 0xa1, 0x2a, 0x65,
-	0x5b, 0xa0, 0x37, 0x95, 0xb5, 0xeb, 0x3d, 0x31, 0x9b, 0xda, 0x3b, 0xdb, 0x99, 0x75, 0xaa, 0x80,
-	0x90, 0x10, 0x57, 0xdc, 0x20, 0x21, 0xc1, 0xaf, 0xe1, 0x17, 0xc0, 0x5d, 0x05, 0x37, 0x95, 0x90,
-	0x10, 0xa4, 0x5c, 0xf1, 0x2b, 0xd0, 0x9e, 0xd9, 0xf5, 0xae, 0x63, 0x27, 0x55, 0xe0, 0x6e, 0xcf,
-	0x99, 0xe7, 0x3c, 0xe7, 0xcc, 0x9c, 0x67, 0xce, 0x0e, 0x54, 0xc2, 0xc7, 0xdd, 0x96, 0x13, 0xfa,
-	0x2d, 0x39, 0x70, 0xfb, 0x7e, 0x64, 0x85, 0x82, 0x47, 0x9c, 0x15, 0x9c, 0xd0, 0x37, 0x56, 0xbb,
-	0x9c, 0x77, 0x7b, 0xd8, 0x22, 0x97, 0x3b, 0xd8, 0x6b, 0x61, 0x3f, 0x8c, 0x0e, 0x15, 0xc2, 0x30,
-	0x1f, 0x5f, 0x91, 0x96, 0xcf, 0x29, 0xb4, 0xc3, 0x05, 0xb6, 0x0e, 0x2e, 0xb7, 0xba, 0x18, 0xa0,
-	0x70, 0x22, 0xf4, 0x12, 0xcc, 0x5a, 0x42, 0x10, 0x63, 0x9c, 0x20, 0xe0, 0x91, 0x13, 0xf9, 0x3c,
-	0x90, 0xc9, 0xea, 0x9b, 0x5d, 0x3f, 0xfa, 0x6c, 0xe0, 0x5a, 0x1d, 0xde, 0x6f, 0x75, 0x79, 0x97,
-	0x67, 0x79, 0x62, 0x8b, 0x0c, 0xfa, 0x52, 0x70, 0xf3, 0xe7, 0x59, 0xa8, 0xec, 0x72, 0xf7, 0x3e,
-	0x95, 0x69, 0xe3, 0x93, 0x01, 0xca, 0x68, 0x27, 0xc2, 0x3e, 0x33, 0xa0, 0x18, 0x0a, 0x9f, 0x0b,
-	0x3f, 0x3a, 0xac, 0x69, 0x0d, 0xad, 0xa9, 0xd9, 0x43, 0x9b, 0xad, 0x41, 0x29, 0x70, 0xfa, 0x28,
-	0x43, 0xa7, 0x83, 0xb5, 0x42, 0x43, 0x6b, 0x96, 0xec, 0xcc, 0xc1, 0x56, 0xa1, 0xd4, 0xe9, 0xf9,
-	0x18, 0x44, 0x6d, 0xdf, 0xab, 0x15, 0x69, 0xb5, 0xa8, 0x1c, 0x3b, 0x1e, 0x7b, 0x17, 0xe6, 0x7a,
-	0x8e, 0x8b, 0x3d, 0x59, 0x9b, 0x69, 0x14, 0x9a, 0xfa, 0xe6, 0x25, 0xcb, 0x09, 0x7d, 0x6b, 0x52,
-	0x05, 0xd6, 0x1d, 0xc2, 0x6d, 0x05, 0x91, 0x38, 0xb4, 0x93, 0x20, 0x76, 0x07, 0xf4, 0xdc, 0x96,
-	0x6b, 0xb3, 0xc4, 0xb1, 0x71, 0x32, 0xc7, 0xf5, 0x0c, 0xac, 0x88, 0xf2, 0xe1, 0xac, 0x0b, 0x15,
-	0x81, 0x4f, 0x06, 0xbe, 0x40, 0xaf, 0x1d, 0x70, 0x0f, 0xdb, 0x49, 0x69, 0x73, 0x44, 0x7b, 0xf9,
-	0x64, 0x5a, 0x3b, 0x89, 0xba, 0xcb, 0x3d, 0xcc, 0x95, 0x79, 0x63, 0xba, 0xa6, 0xd9, 0x4c, 0x8c,
-	0x2d, 0xb2, 0x6b, 0x50, 0x0c, 0xb9, 0xd7, 0x96, 0x21, 0x76, 0x6a, 0xd3, 0x0d, 0xad, 0xa9, 0x6f,
-	0xae, 0x5a, 0xaa, 0xd3, 0x94, 0x23, 0xee, 0xb4, 0x75, 0x70, 0xd9, 0xba, 0xc7, 0xbd, 0xfb, 0x21,
-	0x76, 0x88, 0x66, 0x3e, 0x54, 0x06, 0xbb, 0x02, 0xa5, 0x34, 0x56, 0xd6, 0xe6, 0xa9, 0xb2, 0xd3,
-	0x82, 0xed, 0x62, 0x12, 0x28, 0xd9, 0x1b, 0x30, 0xef, 0x07, 0x5d, 0x81, 0x52, 0xd6, 0x4a, 0x14,
-	0xc7, 0x28, 0x60, 0x47, 0xf9, 0x6e, 0xf2, 0x60, 0xcf, 0xef, 0xda, 0x29, 0xc4, 0xb8, 0x0a, 0x7a,
-	0x6e, 0x2b, 0xac, 0x0c, 0x85, 0xc7, 0xa8, 0x5a, 0x5f, 0xb2, 0xe3, 0x4f, 0x56, 0x81, 0xd9, 0x03,
-	0xa7, 0x37, 0x40, 0xda, 0x41, 0xc9, 0x56, 0xc6, 0xb5, 0xe9, 0x2b, 0x9a, 0xf1, 0x1e, 0x94, 0x8f,
-	0x1f, 0xf4, 0x99, 0xe2, 0xb7, 0xa0, 0x7a, 0xc2, 0x89, 0x9e, 0x85, 0xc6, 0xfc, 0x45, 0x83, 0xc5,
-	0x91, 0xcd, 0xb1, 0x57, 0x61, 0x26, 0x3a, 0x0c, 0x91, 0xc2, 0x97, 0x36, 0xcb, 0xf9, 0xed, 0x3f,
-	0x38, 0x0c, 0xd1, 0xa6, 0xd5, 0x98, 0x31, 0xe4, 0x22, 0x92, 0xb5, 0xe9, 0x46, 0xa1, 0xb9, 0x68,
-	0x2b, 0x83, 0x6d, 0x8d, 0x4a, 0xad, 0x40, 0x27, 0xf8, 0xca, 0xf8, 0x09, 0x9e, 0xae, 0xb1, 0xff,
-	0x7a, 0x36, 0xe6, 0xb7, 0x1a, 0x94, 0x8f, 0x6b, 0x30, 0x86, 0x3f, 0x19, 0xe0, 0x00, 0x13, 0x0a,
-	0x65, 0xb0, 0x35, 0x80, 0x7d, 0xee, 0xb6, 0x25, 0xd2, 0xcd, 0x53, 0x4c, 0xc5, 0x7d, 0xee, 0xde,
-	0xc7, 0xf8, 0xe6, 0x6d, 0xc1, 0xb9, 0x78, 0x55, 0x28, 0x8a, 0xb6, 0x1f, 0x61, 0x3f, 0xdd, 0xd5,
-	0xc5, 0x13, 0x95, 0x6e, 0x2f, 0xef, 0x73, 0x37, 0x67, 0x4b, 0xf3, 0x11, 0x95, 0x73, 0xd3, 0x09,
-	0x3a, 0xd8, 0x4b, 0xcb, 0x59, 0x81, 0xb9, 0x98, 0xda, 0xf7, 0xd2, 0x7a, 0xf6, 0xb9, 0xbb, 0xe3,
-	0xbd, 0xa4, 0x9e, 0xe1, 0x1e, 0x0a, 0xb9, 0x3d, 0x98, 0xdf, 0x68, 0x70, 0x61, 0x37, 0x4e, 0x99,
-	0x0c, 0x1b, 0xff, 0x73, 0x4c, 0xb3, 0x54, 0x61, 0x5e, 0x65, 0x91, 0x35, 0xad, 0x51, 0x68, 0x96,
-	0xec, 0x39, 0x4a, 0x23, 0xff, 0x4d, 0x1e, 0xf6, 0x7f, 0x58, 0x08, 0xf0, 0x69, 0x7b, 0x38, 0xe2,
-	0x66, 0x68, 0xc4, 0xe9, 0x01, 0x3e, 0xbd, 0x97, 0xb8, 0xcc, 0xdf, 0x34, 0xa8, 0x8e, 0x95, 0x22,
-	0x43, 0x1e, 0x48, 0x64, 0x11, 0xd4, 0x44, 0xe6, 0xa7, 0xde, 0xb6, 0x05, 0xca, 0x41, 0x2f, 0x52,
-	0xc5, 0xe9, 0x9b, 0x57, 0xd3, 0x33, 0x9d, 0x14, 0x6f, 0xd9, 0xc7, 0x82, 0x6d, 0x15, 0xab, 0xf4,
-	0x53, 0x15, 0x93, 0x57, 0x8d, 0x5d, 0x58, 0x3b, 0x2d, 0xf0, 0x4c, 0xba, 0xba, 0x05, 0x2b, 0xb9,
-	0x86, 0xab, 0xb2, 0x68, 0xf0, 0x9f, 0xd0, 0xcc, 0x0a, 0xcc, 0xa2, 0x10, 0x5c, 0xa4, 0x4c, 0x64,
-	0x98, 0x8f, 0xe0, 0xdc, 0x18, 0x0b, 0x7b, 0x1f, 0x98, 0x52, 0x9a, 0xb2, 0x13, 0xa9, 0xa9, 0x63,
-	0x31, 0x8e, 0x4b, 0x2d, 0xcb, 0x6c, 0x97, 0x49, 0x6b, 0x99, 0x43, 0x9a, 0x3f, 0x4c, 0xc3, 0xec,
-	0x47, 0xd4, 0x2f, 0x06, 0x33, 0xf1, 0x1f, 0x26, 0xa9, 0x89, 0xbe, 0xd9, 0xeb, 0xb0, 0x9c, 0xf6,
-	0xaf, 0xbd, 0xe7, 0x74, 0xa2, 0xa4, 0x38, 0xcd, 0x5e, 0x4a, 0xdd, 0xb7, 0xc9, 0xcb, 0xd6, 0x41,
-	0x1f, 0x48, 0x14, 0x6d, 0xfe, 0x34, 0x40, 0xa1, 0x44, 0x5f, 0xb2, 0x21, 0x76, 0x7d, 0x48, 0x9e,
-	0x58, 0x0d, 0x5d, 0xc1, 0x07, 0x61, 0x8a, 0x98, 0x21, 0x84, 0x4e, 0xbe, 0x04, 0xb2, 0x0d, 0xcb,
-	0x02, 0x25, 0x1f, 0x88, 0x0e, 0xb6, 0x7b, 0x7e, 0xdf, 0x8f, 0xd2, 0xbf, 0x4f, 0x9d, 0x76, 0x44,
-	0x55, 0x5a, 0x76, 0x82, 0xb8, 0x43, 0x00, 0xd5, 0xcd, 0x25, 0x31, 0xe2, 0x34, 0xae, 0xc3, 0xf9,
-	0x09, 0xb0, 0x97, 0xf5, 0x4e, 0xcb, 0xf7, 0xee, 0x03, 0x60, 0xea, 0x02, 0xf6, 0x72, 0x1a, 0x60,
-	0x6f, 0xc3, 0x62, 0x47, 0x79, 0xd1, 0xcb, 0x6e, 0xc9, 0x8d, 0xf2, 0xdf, 0xbf, 0xaf, 0x2f, 0x0c,
-	0x17, 0x76, 0x3c, 0x69, 0x8f, 0x58, 0xe6, 0x25, 0x58, 0xa6, 0xe2, 0xb7, 0x71, 0x38, 0x5e, 0x26,
-	0x1c, 0xb6, 0xf9, 0x1a, 0x94, 0x09, 0xb6, 0x13, 0xec, 0xf1, 0xd3, 0x70, 0x4d, 0x60, 0x84, 0xbb,
-	0x85, 0x3d, 0x8c, 0xf0, 0x34, 0xe4, 0x43, 0x28, 0x0d, 0x19, 0x27, 0xf6, 0xf7, 0x1d, 0x58, 0x76,
-	0x3a, 0x91, 0x7f, 0x80, 0xed, 0xe4, 0x7a, 0xab, 0x09, 0xad, 0x6f, 0x2e, 0x0f, 0x45, 0x84, 0x11,
-	0xd5, 0xb3, 0xa8, 0x70, 0xca, 0x23, 0x4d, 0x17, 0x20, 0x5b, 0x9c, 0x48, 0xbd, 0x0e, 0x3a, 0xcd,
-	0x01, 0x2f, 0xa6, 0x96, 0x74, 0xc2, 0xb3, 0x36, 0x28, 0xd7, 0x2e, 0x77, 0x65, 0x0c, 0xe8, 0xa1,
-	0x23, 0x53, 0x40, 0x41, 0x01, 0x94, 0x2b, 0x06, 0x6c, 0x34, 0x41, 0xcf, 0xfd, 0x49, 0xd8, 0x02,
-	0x14, 0xe3, 0x5f, 0xd7, 0x3d, 0x2e, 0xa2, 0xf2, 0x14, 0xd3, 0x61, 0x3e, 0x59, 0x2c, 0x6b, 0x9b,
-	0x3f, 0xce, 0xc2, 0x9c, 0x52, 0x3b, 0xfb, 0x04, 0x40, 0x7d, 0x51, 0x8e, 0x95, 0x89, 0x63, 0xd7,
-	0xb8, 0x30, 0xf9, 0x8a, 0x98, 0x17, 0xbf, 0xfe, 0xf5, 0xaf, 0xef, 0xa7, 0xcf, 0x9b, 0x4b, 0xf1,
-	0xa3, 0x70, 0x9f, 0xbb, 0xc9, 0xdb, 0xf2, 0x9a, 0xb6, 0xc1, 0x3e, 0x05, 0x50, 0x82, 0x18, 0xe5,
-	0x1d, 0x99, 0xd2, 0x46, 0x95, 0xdc, 0xe3, 0xc2, 0x19, 0x27, 0x56, 0xfa, 0x88, 0x89, 0x03, 0x28,
-	0xe7, 0xe7, 0x17, 0xd1, 0xaf, 0x4e, 0x9e, 0x6c, 0x2a, 0xc9, 0xda, 0x69, 0x63, 0xcf, 0x5c, 0xa7,
-	0x4c, 0x17, 0xcd, 0x4a, 0x9a, 0x29, 0x37, 0xe9, 0x30, 0xce, 0xb7, 0x0d, 0xfa, 0x4d, 0x81, 0x4e,
-	0x84, 0xea, 0xd6, 0x43, 0x76, 0xb7, 0x8c, 0x0b, 0x96, 0x7a, 0xf7, 0x5a, 0xe9, 0x83, 0xd6, 0xda,
-	0x8a, 0x1f, 0xce, 0x66, 0x85, 0x38, 0x97, 0xcc, 0x52, 0xcc, 0x49, 0x1d, 0x8c, 0x89, 0xee, 0x82,
-	0xfe, 0x71, 0xe8, 0x9d, 0x89, 0x68, 0x95, 0x88, 0x56, 0x8c, 0xf2, 0x90, 0xa8, 0xf5, 0x45, 0x2c,
-	0x96, 0x2f, 0x63, 0xbe, 0x87, 0xa0, 0x2b, 0x45, 0x2b, 0xbe, 0x6a, 0xc6, 0x37, 0x22, 0xf4, 0x13,
-	0xc9, 0x6b, 0x44, 0xce, 0x36, 0xc6, 0xc8, 0xd9, 0x6d, 0x28, 0x6e, 0x63, 0xa4, 0x68, 0x2b, 0x19,
-	0x6d, 0x76, 0x1d, 0x8d, 0x5c, 0xf1, 0x29, 0x0f, 0x1b, 0xe7, 0x79, 0x00, 0x0b, 0x29, 0x0f, 0xc9,
-	0x7e, 0x25, 0x8b, 0xca, 0xdd, 0x59, 0x63, 0x69, 0xd4, 0x6d, 0xfe, 0x8f, 0x08, 0xab, 0x6c, 0xe5,
-	0x38, 0x61, 0xcb, 0x0f, 0xf6, 0xf8, 0x8d, 0xc6, 0xf3, 0x3f, 0xeb, 0x53, 0x5f, 0x1d, 0xd5, 0xb5,
-	0x9f, 0x8e, 0xea, 0xda, 0xb3, 0xa3, 0xba, 0xf6, 0xc7, 0x51, 0x5d, 0xfb, 0xee, 0x45, 0x7d, 0xea,
-	0xd9, 0x8b, 0xfa, 0xd4, 0xf3, 0x17, 0xf5, 0x29, 0x77, 0x8e, 0x76, 0xfa, 0xd6, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x9f, 0x5a, 0x0e, 0xf4, 0xf3, 0x0c, 0x00, 0x00,
+// swagger:model
+type ClusterCordonResponse struct {
+	DrainedJobIds []string `protobuf:"bytes,1,rep,name=drained_job_ids,json=drainedJobIds,proto3" json:"drainedJobIds,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ClusterCordonResponse) Reset()      { *m = ClusterCordonResponse{} }
+func (*ClusterCordonResponse) ProtoMessage() {}
+func (*ClusterCordonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{19}
+}
+func (m *ClusterCordonResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCordonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCordonResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterCordonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCordonResponse.Merge(m, src)
+}
+func (m *ClusterCordonResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCordonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCordonResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ClusterCordonResponse proto.InternalMessageInfo
 
-// SubmitClient is the client API for Submit service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SubmitClient interface {
-	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
-	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
-	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
-	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error)
-	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
+func (m *ClusterCordonResponse) GetDrainedJobIds() []string {
+	if m != nil {
+		return m.DrainedJobIds
+	}
+	return nil
 }
 
-type submitClient struct {
-	cc *grpc.ClientConn
+// swagger:model
+type ClusterUncordonRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Pool      string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
-func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
-	return &submitClient{cc}
+func (m *ClusterUncordonRequest) Reset()      { *m = ClusterUncordonRequest{} }
+func (*ClusterUncordonRequest) ProtoMessage() {}
+func (*ClusterUncordonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{20}
+}
+func (m *ClusterUncordonRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterUncordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterUncordonRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterUncordonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterUncordonRequest.Merge(m, src)
+}
+func (m *ClusterUncordonRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterUncordonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterUncordonRequest.DiscardUnknown(m)
 }
 
-func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
-	out := new(JobSubmitResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClusterUncordonRequest proto.InternalMessageInfo
+
+func (m *ClusterUncordonRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
-	out := new(CancellationResult)
-	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClusterUncordonRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return out, nil
+	return ""
 }
 
-func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
-	out := new(JobReprioritizeResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+// swagger:model
+type ClusterCordonList struct {
+	Cordons []*ClusterCordon `protobuf:"bytes,1,rep,name=cordons,proto3" json:"cordons,omitempty"`
+}
+
+func (m *ClusterCordonList) Reset()      { *m = ClusterCordonList{} }
+func (*ClusterCordonList) ProtoMessage() {}
+func (*ClusterCordonList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{21}
+}
+func (m *ClusterCordonList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCordonList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCordonList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ClusterCordonList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCordonList.Merge(m, src)
+}
+func (m *ClusterCordonList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCordonList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCordonList.DiscardUnknown(m)
 }
 
-func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClusterCordonList proto.InternalMessageInfo
+
+func (m *ClusterCordonList) GetCordons() []*ClusterCordon {
+	if m != nil {
+		return m.Cordons
 	}
-	return out, nil
+	return nil
 }
 
-func (c *submitClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+// Submits the jobs of the template at each tick of the cron expression
+// swagger:model
+type JobSchedule struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Five field cron expression (minute, hour, day of month, month, day of week) evaluated in UTC
+	Cron              string            `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	Template          *JobSubmitRequest `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	ConcurrencyPolicy ConcurrencyPolicy `protobuf:"varint,4,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=api.ConcurrencyPolicy" json:"concurrencyPolicy,omitempty"`
+	// Set by the server to the user creating the schedule, jobs are submitted on their behalf
+	Owner           string    `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnershipGroups []string  `protobuf:"bytes,6,rep,name=ownership_groups,json=ownershipGroups,proto3" json:"ownershipGroups,omitempty"`
+	Created         time.Time `protobuf:"bytes,7,opt,name=created,proto3,stdtime" json:"created"`
+	// Set by the server to the groups of the owner, their permission on the queue is checked with them on each firing
+	OwnerGroups []string `protobuf:"bytes,8,rep,name=owner_groups,json=ownerGroups,proto3" json:"ownerGroups,omitempty"`
+}
+
+func (m *JobSchedule) Reset()      { *m = JobSchedule{} }
+func (*JobSchedule) ProtoMessage() {}
+func (*JobSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{22}
+}
+func (m *JobSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *JobSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSchedule.Merge(m, src)
+}
+func (m *JobSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSchedule.DiscardUnknown(m)
 }
 
-func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobSchedule proto.InternalMessageInfo
+
+func (m *JobSchedule) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *submitClient) GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error) {
-	out := new(Queue)
-	err := c.cc.Invoke(ctx, "/api.Submit/GetQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSchedule) GetCron() string {
+	if m != nil {
+		return m.Cron
 	}
-	return out, nil
+	return ""
 }
 
-func (c *submitClient) GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error) {
-	out := new(QueueInfo)
-	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSchedule) GetTemplate() *JobSubmitRequest {
+	if m != nil {
+		return m.Template
 	}
-	return out, nil
+	return nil
 }
 
-// SubmitServer is the server API for Submit service.
-type SubmitServer interface {
-	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
-	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
-	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
-	CreateQueue(context.Context, *Queue) (*types.Empty, error)
-	UpdateQueue(context.Context, *Queue) (*types.Empty, error)
-	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
-	GetQueue(context.Context, *QueueGetRequest) (*Queue, error)
-	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
+func (m *JobSchedule) GetConcurrencyPolicy() ConcurrencyPolicy {
+	if m != nil {
+		return m.ConcurrencyPolicy
+	}
+	return ConcurrencyPolicy_Allow
 }
 
-// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
-type UnimplementedSubmitServer struct {
+func (m *JobSchedule) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
+func (m *JobSchedule) GetOwnershipGroups() []string {
+	if m != nil {
+		return m.OwnershipGroups
+	}
+	return nil
 }
-func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+
+func (m *JobSchedule) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
 }
-func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
+
+func (m *JobSchedule) GetOwnerGroups() []string {
+	if m != nil {
+		return m.OwnerGroups
+	}
+	return nil
 }
-func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+
+// swagger:model
+type JobScheduleDeleteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
-func (*UnimplementedSubmitServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+
+func (m *JobScheduleDeleteRequest) Reset()      { *m = JobScheduleDeleteRequest{} }
+func (*JobScheduleDeleteRequest) ProtoMessage() {}
+func (*JobScheduleDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{23}
 }
-func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+func (m *JobScheduleDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedSubmitServer) GetQueue(ctx context.Context, req *QueueGetRequest) (*Queue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
+func (m *JobScheduleDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobScheduleDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedSubmitServer) GetQueueInfo(ctx context.Context, req *QueueInfoRequest) (*QueueInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueueInfo not implemented")
+func (m *JobScheduleDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobScheduleDeleteRequest.Merge(m, src)
 }
-
-func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
-	s.RegisterService(&_Submit_serviceDesc, srv)
+func (m *JobScheduleDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobScheduleDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobScheduleDeleteRequest.DiscardUnknown(m)
 }
 
-func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobSubmitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).SubmitJobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/SubmitJobs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
+var xxx_messageInfo_JobScheduleDeleteRequest proto.InternalMessageInfo
+
+func (m *JobScheduleDeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CancelJobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CancelJobs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// swagger:model
+type JobScheduleList struct {
+	Schedules []*JobSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 }
 
-func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobReprioritizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).ReprioritizeJobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/ReprioritizeJobs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).ReprioritizeJobs(ctx, req.(*JobReprioritizeRequest))
+func (m *JobScheduleList) Reset()      { *m = JobScheduleList{} }
+func (*JobScheduleList) ProtoMessage() {}
+func (*JobScheduleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{24}
+}
+func (m *JobScheduleList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobScheduleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobScheduleList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *JobScheduleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobScheduleList.Merge(m, src)
+}
+func (m *JobScheduleList) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobScheduleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobScheduleList.DiscardUnknown(m)
 }
 
-func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CreateQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CreateQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
+var xxx_messageInfo_JobScheduleList proto.InternalMessageInfo
+
+func (m *JobScheduleList) GetSchedules() []*JobSchedule {
+	if m != nil {
+		return m.Schedules
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Submit_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).UpdateQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/UpdateQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).UpdateQueue(ctx, req.(*Queue))
-	}
-	return interceptor(ctx, in, info, handler)
+//swagger:model
+type QueueDeleteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).DeleteQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/DeleteQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+func (m *QueueDeleteRequest) Reset()      { *m = QueueDeleteRequest{} }
+func (*QueueDeleteRequest) ProtoMessage() {}
+func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{25}
+}
+func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
+}
+func (m *QueueDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
 }
 
-func _Submit_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueGetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).GetQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/GetQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).GetQueue(ctx, req.(*QueueGetRequest))
+var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
+
+func (m *QueueDeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).GetQueueInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/GetQueueInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+//swagger:model
+type QueueInfo struct {
+	Name          string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ActiveJobSets []*JobSetInfo `protobuf:"bytes,2,rep,name=active_job_sets,json=activeJobSets,proto3" json:"activeJobSets,omitempty"`
+}
+
+func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
+func (*QueueInfo) ProtoMessage() {}
+func (*QueueInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{26}
+}
+func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueueInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueInfo.Merge(m, src)
+}
+func (m *QueueInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueInfo.DiscardUnknown(m)
 }
 
-var _Submit_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Submit",
-	HandlerType: (*SubmitServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitJobs",
-			Handler:    _Submit_SubmitJobs_Handler,
-		},
-		{
-			MethodName: "CancelJobs",
-			Handler:    _Submit_CancelJobs_Handler,
-		},
-		{
-			MethodName: "ReprioritizeJobs",
-			Handler:    _Submit_ReprioritizeJobs_Handler,
-		},
-		{
-			MethodName: "CreateQueue",
-			Handler:    _Submit_CreateQueue_Handler,
-		},
-		{
-			MethodName: "UpdateQueue",
-			Handler:    _Submit_UpdateQueue_Handler,
-		},
-		{
-			MethodName: "DeleteQueue",
-			Handler:    _Submit_DeleteQueue_Handler,
-		},
-		{
-			MethodName: "GetQueue",
-			Handler:    _Submit_GetQueue_Handler,
-		},
-		{
-			MethodName: "GetQueueInfo",
-			Handler:    _Submit_GetQueueInfo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/api/submit.proto",
+var xxx_messageInfo_QueueInfo proto.InternalMessageInfo
+
+func (m *QueueInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueInfo) GetActiveJobSets() []*JobSetInfo {
+	if m != nil {
+		return m.ActiveJobSets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobSetInfo struct {
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
+	LeasedJobs int32  `protobuf:"varint,3,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
+	HeldJobs   int32  `protobuf:"varint,4,opt,name=held_jobs,json=heldJobs,proto3" json:"heldJobs,omitempty"`
 }
 
-func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ingress) > 0 {
-		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ingress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PodSpecs) > 0 {
-		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
+func (*JobSetInfo) ProtoMessage() {}
+func (*JobSetInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{27}
+}
+func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k := range m.RequiredNodeLabels {
-			v := m.RequiredNodeLabels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
+}
+func (m *JobSetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetInfo.Merge(m, src)
+}
+func (m *JobSetInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
+
+func (m *JobSetInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
+	return ""
+}
+
+func (m *JobSetInfo) GetQueuedJobs() int32 {
+	if m != nil {
+		return m.QueuedJobs
 	}
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+	return 0
+}
+
+func (m *JobSetInfo) GetLeasedJobs() int32 {
+	if m != nil {
+		return m.LeasedJobs
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *JobSetInfo) GetHeldJobs() int32 {
+	if m != nil {
+		return m.HeldJobs
 	}
-	if m.PodSpec != nil {
-		{
-			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
+	return 0
+}
+
+type QueueListRequest struct {
+	// Only queues owned by this user or group are returned, when set
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Group      string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	NamePrefix string `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix,proto3" json:"namePrefix,omitempty"`
+	// Number of queues to return, all remaining queues are returned when 0
+	Take uint32 `protobuf:"varint,4,opt,name=take,proto3" json:"take,omitempty"`
+	// Value of next_cursor returned by a previous call with the same filters
+	Cursor        string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	IncludeStatus bool   `protobuf:"varint,6,opt,name=include_status,json=includeStatus,proto3" json:"includeStatus,omitempty"`
+}
+
+func (m *QueueListRequest) Reset()      { *m = QueueListRequest{} }
+func (*QueueListRequest) ProtoMessage() {}
+func (*QueueListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{28}
+}
+func (m *QueueListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Priority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
-		i--
-		dAtA[i] = 0x9
+}
+func (m *QueueListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueListRequest.Merge(m, src)
+}
+func (m *QueueListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueListRequest proto.InternalMessageInfo
+
+func (m *QueueListRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IngressConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueListRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *IngressConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueListRequest) GetNamePrefix() string {
+	if m != nil {
+		return m.NamePrefix
+	}
+	return ""
 }
 
-func (m *IngressConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Ports) > 0 {
-		dAtA3 := make([]byte, len(m.Ports)*10)
-		var j2 int
-		for _, num := range m.Ports {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintSubmit(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x12
+func (m *QueueListRequest) GetTake() uint32 {
+	if m != nil {
+		return m.Take
 	}
-	if m.Type != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *QueueListRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueListRequest) GetIncludeStatus() bool {
+	if m != nil {
+		return m.IncludeStatus
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueueListResponse struct {
+	// Ordered by queue name
+	Queues []*QueueListItem `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	// Empty when there are no more queues
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"nextCursor,omitempty"`
 }
 
-func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobRequestItems) > 0 {
-		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueueListResponse) Reset()      { *m = QueueListResponse{} }
+func (*QueueListResponse) ProtoMessage() {}
+func (*QueueListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{29}
+}
+func (m *QueueListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueueListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueListResponse.Merge(m, src)
+}
+func (m *QueueListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueListResponse proto.InternalMessageInfo
+
+func (m *QueueListResponse) GetQueues() []*QueueListItem {
+	if m != nil {
+		return m.Queues
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueListResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueueListItem struct {
+	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Only set when requested with include_status
+	Status *QueueStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueueListItem) Reset()      { *m = QueueListItem{} }
+func (*QueueListItem) ProtoMessage() {}
+func (*QueueListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{30}
+}
+func (m *QueueListItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueListItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueueListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueListItem.Merge(m, src)
+}
+func (m *QueueListItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueListItem proto.InternalMessageInfo
+
+func (m *QueueListItem) GetQueue() *Queue {
+	if m != nil {
+		return m.Queue
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobReprioritizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueListItem) GetStatus() *QueueStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueueStatus struct {
+	QueuedJobs int64 `protobuf:"varint,1,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
+	LeasedJobs int64 `protobuf:"varint,2,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
+	// Current priority of the queue in each pool, lower priority is scheduled first
+	Priority map[string]float64 `protobuf:"bytes,3,rep,name=priority,proto3" json:"priority,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Resources used by running jobs of the queue across all active clusters
+	Usage         map[string]resource.Quantity `protobuf:"bytes,4,rep,name=usage,proto3" json:"usage" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ActiveJobSets []*JobSetInfo                `protobuf:"bytes,5,rep,name=active_job_sets,json=activeJobSets,proto3" json:"activeJobSets,omitempty"`
 }
 
-func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewPriority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
-		i--
-		dAtA[i] = 0x21
+func (m *QueueStatus) Reset()      { *m = QueueStatus{} }
+func (*QueueStatus) ProtoMessage() {}
+func (*QueueStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{31}
+}
+func (m *QueueStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *QueueStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueStatus.Merge(m, src)
+}
+func (m *QueueStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueStatus proto.InternalMessageInfo
+
+func (m *QueueStatus) GetQueuedJobs() int64 {
+	if m != nil {
+		return m.QueuedJobs
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *QueueStatus) GetLeasedJobs() int64 {
+	if m != nil {
+		return m.LeasedJobs
 	}
-	if len(m.JobIds) > 0 {
-		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobIds[iNdEx])
-			copy(dAtA[i:], m.JobIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *QueueStatus) GetPriority() map[string]float64 {
+	if m != nil {
+		return m.Priority
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueStatus) GetUsage() map[string]resource.Quantity {
+	if m != nil {
+		return m.Usage
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobReprioritizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueStatus) GetActiveJobSets() []*JobSetInfo {
+	if m != nil {
+		return m.ActiveJobSets
+	}
+	return nil
 }
 
-func (m *JobReprioritizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReprioritizationResults) > 0 {
-		for k := range m.ReprioritizationResults {
-			v := m.ReprioritizationResults[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("api.IngressType", IngressType_name, IngressType_value)
+	proto.RegisterEnum("api.ConcurrencyPolicy", ConcurrencyPolicy_name, ConcurrencyPolicy_value)
+	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
+	proto.RegisterType((*IngressConfig)(nil), "api.IngressConfig")
+	proto.RegisterMapType((map[string]string)(nil), "api.IngressConfig.AnnotationsEntry")
+	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
+	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
+	proto.RegisterType((*JobReprioritizeRequest)(nil), "api.JobReprioritizeRequest")
+	proto.RegisterType((*JobReprioritizeResponse)(nil), "api.JobReprioritizeResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobReprioritizeResponse.ReprioritizationResultsEntry")
+	proto.RegisterType((*JobHoldRequest)(nil), "api.JobHoldRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobHoldRequest.LabelsEntry")
+	proto.RegisterType((*JobHoldResponse)(nil), "api.JobHoldResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobHoldResponse.HoldResultsEntry")
+	proto.RegisterType((*JobReleaseRequest)(nil), "api.JobReleaseRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobReleaseRequest.LabelsEntry")
+	proto.RegisterType((*JobReleaseResponse)(nil), "api.JobReleaseResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobReleaseResponse.ReleaseResultsEntry")
+	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
+	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
+	proto.RegisterType((*Queue)(nil), "api.Queue")
+	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
+	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
+	proto.RegisterType((*QueueGetRequest)(nil), "api.QueueGetRequest")
+	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
+	proto.RegisterType((*QueueSchedulingRequest)(nil), "api.QueueSchedulingRequest")
+	proto.RegisterType((*ClusterCordon)(nil), "api.ClusterCordon")
+	proto.RegisterType((*ClusterCordonRequest)(nil), "api.ClusterCordonRequest")
+	proto.RegisterType((*ClusterCordonResponse)(nil), "api.ClusterCordonResponse")
+	proto.RegisterType((*ClusterUncordonRequest)(nil), "api.ClusterUncordonRequest")
+	proto.RegisterType((*ClusterCordonList)(nil), "api.ClusterCordonList")
+	proto.RegisterType((*JobSchedule)(nil), "api.JobSchedule")
+	proto.RegisterType((*JobScheduleDeleteRequest)(nil), "api.JobScheduleDeleteRequest")
+	proto.RegisterType((*JobScheduleList)(nil), "api.JobScheduleList")
+	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
+	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
+	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
+	proto.RegisterType((*QueueListRequest)(nil), "api.QueueListRequest")
+	proto.RegisterType((*QueueListResponse)(nil), "api.QueueListResponse")
+	proto.RegisterType((*QueueListItem)(nil), "api.QueueListItem")
+	proto.RegisterType((*QueueStatus)(nil), "api.QueueStatus")
+	proto.RegisterMapType((map[string]float64)(nil), "api.QueueStatus.PriorityEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueStatus.UsageEntry")
 }
 
-func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
+
+var fileDescriptor_e998bacb27df16c1 = []byte{
+	// 2557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x73, 0x1c, 0x47,
+	0xf5, 0xd7, 0x68, 0xf5, 0x63, 0xf7, 0x8d, 0x56, 0xbb, 0x6a, 0xad, 0xa4, 0xf5, 0x4a, 0x91, 0x94,
+	0xc9, 0xd7, 0xf9, 0x0a, 0x25, 0xac, 0xb0, 0x80, 0x72, 0x62, 0x8a, 0x50, 0xb2, 0xac, 0x28, 0x52,
+	0x5c, 0xb1, 0x3c, 0xb2, 0xc1, 0x05, 0xe5, 0x1a, 0x66, 0x67, 0x5a, 0xab, 0xb1, 0x67, 0xa7, 0xc7,
+	0xf3, 0xc3, 0x96, 0xa0, 0x28, 0x28, 0xaa, 0xa8, 0xe2, 0x98, 0x2a, 0x8e, 0x5c, 0xb9, 0xc2, 0x81,
+	0x13, 0x77, 0x2e, 0x39, 0x06, 0x72, 0x20, 0x55, 0x54, 0x05, 0xb0, 0x39, 0x71, 0xe4, 0x2f, 0xa0,
+	0xfa, 0x75, 0xcf, 0x8f, 0x9d, 0x5d, 0xc9, 0x56, 0xec, 0xdc, 0xb6, 0x5f, 0xbf, 0xf7, 0x79, 0x6f,
+	0xfa, 0xbd, 0x7e, 0xfd, 0xe9, 0x5e, 0x68, 0xf8, 0x0f, 0xbb, 0x1b, 0xa6, 0xef, 0x6c, 0x84, 0x71,
+	0xa7, 0xe7, 0x44, 0x6d, 0x3f, 0x60, 0x11, 0x23, 0x25, 0xd3, 0x77, 0x5a, 0x8b, 0x5d, 0xc6, 0xba,
+	0x2e, 0xdd, 0x40, 0x51, 0x27, 0x3e, 0xda, 0xa0, 0x3d, 0x3f, 0x3a, 0x15, 0x1a, 0xad, 0x95, 0xe2,
+	0x64, 0xe4, 0xf4, 0x68, 0x18, 0x99, 0x3d, 0x5f, 0x2a, 0x68, 0x0f, 0xdf, 0x09, 0xdb, 0x0e, 0x43,
+	0x6c, 0x8b, 0x05, 0x74, 0xe3, 0xf1, 0x95, 0x8d, 0x2e, 0xf5, 0x68, 0x60, 0x46, 0xd4, 0x96, 0x3a,
+	0xdf, 0xca, 0x74, 0x7a, 0xa6, 0x75, 0xec, 0x78, 0x34, 0x38, 0xdd, 0x48, 0x02, 0x0a, 0x68, 0xc8,
+	0xe2, 0xc0, 0xa2, 0x03, 0x56, 0x4b, 0xd2, 0x35, 0x57, 0x32, 0x3d, 0x8f, 0x45, 0x66, 0xe4, 0x30,
+	0x2f, 0x94, 0xb3, 0x5f, 0xef, 0x3a, 0xd1, 0x71, 0xdc, 0x69, 0x5b, 0xac, 0xb7, 0xd1, 0x65, 0x5d,
+	0x96, 0x45, 0xc8, 0x47, 0x38, 0xc0, 0x5f, 0x42, 0x5d, 0xfb, 0xd5, 0x24, 0x34, 0xf6, 0x59, 0xe7,
+	0x10, 0xbf, 0x5e, 0xa7, 0x8f, 0x62, 0x1a, 0x46, 0x7b, 0x11, 0xed, 0x91, 0x16, 0x94, 0xfd, 0xc0,
+	0x61, 0x81, 0x13, 0x9d, 0x36, 0x95, 0x55, 0x65, 0x4d, 0xd1, 0xd3, 0x31, 0x59, 0x82, 0x8a, 0x67,
+	0xf6, 0x68, 0xe8, 0x9b, 0x16, 0x6d, 0x96, 0x56, 0x95, 0xb5, 0x8a, 0x9e, 0x09, 0xc8, 0x22, 0x54,
+	0x2c, 0xd7, 0xa1, 0x5e, 0x64, 0x38, 0x76, 0xb3, 0x8c, 0xb3, 0x65, 0x21, 0xd8, 0xb3, 0xc9, 0x77,
+	0x61, 0xc2, 0x35, 0x3b, 0xd4, 0x0d, 0x9b, 0x63, 0xab, 0xa5, 0x35, 0x75, 0xf3, 0x72, 0xdb, 0xf4,
+	0x9d, 0xf6, 0xb0, 0x08, 0xda, 0x37, 0x51, 0x6f, 0xc7, 0x8b, 0x82, 0x53, 0x5d, 0x1a, 0x91, 0x9b,
+	0xa0, 0xe6, 0x3e, 0xb9, 0x39, 0x8e, 0x18, 0xeb, 0x67, 0x63, 0x6c, 0x65, 0xca, 0x02, 0x28, 0x6f,
+	0x4e, 0xba, 0xd0, 0x08, 0xe8, 0xa3, 0xd8, 0x09, 0xa8, 0x6d, 0x78, 0xcc, 0xa6, 0x86, 0x0c, 0x6d,
+	0x02, 0x61, 0xaf, 0x9c, 0x0d, 0xab, 0x4b, 0xab, 0x8f, 0x98, 0x4d, 0x73, 0x61, 0x5e, 0x1f, 0x6d,
+	0x2a, 0x3a, 0x09, 0x06, 0x26, 0xc9, 0x35, 0x28, 0xfb, 0xcc, 0x36, 0x42, 0x9f, 0x5a, 0xcd, 0xd1,
+	0x55, 0x65, 0x4d, 0xdd, 0x5c, 0x6c, 0x8b, 0xdc, 0xa3, 0x0f, 0x5e, 0x1f, 0xed, 0xc7, 0x57, 0xda,
+	0x07, 0xcc, 0x3e, 0xf4, 0xa9, 0x85, 0x30, 0x93, 0xbe, 0x18, 0x90, 0x77, 0xa0, 0x92, 0xd8, 0x86,
+	0xcd, 0xc9, 0xd5, 0xd2, 0x73, 0x8c, 0xf5, 0xb2, 0x34, 0x0c, 0xc9, 0xdb, 0x30, 0xe9, 0x78, 0xdd,
+	0x80, 0x86, 0x61, 0xb3, 0x82, 0x76, 0x04, 0x0d, 0xf6, 0x84, 0x6c, 0x9b, 0x79, 0x47, 0x4e, 0x57,
+	0x4f, 0x54, 0xc8, 0x65, 0x98, 0xf6, 0x29, 0x0d, 0x0c, 0xdb, 0x09, 0x2d, 0xf6, 0x98, 0x06, 0xa7,
+	0x4d, 0x58, 0x55, 0xd6, 0xca, 0x7a, 0x95, 0x4b, 0x6f, 0x24, 0x42, 0xb2, 0x0d, 0xe0, 0xb1, 0xc8,
+	0xe8, 0xd0, 0x23, 0x16, 0xd0, 0xa6, 0x8a, 0x1f, 0xd3, 0x6a, 0x8b, 0x92, 0x6c, 0x27, 0xb5, 0xd6,
+	0xbe, 0x93, 0xec, 0x86, 0xeb, 0xe5, 0x4f, 0xbe, 0x58, 0x51, 0x3e, 0xfe, 0xc7, 0x8a, 0xa2, 0x57,
+	0x3c, 0x16, 0x5d, 0x47, 0x33, 0xf2, 0x06, 0x54, 0x4d, 0xd7, 0x65, 0x4f, 0xa8, 0x6d, 0xf8, 0x8c,
+	0xb9, 0x61, 0x73, 0x6a, 0xb5, 0xb4, 0x56, 0xd1, 0xa7, 0xa4, 0xf0, 0x80, 0xcb, 0xc8, 0xd7, 0xa0,
+	0x9e, 0x28, 0x59, 0x6e, 0x1c, 0x46, 0x34, 0x08, 0x9b, 0x55, 0xd4, 0xab, 0x49, 0xf9, 0xb6, 0x14,
+	0xb7, 0xde, 0x05, 0x35, 0x97, 0x06, 0x52, 0x87, 0xd2, 0x43, 0x2a, 0xca, 0xb6, 0xa2, 0xf3, 0x9f,
+	0xa4, 0x01, 0xe3, 0x8f, 0x4d, 0x37, 0xa6, 0xb8, 0xfa, 0x15, 0x5d, 0x0c, 0xae, 0x8d, 0xbe, 0xa3,
+	0xb4, 0xde, 0x83, 0x7a, 0xb1, 0x48, 0x2e, 0x64, 0xbf, 0x03, 0x0b, 0x67, 0x54, 0xc3, 0x45, 0x60,
+	0xb4, 0xbf, 0x2a, 0x50, 0xed, 0x4b, 0x0c, 0xf9, 0x3f, 0x18, 0x8b, 0x4e, 0x7d, 0x8a, 0xe6, 0xd3,
+	0x9b, 0xf5, 0x7c, 0xea, 0xee, 0x9c, 0xfa, 0x54, 0xc7, 0x59, 0x8e, 0xe8, 0xb3, 0x20, 0x0a, 0x9b,
+	0xa3, 0xab, 0xa5, 0xb5, 0xaa, 0x2e, 0x06, 0x64, 0xa7, 0x7f, 0x9b, 0x94, 0x30, 0xfb, 0x6f, 0x0c,
+	0x66, 0xff, 0xfc, 0xfd, 0xf1, 0xb2, 0x6b, 0xa3, 0xfd, 0x41, 0x81, 0x7a, 0x71, 0xff, 0x70, 0xf5,
+	0x47, 0x31, 0x8d, 0xa9, 0x84, 0x10, 0x03, 0xb2, 0x04, 0xf0, 0x80, 0x75, 0x8c, 0x90, 0x62, 0xd7,
+	0x10, 0x48, 0xe5, 0x07, 0xac, 0x73, 0x48, 0x79, 0xd7, 0xd8, 0x81, 0x19, 0x3e, 0x1b, 0x08, 0x08,
+	0xc3, 0x89, 0x68, 0x2f, 0xf9, 0xaa, 0x4b, 0x67, 0xee, 0x52, 0xbd, 0xf6, 0x80, 0x75, 0x72, 0xe3,
+	0x90, 0xac, 0x80, 0xda, 0x33, 0x4f, 0x8c, 0x20, 0xf6, 0x3c, 0xc7, 0xeb, 0x36, 0xc7, 0x56, 0x95,
+	0xb5, 0xaa, 0x0e, 0x3d, 0xf3, 0x44, 0x17, 0x12, 0xed, 0x3e, 0xc6, 0xbb, 0x6d, 0x7a, 0x16, 0x75,
+	0x93, 0x78, 0xe7, 0x60, 0x82, 0xfb, 0x76, 0xec, 0x24, 0xe0, 0x07, 0xac, 0xb3, 0x67, 0x3f, 0x27,
+	0xe0, 0xf4, 0x23, 0x4b, 0xb9, 0x8f, 0xd4, 0x7e, 0xad, 0xc0, 0xfc, 0x3e, 0x8f, 0x49, 0x76, 0x52,
+	0xe7, 0x27, 0x34, 0xf1, 0xb2, 0x00, 0x93, 0xc2, 0x4b, 0xd8, 0x54, 0xb0, 0xc6, 0x27, 0xd0, 0x4d,
+	0xf8, 0x65, 0xfc, 0x90, 0xd7, 0x61, 0xca, 0xa3, 0x4f, 0x8c, 0xb4, 0x7f, 0x8f, 0x61, 0xff, 0x56,
+	0x3d, 0xfa, 0xe4, 0x40, 0x8a, 0xb4, 0xbf, 0x2b, 0xb0, 0x30, 0x10, 0x4a, 0xe8, 0x33, 0x2f, 0xa4,
+	0x24, 0x82, 0x66, 0x90, 0xc9, 0x31, 0xf9, 0x46, 0x40, 0xc3, 0xd8, 0x8d, 0x44, 0x70, 0xea, 0xe6,
+	0xbb, 0xc9, 0xa2, 0x0f, 0xb3, 0x6f, 0xeb, 0x05, 0x63, 0x5d, 0xd8, 0x8a, 0x02, 0x5b, 0x08, 0x86,
+	0xcf, 0xb6, 0xf6, 0x61, 0xe9, 0x3c, 0xc3, 0x0b, 0x15, 0xde, 0x5f, 0x14, 0x98, 0xde, 0x67, 0x9d,
+	0x0f, 0x98, 0x6b, 0x7f, 0x25, 0x0b, 0x7c, 0xb5, 0x70, 0x8a, 0xad, 0x24, 0xeb, 0x91, 0xf3, 0x38,
+	0xec, 0xfc, 0x7a, 0x89, 0x46, 0xa5, 0xfd, 0x56, 0x81, 0x5a, 0xea, 0x41, 0x66, 0xea, 0x03, 0x98,
+	0x3a, 0x66, 0xae, 0x5d, 0xc8, 0xce, 0xe5, 0xfe, 0x68, 0x64, 0x56, 0xe4, 0x20, 0xcb, 0x84, 0x7a,
+	0x9c, 0x49, 0xf8, 0x56, 0x2f, 0x2a, 0x5c, 0x28, 0xba, 0xbf, 0x29, 0x30, 0x83, 0xf5, 0xe0, 0x52,
+	0x33, 0xfc, 0x6a, 0xaa, 0xfa, 0x5a, 0x61, 0xd1, 0xb5, 0xac, 0x08, 0xf3, 0x4e, 0x5f, 0xf5, 0xba,
+	0xff, 0x5e, 0x01, 0x92, 0x77, 0x22, 0x97, 0xfe, 0x0e, 0xd4, 0x02, 0x21, 0x2a, 0xac, 0xfe, 0x5b,
+	0x03, 0x61, 0xa5, 0xdb, 0x22, 0x19, 0x67, 0x39, 0x98, 0x0e, 0xfa, 0x84, 0xad, 0x2d, 0x98, 0x1d,
+	0xa2, 0x76, 0xa1, 0x78, 0x6f, 0xc0, 0x5c, 0xae, 0x1b, 0x0a, 0xdf, 0xc8, 0xe8, 0xce, 0x68, 0x64,
+	0x0d, 0x18, 0xa7, 0x41, 0xc0, 0x82, 0x04, 0x09, 0x07, 0xda, 0x7d, 0x98, 0x19, 0x40, 0x21, 0x1f,
+	0x00, 0x11, 0x6d, 0x58, 0x8c, 0x65, 0x1f, 0x16, 0x9f, 0xdd, 0x2a, 0xf6, 0xe1, 0xcc, 0xb3, 0x5e,
+	0xc7, 0x46, 0x9c, 0x09, 0x42, 0xed, 0xbf, 0x25, 0x18, 0xbf, 0x8d, 0x59, 0x25, 0x30, 0xc6, 0xa9,
+	0xa3, 0x8c, 0x09, 0x7f, 0x93, 0xff, 0x87, 0x5a, 0xd2, 0xbb, 0x8c, 0x23, 0xd3, 0x8a, 0x64, 0x70,
+	0x8a, 0x3e, 0x9d, 0x88, 0xdf, 0x47, 0x29, 0x6f, 0xe8, 0x71, 0x48, 0x03, 0x83, 0x3d, 0xf1, 0x68,
+	0x20, 0x4e, 0x84, 0x8a, 0x0e, 0x5c, 0x74, 0x0b, 0x25, 0xbc, 0x13, 0x76, 0x03, 0x16, 0xfb, 0x89,
+	0xc6, 0x18, 0x6a, 0xa8, 0x28, 0x93, 0x2a, 0xbb, 0x50, 0x4b, 0xa8, 0xb6, 0xe1, 0x3a, 0x3d, 0x27,
+	0x4a, 0x68, 0xe5, 0x32, 0x7e, 0x11, 0x46, 0xd9, 0xd6, 0xa5, 0xc6, 0x4d, 0x54, 0x48, 0x73, 0x97,
+	0x17, 0x92, 0xb7, 0x60, 0x26, 0xb4, 0x8e, 0xa9, 0x1d, 0xbb, 0x8e, 0xd7, 0x35, 0x7c, 0x33, 0x0e,
+	0xa9, 0xdd, 0x9c, 0x40, 0x0e, 0x55, 0xcf, 0x26, 0x0e, 0x50, 0x4e, 0xda, 0x30, 0x9b, 0x7e, 0xa2,
+	0xd9, 0xe5, 0x06, 0x9c, 0xe2, 0x37, 0x27, 0xf1, 0x33, 0x67, 0x92, 0xa9, 0x2d, 0x3e, 0xa3, 0x9b,
+	0x11, 0x25, 0x6f, 0x03, 0x29, 0xe8, 0x5b, 0xa6, 0x8f, 0xec, 0x5a, 0xd1, 0xeb, 0x7d, 0xea, 0xdb,
+	0xa6, 0x5f, 0x3c, 0xe8, 0x2a, 0xc5, 0x83, 0x8e, 0x7c, 0x03, 0x1a, 0x5c, 0x81, 0x53, 0x3a, 0x8b,
+	0xf5, 0x7a, 0x4e, 0xc4, 0xdd, 0x3b, 0x0c, 0x29, 0x9f, 0xa2, 0x93, 0x9e, 0x79, 0x72, 0x2b, 0x9d,
+	0xd2, 0xf9, 0x8c, 0xa8, 0xcc, 0x81, 0x45, 0x78, 0x5e, 0x65, 0x2a, 0xf9, 0xca, 0xfc, 0x10, 0x88,
+	0x38, 0x5a, 0xdd, 0x5c, 0x77, 0x27, 0xdf, 0x86, 0xaa, 0x25, 0xa4, 0xd4, 0xce, 0x3a, 0xc5, 0xf5,
+	0xfa, 0x7f, 0xbe, 0x58, 0x99, 0x4a, 0x27, 0xf6, 0xec, 0x50, 0xef, 0x1b, 0x69, 0x97, 0xa1, 0x86,
+	0xa9, 0xd9, 0xa5, 0x29, 0xb3, 0x18, 0x52, 0x4a, 0xda, 0x9b, 0x50, 0x47, 0xb5, 0x3d, 0xef, 0x88,
+	0x9d, 0xa7, 0xf7, 0x36, 0xcc, 0xa3, 0xde, 0x61, 0x9a, 0xa8, 0xf3, 0xb4, 0xff, 0xa4, 0x40, 0x55,
+	0x92, 0xcf, 0x6d, 0x16, 0xd8, 0xcc, 0x23, 0xaf, 0x01, 0x48, 0x92, 0x9a, 0x6d, 0xb0, 0x8a, 0x94,
+	0xec, 0xd9, 0x1c, 0x84, 0x13, 0x5d, 0xb9, 0xc7, 0xf0, 0x37, 0x99, 0x87, 0x89, 0x80, 0x9a, 0x21,
+	0xf3, 0x64, 0x9b, 0x93, 0x23, 0x7e, 0xbb, 0x92, 0x44, 0x87, 0x05, 0x78, 0x74, 0x57, 0xf4, 0x4c,
+	0x40, 0xde, 0x83, 0x49, 0x2b, 0xa0, 0xfc, 0x3a, 0xd8, 0x1c, 0x7f, 0x21, 0xf2, 0x3d, 0x82, 0xe4,
+	0x3b, 0x31, 0xd2, 0x9e, 0x40, 0xa3, 0x2f, 0xf2, 0xe4, 0x33, 0x5f, 0xe1, 0x07, 0x34, 0x60, 0xdc,
+	0x0e, 0x4c, 0xc7, 0xc3, 0xe0, 0xcb, 0xba, 0x18, 0x68, 0xdf, 0x83, 0xb9, 0x82, 0x63, 0xd9, 0x55,
+	0xde, 0x84, 0x1a, 0x6a, 0x50, 0xdb, 0xe8, 0x3f, 0x2c, 0xaa, 0x52, 0xbc, 0x8f, 0x67, 0x86, 0xf6,
+	0x21, 0xcc, 0x4b, 0x80, 0xbb, 0x9e, 0xf5, 0x92, 0xb1, 0x6b, 0x5b, 0x30, 0xd3, 0x17, 0xcd, 0x4d,
+	0x27, 0x8c, 0xf8, 0x85, 0x49, 0x00, 0x27, 0x4d, 0x4d, 0x5c, 0x98, 0xfa, 0xc3, 0x4e, 0x54, 0xb4,
+	0xcf, 0x46, 0x41, 0xe5, 0xfd, 0x4e, 0x54, 0xcc, 0xf0, 0x4e, 0x46, 0x60, 0xcc, 0x0a, 0x98, 0x97,
+	0xb8, 0xe6, 0xbf, 0xc9, 0x15, 0x28, 0x47, 0xb4, 0xe7, 0xbb, 0x7c, 0xbf, 0x97, 0x30, 0x85, 0x73,
+	0x43, 0x39, 0xac, 0x9e, 0xaa, 0x91, 0x1d, 0x20, 0x16, 0xf3, 0xac, 0x38, 0x08, 0xa8, 0x67, 0x9d,
+	0x1a, 0x3e, 0x73, 0x1d, 0x4b, 0xd0, 0xba, 0xe9, 0xcd, 0x79, 0x11, 0x63, 0x36, 0x7d, 0x80, 0xb3,
+	0xfa, 0x8c, 0x55, 0x14, 0xf1, 0xc4, 0x60, 0x1f, 0xc4, 0xca, 0xa9, 0xe8, 0x62, 0xc0, 0xef, 0x59,
+	0xf8, 0x23, 0x3c, 0x76, 0x7c, 0x03, 0x3b, 0xa3, 0xb8, 0x01, 0x57, 0xf4, 0x5a, 0x2a, 0xdf, 0x45,
+	0x71, 0xbe, 0xf8, 0x26, 0xbf, 0x44, 0xf1, 0xf1, 0x76, 0x8c, 0x90, 0x89, 0x9b, 0xb2, 0x68, 0xc7,
+	0x28, 0x13, 0x2e, 0xb4, 0x36, 0x34, 0x73, 0x8b, 0x7a, 0x83, 0xba, 0x34, 0xa2, 0xe7, 0x6d, 0xc5,
+	0x2d, 0xa8, 0xe5, 0xf4, 0x31, 0x8d, 0x6d, 0xa8, 0xc8, 0x7e, 0x4b, 0x93, 0x44, 0xd6, 0xd3, 0x15,
+	0x96, 0x13, 0x7a, 0xa6, 0xa2, 0xad, 0x01, 0xc1, 0xbd, 0xff, 0x7c, 0x67, 0xf7, 0xa0, 0x92, 0x76,
+	0x93, 0xa1, 0xf9, 0xbe, 0x0a, 0x35, 0xd3, 0x8a, 0x9c, 0xc7, 0xd4, 0x90, 0xf4, 0x46, 0x5c, 0xcc,
+	0xd4, 0xcd, 0x5a, 0x1a, 0x00, 0x8d, 0xb8, 0xb5, 0x5e, 0x15, 0x7a, 0x42, 0x12, 0x6a, 0x3f, 0x07,
+	0xc8, 0x26, 0x87, 0x42, 0xaf, 0x80, 0x8a, 0x3c, 0x08, 0x77, 0x49, 0x88, 0x15, 0x35, 0xae, 0x83,
+	0x10, 0xed, 0xb3, 0x0e, 0xde, 0x6e, 0x90, 0x39, 0x48, 0x85, 0x92, 0x50, 0x10, 0x22, 0x54, 0x58,
+	0x84, 0xca, 0x31, 0x75, 0xe5, 0xf4, 0x18, 0x4e, 0x97, 0xb9, 0x80, 0x4f, 0x6a, 0x7f, 0x54, 0x64,
+	0xa7, 0xe4, 0x4b, 0x98, 0xbb, 0xab, 0x89, 0x82, 0x51, 0xf2, 0x05, 0xd3, 0x80, 0x71, 0xcc, 0x5f,
+	0xc2, 0x18, 0x70, 0xc0, 0xdd, 0xf3, 0x38, 0x0d, 0x3f, 0xa0, 0x47, 0xce, 0x89, 0x6c, 0x09, 0xc0,
+	0x45, 0x07, 0x28, 0xe1, 0x1f, 0x15, 0x99, 0x0f, 0xa9, 0xbc, 0x76, 0xe1, 0x6f, 0xde, 0x42, 0xac,
+	0x38, 0x08, 0x59, 0x52, 0x92, 0x72, 0xc4, 0x1f, 0x23, 0x1c, 0xcf, 0x72, 0x63, 0x9b, 0x1a, 0x61,
+	0x64, 0x46, 0x71, 0x28, 0x0f, 0xd2, 0xaa, 0x94, 0x1e, 0xa2, 0x50, 0xfb, 0x31, 0xcc, 0xe4, 0x62,
+	0x96, 0xfd, 0x64, 0x1d, 0x26, 0x70, 0x55, 0xfa, 0x37, 0x71, 0xaa, 0x87, 0x8c, 0x44, 0x6a, 0x60,
+	0xd0, 0xf4, 0x24, 0x32, 0x64, 0x10, 0xa3, 0x32, 0x68, 0x7a, 0x12, 0x6d, 0xa3, 0x44, 0xfb, 0x11,
+	0x54, 0xfb, 0x2c, 0xc9, 0x6a, 0xfe, 0xfa, 0xaa, 0x6e, 0x42, 0x06, 0x9e, 0xf0, 0xd4, 0x35, 0x98,
+	0x90, 0x31, 0x8b, 0xa7, 0x9e, 0x7a, 0xa6, 0x22, 0xc2, 0xd6, 0xe5, 0xbc, 0xf6, 0xbb, 0x12, 0xa8,
+	0x39, 0x79, 0x31, 0xc5, 0xdc, 0x43, 0xe9, 0xbc, 0x14, 0x8f, 0x0a, 0x85, 0x5c, 0x8a, 0xaf, 0xe5,
+	0x5e, 0xed, 0x4a, 0x45, 0x16, 0x23, 0xbc, 0xb4, 0x93, 0x3b, 0xa0, 0x60, 0x31, 0xa9, 0x3e, 0xb9,
+	0x0a, 0xe3, 0x71, 0x68, 0x76, 0xa9, 0xa4, 0xd7, 0x8b, 0x03, 0x86, 0x77, 0xf9, 0xac, 0x78, 0xe8,
+	0x1a, 0xe3, 0x7b, 0x5b, 0x17, 0xfa, 0xc3, 0x8a, 0x7e, 0xfc, 0x45, 0x8a, 0xbe, 0xf5, 0x1d, 0xa8,
+	0xf6, 0x05, 0x73, 0x11, 0x36, 0xd1, 0x3a, 0x06, 0xc8, 0x02, 0x1a, 0x62, 0x79, 0x23, 0x6f, 0xa9,
+	0x6e, 0xb6, 0x73, 0x6f, 0x66, 0xe9, 0x63, 0x6b, 0xdb, 0x7f, 0xd8, 0xc5, 0x18, 0x13, 0x2a, 0xd7,
+	0xbe, 0x1d, 0x9b, 0x5e, 0xe4, 0x44, 0xa7, 0x39, 0x4f, 0xeb, 0x6b, 0xa0, 0xe6, 0x1e, 0x5e, 0xc8,
+	0x14, 0x94, 0xf9, 0x4b, 0xcf, 0x01, 0x0b, 0xa2, 0xfa, 0x08, 0x51, 0x61, 0x52, 0x4e, 0xd6, 0x95,
+	0xf5, 0xab, 0x30, 0x33, 0xd0, 0x88, 0x49, 0x05, 0xc6, 0xb7, 0xf8, 0x7b, 0x55, 0x7d, 0x84, 0x00,
+	0x4c, 0xbc, 0xcf, 0x82, 0x8e, 0x63, 0xd7, 0x15, 0x6e, 0xa8, 0x53, 0xdf, 0x35, 0x2d, 0x5a, 0x1f,
+	0xdd, 0xfc, 0x73, 0x15, 0x26, 0x44, 0xf3, 0x27, 0xdf, 0x07, 0x10, 0xbf, 0x30, 0xa1, 0xc3, 0x8f,
+	0x86, 0xd6, 0xfc, 0x70, 0xb6, 0xad, 0x5d, 0xfa, 0xe5, 0x67, 0xff, 0xfe, 0xcd, 0xe8, 0xac, 0x36,
+	0xcd, 0x9f, 0x9b, 0x1f, 0xb0, 0x8e, 0x7c, 0xd6, 0xbe, 0xa6, 0xac, 0x93, 0x1f, 0x00, 0x08, 0xf6,
+	0xd5, 0x8f, 0xdb, 0xf7, 0xd8, 0xd1, 0x5a, 0x10, 0x87, 0xc9, 0x00, 0x4b, 0x1b, 0x04, 0x16, 0x64,
+	0x8c, 0x03, 0x7b, 0x50, 0xcf, 0x3f, 0x03, 0x88, 0x56, 0x33, 0xfc, 0x81, 0x40, 0x38, 0x59, 0x3a,
+	0xef, 0xf5, 0x40, 0x5b, 0x41, 0x4f, 0x97, 0xb4, 0x46, 0xe2, 0x29, 0xf7, 0x60, 0x40, 0xb9, 0xbf,
+	0x8f, 0xa0, 0xcc, 0xaf, 0xaa, 0xe8, 0x67, 0x76, 0xc8, 0xc5, 0xbb, 0xd5, 0x18, 0x76, 0xff, 0xd5,
+	0x16, 0x10, 0x77, 0x46, 0x9b, 0x4a, 0x70, 0xf9, 0xf5, 0x97, 0xe3, 0xfd, 0x10, 0x54, 0x79, 0xe7,
+	0x42, 0xc8, 0xf9, 0xe1, 0xd7, 0xca, 0xd6, 0xc2, 0x80, 0x5c, 0x02, 0xb7, 0x10, 0xb8, 0xa1, 0xd5,
+	0xb2, 0x80, 0x51, 0x81, 0x63, 0xef, 0x82, 0xba, 0x8d, 0x67, 0x9f, 0xb8, 0xec, 0xe4, 0xba, 0x45,
+	0x6b, 0x7e, 0xe0, 0xe8, 0xdc, 0xe1, 0xff, 0x2f, 0x68, 0x0d, 0x84, 0x9b, 0xd6, 0x2a, 0x1c, 0x0e,
+	0xf7, 0xbe, 0xf8, 0x68, 0xf5, 0xae, 0x6f, 0x5f, 0x08, 0x68, 0x11, 0x81, 0xe6, 0x5a, 0xf5, 0x14,
+	0x68, 0xe3, 0xa7, 0xbc, 0x1d, 0xff, 0x8c, 0xe3, 0xdd, 0x03, 0x55, 0x1c, 0x77, 0x02, 0x6f, 0x21,
+	0xc3, 0xeb, 0x3b, 0x05, 0xcf, 0x04, 0x6f, 0x22, 0x38, 0x59, 0x1f, 0x00, 0x27, 0x1e, 0x34, 0xf0,
+	0x8e, 0x53, 0xa0, 0xd3, 0x24, 0xdf, 0x4f, 0x8a, 0x24, 0xfb, 0x4c, 0x37, 0xaf, 0xa3, 0x9b, 0x45,
+	0x6d, 0xbe, 0xe8, 0x66, 0x03, 0xef, 0x57, 0xfc, 0x4b, 0x7c, 0x98, 0xe3, 0x35, 0xda, 0x7b, 0x35,
+	0x0e, 0x35, 0x74, 0xb8, 0xa4, 0x2d, 0x0c, 0x38, 0x0c, 0xd0, 0x09, 0xf7, 0x78, 0x1f, 0xaa, 0x82,
+	0x0b, 0x4a, 0x62, 0x48, 0x2e, 0x0d, 0xa1, 0x89, 0xd2, 0x4f, 0x6b, 0xd8, 0x94, 0x2c, 0x9c, 0x39,
+	0xf4, 0x55, 0xd3, 0x80, 0xfb, 0x12, 0xac, 0x92, 0xc3, 0x1b, 0x50, 0x4b, 0x08, 0x6e, 0xe2, 0x60,
+	0x31, 0x8f, 0x52, 0x60, 0xbf, 0x67, 0x7e, 0x4a, 0x5f, 0xc1, 0xc7, 0x5e, 0xe6, 0xe0, 0x1e, 0xcc,
+	0xec, 0xd2, 0xa8, 0x2f, 0xa6, 0x90, 0x9c, 0x81, 0x22, 0x3b, 0xcd, 0x00, 0x57, 0xd6, 0x66, 0x11,
+	0xbd, 0x4a, 0xd4, 0x2c, 0xf8, 0x90, 0x1c, 0xc2, 0xb4, 0x28, 0xf7, 0x94, 0x14, 0x0f, 0x10, 0xaf,
+	0x17, 0x0b, 0x37, 0x21, 0x67, 0x3c, 0x5c, 0x1b, 0xa6, 0x45, 0x4d, 0xa6, 0xa0, 0xaf, 0x15, 0x41,
+	0x5f, 0xac, 0x66, 0xe5, 0x86, 0x58, 0x9f, 0xcd, 0x7b, 0x48, 0xca, 0xf6, 0x10, 0xa6, 0x76, 0x69,
+	0x94, 0x00, 0x9e, 0xbd, 0x1e, 0x8d, 0xa2, 0x6f, 0x5c, 0x0d, 0x99, 0x4a, 0x52, 0xcd, 0x43, 0x87,
+	0xe4, 0x7d, 0x28, 0xef, 0xd2, 0x48, 0x6c, 0xb1, 0x46, 0x56, 0x8e, 0xd9, 0x9d, 0xb5, 0x95, 0xdb,
+	0xc8, 0xc9, 0x9e, 0x22, 0x83, 0x7b, 0xea, 0x0e, 0x06, 0x97, 0x51, 0xcf, 0xb9, 0xcc, 0x2a, 0x77,
+	0xb1, 0x6d, 0x4d, 0xf7, 0x8b, 0xb5, 0xd7, 0x10, 0x70, 0x81, 0xcc, 0x0d, 0x14, 0xb3, 0xc3, 0x51,
+	0x6e, 0x01, 0xf0, 0xe0, 0x6f, 0x0b, 0x2a, 0x34, 0xd7, 0x4f, 0x93, 0xfa, 0x4f, 0x9a, 0x01, 0x96,
+	0xa5, 0x11, 0xc4, 0x9e, 0x22, 0x90, 0x62, 0x87, 0xd7, 0x57, 0x3f, 0xff, 0xd7, 0xf2, 0xc8, 0x2f,
+	0x9e, 0x2e, 0x2b, 0x9f, 0x3c, 0x5d, 0x56, 0x3e, 0x7d, 0xba, 0xac, 0xfc, 0xf3, 0xe9, 0xb2, 0xf2,
+	0xf1, 0xb3, 0xe5, 0x91, 0x4f, 0x9f, 0x2d, 0x8f, 0x7c, 0xfe, 0x6c, 0x79, 0xa4, 0x33, 0x81, 0xab,
+	0xf9, 0xcd, 0xff, 0x0d, 0x00, 0xbf, 0x3f, 0xd6, 0xcc, 0x77, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SubmitClient is the client API for Submit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SubmitClient interface {
+	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
+	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
+	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
+	HoldJobs(ctx context.Context, in *JobHoldRequest, opts ...grpc.CallOption) (*JobHoldResponse, error)
+	ReleaseJobs(ctx context.Context, in *JobReleaseRequest, opts ...grpc.CallOption) (*JobReleaseResponse, error)
+	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	PauseQueueScheduling(ctx context.Context, in *QueueSchedulingRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ResumeQueueScheduling(ctx context.Context, in *QueueSchedulingRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	CordonCluster(ctx context.Context, in *ClusterCordonRequest, opts ...grpc.CallOption) (*ClusterCordonResponse, error)
+	UncordonCluster(ctx context.Context, in *ClusterUncordonRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetClusterCordons(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterCordonList, error)
+	CreateSchedule(ctx context.Context, in *JobSchedule, opts ...grpc.CallOption) (*types.Empty, error)
+	DeleteSchedule(ctx context.Context, in *JobScheduleDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetSchedules(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*JobScheduleList, error)
+	GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error)
+	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
+	ListQueues(ctx context.Context, in *QueueListRequest, opts ...grpc.CallOption) (*QueueListResponse, error)
+}
+
+type submitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
+	return &submitClient{cc}
+}
+
+func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
+	out := new(JobSubmitResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
+	out := new(CancellationResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
+	out := new(JobReprioritizeResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) HoldJobs(ctx context.Context, in *JobHoldRequest, opts ...grpc.CallOption) (*JobHoldResponse, error) {
+	out := new(JobHoldResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/HoldJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) ReleaseJobs(ctx context.Context, in *JobReleaseRequest, opts ...grpc.CallOption) (*JobReleaseResponse, error) {
+	out := new(JobReleaseResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReleaseJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Queue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ResourceLimits) > 0 {
-		for k := range m.ResourceLimits {
-			v := m.ResourceLimits[k]
-			baseI := i
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.GroupOwners) > 0 {
-		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupOwners[iNdEx])
-			copy(dAtA[i:], m.GroupOwners[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.UserOwners) > 0 {
-		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UserOwners[iNdEx])
-			copy(dAtA[i:], m.UserOwners[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.PriorityFactor != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
-		i--
-		dAtA[i] = 0x11
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *submitClient) PauseQueueScheduling(ctx context.Context, in *QueueSchedulingRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/PauseQueueScheduling", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) ResumeQueueScheduling(ctx context.Context, in *QueueSchedulingRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/ResumeQueueScheduling", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) CordonCluster(ctx context.Context, in *ClusterCordonRequest, opts ...grpc.CallOption) (*ClusterCordonResponse, error) {
+	out := new(ClusterCordonResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/CordonCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CancelledIds) > 0 {
-		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CancelledIds[iNdEx])
-			copy(dAtA[i:], m.CancelledIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *submitClient) UncordonCluster(ctx context.Context, in *ClusterUncordonRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/UncordonCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueueGetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) GetClusterCordons(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterCordonList, error) {
+	out := new(ClusterCordonList)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetClusterCordons", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *submitClient) CreateSchedule(ctx context.Context, in *JobSchedule, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) DeleteSchedule(ctx context.Context, in *JobScheduleDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/DeleteSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueueInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueueInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *submitClient) GetSchedules(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*JobScheduleList, error) {
+	out := new(JobScheduleList)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error) {
+	out := new(Queue)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *submitClient) GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error) {
+	out := new(QueueInfo)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) ListQueues(ctx context.Context, in *QueueListRequest, opts ...grpc.CallOption) (*QueueListResponse, error) {
+	out := new(QueueListResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ListQueues", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SubmitServer is the server API for Submit service.
+type SubmitServer interface {
+	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
+	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
+	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
+	HoldJobs(context.Context, *JobHoldRequest) (*JobHoldResponse, error)
+	ReleaseJobs(context.Context, *JobReleaseRequest) (*JobReleaseResponse, error)
+	CreateQueue(context.Context, *Queue) (*types.Empty, error)
+	UpdateQueue(context.Context, *Queue) (*types.Empty, error)
+	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
+	PauseQueueScheduling(context.Context, *QueueSchedulingRequest) (*types.Empty, error)
+	ResumeQueueScheduling(context.Context, *QueueSchedulingRequest) (*types.Empty, error)
+	CordonCluster(context.Context, *ClusterCordonRequest) (*ClusterCordonResponse, error)
+	UncordonCluster(context.Context, *ClusterUncordonRequest) (*types.Empty, error)
+	GetClusterCordons(context.Context, *types.Empty) (*ClusterCordonList, error)
+	CreateSchedule(context.Context, *JobSchedule) (*types.Empty, error)
+	DeleteSchedule(context.Context, *JobScheduleDeleteRequest) (*types.Empty, error)
+	GetSchedules(context.Context, *types.Empty) (*JobScheduleList, error)
+	GetQueue(context.Context, *QueueGetRequest) (*Queue, error)
+	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
+	ListQueues(context.Context, *QueueListRequest) (*QueueListResponse, error)
 }
 
-func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActiveJobSets) > 0 {
-		for iNdEx := len(m.ActiveJobSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActiveJobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
+type UnimplementedSubmitServer struct {
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+}
+func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
+}
+func (*UnimplementedSubmitServer) HoldJobs(ctx context.Context, req *JobHoldRequest) (*JobHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldJobs not implemented")
+}
+func (*UnimplementedSubmitServer) ReleaseJobs(ctx context.Context, req *JobReleaseRequest) (*JobReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+}
+func (*UnimplementedSubmitServer) PauseQueueScheduling(ctx context.Context, req *QueueSchedulingRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseQueueScheduling not implemented")
+}
+func (*UnimplementedSubmitServer) ResumeQueueScheduling(ctx context.Context, req *QueueSchedulingRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeQueueScheduling not implemented")
+}
+func (*UnimplementedSubmitServer) CordonCluster(ctx context.Context, req *ClusterCordonRequest) (*ClusterCordonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonCluster not implemented")
+}
+func (*UnimplementedSubmitServer) UncordonCluster(ctx context.Context, req *ClusterUncordonRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonCluster not implemented")
+}
+func (*UnimplementedSubmitServer) GetClusterCordons(ctx context.Context, req *types.Empty) (*ClusterCordonList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterCordons not implemented")
+}
+func (*UnimplementedSubmitServer) CreateSchedule(ctx context.Context, req *JobSchedule) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedSubmitServer) DeleteSchedule(ctx context.Context, req *JobScheduleDeleteRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (*UnimplementedSubmitServer) GetSchedules(ctx context.Context, req *types.Empty) (*JobScheduleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueue(ctx context.Context, req *QueueGetRequest) (*Queue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueueInfo(ctx context.Context, req *QueueInfoRequest) (*QueueInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueInfo not implemented")
+}
+func (*UnimplementedSubmitServer) ListQueues(ctx context.Context, req *QueueListRequest) (*QueueListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueues not implemented")
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
+	s.RegisterService(&_Submit_serviceDesc, srv)
 }
 
-func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LeasedJobs != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
-		i--
-		dAtA[i] = 0x18
+func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.QueuedJobs != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(SubmitServer).SubmitJobs(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SubmitJobs",
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSubmit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return interceptor(ctx, in, info, handler)
 }
-func (m *JobSubmitRequestItem) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Priority != 0 {
-		n += 9
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobs(ctx, in)
 	}
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobs",
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
 	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k, v := range m.RequiredNodeLabels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReprioritizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PodSpecs) > 0 {
-		for _, e := range m.PodSpecs {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, in)
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReprioritizeJobs",
 	}
-	if len(m.Ingress) > 0 {
-		for _, e := range m.Ingress {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, req.(*JobReprioritizeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IngressConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_HoldJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovSubmit(uint64(m.Type))
+	if interceptor == nil {
+		return srv.(SubmitServer).HoldJobs(ctx, in)
 	}
-	if len(m.Ports) > 0 {
-		l = 0
-		for _, e := range m.Ports {
-			l += sovSubmit(uint64(e))
-		}
-		n += 1 + sovSubmit(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/HoldJobs",
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).HoldJobs(ctx, req.(*JobHoldRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_ReleaseJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if interceptor == nil {
+		return srv.(SubmitServer).ReleaseJobs(ctx, in)
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReleaseJobs",
 	}
-	if len(m.JobRequestItems) > 0 {
-		for _, e := range m.JobRequestItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReleaseJobs(ctx, req.(*JobReleaseRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateQueue(ctx, in)
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateQueue",
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.JobIds) > 0 {
-		for _, s := range m.JobIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+func _Submit_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if interceptor == nil {
+		return srv.(SubmitServer).UpdateQueue(ctx, in)
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UpdateQueue",
 	}
-	if m.NewPriority != 0 {
-		n += 9
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UpdateQueue(ctx, req.(*Queue))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ReprioritizationResults) > 0 {
-		for k, v := range m.ReprioritizationResults {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).DeleteQueue(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/DeleteQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitResponseItem) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_PauseQueueScheduling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueSchedulingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if interceptor == nil {
+		return srv.(SubmitServer).PauseQueueScheduling(ctx, in)
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/PauseQueueScheduling",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).PauseQueueScheduling(ctx, req.(*QueueSchedulingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_ResumeQueueScheduling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueSchedulingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for _, e := range m.JobResponseItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).ResumeQueueScheduling(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ResumeQueueScheduling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ResumeQueueScheduling(ctx, req.(*QueueSchedulingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Queue) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_CordonCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterCordonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if interceptor == nil {
+		return srv.(SubmitServer).CordonCluster(ctx, in)
 	}
-	if m.PriorityFactor != 0 {
-		n += 9
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CordonCluster",
 	}
-	if len(m.UserOwners) > 0 {
-		for _, s := range m.UserOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.GroupOwners) > 0 {
-		for _, s := range m.GroupOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.ResourceLimits) > 0 {
-		for k, v := range m.ResourceLimits {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CordonCluster(ctx, req.(*ClusterCordonRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CancellationResult) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_UncordonCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterUncordonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CancelledIds) > 0 {
-		for _, s := range m.CancelledIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).UncordonCluster(ctx, in)
 	}
-	return n
-}
-
-func (m *QueueGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UncordonCluster",
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UncordonCluster(ctx, req.(*ClusterUncordonRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueueInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_GetClusterCordons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if interceptor == nil {
+		return srv.(SubmitServer).GetClusterCordons(ctx, in)
 	}
-	return n
-}
-
-func (m *QueueDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetClusterCordons",
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetClusterCordons(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueueInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateSchedule(ctx, in)
 	}
-	if len(m.ActiveJobSets) > 0 {
-		for _, e := range m.ActiveJobSets {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateSchedule",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateSchedule(ctx, req.(*JobSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func _Submit_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobScheduleDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if interceptor == nil {
+		return srv.(SubmitServer).DeleteSchedule(ctx, in)
 	}
-	if m.QueuedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.QueuedJobs))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/DeleteSchedule",
 	}
-	if m.LeasedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).DeleteSchedule(ctx, req.(*JobScheduleDeleteRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovSubmit(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSubmit(x uint64) (n int) {
-	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *JobSubmitRequestItem) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForPodSpecs := "[]*PodSpec{"
-	for _, f := range this.PodSpecs {
-		repeatedStringForPodSpecs += strings.Replace(fmt.Sprintf("%v", f), "PodSpec", "v1.PodSpec", 1) + ","
+func _Submit_GetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	repeatedStringForPodSpecs += "}"
-	repeatedStringForIngress := "[]*IngressConfig{"
-	for _, f := range this.Ingress {
-		repeatedStringForIngress += strings.Replace(f.String(), "IngressConfig", "IngressConfig", 1) + ","
+	if interceptor == nil {
+		return srv.(SubmitServer).GetSchedules(ctx, in)
 	}
-	repeatedStringForIngress += "}"
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k, _ := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetSchedules",
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetSchedules(ctx, req.(*types.Empty))
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k, _ := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueue(ctx, in)
 	}
-	mapStringForAnnotations += "}"
-	keysForRequiredNodeLabels := make([]string, 0, len(this.RequiredNodeLabels))
-	for k, _ := range this.RequiredNodeLabels {
-		keysForRequiredNodeLabels = append(keysForRequiredNodeLabels, k)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueue",
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredNodeLabels)
-	mapStringForRequiredNodeLabels := "map[string]string{"
-	for _, k := range keysForRequiredNodeLabels {
-		mapStringForRequiredNodeLabels += fmt.Sprintf("%v: %v,", k, this.RequiredNodeLabels[k])
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueue(ctx, req.(*QueueGetRequest))
 	}
-	mapStringForRequiredNodeLabels += "}"
-	s := strings.Join([]string{`&JobSubmitRequestItem{`,
-		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
-		`PodSpec:` + strings.Replace(fmt.Sprintf("%v", this.PodSpec), "PodSpec", "v1.PodSpec", 1) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`RequiredNodeLabels:` + mapStringForRequiredNodeLabels + `,`,
-		`PodSpecs:` + repeatedStringForPodSpecs + `,`,
-		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
-		`Ingress:` + repeatedStringForIngress + `,`,
-		`}`,
-	}, "")
-	return s
+	return interceptor(ctx, in, info, handler)
 }
-func (this *IngressConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k, _ := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueueInfo(ctx, in)
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueueInfo",
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&IngressConfig{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Ports:` + fmt.Sprintf("%v", this.Ports) + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *JobSubmitRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func _Submit_ListQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	repeatedStringForJobRequestItems := "[]*JobSubmitRequestItem{"
-	for _, f := range this.JobRequestItems {
-		repeatedStringForJobRequestItems += strings.Replace(f.String(), "JobSubmitRequestItem", "JobSubmitRequestItem", 1) + ","
+	if interceptor == nil {
+		return srv.(SubmitServer).ListQueues(ctx, in)
 	}
-	repeatedStringForJobRequestItems += "}"
-	s := strings.Join([]string{`&JobSubmitRequest{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`JobRequestItems:` + repeatedStringForJobRequestItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobCancelRequest) String() string {
-	if this == nil {
-		return "nil"
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ListQueues",
 	}
-	s := strings.Join([]string{`&JobCancelRequest{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobReprioritizeRequest) String() string {
-	if this == nil {
-		return "nil"
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ListQueues(ctx, req.(*QueueListRequest))
 	}
-	s := strings.Join([]string{`&JobReprioritizeRequest{`,
-		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
-		`}`,
-	}, "")
-	return s
+	return interceptor(ctx, in, info, handler)
 }
-func (this *JobReprioritizeResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForReprioritizationResults := make([]string, 0, len(this.ReprioritizationResults))
-	for k, _ := range this.ReprioritizationResults {
-		keysForReprioritizationResults = append(keysForReprioritizationResults, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForReprioritizationResults)
-	mapStringForReprioritizationResults := "map[string]string{"
-	for _, k := range keysForReprioritizationResults {
-		mapStringForReprioritizationResults += fmt.Sprintf("%v: %v,", k, this.ReprioritizationResults[k])
-	}
-	mapStringForReprioritizationResults += "}"
-	s := strings.Join([]string{`&JobReprioritizeResponse{`,
-		`ReprioritizationResults:` + mapStringForReprioritizationResults + `,`,
-		`}`,
-	}, "")
-	return s
+
+var _Submit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Submit",
+	HandlerType: (*SubmitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitJobs",
+			Handler:    _Submit_SubmitJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobs",
+			Handler:    _Submit_CancelJobs_Handler,
+		},
+		{
+			MethodName: "ReprioritizeJobs",
+			Handler:    _Submit_ReprioritizeJobs_Handler,
+		},
+		{
+			MethodName: "HoldJobs",
+			Handler:    _Submit_HoldJobs_Handler,
+		},
+		{
+			MethodName: "ReleaseJobs",
+			Handler:    _Submit_ReleaseJobs_Handler,
+		},
+		{
+			MethodName: "CreateQueue",
+			Handler:    _Submit_CreateQueue_Handler,
+		},
+		{
+			MethodName: "UpdateQueue",
+			Handler:    _Submit_UpdateQueue_Handler,
+		},
+		{
+			MethodName: "DeleteQueue",
+			Handler:    _Submit_DeleteQueue_Handler,
+		},
+		{
+			MethodName: "PauseQueueScheduling",
+			Handler:    _Submit_PauseQueueScheduling_Handler,
+		},
+		{
+			MethodName: "ResumeQueueScheduling",
+			Handler:    _Submit_ResumeQueueScheduling_Handler,
+		},
+		{
+			MethodName: "CordonCluster",
+			Handler:    _Submit_CordonCluster_Handler,
+		},
+		{
+			MethodName: "UncordonCluster",
+			Handler:    _Submit_UncordonCluster_Handler,
+		},
+		{
+			MethodName: "GetClusterCordons",
+			Handler:    _Submit_GetClusterCordons_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Submit_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _Submit_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "GetSchedules",
+			Handler:    _Submit_GetSchedules_Handler,
+		},
+		{
+			MethodName: "GetQueue",
+			Handler:    _Submit_GetQueue_Handler,
+		},
+		{
+			MethodName: "GetQueueInfo",
+			Handler:    _Submit_GetQueueInfo_Handler,
+		},
+		{
+			MethodName: "ListQueues",
+			Handler:    _Submit_ListQueues_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/submit.proto",
 }
-func (this *JobSubmitResponseItem) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&JobSubmitResponseItem{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *JobSubmitResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForJobResponseItems := "[]*JobSubmitResponseItem{"
-	for _, f := range this.JobResponseItems {
-		repeatedStringForJobResponseItems += strings.Replace(f.String(), "JobSubmitResponseItem", "JobSubmitResponseItem", 1) + ","
-	}
-	repeatedStringForJobResponseItems += "}"
-	s := strings.Join([]string{`&JobSubmitResponse{`,
-		`JobResponseItems:` + repeatedStringForJobResponseItems + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Queue) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForResourceLimits := make([]string, 0, len(this.ResourceLimits))
-	for k, _ := range this.ResourceLimits {
-		keysForResourceLimits = append(keysForResourceLimits, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceLimits)
-	mapStringForResourceLimits := "map[string]float64{"
-	for _, k := range keysForResourceLimits {
-		mapStringForResourceLimits += fmt.Sprintf("%v: %v,", k, this.ResourceLimits[k])
+
+func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedClusters) > 0 {
+		for iNdEx := len(m.AllowedClusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedClusters[iNdEx])
+			copy(dAtA[i:], m.AllowedClusters[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.AllowedClusters[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	mapStringForResourceLimits += "}"
-	s := strings.Join([]string{`&Queue{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`PriorityFactor:` + fmt.Sprintf("%v", this.PriorityFactor) + `,`,
-		`UserOwners:` + fmt.Sprintf("%v", this.UserOwners) + `,`,
-		`GroupOwners:` + fmt.Sprintf("%v", this.GroupOwners) + `,`,
-		`ResourceLimits:` + mapStringForResourceLimits + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CancellationResult) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.AllowedPools) > 0 {
+		for iNdEx := len(m.AllowedPools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPools[iNdEx])
+			copy(dAtA[i:], m.AllowedPools[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.AllowedPools[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	s := strings.Join([]string{`&CancellationResult{`,
-		`CancelledIds:` + fmt.Sprintf("%v", this.CancelledIds) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueGetRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.NotBefore != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintSubmit(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
 	}
-	s := strings.Join([]string{`&QueueGetRequest{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueInfoRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.PeerDiscovery {
+		i--
+		if m.PeerDiscovery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	s := strings.Join([]string{`&QueueInfoRequest{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueDeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Ingress) > 0 {
+		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ingress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	s := strings.Join([]string{`&QueueDeleteRequest{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueueInfo) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x42
 	}
-	repeatedStringForActiveJobSets := "[]*JobSetInfo{"
-	for _, f := range this.ActiveJobSets {
-		repeatedStringForActiveJobSets += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	if len(m.PodSpecs) > 0 {
+		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	repeatedStringForActiveJobSets += "}"
-	s := strings.Join([]string{`&QueueInfo{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ActiveJobSets:` + repeatedStringForActiveJobSets + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobSetInfo) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.RequiredNodeLabels) > 0 {
+		for k := range m.RequiredNodeLabels {
+			v := m.RequiredNodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Priority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IngressConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngressConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngressConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ports) > 0 {
+		dAtA4 := make([]byte, len(m.Ports)*10)
+		var j3 int
+		for _, num := range m.Ports {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSubmit(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRunning != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxRunning))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.JobRequestItems) > 0 {
+		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReprioritizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReprioritizationResults) > 0 {
+		for k := range m.ReprioritizationResults {
+			v := m.ReprioritizationResults[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobHoldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobHoldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobHoldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobHoldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobHoldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobHoldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HoldResults) > 0 {
+		for k := range m.HoldResults {
+			v := m.HoldResults[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReleaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReleaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReleaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleaseResults) > 0 {
+		for k := range m.ReleaseResults {
+			v := m.ReleaseResults[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobResponseItems) > 0 {
+		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Queue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxOvercommitRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxOvercommitRatio))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.MaxRunning != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxRunning))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PriorityAgingCap != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityAgingCap))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.PriorityAgingRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityAgingRate))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.SchedulingPaused {
+		i--
+		if m.SchedulingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k := range m.ResourceLimits {
+			v := m.ResourceLimits[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupOwners[iNdEx])
+			copy(dAtA[i:], m.GroupOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserOwners) > 0 {
+		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UserOwners[iNdEx])
+			copy(dAtA[i:], m.UserOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PriorityFactor != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelledIds[iNdEx])
+			copy(dAtA[i:], m.CancelledIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSchedulingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSchedulingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSchedulingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterCordon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCordon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCordon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSubmit(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterCordonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCordonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCordonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Drain {
+		i--
+		if m.Drain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterCordonResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCordonResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCordonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DrainedJobIds) > 0 {
+		for iNdEx := len(m.DrainedJobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DrainedJobIds[iNdEx])
+			copy(dAtA[i:], m.DrainedJobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.DrainedJobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterUncordonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterUncordonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterUncordonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterCordonList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCordonList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCordonList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cordons) > 0 {
+		for iNdEx := len(m.Cordons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cordons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerGroups) > 0 {
+		for iNdEx := len(m.OwnerGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OwnerGroups[iNdEx])
+			copy(dAtA[i:], m.OwnerGroups[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.OwnerGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSubmit(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.OwnershipGroups) > 0 {
+		for iNdEx := len(m.OwnershipGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OwnershipGroups[iNdEx])
+			copy(dAtA[i:], m.OwnershipGroups[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.OwnershipGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ConcurrencyPolicy != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.ConcurrencyPolicy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobScheduleDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobScheduleDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobScheduleDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobScheduleList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobScheduleList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobScheduleList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActiveJobSets) > 0 {
+		for iNdEx := len(m.ActiveJobSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveJobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeldJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.HeldJobs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeasedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QueuedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeStatus {
+		i--
+		if m.IncludeStatus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Take != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Take))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NamePrefix) > 0 {
+		i -= len(m.NamePrefix)
+		copy(dAtA[i:], m.NamePrefix)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.NamePrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueListItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueListItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueListItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Queue != nil {
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActiveJobSets) > 0 {
+		for iNdEx := len(m.ActiveJobSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveJobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Usage) > 0 {
+		for k := range m.Usage {
+			v := m.Usage[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Priority) > 0 {
+		for k := range m.Priority {
+			v := m.Priority[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LeasedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueuedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubmit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSubmitRequestItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 9
+	}
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k, v := range m.RequiredNodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PodSpecs) > 0 {
+		for _, e := range m.PodSpecs {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Ingress) > 0 {
+		for _, e := range m.Ingress {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.PeerDiscovery {
+		n += 2
+	}
+	if m.NotBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.AllowedPools) > 0 {
+		for _, s := range m.AllowedPools {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.AllowedClusters) > 0 {
+		for _, s := range m.AllowedClusters {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IngressConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSubmit(uint64(m.Type))
+	}
+	if len(m.Ports) > 0 {
+		l = 0
+		for _, e := range m.Ports {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.JobRequestItems) > 0 {
+		for _, e := range m.JobRequestItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.MaxRunning != 0 {
+		n += 1 + sovSubmit(uint64(m.MaxRunning))
+	}
+	return n
+}
+
+func (m *JobCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReprioritizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *JobReprioritizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReprioritizationResults) > 0 {
+		for k, v := range m.ReprioritizationResults {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobHoldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobHoldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HoldResults) > 0 {
+		for k, v := range m.HoldResults {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobReleaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReleaseResults) > 0 {
+		for k, v := range m.ReleaseResults {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobSubmitResponseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobResponseItems) > 0 {
+		for _, e := range m.JobResponseItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Queue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.PriorityFactor != 0 {
+		n += 9
+	}
+	if len(m.UserOwners) > 0 {
+		for _, s := range m.UserOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for _, s := range m.GroupOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k, v := range m.ResourceLimits {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.SchedulingPaused {
+		n += 2
+	}
+	if m.PriorityAgingRate != 0 {
+		n += 9
+	}
+	if m.PriorityAgingCap != 0 {
+		n += 9
+	}
+	if m.MaxRunning != 0 {
+		n += 1 + sovSubmit(uint64(m.MaxRunning))
+	}
+	if m.MaxOvercommitRatio != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *CancellationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for _, s := range m.CancelledIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueSchedulingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *ClusterCordon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovSubmit(uint64(l))
+	return n
+}
+
+func (m *ClusterCordonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Drain {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClusterCordonResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DrainedJobIds) > 0 {
+		for _, s := range m.DrainedJobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterUncordonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *ClusterCordonList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cordons) > 0 {
+		for _, e := range m.Cordons {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.ConcurrencyPolicy != 0 {
+		n += 1 + sovSubmit(uint64(m.ConcurrencyPolicy))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.OwnershipGroups) > 0 {
+		for _, s := range m.OwnershipGroups {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovSubmit(uint64(l))
+	if len(m.OwnerGroups) > 0 {
+		for _, s := range m.OwnerGroups {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobScheduleDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobScheduleList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ActiveJobSets) > 0 {
+		for _, e := range m.ActiveJobSets {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.QueuedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.QueuedJobs))
+	}
+	if m.LeasedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	}
+	if m.HeldJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.HeldJobs))
+	}
+	return n
+}
+
+func (m *QueueListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Take != 0 {
+		n += 1 + sovSubmit(uint64(m.Take))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.IncludeStatus {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueueListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueListItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueuedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.QueuedJobs))
+	}
+	if m.LeasedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	}
+	if len(m.Priority) > 0 {
+		for k, v := range m.Priority {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Usage) > 0 {
+		for k, v := range m.Usage {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ActiveJobSets) > 0 {
+		for _, e := range m.ActiveJobSets {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSubmit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubmit(x uint64) (n int) {
+	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *JobSubmitRequestItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPodSpecs := "[]*PodSpec{"
+	for _, f := range this.PodSpecs {
+		repeatedStringForPodSpecs += strings.Replace(fmt.Sprintf("%v", f), "PodSpec", "v1.PodSpec", 1) + ","
+	}
+	repeatedStringForPodSpecs += "}"
+	repeatedStringForIngress := "[]*IngressConfig{"
+	for _, f := range this.Ingress {
+		repeatedStringForIngress += strings.Replace(f.String(), "IngressConfig", "IngressConfig", 1) + ","
+	}
+	repeatedStringForIngress += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k, _ := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	keysForRequiredNodeLabels := make([]string, 0, len(this.RequiredNodeLabels))
+	for k, _ := range this.RequiredNodeLabels {
+		keysForRequiredNodeLabels = append(keysForRequiredNodeLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredNodeLabels)
+	mapStringForRequiredNodeLabels := "map[string]string{"
+	for _, k := range keysForRequiredNodeLabels {
+		mapStringForRequiredNodeLabels += fmt.Sprintf("%v: %v,", k, this.RequiredNodeLabels[k])
+	}
+	mapStringForRequiredNodeLabels += "}"
+	s := strings.Join([]string{`&JobSubmitRequestItem{`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`PodSpec:` + strings.Replace(fmt.Sprintf("%v", this.PodSpec), "PodSpec", "v1.PodSpec", 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`RequiredNodeLabels:` + mapStringForRequiredNodeLabels + `,`,
+		`PodSpecs:` + repeatedStringForPodSpecs + `,`,
+		`ClientId:` + fmt.Sprintf("%v", this.ClientId) + `,`,
+		`Ingress:` + repeatedStringForIngress + `,`,
+		`PeerDiscovery:` + fmt.Sprintf("%v", this.PeerDiscovery) + `,`,
+		`NotBefore:` + strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedPools:` + fmt.Sprintf("%v", this.AllowedPools) + `,`,
+		`AllowedClusters:` + fmt.Sprintf("%v", this.AllowedClusters) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IngressConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k, _ := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&IngressConfig{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Ports:` + fmt.Sprintf("%v", this.Ports) + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobRequestItems := "[]*JobSubmitRequestItem{"
+	for _, f := range this.JobRequestItems {
+		repeatedStringForJobRequestItems += strings.Replace(f.String(), "JobSubmitRequestItem", "JobSubmitRequestItem", 1) + ","
+	}
+	repeatedStringForJobRequestItems += "}"
+	s := strings.Join([]string{`&JobSubmitRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`JobRequestItems:` + repeatedStringForJobRequestItems + `,`,
+		`MaxRunning:` + fmt.Sprintf("%v", this.MaxRunning) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReprioritizeRequest{`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForReprioritizationResults := make([]string, 0, len(this.ReprioritizationResults))
+	for k, _ := range this.ReprioritizationResults {
+		keysForReprioritizationResults = append(keysForReprioritizationResults, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForReprioritizationResults)
+	mapStringForReprioritizationResults := "map[string]string{"
+	for _, k := range keysForReprioritizationResults {
+		mapStringForReprioritizationResults += fmt.Sprintf("%v: %v,", k, this.ReprioritizationResults[k])
+	}
+	mapStringForReprioritizationResults += "}"
+	s := strings.Join([]string{`&JobReprioritizeResponse{`,
+		`ReprioritizationResults:` + mapStringForReprioritizationResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobHoldRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&JobHoldRequest{`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobHoldResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHoldResults := make([]string, 0, len(this.HoldResults))
+	for k, _ := range this.HoldResults {
+		keysForHoldResults = append(keysForHoldResults, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHoldResults)
+	mapStringForHoldResults := "map[string]string{"
+	for _, k := range keysForHoldResults {
+		mapStringForHoldResults += fmt.Sprintf("%v: %v,", k, this.HoldResults[k])
+	}
+	mapStringForHoldResults += "}"
+	s := strings.Join([]string{`&JobHoldResponse{`,
+		`HoldResults:` + mapStringForHoldResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReleaseRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&JobReleaseRequest{`,
+		`JobIds:` + fmt.Sprintf("%v", this.JobIds) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReleaseResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForReleaseResults := make([]string, 0, len(this.ReleaseResults))
+	for k, _ := range this.ReleaseResults {
+		keysForReleaseResults = append(keysForReleaseResults, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForReleaseResults)
+	mapStringForReleaseResults := "map[string]string{"
+	for _, k := range keysForReleaseResults {
+		mapStringForReleaseResults += fmt.Sprintf("%v: %v,", k, this.ReleaseResults[k])
+	}
+	mapStringForReleaseResults += "}"
+	s := strings.Join([]string{`&JobReleaseResponse{`,
+		`ReleaseResults:` + mapStringForReleaseResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitResponseItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSubmitResponseItem{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSubmitResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobResponseItems := "[]*JobSubmitResponseItem{"
+	for _, f := range this.JobResponseItems {
+		repeatedStringForJobResponseItems += strings.Replace(f.String(), "JobSubmitResponseItem", "JobSubmitResponseItem", 1) + ","
+	}
+	repeatedStringForJobResponseItems += "}"
+	s := strings.Join([]string{`&JobSubmitResponse{`,
+		`JobResponseItems:` + repeatedStringForJobResponseItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Queue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForResourceLimits := make([]string, 0, len(this.ResourceLimits))
+	for k, _ := range this.ResourceLimits {
+		keysForResourceLimits = append(keysForResourceLimits, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourceLimits)
+	mapStringForResourceLimits := "map[string]float64{"
+	for _, k := range keysForResourceLimits {
+		mapStringForResourceLimits += fmt.Sprintf("%v: %v,", k, this.ResourceLimits[k])
+	}
+	mapStringForResourceLimits += "}"
+	s := strings.Join([]string{`&Queue{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`PriorityFactor:` + fmt.Sprintf("%v", this.PriorityFactor) + `,`,
+		`UserOwners:` + fmt.Sprintf("%v", this.UserOwners) + `,`,
+		`GroupOwners:` + fmt.Sprintf("%v", this.GroupOwners) + `,`,
+		`ResourceLimits:` + mapStringForResourceLimits + `,`,
+		`SchedulingPaused:` + fmt.Sprintf("%v", this.SchedulingPaused) + `,`,
+		`PriorityAgingRate:` + fmt.Sprintf("%v", this.PriorityAgingRate) + `,`,
+		`PriorityAgingCap:` + fmt.Sprintf("%v", this.PriorityAgingCap) + `,`,
+		`MaxRunning:` + fmt.Sprintf("%v", this.MaxRunning) + `,`,
+		`MaxOvercommitRatio:` + fmt.Sprintf("%v", this.MaxOvercommitRatio) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancellationResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancellationResult{`,
+		`CancelledIds:` + fmt.Sprintf("%v", this.CancelledIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueGetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueGetRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueInfoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueInfoRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueSchedulingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueSchedulingRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterCordon) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterCordon{`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterCordonRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterCordonRequest{`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Drain:` + fmt.Sprintf("%v", this.Drain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterCordonResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterCordonResponse{`,
+		`DrainedJobIds:` + fmt.Sprintf("%v", this.DrainedJobIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterUncordonRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterUncordonRequest{`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterCordonList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCordons := "[]*ClusterCordon{"
+	for _, f := range this.Cordons {
+		repeatedStringForCordons += strings.Replace(f.String(), "ClusterCordon", "ClusterCordon", 1) + ","
+	}
+	repeatedStringForCordons += "}"
+	s := strings.Join([]string{`&ClusterCordonList{`,
+		`Cordons:` + repeatedStringForCordons + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSchedule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Cron:` + fmt.Sprintf("%v", this.Cron) + `,`,
+		`Template:` + strings.Replace(this.Template.String(), "JobSubmitRequest", "JobSubmitRequest", 1) + `,`,
+		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`OwnershipGroups:` + fmt.Sprintf("%v", this.OwnershipGroups) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`OwnerGroups:` + fmt.Sprintf("%v", this.OwnerGroups) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobScheduleDeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobScheduleDeleteRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobScheduleList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSchedules := "[]*JobSchedule{"
+	for _, f := range this.Schedules {
+		repeatedStringForSchedules += strings.Replace(f.String(), "JobSchedule", "JobSchedule", 1) + ","
+	}
+	repeatedStringForSchedules += "}"
+	s := strings.Join([]string{`&JobScheduleList{`,
+		`Schedules:` + repeatedStringForSchedules + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueDeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueDeleteRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActiveJobSets := "[]*JobSetInfo{"
+	for _, f := range this.ActiveJobSets {
+		repeatedStringForActiveJobSets += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	}
+	repeatedStringForActiveJobSets += "}"
+	s := strings.Join([]string{`&QueueInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ActiveJobSets:` + repeatedStringForActiveJobSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`QueuedJobs:` + fmt.Sprintf("%v", this.QueuedJobs) + `,`,
+		`LeasedJobs:` + fmt.Sprintf("%v", this.LeasedJobs) + `,`,
+		`HeldJobs:` + fmt.Sprintf("%v", this.HeldJobs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueListRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueListRequest{`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`NamePrefix:` + fmt.Sprintf("%v", this.NamePrefix) + `,`,
+		`Take:` + fmt.Sprintf("%v", this.Take) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`IncludeStatus:` + fmt.Sprintf("%v", this.IncludeStatus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueListResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*QueueListItem{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(f.String(), "QueueListItem", "QueueListItem", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&QueueListResponse{`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`NextCursor:` + fmt.Sprintf("%v", this.NextCursor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueListItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueListItem{`,
+		`Queue:` + strings.Replace(this.Queue.String(), "Queue", "Queue", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "QueueStatus", "QueueStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActiveJobSets := "[]*JobSetInfo{"
+	for _, f := range this.ActiveJobSets {
+		repeatedStringForActiveJobSets += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	}
+	repeatedStringForActiveJobSets += "}"
+	keysForPriority := make([]string, 0, len(this.Priority))
+	for k, _ := range this.Priority {
+		keysForPriority = append(keysForPriority, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPriority)
+	mapStringForPriority := "map[string]float64{"
+	for _, k := range keysForPriority {
+		mapStringForPriority += fmt.Sprintf("%v: %v,", k, this.Priority[k])
+	}
+	mapStringForPriority += "}"
+	keysForUsage := make([]string, 0, len(this.Usage))
+	for k, _ := range this.Usage {
+		keysForUsage = append(keysForUsage, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsage)
+	mapStringForUsage := "map[string]resource.Quantity{"
+	for _, k := range keysForUsage {
+		mapStringForUsage += fmt.Sprintf("%v: %v,", k, this.Usage[k])
+	}
+	mapStringForUsage += "}"
+	s := strings.Join([]string{`&QueueStatus{`,
+		`QueuedJobs:` + fmt.Sprintf("%v", this.QueuedJobs) + `,`,
+		`LeasedJobs:` + fmt.Sprintf("%v", this.LeasedJobs) + `,`,
+		`Priority:` + mapStringForPriority + `,`,
+		`Usage:` + mapStringForUsage + `,`,
+		`ActiveJobSets:` + repeatedStringForActiveJobSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSubmit(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Priority = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v1.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredNodeLabels == nil {
+				m.RequiredNodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredNodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
+			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, &IngressConfig{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerDiscovery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PeerDiscovery = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPools = append(m.AllowedPools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedClusters = append(m.AllowedClusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngressConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngressConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngressConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= IngressType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
+			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRunning", wireType)
+			}
+			m.MaxRunning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRunning |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritizationResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReprioritizationResults == nil {
+				m.ReprioritizationResults = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ReprioritizationResults[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobHoldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobHoldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobHoldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobHoldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobHoldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobHoldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HoldResults == nil {
+				m.HoldResults = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HoldResults[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReleaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReleaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReleaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleaseResults == nil {
+				m.ReleaseResults = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ReleaseResults[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&JobSetInfo{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`QueuedJobs:` + fmt.Sprintf("%v", this.QueuedJobs) + `,`,
-		`LeasedJobs:` + fmt.Sprintf("%v", this.LeasedJobs) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func valueToStringSubmit(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
+			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+func (m *Queue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2750,15 +8809,47 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFactor", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -2766,12 +8857,12 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Priority = float64(math.Float64frombits(v))
-		case 2:
+			m.PriorityFactor = float64(math.Float64frombits(v))
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOwners", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -2781,31 +8872,27 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodSpec == nil {
-				m.PodSpec = &v1.PodSpec{}
-			}
-			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UserOwners = append(m.UserOwners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2833,11 +8920,11 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.GroupOwners = append(m.GroupOwners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2864,11 +8951,11 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = make(map[string]float64)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue float64
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2917,34 +9004,13 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -2960,13 +9026,13 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.ResourceLimits[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingPaused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -2976,124 +9042,119 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
+			m.SchedulingPaused = bool(v != 0)
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityAgingRate", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PriorityAgingRate = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityAgingCap", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PriorityAgingCap = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRunning", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.MaxRunning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRunning |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 6:
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOvercommitRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxOvercommitRatio = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3103,124 +9164,79 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredNodeLabels == nil {
-				m.RequiredNodeLabels = make(map[string]string)
+			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			m.RequiredNodeLabels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 7:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueGetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueGetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3230,29 +9246,77 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
-			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3280,13 +9344,63 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueSchedulingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueSchedulingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueSchedulingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3296,25 +9410,23 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, &IngressConfig{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3337,7 +9449,7 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IngressConfig) Unmarshal(dAtA []byte) error {
+func (m *ClusterCordon) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3360,17 +9472,17 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IngressConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCordon: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IngressConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCordon: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3380,90 +9492,123 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= IngressType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
 				}
-				m.Ports = append(m.Ports, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSubmit
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthSubmit
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ports) == 0 {
-					m.Ports = make([]uint32, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ports = append(m.Ports, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3490,103 +9635,9 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3609,7 +9660,7 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterCordonRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3632,15 +9683,15 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCordonRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCordonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3668,11 +9719,11 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3700,13 +9751,13 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3716,25 +9767,125 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
-			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drain = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCordonResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCordonResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCordonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainedJobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrainedJobIds = append(m.DrainedJobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3757,7 +9908,7 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterUncordonRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3780,15 +9931,15 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterUncordonRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterUncordonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3816,11 +9967,11 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3848,13 +9999,63 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCordonList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCordonList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCordonList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordons", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3864,23 +10065,25 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Cordons = append(m.Cordons, &ClusterCordon{})
+			if err := m.Cordons[len(m.Cordons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3903,7 +10106,7 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
+func (m *JobSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3926,15 +10129,15 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3962,11 +10165,11 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3994,13 +10197,13 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.Cron = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -4010,90 +10213,84 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			if m.Template == nil {
+				m.Template = &JobSubmitRequest{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.ConcurrencyPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcurrencyPolicy |= ConcurrencyPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritizationResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipGroups", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -4103,118 +10300,88 @@ func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReprioritizationResults == nil {
-				m.ReprioritizationResults = make(map[string]string)
+			m.OwnershipGroups = append(m.OwnershipGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ReprioritizationResults[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerGroups = append(m.OwnerGroups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4237,7 +10404,7 @@ func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
+func (m *JobScheduleDeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4260,47 +10427,15 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobScheduleDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobScheduleDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4328,7 +10463,7 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4351,7 +10486,7 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
+func (m *JobScheduleList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4374,15 +10509,15 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobScheduleList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobScheduleList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4409,8 +10544,8 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
-			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, &JobSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4435,7 +10570,7 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Queue) Unmarshal(dAtA []byte) error {
+func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4458,10 +10593,10 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4496,52 +10631,59 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFactor", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.PriorityFactor = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserOwners", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserOwners = append(m.UserOwners, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4569,11 +10711,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwners = append(m.GroupOwners, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveJobSets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4600,82 +10742,10 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = make(map[string]float64)
-			}
-			var mapkey string
-			var mapvalue float64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					mapvalue = math.Float64frombits(mapvaluetemp)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.ActiveJobSets = append(m.ActiveJobSets, &JobSetInfo{})
+			if err := m.ActiveJobSets[len(m.ActiveJobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ResourceLimits[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4698,7 +10768,7 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancellationResult) Unmarshal(dAtA []byte) error {
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4721,15 +10791,15 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4757,8 +10827,65 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJobs", wireType)
+			}
+			m.QueuedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasedJobs", wireType)
+			}
+			m.LeasedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldJobs", wireType)
+			}
+			m.HeldJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeldJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -4780,7 +10907,7 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueGetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueueListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4803,15 +10930,15 @@ func (m *QueueGetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueGetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4839,61 +10966,94 @@ func (m *QueueGetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Take", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Take = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Take |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4921,8 +11081,28 @@ func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeStatus = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -4944,7 +11124,7 @@ func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueueListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4967,15 +11147,49 @@ func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &QueueListItem{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5003,7 +11217,7 @@ func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5026,7 +11240,7 @@ func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+func (m *QueueListItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5049,17 +11263,17 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueListItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueListItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5069,27 +11283,31 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Queue == nil {
+				m.Queue = &Queue{}
+			}
+			if err := m.Queue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveJobSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5116,8 +11334,10 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveJobSets = append(m.ActiveJobSets, &JobSetInfo{})
-			if err := m.ActiveJobSets[len(m.ActiveJobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Status == nil {
+				m.Status = &QueueStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5142,7 +11362,7 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+func (m *QueueStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5165,17 +11385,55 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJobs", wireType)
+			}
+			m.QueuedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedJobs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasedJobs", wireType)
+			}
+			m.LeasedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasedJobs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5185,29 +11443,103 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Priority == nil {
+				m.Priority = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Priority[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJobs", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
-			m.QueuedJobs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5217,16 +11549,126 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueuedJobs |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeasedJobs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			m.LeasedJobs = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Usage[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveJobSets", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -5236,11 +11678,26 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeasedJobs |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveJobSets = append(m.ActiveJobSets, &JobSetInfo{})
+			if err := m.ActiveJobSets[len(m.ActiveJobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -42,6 +42,7 @@ type QueueReport struct {
 	Resources          map[string]resource.Quantity `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ResourcesUsed      map[string]resource.Quantity `protobuf:"bytes,3,rep,name=resources_used,json=resourcesUsed,proto3" json:"resourcesUsed,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	CountOfPodsByPhase map[string]uint32            `protobuf:"bytes,4,rep,name=count_of_pods_by_phase,json=countOfPodsByPhase,proto3" json:"countOfPodsByPhase,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ResourcesLimit     map[string]resource.Quantity `protobuf:"bytes,5,rep,name=resources_limit,json=resourcesLimit,proto3" json:"resourcesLimit,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *QueueReport) Reset()      { *m = QueueReport{} }
@@ -104,6 +105,13 @@ func (m *QueueReport) GetCountOfPodsByPhase() map[string]uint32 {
 	return nil
 }
 
+func (m *QueueReport) GetResourcesLimit() map[string]resource.Quantity {
+	if m != nil {
+		return m.ResourcesLimit
+	}
+	return nil
+}
+
 type ClusterUsageReport struct {
 	ClusterId                string                       `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
 	Pool                     string                       `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
@@ -320,6 +328,7 @@ func init() {
 	proto.RegisterType((*QueueReport)(nil), "api.QueueReport")
 	proto.RegisterMapType((map[string]uint32)(nil), "api.QueueReport.CountOfPodsByPhaseEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueReport.ResourcesEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueReport.ResourcesLimitEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueReport.ResourcesUsedEntry")
 	proto.RegisterType((*ClusterUsageReport)(nil), "api.ClusterUsageReport")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterUsageReport.ClusterAvailableCapacityEntry")
@@ -333,58 +342,60 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/usage.proto", fileDescriptor_5643ccb387d55d48) }
 
 var fileDescriptor_5643ccb387d55d48 = []byte{
-	// 806 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xd3, 0x34, 0x34, 0x2f, 0x6a, 0x29, 0xb3, 0x55, 0x63, 0x8c, 0xd6, 0x89, 0x82, 0x84,
-	0x72, 0x58, 0xc6, 0xda, 0xb0, 0x88, 0x15, 0x07, 0x24, 0x12, 0x22, 0xd8, 0x0b, 0x74, 0xad, 0xf6,
-	0x06, 0xb2, 0x26, 0xf6, 0xc4, 0xb5, 0x92, 0x78, 0x06, 0x7b, 0x1c, 0xc9, 0xda, 0x0b, 0x47, 0x8e,
-	0xfb, 0x0f, 0x71, 0xdf, 0xe3, 0x1e, 0xf7, 0xc4, 0x8f, 0xf4, 0x1f, 0x41, 0x9e, 0xb1, 0x13, 0x67,
-	0x9d, 0xc0, 0xa9, 0xbd, 0x79, 0x66, 0xbe, 0xf7, 0x7d, 0x6f, 0xde, 0xfb, 0xde, 0xc8, 0xf0, 0x88,
-	0xcf, 0x7d, 0x8b, 0xf0, 0xc0, 0x4a, 0x62, 0xe2, 0x53, 0xcc, 0x23, 0x26, 0x18, 0x3a, 0x22, 0x3c,
-	0x30, 0xba, 0x3e, 0x63, 0xfe, 0x82, 0x5a, 0x72, 0x6b, 0x9a, 0xcc, 0x2c, 0x11, 0x2c, 0x69, 0x2c,
-	0xc8, 0x92, 0x2b, 0x94, 0xf1, 0xc9, 0xfb, 0x00, 0xba, 0xe4, 0x22, 0xcd, 0x0f, 0xfb, 0xf3, 0xe7,
-	0x31, 0x0e, 0x98, 0xa4, 0x76, 0x59, 0x44, 0xad, 0xd5, 0x53, 0xcb, 0xa7, 0x21, 0x8d, 0x88, 0xa0,
-	0x5e, 0x8e, 0x79, 0xb6, 0xc5, 0x2c, 0x89, 0x7b, 0x1b, 0x84, 0x34, 0x4a, 0xad, 0x22, 0x9f, 0x88,
-	0xc6, 0x2c, 0x89, 0x5c, 0x5a, 0x89, 0xfa, 0xdc, 0x0f, 0xc4, 0x6d, 0x32, 0xc5, 0x2e, 0x5b, 0x5a,
-	0x3e, 0xf3, 0xd9, 0x56, 0x3f, 0x5b, 0xc9, 0x85, 0xfc, 0x52, 0xf0, 0xfe, 0x1f, 0x0d, 0x68, 0xbf,
-	0x4c, 0x68, 0x42, 0x6d, 0xca, 0x59, 0x24, 0x10, 0x82, 0x46, 0x48, 0x96, 0x54, 0xd7, 0x7a, 0xda,
-	0xa0, 0x65, 0xcb, 0x6f, 0x34, 0x86, 0x56, 0x21, 0x17, 0xeb, 0xf5, 0xde, 0xd1, 0xa0, 0x3d, 0xec,
-	0x62, 0xc2, 0x03, 0x5c, 0x0a, 0xc4, 0x76, 0x81, 0x98, 0x84, 0x22, 0x4a, 0x47, 0x8d, 0x37, 0x7f,
-	0x76, 0x6b, 0xf6, 0x36, 0x0e, 0x5d, 0xc1, 0xd9, 0x66, 0xe1, 0x24, 0x31, 0xf5, 0xf4, 0x23, 0xc9,
-	0xf4, 0xe9, 0x61, 0xa6, 0x9b, 0x98, 0x7a, 0x65, 0xb6, 0xd3, 0xa8, 0x7c, 0x82, 0x7e, 0x86, 0x4b,
-	0x97, 0x25, 0xa1, 0x70, 0xd8, 0xcc, 0xe1, 0xcc, 0x8b, 0x9d, 0x69, 0xea, 0xf0, 0x5b, 0x12, 0x53,
-	0xbd, 0x21, 0x99, 0x07, 0x15, 0xe6, 0x71, 0x06, 0xff, 0x69, 0x76, 0xc5, 0xbc, 0x78, 0x94, 0x5e,
-	0x65, 0x50, 0x49, 0x6f, 0x23, 0xb7, 0x72, 0x60, 0x2c, 0xe0, 0x6c, 0xf7, 0x4a, 0xe8, 0x1c, 0x8e,
-	0xe6, 0x34, 0xcd, 0x2b, 0x93, 0x7d, 0xa2, 0xef, 0xe0, 0x78, 0x45, 0x16, 0x09, 0xd5, 0xeb, 0x3d,
-	0x6d, 0xd0, 0x1e, 0x62, 0xac, 0x3a, 0x86, 0xcb, 0x1d, 0xc3, 0x7c, 0xee, 0xcb, 0x44, 0x8a, 0xe4,
-	0xf1, 0xcb, 0x84, 0x84, 0x22, 0x10, 0xa9, 0xad, 0x82, 0xbf, 0xae, 0x3f, 0xd7, 0x0c, 0x0e, 0xa8,
-	0x7a, 0xed, 0x7b, 0x55, 0x9c, 0x40, 0xe7, 0x40, 0x39, 0xf6, 0xc8, 0x5e, 0x94, 0x65, 0x4f, 0x4b,
-	0x34, 0xfd, 0xf5, 0x31, 0xa0, 0xf1, 0x22, 0x89, 0x05, 0x8d, 0x6e, 0xb2, 0x11, 0xc9, 0x6d, 0xf4,
-	0x18, 0xc0, 0x55, 0xbb, 0x4e, 0xe0, 0xe5, 0x4c, 0xad, 0x7c, 0xe7, 0x85, 0x97, 0xb9, 0x8c, 0x33,
-	0xb6, 0xd0, 0x9b, 0xca, 0x65, 0xd9, 0x37, 0x9a, 0x40, 0x3b, 0x92, 0xc1, 0x4e, 0x36, 0x49, 0xf9,
-	0x05, 0x0d, 0xac, 0xa6, 0x08, 0x17, 0x2e, 0xc6, 0xd7, 0xc5, 0x98, 0x8d, 0x4e, 0x32, 0x53, 0xbc,
-	0xfe, 0xab, 0xab, 0xd9, 0xa0, 0x02, 0xb3, 0x23, 0xf4, 0x04, 0x9a, 0xbf, 0x66, 0x2d, 0x8f, 0x73,
-	0x7f, 0x9d, 0xbf, 0xef, 0x82, 0x51, 0x5d, 0xd7, 0xec, 0x1c, 0x83, 0x1c, 0x38, 0x2f, 0xf2, 0x74,
-	0x09, 0x27, 0x6e, 0x20, 0xd2, 0xdc, 0x3d, 0x4f, 0x64, 0x5c, 0xf5, 0x6a, 0xc5, 0xd6, 0x38, 0x87,
-	0x2b, 0x83, 0x36, 0xb3, 0x5c, 0x74, 0xcd, 0xfe, 0xd0, 0xdd, 0x3d, 0x45, 0xaf, 0xc0, 0x28, 0x04,
-	0xc8, 0x8a, 0x04, 0x0b, 0x32, 0x5d, 0xd0, 0xad, 0xd4, 0xb1, 0x94, 0xfa, 0xf2, 0x7f, 0xa4, 0xbe,
-	0x2d, 0x02, 0xf7, 0x6b, 0xea, 0xee, 0x01, 0x18, 0xba, 0x81, 0x4e, 0xc8, 0x3c, 0xea, 0x88, 0x94,
-	0x53, 0x47, 0xbe, 0x60, 0x8e, 0xaa, 0x54, 0xac, 0x7f, 0x20, 0x95, 0x75, 0xa9, 0xfc, 0x23, 0xf3,
-	0xe8, 0x75, 0xca, 0x69, 0x49, 0x3a, 0x9f, 0xb8, 0x8b, 0xb0, 0x7a, 0x14, 0x1b, 0x11, 0x5c, 0xec,
-	0x2b, 0xc2, 0xbd, 0xda, 0xf5, 0x15, 0x3c, 0xfe, 0xcf, 0x6a, 0xdc, 0xa7, 0x78, 0xff, 0x17, 0x40,
-	0x45, 0x8d, 0x5e, 0x78, 0x34, 0x14, 0xc1, 0x2c, 0xa0, 0x11, 0x3a, 0x83, 0xfa, 0xc6, 0xdb, 0xf5,
-	0xc0, 0x43, 0x5f, 0x41, 0x53, 0x90, 0x20, 0x14, 0xc5, 0x1b, 0xf9, 0x71, 0x49, 0x10, 0x67, 0x8f,
-	0x3c, 0x5e, 0x3d, 0xc5, 0xd7, 0x19, 0x22, 0xaf, 0x6e, 0x0e, 0xef, 0xff, 0xde, 0x80, 0x47, 0x7b,
-	0x7a, 0x80, 0x9e, 0x41, 0x6b, 0xd3, 0x3e, 0xa9, 0xd3, 0x1e, 0x76, 0x76, 0x1a, 0xb6, 0x4d, 0xc6,
-	0x3e, 0x29, 0x3a, 0x85, 0x7e, 0x80, 0x93, 0x8d, 0xbf, 0x54, 0x22, 0x9f, 0x1d, 0xea, 0x32, 0xde,
-	0x35, 0x94, 0xca, 0x6a, 0x13, 0x8d, 0x3c, 0x40, 0x7b, 0x3c, 0xab, 0xc6, 0xca, 0x3a, 0xc8, 0x79,
-	0xc0, 0xad, 0x8a, 0xfc, 0x23, 0x52, 0x31, 0xe9, 0x60, 0x33, 0xb0, 0x8d, 0xfd, 0x03, 0x5b, 0x0c,
-	0xab, 0x31, 0x87, 0xd3, 0x87, 0x33, 0x9c, 0x80, 0xcb, 0x87, 0x77, 0xda, 0xf0, 0x7b, 0x38, 0x96,
-	0xb5, 0x44, 0xdf, 0x40, 0x5b, 0xdd, 0x5e, 0x2d, 0x3b, 0x07, 0x9e, 0x08, 0xe3, 0xb2, 0xf2, 0x40,
-	0x4e, 0xb2, 0xdf, 0x8c, 0x51, 0xef, 0xdd, 0x3f, 0x66, 0xed, 0xb7, 0xb5, 0xa9, 0xbd, 0x59, 0x9b,
-	0xda, 0xdb, 0xb5, 0xa9, 0xfd, 0xbd, 0x36, 0xb5, 0xd7, 0x77, 0x66, 0xed, 0xed, 0x9d, 0x59, 0x7b,
-	0x77, 0x67, 0xd6, 0xa6, 0x4d, 0x19, 0xf1, 0xc5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x34, 0xc1,
-	0x27, 0xb3, 0xe3, 0x08, 0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x65, 0x59, 0xb5, 0x47, 0xb0, 0xe3, 0xae, 0x0d, 0x9b, 0x65, 0x11, 0xd9, 0x70, 0x8b,
+	0x42, 0x87, 0x74, 0x89, 0xb8, 0x29, 0x1a, 0xf4, 0x50, 0xa0, 0x72, 0x8d, 0x36, 0x40, 0xd1, 0x3a,
+	0xac, 0x7d, 0x6b, 0x41, 0xac, 0xc8, 0x35, 0xbd, 0x90, 0xc4, 0xdd, 0x90, 0x4b, 0x03, 0x44, 0x2e,
+	0xbd, 0x14, 0xe8, 0x31, 0x3f, 0x2b, 0xc7, 0x1c, 0x73, 0xea, 0x87, 0xfc, 0x47, 0x8a, 0xdd, 0x25,
+	0x25, 0x2a, 0x14, 0x9b, 0x93, 0x7d, 0xdb, 0x8f, 0x37, 0xf3, 0x86, 0xb3, 0xef, 0x8d, 0x04, 0xbb,
+	0x62, 0x1c, 0xb9, 0x44, 0x30, 0x37, 0x4b, 0x49, 0x44, 0xb1, 0x48, 0xb8, 0xe4, 0x68, 0x8d, 0x08,
+	0xe6, 0x1c, 0x46, 0x9c, 0x47, 0x13, 0xea, 0xea, 0xa3, 0x51, 0x76, 0xe5, 0x4a, 0x36, 0xa5, 0xa9,
+	0x24, 0x53, 0x61, 0x50, 0xce, 0xc7, 0xef, 0x02, 0xe8, 0x54, 0xc8, 0xbc, 0xb8, 0x3c, 0x1e, 0x3f,
+	0x4d, 0x31, 0xe3, 0x3a, 0x75, 0xc0, 0x13, 0xea, 0xde, 0x3c, 0x76, 0x23, 0x1a, 0xd3, 0x84, 0x48,
+	0x1a, 0x16, 0x98, 0x27, 0x0b, 0xcc, 0x94, 0x04, 0xd7, 0x2c, 0xa6, 0x49, 0xee, 0x96, 0xf5, 0x24,
+	0x34, 0xe5, 0x59, 0x12, 0xd0, 0x5a, 0xd4, 0xe7, 0x11, 0x93, 0xd7, 0xd9, 0x08, 0x07, 0x7c, 0xea,
+	0x46, 0x3c, 0xe2, 0x0b, 0x7e, 0xb5, 0xd3, 0x1b, 0xbd, 0x32, 0xf0, 0xe3, 0x3f, 0xba, 0xd0, 0x7b,
+	0x9e, 0xd1, 0x8c, 0x7a, 0x54, 0xf0, 0x44, 0x22, 0x04, 0x9d, 0x98, 0x4c, 0xa9, 0x6d, 0x1d, 0x59,
+	0x83, 0x4d, 0x4f, 0xaf, 0xd1, 0x29, 0x6c, 0x96, 0x74, 0xa9, 0xdd, 0x3e, 0x5a, 0x1b, 0xf4, 0x4e,
+	0x0e, 0x31, 0x11, 0x0c, 0x57, 0x02, 0xb1, 0x57, 0x22, 0xce, 0x62, 0x99, 0xe4, 0xc3, 0xce, 0xeb,
+	0xbf, 0x0e, 0x5b, 0xde, 0x22, 0x0e, 0x9d, 0xc3, 0xf6, 0x7c, 0xe3, 0x67, 0x29, 0x0d, 0xed, 0x35,
+	0x9d, 0xe9, 0x93, 0xe6, 0x4c, 0x97, 0x29, 0x0d, 0xab, 0xd9, 0xb6, 0x92, 0xea, 0x0d, 0xfa, 0x15,
+	0xf6, 0x03, 0x9e, 0xc5, 0xd2, 0xe7, 0x57, 0xbe, 0xe0, 0x61, 0xea, 0x8f, 0x72, 0x5f, 0x5c, 0x93,
+	0x94, 0xda, 0x1d, 0x9d, 0x79, 0x50, 0xcb, 0x7c, 0xaa, 0xe0, 0x3f, 0x5f, 0x9d, 0xf3, 0x30, 0x1d,
+	0xe6, 0xe7, 0x0a, 0xaa, 0xd3, 0x7b, 0x28, 0xa8, 0x5d, 0xa0, 0x5f, 0xe0, 0xc1, 0xa2, 0xde, 0x09,
+	0x9b, 0x32, 0x69, 0xaf, 0xeb, 0xb4, 0x9f, 0x36, 0x17, 0xfc, 0xa3, 0x82, 0x55, 0x2b, 0xde, 0x4e,
+	0x96, 0xae, 0x9c, 0x09, 0x6c, 0x2f, 0xf7, 0x09, 0xed, 0xc0, 0xda, 0x98, 0xe6, 0x45, 0xbb, 0xd5,
+	0x12, 0x7d, 0x07, 0xeb, 0x37, 0x64, 0x92, 0x51, 0xbb, 0x7d, 0x64, 0x0d, 0x7a, 0x27, 0x18, 0x1b,
+	0x19, 0xe0, 0xaa, 0x0c, 0xb0, 0x18, 0x47, 0xba, 0x8c, 0x32, 0x3f, 0x7e, 0x9e, 0x91, 0x58, 0x32,
+	0x99, 0x7b, 0x26, 0xf8, 0xeb, 0xf6, 0x53, 0xcb, 0x11, 0x80, 0xea, 0xbd, 0xbc, 0x53, 0xc6, 0x33,
+	0x38, 0x68, 0xe8, 0xf1, 0x0a, 0xda, 0xbd, 0x2a, 0xed, 0x56, 0x35, 0xcd, 0x0b, 0xd8, 0x5d, 0xd1,
+	0xd3, 0xbb, 0xac, 0xfc, 0x78, 0xb6, 0x0e, 0xe8, 0x74, 0x92, 0xa5, 0x92, 0x26, 0x97, 0xca, 0xea,
+	0x85, 0x1d, 0x1e, 0x02, 0x04, 0xe6, 0xd4, 0x67, 0x61, 0xc1, 0xbc, 0x59, 0x9c, 0x3c, 0x0b, 0x95,
+	0x5b, 0x04, 0xe7, 0x13, 0xbb, 0x6b, 0xdc, 0xa2, 0xd6, 0xe8, 0x0c, 0x7a, 0x89, 0x0e, 0xf6, 0xd5,
+	0x44, 0x28, 0x2a, 0x73, 0xb0, 0x99, 0x06, 0xb8, 0x74, 0x23, 0xbe, 0x28, 0xc7, 0xc5, 0x70, 0x43,
+	0x49, 0xe5, 0xd5, 0xdf, 0x87, 0x96, 0x07, 0x26, 0x50, 0x5d, 0xa1, 0x47, 0xd0, 0x7d, 0xa1, 0x34,
+	0x96, 0x16, 0x3e, 0xd9, 0x79, 0x57, 0x76, 0xc3, 0xb6, 0x6d, 0x79, 0x05, 0x06, 0xf9, 0xb0, 0x53,
+	0xd6, 0x19, 0x10, 0x41, 0x02, 0x26, 0xf3, 0xc2, 0x05, 0x8f, 0x74, 0x5c, 0xfd, 0xd3, 0xca, 0xa3,
+	0xd3, 0x02, 0x6e, 0x64, 0xdb, 0x55, 0xb5, 0xd8, 0x96, 0xf7, 0x20, 0x58, 0xbe, 0x45, 0x2f, 0xc1,
+	0x29, 0x09, 0xc8, 0x0d, 0x61, 0x13, 0x32, 0x9a, 0xd0, 0x05, 0x95, 0x71, 0xc6, 0x97, 0xef, 0xa1,
+	0xfa, 0xb6, 0x0c, 0x5c, 0xcd, 0x69, 0x07, 0x0d, 0x30, 0x74, 0x09, 0x07, 0x31, 0x0f, 0xa9, 0x2f,
+	0x73, 0x41, 0x7d, 0x3d, 0x89, 0x7d, 0xd3, 0xa9, 0xd4, 0xfe, 0x40, 0x33, 0xdb, 0x9a, 0xf9, 0x27,
+	0x1e, 0xd2, 0x8b, 0x5c, 0xd0, 0x0a, 0x75, 0xe1, 0xc3, 0xbd, 0xb8, 0x7e, 0x95, 0x3a, 0x09, 0xec,
+	0xad, 0x6a, 0xc2, 0x9d, 0x3a, 0xe4, 0x25, 0x3c, 0xfc, 0xdf, 0x6e, 0xdc, 0xa9, 0xc8, 0x7f, 0x03,
+	0x54, 0xf6, 0xe8, 0x59, 0x48, 0x63, 0xc9, 0xae, 0x18, 0x4d, 0xd0, 0x36, 0xb4, 0xe7, 0xda, 0x6e,
+	0xb3, 0x10, 0x7d, 0x05, 0x5d, 0x49, 0x58, 0x2c, 0xcb, 0x59, 0xff, 0x51, 0x85, 0x10, 0xab, 0x1f,
+	0x2b, 0x7c, 0xf3, 0x18, 0x5f, 0x28, 0x44, 0xd1, 0xdd, 0x02, 0x7e, 0xfc, 0x67, 0x07, 0x76, 0x57,
+	0xbc, 0x01, 0x7a, 0x02, 0x9b, 0xf3, 0xe7, 0xd3, 0x3c, 0xbd, 0x93, 0x83, 0xa5, 0x07, 0x5b, 0x14,
+	0xe3, 0x6d, 0x94, 0x2f, 0x85, 0x7e, 0x80, 0x8d, 0xb9, 0xbe, 0x4c, 0x21, 0x9f, 0x35, 0xbd, 0x32,
+	0x5e, 0x16, 0x94, 0xa9, 0x6a, 0x1e, 0x8d, 0x42, 0x40, 0x2b, 0x34, 0x6b, 0x6c, 0xe5, 0x36, 0xe6,
+	0x6c, 0x50, 0xab, 0x49, 0xfe, 0x21, 0xa9, 0x89, 0x74, 0x30, 0x37, 0x6c, 0x67, 0xb5, 0x61, 0x4b,
+	0xb3, 0x3a, 0x63, 0xd8, 0xba, 0x3f, 0xc1, 0x49, 0xd8, 0xbf, 0x7f, 0xa5, 0x9d, 0x7c, 0x0f, 0xeb,
+	0xba, 0x97, 0xe8, 0x1b, 0xe8, 0x99, 0xaf, 0x37, 0xdb, 0x83, 0x86, 0x11, 0xe1, 0xec, 0xd7, 0x06,
+	0xe4, 0x99, 0xfa, 0xbb, 0x34, 0x3c, 0x7a, 0xfb, 0x6f, 0xbf, 0xf5, 0xfb, 0xac, 0x6f, 0xbd, 0x9e,
+	0xf5, 0xad, 0x37, 0xb3, 0xbe, 0xf5, 0xcf, 0xac, 0x6f, 0xbd, 0xba, 0xed, 0xb7, 0xde, 0xdc, 0xf6,
+	0x5b, 0x6f, 0x6f, 0xfb, 0xad, 0x51, 0x57, 0x47, 0x7c, 0xf1, 0xdf, 0x00, 0x7d, 0x81, 0x48, 0x25,
+	0xab, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -487,6 +498,30 @@ func (m *QueueReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ResourcesLimit) > 0 {
+		for k := range m.ResourcesLimit {
+			v := m.ResourcesLimit[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintUsage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUsage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.CountOfPodsByPhase) > 0 {
 		for k := range m.CountOfPodsByPhase {
 			v := m.CountOfPodsByPhase[k]
@@ -665,12 +700,12 @@ func (m *ClusterUsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintUsage(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintUsage(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ClusterId) > 0 {
@@ -871,6 +906,15 @@ func (m *QueueReport) Size() (n int) {
 			n += mapEntrySize + 1 + sovUsage(uint64(mapEntrySize))
 		}
 	}
+	if len(m.ResourcesLimit) > 0 {
+		for k, v := range m.ResourcesLimit {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovUsage(uint64(len(k))) + 1 + l + sovUsage(uint64(l))
+			n += mapEntrySize + 1 + sovUsage(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1019,11 +1063,22 @@ func (this *QueueReport) String() string {
 		mapStringForCountOfPodsByPhase += fmt.Sprintf("%v: %v,", k, this.CountOfPodsByPhase[k])
 	}
 	mapStringForCountOfPodsByPhase += "}"
+	keysForResourcesLimit := make([]string, 0, len(this.ResourcesLimit))
+	for k, _ := range this.ResourcesLimit {
+		keysForResourcesLimit = append(keysForResourcesLimit, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesLimit)
+	mapStringForResourcesLimit := "map[string]resource.Quantity{"
+	for _, k := range keysForResourcesLimit {
+		mapStringForResourcesLimit += fmt.Sprintf("%v: %v,", k, this.ResourcesLimit[k])
+	}
+	mapStringForResourcesLimit += "}"
 	s := strings.Join([]string{`&QueueReport{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Resources:` + mapStringForResources + `,`,
 		`ResourcesUsed:` + mapStringForResourcesUsed + `,`,
 		`CountOfPodsByPhase:` + mapStringForCountOfPodsByPhase + `,`,
+		`ResourcesLimit:` + mapStringForResourcesLimit + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1568,6 +1623,135 @@ func (m *QueueReport) Unmarshal(dAtA []byte) error {
 			}
 			m.CountOfPodsByPhase[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourcesLimit == nil {
+				m.ResourcesLimit = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUsage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUsage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthUsage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUsage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthUsage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipUsage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesLimit[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsage(dAtA[iNdEx:])
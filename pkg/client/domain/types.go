@@ -36,7 +36,9 @@ type JobSubmissionDescription struct {
 type JobSubmitFile struct {
 	Queue    string
 	JobSetId string
-	Jobs     []*api.JobSubmitRequestItem `json:"jobs"`
+	// Maximum number of jobs of the job set leased or running at once
+	MaxRunning uint32
+	Jobs       []*api.JobSubmitRequestItem `json:"jobs"`
 }
 
 type LoadTestSummary struct {
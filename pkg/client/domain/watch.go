@@ -16,6 +16,7 @@ const (
 	Submitted = "Submitted"
 	Duplicate = "Duplicate"
 	Queued    = "Queued"
+	Held      = "Held"
 	Leased    = "Leased"
 	Pending   = "Pending"
 	Running   = "Running"
@@ -42,6 +43,7 @@ var inactiveStates []JobStatus
 func init() {
 	statesToIncludeInSummary = []JobStatus{
 		Queued,
+		Held,
 		Leased,
 		Pending,
 		Running,
@@ -198,6 +200,10 @@ func updateJobInfo(info *JobInfo, event api.Event) {
 		resetPodStatus(info)
 	case *api.JobCancelledEvent:
 		info.Status = Cancelled
+	case *api.JobHeldEvent:
+		info.Status = Held
+	case *api.JobReleasedEvent:
+		info.Status = Queued
 
 	// pod events:
 	case *api.JobPendingEvent:
@@ -305,6 +311,10 @@ func isLifeCycleEvent(event api.Event) bool {
 
 	case *api.JobCancelledEvent:
 		return true
+	case *api.JobHeldEvent:
+		return true
+	case *api.JobReleasedEvent:
+		return true
 	case *api.JobUnableToScheduleEvent:
 		return false
 	case *api.JobReprioritizedEvent:
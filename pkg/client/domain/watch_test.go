@@ -88,18 +88,29 @@ func TestWatchContext_GetCurrentStateSummary(t *testing.T) {
 	watchContext.ProcessEvent(&api.JobPendingEvent{JobId: "2"})
 	watchContext.ProcessEvent(&api.JobRunningEvent{JobId: "3"})
 
-	expected := "Queued:   1, Leased:   0, Pending:   1, Running:   1, Succeeded:   0, Failed:   0, Cancelled:   0"
+	expected := "Queued:   1, Held:   0, Leased:   0, Pending:   1, Running:   1, Succeeded:   0, Failed:   0, Cancelled:   0"
 	result := watchContext.GetCurrentStateSummary()
 
 	assert.Equal(t, expected, result)
 }
 
+func TestWatchContext_HeldJobIsQueuedAgainWhenReleased(t *testing.T) {
+	watchContext := NewWatchContext()
+
+	watchContext.ProcessEvent(&api.JobQueuedEvent{JobId: "1", Created: time.Now()})
+	watchContext.ProcessEvent(&api.JobHeldEvent{JobId: "1", Created: time.Now().Add(time.Second)})
+	assert.Equal(t, JobStatus(Held), watchContext.GetJobInfo("1").Status)
+
+	watchContext.ProcessEvent(&api.JobReleasedEvent{JobId: "1", Created: time.Now().Add(2 * time.Second)})
+	assert.Equal(t, JobStatus(Queued), watchContext.GetJobInfo("1").Status)
+}
+
 func TestWatchContext_GetCurrentStateSummary_IsCorrectlyAlteredOnUpdateToExistingJob(t *testing.T) {
 	watchContext := NewWatchContext()
 
 	watchContext.ProcessEvent(&api.JobQueuedEvent{JobId: "1"})
 	watchContext.ProcessEvent(&api.JobPendingEvent{JobId: "1"})
-	expected := "Queued:   0, Leased:   0, Pending:   1, Running:   0, Succeeded:   0, Failed:   0, Cancelled:   0"
+	expected := "Queued:   0, Held:   0, Leased:   0, Pending:   1, Running:   0, Succeeded:   0, Failed:   0, Cancelled:   0"
 	result := watchContext.GetCurrentStateSummary()
 	assert.Equal(t, result, expected)
 }
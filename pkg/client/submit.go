@@ -31,6 +31,25 @@ func DeleteQueue(submitClient api.SubmitClient, name string) error {
 	return e
 }
 
+// ListQueues returns the queues of all pages, fetching request.Take queues at a time
+func ListQueues(submitClient api.SubmitClient, request *api.QueueListRequest) ([]*api.QueueListItem, error) {
+	pageRequest := *request
+	result := []*api.QueueListItem{}
+	for {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		response, e := submitClient.ListQueues(ctx, &pageRequest)
+		cancel()
+		if e != nil {
+			return nil, e
+		}
+		result = append(result, response.Queues...)
+		if response.NextCursor == "" {
+			return result, nil
+		}
+		pageRequest.Cursor = response.NextCursor
+	}
+}
+
 func SubmitJobs(submitClient api.SubmitClient, request *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
 	AddClientIds(request.JobRequestItems)
 	ctx, cancel := common.ContextWithDefaultTimeout()
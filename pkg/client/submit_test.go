@@ -1,10 +1,12 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/G-Research/armada/pkg/api"
@@ -54,3 +56,41 @@ func createJobRequestItems(numberOfItems int) []*api.JobSubmitRequestItem {
 
 	return requestItems
 }
+
+type fakeListQueuesClient struct {
+	api.SubmitClient
+	queues   []string
+	requests []*api.QueueListRequest
+}
+
+func (c *fakeListQueuesClient) ListQueues(ctx context.Context, in *api.QueueListRequest, opts ...grpc.CallOption) (*api.QueueListResponse, error) {
+	c.requests = append(c.requests, in)
+	start := 0
+	for start < len(c.queues) && c.queues[start] <= in.Cursor {
+		start++
+	}
+	end := start + int(in.Take)
+	response := &api.QueueListResponse{}
+	if end < len(c.queues) {
+		response.NextCursor = c.queues[end-1]
+	} else {
+		end = len(c.queues)
+	}
+	for _, name := range c.queues[start:end] {
+		response.Queues = append(response.Queues, &api.QueueListItem{Queue: &api.Queue{Name: name}})
+	}
+	return response, nil
+}
+
+func TestListQueues_FetchesAllPages(t *testing.T) {
+	submitClient := &fakeListQueuesClient{queues: []string{"a", "b", "c"}}
+
+	result, err := ListQueues(submitClient, &api.QueueListRequest{Owner: "owner", Take: 2})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 3)
+	assert.Equal(t, "c", result[2].Queue.Name)
+	assert.Len(t, submitClient.requests, 2)
+	assert.Equal(t, "b", submitClient.requests[1].Cursor)
+	assert.Equal(t, "owner", submitClient.requests[1].Owner)
+}
@@ -44,7 +44,8 @@ protoc \
 --grpc-gateway_out=logtostderr=true,$TYPES:. \
 --swagger_out=logtostderr=true,$TYPES,allow_merge=true,simple_operation_ids=true,json_names_for_fields=true,merge_file_name=./pkg/api/api:. \
 pkg/api/event.proto \
-pkg/api/submit.proto
+pkg/api/submit.proto \
+pkg/api/job.proto
 
 protoc \
 --proto_path=. \